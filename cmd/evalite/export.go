@@ -0,0 +1,75 @@
+package main
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"flag"
+	"fmt"
+	"github.com/tincans-ai/evalite/gen/eval/v1"
+	"github.com/tincans-ai/evalite/gen/eval/v1/evalv1connect"
+	"github.com/tincans-ai/evalite/packages/tabular"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func runExport(client evalv1connect.EvaluationServiceClient, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	workspaceID := fs.String("workspace", "", "workspace ID to export (required)")
+	format := fs.String("format", "", "file format: csv, jsonl or yaml (default: from the output extension, or csv)")
+	output := fs.String("o", "", "output file (default: stdout)")
+	version := fs.Uint("version", 0, "only export results for this prompt version")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: evalite export -workspace ID [flags]")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *workspaceID == "" || fs.NArg() != 0 {
+		fs.Usage()
+		return flag.ErrHelp
+	}
+
+	if *format == "" {
+		*format = strings.TrimPrefix(filepath.Ext(*output), ".")
+		if *format == "" {
+			*format = string(tabular.CSV)
+		}
+	}
+	f, err := tabular.ParseFormat(*format)
+	if err != nil {
+		return err
+	}
+
+	req := &evalv1.ExportWorkspaceRequest{
+		WorkspaceId: *workspaceID,
+		Format:      formatToProto(f),
+	}
+	if *version > 0 {
+		v := uint32(*version)
+		req.VersionNumber = &v
+	}
+	stream, err := client.ExportWorkspace(context.Background(), connect.NewRequest(req))
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		w = file
+	}
+	for stream.Receive() {
+		if _, err := w.Write(stream.Msg().Chunk); err != nil {
+			return err
+		}
+	}
+	return stream.Err()
+}
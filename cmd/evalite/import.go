@@ -0,0 +1,136 @@
+package main
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"flag"
+	"fmt"
+	"github.com/tincans-ai/evalite/gen/eval/v1"
+	"github.com/tincans-ai/evalite/gen/eval/v1/evalv1connect"
+	"github.com/tincans-ai/evalite/packages/tabular"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func runImport(client evalv1connect.EvaluationServiceClient, args []string) error {
+	fs := flag.NewFlagSet("import", flag.ContinueOnError)
+	workspaceID := fs.String("workspace", "", "workspace ID to import into (required)")
+	format := fs.String("format", "", "file format: csv, jsonl or yaml (default: from the file extension)")
+	reference := fs.String("reference", "", "column holding the reference output")
+	skipInvalid := fs.Bool("skip-invalid", false, "import the valid rows even if some rows fail")
+	dryRun := fs.Bool("dry-run", false, "validate the file without importing")
+	tags := fs.String("tags", "", "comma-separated tags to give every imported case")
+	split := fs.String("split", "", "split to put every imported case in: dev, test or holdout")
+	threshold := fs.Float64("dedup-threshold", 0, "similarity above which rows are near-duplicates of existing cases (default 0.8)")
+	nearDuplicates := fs.String("near-duplicates", "skip", "what to do with near-duplicate rows: skip, flag or allow")
+	mapping := mappingFlag{}
+	fs.Var(mapping, "map", "map a column to a prompt variable, as column=variable (repeatable)")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: evalite import -workspace ID [flags] FILE")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *workspaceID == "" || fs.NArg() != 1 {
+		fs.Usage()
+		return flag.ErrHelp
+	}
+	path := fs.Arg(0)
+
+	if *format == "" {
+		*format = strings.TrimPrefix(filepath.Ext(path), ".")
+	}
+	f, err := tabular.ParseFormat(*format)
+	if err != nil {
+		return err
+	}
+	s, err := parseSplit(*split)
+	if err != nil {
+		return err
+	}
+	action, err := parseDuplicateAction(*nearDuplicates)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	req := &evalv1.ImportTestCasesRequest{
+		WorkspaceId:   *workspaceID,
+		Format:        formatToProto(f),
+		Data:          data,
+		ColumnMapping: mapping,
+		SkipInvalid:   *skipInvalid,
+		DryRun:        *dryRun,
+		Split:         s,
+		Dedup:         &evalv1.DedupOptions{Threshold: *threshold, Action: action},
+	}
+	if *tags != "" {
+		req.Tags = strings.Split(*tags, ",")
+	}
+	if *reference != "" {
+		req.ReferenceColumn = reference
+	}
+	res, err := client.ImportTestCases(context.Background(), connect.NewRequest(req))
+	if err != nil {
+		return err
+	}
+
+	for _, e := range res.Msg.Errors {
+		fmt.Fprintf(os.Stderr, "%s:%d: %s\n", path, e.Row, e.Error)
+	}
+	for _, nd := range res.Msg.NearDuplicates {
+		fmt.Fprintf(os.Stderr, "%s:%d: near-duplicate of %s (similarity %.2f)\n", path, nd.Row, nd.DuplicateOf, nd.Similarity)
+	}
+	verb := "imported"
+	if *dryRun {
+		verb = "would import"
+	}
+	fmt.Printf("%s %d test cases, skipped %d duplicates, found %d near-duplicates, %d rows with errors\n",
+		verb, len(res.Msg.TestCases), res.Msg.NDuplicates, len(res.Msg.NearDuplicates), len(res.Msg.Errors))
+	if len(res.Msg.Errors) > 0 && !*skipInvalid {
+		return fmt.Errorf("nothing imported; fix the rows above or pass -skip-invalid")
+	}
+	return nil
+}
+
+func parseSplit(name string) (evalv1.Split, error) {
+	switch strings.ToLower(name) {
+	case "":
+		return evalv1.Split_SPLIT_UNSPECIFIED, nil
+	case "dev":
+		return evalv1.Split_SPLIT_DEV, nil
+	case "test":
+		return evalv1.Split_SPLIT_TEST, nil
+	case "holdout":
+		return evalv1.Split_SPLIT_HOLDOUT, nil
+	}
+	return 0, fmt.Errorf("unknown split %q", name)
+}
+
+func parseDuplicateAction(name string) (evalv1.DuplicateAction, error) {
+	switch strings.ToLower(name) {
+	case "skip":
+		return evalv1.DuplicateAction_DUPLICATE_ACTION_SKIP, nil
+	case "flag":
+		return evalv1.DuplicateAction_DUPLICATE_ACTION_FLAG, nil
+	case "allow":
+		return evalv1.DuplicateAction_DUPLICATE_ACTION_ALLOW, nil
+	}
+	return 0, fmt.Errorf("unknown near-duplicate action %q", name)
+}
+
+func formatToProto(f tabular.Format) evalv1.FileFormat {
+	switch f {
+	case tabular.JSONL:
+		return evalv1.FileFormat_FILE_FORMAT_JSONL
+	case tabular.YAML:
+		return evalv1.FileFormat_FILE_FORMAT_YAML
+	default:
+		return evalv1.FileFormat_FILE_FORMAT_CSV
+	}
+}
@@ -0,0 +1,71 @@
+// Command evalite is a command line client for an evalite server.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/tincans-ai/evalite/gen/eval/v1/evalv1connect"
+	"net/http"
+	"os"
+	"strings"
+)
+
+const usage = `usage: evalite <command> [flags]
+
+commands:
+  import    import test cases from a CSV, JSONL or YAML file
+  export    export test cases and results as CSV, JSONL or YAML
+
+Run "evalite <command> -h" for the flags of a command.
+The server address defaults to $EVALITE_ADDR, or http://localhost:8080.
+`
+
+// command runs a subcommand with its arguments.
+type command func(client evalv1connect.EvaluationServiceClient, args []string) error
+
+var commands = map[string]command{
+	"import": runImport,
+	"export": runExport,
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	cmd, ok := commands[os.Args[1]]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+
+	addr := os.Getenv("EVALITE_ADDR")
+	if addr == "" {
+		addr = "http://localhost:8080"
+	}
+	client := evalv1connect.NewEvaluationServiceClient(http.DefaultClient, addr)
+
+	if err := cmd(client, os.Args[2:]); err != nil {
+		if err == flag.ErrHelp {
+			os.Exit(2)
+		}
+		fmt.Fprintf(os.Stderr, "evalite %s: %v\n", os.Args[1], err)
+		os.Exit(1)
+	}
+}
+
+// mappingFlag collects repeated "column=variable" flags.
+type mappingFlag map[string]string
+
+func (m mappingFlag) String() string {
+	return fmt.Sprint(map[string]string(m))
+}
+
+func (m mappingFlag) Set(v string) error {
+	col, variable, ok := strings.Cut(v, "=")
+	if !ok {
+		return fmt.Errorf("expected column=variable, got %q", v)
+	}
+	m[col] = variable
+	return nil
+}
@@ -32,6 +32,13 @@ func withCORS(h http.Handler) http.Handler {
 	return middleware.Handler(h)
 }
 
+// withLogger adds the logger to the context of plain HTTP requests.
+func withLogger(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h.ServeHTTP(w, r.WithContext(logutil.ContextWithLogger(r.Context(), logger)))
+	})
+}
+
 // NewLoggerInterceptor returns a new unary interceptor that logs requests with `slog`.
 func NewLoggerInterceptor() connect.UnaryInterceptorFunc {
 	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
@@ -71,10 +78,15 @@ func main() {
 
 	// Migrate the schema
 	err = db.AutoMigrate(&eval.Workspace{}, &eval.Prompt{}, &eval.TestResult{},
-		&eval.TestCase{}, &eval.WorkspaceConfig{}, &eval.SystemPrompt{})
+		&eval.TestCase{}, &eval.WorkspaceConfig{}, &eval.SystemPrompt{}, &eval.Rating{}, &eval.Grader{},
+		&eval.Dataset{}, &eval.DatasetAttachment{}, &eval.PromptLabel{}, &eval.PromptLabelEvent{},
+		&eval.OptimizationRun{}, &eval.OptimizationCandidate{}, &eval.Example{}, &eval.Trace{})
 	if err != nil {
 		panic("failed to migrate schema")
 	}
+	if err := eval.BackfillRatings(db); err != nil {
+		panic(fmt.Sprintf("failed to backfill ratings: %v", err))
+	}
 
 	server := eval.NewService(db)
 	mux := http.NewServeMux()
@@ -82,6 +94,15 @@ func main() {
 	interceptors := connect.WithInterceptors(NewLoggerInterceptor())
 	path, handler := evalv1connect.NewEvaluationServiceHandler(server, interceptors)
 	mux.Handle(path, handler)
+
+	// OpenAI-compatible proxy that logs traffic as test cases
+	proxyConfig, err := eval.ProxyConfigFromEnv()
+	if err != nil {
+		panic(fmt.Sprintf("invalid proxy config: %v", err))
+	}
+	mux.Handle("/v1/chat/completions", withLogger(server.ProxyHandler(proxyConfig)))
+	// plain JSON version of the Complete RPC
+	mux.Handle("/v1/complete", withLogger(server.CompleteHandler()))
 	fmt.Println("serving on :8080")
 	http.ListenAndServe(
 		"localhost:8080",
@@ -3,12 +3,10 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CreateTestCaseRequest, CreateTestCaseResponse, CreateWorkspaceConfigRequest, CreateWorkspaceConfigResponse, CreateWorkspaceRequest, CreateWorkspaceResponse, DeleteTestCaseRequest, DeleteWorkspaceConfigRequest, EvaluationRequest, EvaluationResponse, GeneratePromptRequest, GeneratePromptResponse, GenerateTestCaseRequest, GenerateTestCaseResponse, GetModelConfigResponse, GetWorkspaceRequest, GetWorkspaceResponse, ListModelConfigsResponse, ListTestCasesRequest, ListTestCasesResponse, ListWorkspacesRequest, ListWorkspacesResponse, RateTestResultRequest, SetDefaultLargeModelConfigRequest, SetDefaultSmallModelConfigRequest, SetVersionActiveRequest, SetWorkspaceConfigActiveRequest, SetXMLModeRequest, SyntheticGenerationRequest, UpdateWorkspaceRequest, UpdateWorkspaceResponse } from "./eval_pb.js";
+import { AddExamplesFromResultsRequest, AddExamplesFromResultsResponse, ArchiveWorkspaceRequest, AttachDatasetRequest, AttachDatasetResponse, CloneWorkspaceRequest, CloneWorkspaceResponse, CompareVersionsRequest, CompareVersionsResponse, CompleteRequest, CompleteResponse, ComputeAgreementRequest, ComputeAgreementResponse, CreateDatasetRequest, CreateDatasetResponse, CreateExampleRequest, CreateExampleResponse, CreateGraderRequest, CreateGraderResponse, CreateTestCaseRequest, CreateTestCaseResponse, CreateWorkspaceConfigRequest, CreateWorkspaceConfigResponse, CreateWorkspaceRequest, CreateWorkspaceResponse, DeleteDatasetRequest, DeleteExampleRequest, DeleteGraderRequest, DeleteTestCaseRequest, DeleteWorkspaceConfigRequest, DeleteWorkspaceRequest, DetachDatasetRequest, DiffPromptVersionsRequest, DiffPromptVersionsResponse, EvaluationRequest, EvaluationResponse, ExportWorkspaceBundleRequest, ExportWorkspaceBundleResponse, ExportWorkspaceRequest, ExportWorkspaceResponse, FindDuplicateTestCasesRequest, FindDuplicateTestCasesResponse, GeneratePromptRequest, GeneratePromptResponse, GenerateTestCaseRequest, GenerateTestCaseResponse, GetModelConfigResponse, GetWorkspaceRequest, GetWorkspaceResponse, ImportTestCasesRequest, ImportTestCasesResponse, ImportWorkspaceBundleRequest, ImportWorkspaceBundleResponse, LintPromptRequest, LintPromptResponse, ListDatasetsRequest, ListDatasetsResponse, ListDeletedTestCasesRequest, ListDeletedTestCasesResponse, ListExamplesRequest, ListExamplesResponse, ListGradersRequest, ListGradersResponse, ListLabelHistoryRequest, ListLabelHistoryResponse, ListLabelsRequest, ListLabelsResponse, ListModelConfigsResponse, ListOptimizationRunsRequest, ListOptimizationRunsResponse, ListTestCasesRequest, ListTestCasesResponse, ListTracesRequest, ListTracesResponse, ListWorkspacesRequest, ListWorkspacesResponse, OptimizePromptRequest, OptimizePromptResponse, PromoteVersionRequest, PromoteVersionResponse, PurgeTestCasesRequest, PurgeTestCasesResponse, RateTestResultRequest, RestoreTestCaseRequest, RestoreTestCaseResponse, RollbackLabelRequest, RollbackLabelResponse, RunGraderRequest, RunGraderResponse, RunPerturbationsRequest, RunPerturbationsResponse, SetDefaultLargeModelConfigRequest, SetDefaultSmallModelConfigRequest, SetExampleSettingsRequest, SetVersionActiveRequest, SetWorkspaceConfigActiveRequest, SetXMLModeRequest, SyntheticGenerationRequest, UpdateTestCasesRequest, UpdateTestCasesResponse, UpdateWorkspaceRequest, UpdateWorkspaceResponse } from "./eval_pb.js";
 import { Empty, MethodKind } from "@bufbuild/protobuf";
 
 /**
- * Extended EvaluationService
- *
  * @generated from service eval.v1.EvaluationService
  */
 export const EvaluationService = {
@@ -70,6 +68,60 @@ export const EvaluationService = {
       O: UpdateWorkspaceResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc eval.v1.EvaluationService.ExportWorkspace
+     */
+    exportWorkspace: {
+      name: "ExportWorkspace",
+      I: ExportWorkspaceRequest,
+      O: ExportWorkspaceResponse,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * @generated from rpc eval.v1.EvaluationService.CloneWorkspace
+     */
+    cloneWorkspace: {
+      name: "CloneWorkspace",
+      I: CloneWorkspaceRequest,
+      O: CloneWorkspaceResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc eval.v1.EvaluationService.DeleteWorkspace
+     */
+    deleteWorkspace: {
+      name: "DeleteWorkspace",
+      I: DeleteWorkspaceRequest,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc eval.v1.EvaluationService.ArchiveWorkspace
+     */
+    archiveWorkspace: {
+      name: "ArchiveWorkspace",
+      I: ArchiveWorkspaceRequest,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc eval.v1.EvaluationService.ExportWorkspaceBundle
+     */
+    exportWorkspaceBundle: {
+      name: "ExportWorkspaceBundle",
+      I: ExportWorkspaceBundleRequest,
+      O: ExportWorkspaceBundleResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc eval.v1.EvaluationService.ImportWorkspaceBundle
+     */
+    importWorkspaceBundle: {
+      name: "ImportWorkspaceBundle",
+      I: ImportWorkspaceBundleRequest,
+      O: ImportWorkspaceBundleResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * Prompt operations
      *
@@ -81,6 +133,42 @@ export const EvaluationService = {
       O: GeneratePromptResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc eval.v1.EvaluationService.DiffPromptVersions
+     */
+    diffPromptVersions: {
+      name: "DiffPromptVersions",
+      I: DiffPromptVersionsRequest,
+      O: DiffPromptVersionsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc eval.v1.EvaluationService.LintPrompt
+     */
+    lintPrompt: {
+      name: "LintPrompt",
+      I: LintPromptRequest,
+      O: LintPromptResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc eval.v1.EvaluationService.OptimizePrompt
+     */
+    optimizePrompt: {
+      name: "OptimizePrompt",
+      I: OptimizePromptRequest,
+      O: OptimizePromptResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc eval.v1.EvaluationService.ListOptimizationRuns
+     */
+    listOptimizationRuns: {
+      name: "ListOptimizationRuns",
+      I: ListOptimizationRunsRequest,
+      O: ListOptimizationRunsResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * TestCase operations
      *
@@ -119,6 +207,60 @@ export const EvaluationService = {
       O: Empty,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc eval.v1.EvaluationService.ImportTestCases
+     */
+    importTestCases: {
+      name: "ImportTestCases",
+      I: ImportTestCasesRequest,
+      O: ImportTestCasesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc eval.v1.EvaluationService.UpdateTestCases
+     */
+    updateTestCases: {
+      name: "UpdateTestCases",
+      I: UpdateTestCasesRequest,
+      O: UpdateTestCasesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc eval.v1.EvaluationService.FindDuplicateTestCases
+     */
+    findDuplicateTestCases: {
+      name: "FindDuplicateTestCases",
+      I: FindDuplicateTestCasesRequest,
+      O: FindDuplicateTestCasesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc eval.v1.EvaluationService.ListDeletedTestCases
+     */
+    listDeletedTestCases: {
+      name: "ListDeletedTestCases",
+      I: ListDeletedTestCasesRequest,
+      O: ListDeletedTestCasesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc eval.v1.EvaluationService.RestoreTestCase
+     */
+    restoreTestCase: {
+      name: "RestoreTestCase",
+      I: RestoreTestCaseRequest,
+      O: RestoreTestCaseResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc eval.v1.EvaluationService.PurgeTestCases
+     */
+    purgeTestCases: {
+      name: "PurgeTestCases",
+      I: PurgeTestCasesRequest,
+      O: PurgeTestCasesResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * ModelConfig operations
      *
@@ -220,6 +362,227 @@ export const EvaluationService = {
       O: Empty,
       kind: MethodKind.Unary,
     },
+    /**
+     * Rating operations
+     *
+     * @generated from rpc eval.v1.EvaluationService.ComputeAgreement
+     */
+    computeAgreement: {
+      name: "ComputeAgreement",
+      I: ComputeAgreementRequest,
+      O: ComputeAgreementResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc eval.v1.EvaluationService.CompareVersions
+     */
+    compareVersions: {
+      name: "CompareVersions",
+      I: CompareVersionsRequest,
+      O: CompareVersionsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Grader operations
+     *
+     * @generated from rpc eval.v1.EvaluationService.CreateGrader
+     */
+    createGrader: {
+      name: "CreateGrader",
+      I: CreateGraderRequest,
+      O: CreateGraderResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc eval.v1.EvaluationService.ListGraders
+     */
+    listGraders: {
+      name: "ListGraders",
+      I: ListGradersRequest,
+      O: ListGradersResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc eval.v1.EvaluationService.DeleteGrader
+     */
+    deleteGrader: {
+      name: "DeleteGrader",
+      I: DeleteGraderRequest,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc eval.v1.EvaluationService.RunGrader
+     */
+    runGrader: {
+      name: "RunGrader",
+      I: RunGraderRequest,
+      O: RunGraderResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Dataset operations
+     *
+     * @generated from rpc eval.v1.EvaluationService.CreateDataset
+     */
+    createDataset: {
+      name: "CreateDataset",
+      I: CreateDatasetRequest,
+      O: CreateDatasetResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc eval.v1.EvaluationService.ListDatasets
+     */
+    listDatasets: {
+      name: "ListDatasets",
+      I: ListDatasetsRequest,
+      O: ListDatasetsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc eval.v1.EvaluationService.DeleteDataset
+     */
+    deleteDataset: {
+      name: "DeleteDataset",
+      I: DeleteDatasetRequest,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc eval.v1.EvaluationService.AttachDataset
+     */
+    attachDataset: {
+      name: "AttachDataset",
+      I: AttachDatasetRequest,
+      O: AttachDatasetResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc eval.v1.EvaluationService.DetachDataset
+     */
+    detachDataset: {
+      name: "DetachDataset",
+      I: DetachDatasetRequest,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Label operations
+     *
+     * @generated from rpc eval.v1.EvaluationService.PromoteVersion
+     */
+    promoteVersion: {
+      name: "PromoteVersion",
+      I: PromoteVersionRequest,
+      O: PromoteVersionResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc eval.v1.EvaluationService.RollbackLabel
+     */
+    rollbackLabel: {
+      name: "RollbackLabel",
+      I: RollbackLabelRequest,
+      O: RollbackLabelResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc eval.v1.EvaluationService.ListLabels
+     */
+    listLabels: {
+      name: "ListLabels",
+      I: ListLabelsRequest,
+      O: ListLabelsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc eval.v1.EvaluationService.ListLabelHistory
+     */
+    listLabelHistory: {
+      name: "ListLabelHistory",
+      I: ListLabelHistoryRequest,
+      O: ListLabelHistoryResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Serving operations
+     *
+     * @generated from rpc eval.v1.EvaluationService.Complete
+     */
+    complete: {
+      name: "Complete",
+      I: CompleteRequest,
+      O: CompleteResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc eval.v1.EvaluationService.ListTraces
+     */
+    listTraces: {
+      name: "ListTraces",
+      I: ListTracesRequest,
+      O: ListTracesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Robustness operations
+     *
+     * @generated from rpc eval.v1.EvaluationService.RunPerturbations
+     */
+    runPerturbations: {
+      name: "RunPerturbations",
+      I: RunPerturbationsRequest,
+      O: RunPerturbationsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Example operations
+     *
+     * @generated from rpc eval.v1.EvaluationService.CreateExample
+     */
+    createExample: {
+      name: "CreateExample",
+      I: CreateExampleRequest,
+      O: CreateExampleResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc eval.v1.EvaluationService.ListExamples
+     */
+    listExamples: {
+      name: "ListExamples",
+      I: ListExamplesRequest,
+      O: ListExamplesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc eval.v1.EvaluationService.DeleteExample
+     */
+    deleteExample: {
+      name: "DeleteExample",
+      I: DeleteExampleRequest,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc eval.v1.EvaluationService.AddExamplesFromResults
+     */
+    addExamplesFromResults: {
+      name: "AddExamplesFromResults",
+      I: AddExamplesFromResultsRequest,
+      O: AddExamplesFromResultsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc eval.v1.EvaluationService.SetExampleSettings
+     */
+    setExampleSettings: {
+      name: "SetExampleSettings",
+      I: SetExampleSettingsRequest,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
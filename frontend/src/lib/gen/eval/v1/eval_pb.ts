@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3, Timestamp } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
 
 /**
  * @generated from enum eval.v1.VariableType
@@ -28,2230 +28,8932 @@ proto3.util.setEnumType(VariableType, "eval.v1.VariableType", [
   { no: 1, name: "IMAGE" },
 ]);
 
+/**
+ * Split assigns a test case to a dataset split, so prompts can be iterated on
+ * with the dev set and only checked against the holdout set before shipping.
+ *
+ * @generated from enum eval.v1.Split
+ */
+export enum Split {
+  /**
+   * @generated from enum value: SPLIT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SPLIT_DEV = 1;
+   */
+  DEV = 1,
+
+  /**
+   * @generated from enum value: SPLIT_TEST = 2;
+   */
+  TEST = 2,
+
+  /**
+   * @generated from enum value: SPLIT_HOLDOUT = 3;
+   */
+  HOLDOUT = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(Split)
+proto3.util.setEnumType(Split, "eval.v1.Split", [
+  { no: 0, name: "SPLIT_UNSPECIFIED", localName: "UNSPECIFIED" },
+  { no: 1, name: "SPLIT_DEV", localName: "DEV" },
+  { no: 2, name: "SPLIT_TEST", localName: "TEST" },
+  { no: 3, name: "SPLIT_HOLDOUT", localName: "HOLDOUT" },
+]);
+
+/**
+ * @generated from enum eval.v1.PerturbationKind
+ */
+export enum PerturbationKind {
+  /**
+   * @generated from enum value: PERTURBATION_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: PERTURBATION_KIND_TYPOS = 1;
+   */
+  TYPOS = 1,
+
+  /**
+   * @generated from enum value: PERTURBATION_KIND_CASING = 2;
+   */
+  CASING = 2,
+
+  /**
+   * @generated from enum value: PERTURBATION_KIND_WHITESPACE = 3;
+   */
+  WHITESPACE = 3,
+
+  /**
+   * rewritten by a model
+   *
+   * @generated from enum value: PERTURBATION_KIND_PARAPHRASE = 4;
+   */
+  PARAPHRASE = 4,
+
+  /**
+   * list items in a different order; only applies to inputs with lists
+   *
+   * @generated from enum value: PERTURBATION_KIND_REORDER_LIST = 5;
+   */
+  REORDER_LIST = 5,
+}
+// Retrieve enum metadata with: proto3.getEnumType(PerturbationKind)
+proto3.util.setEnumType(PerturbationKind, "eval.v1.PerturbationKind", [
+  { no: 0, name: "PERTURBATION_KIND_UNSPECIFIED", localName: "UNSPECIFIED" },
+  { no: 1, name: "PERTURBATION_KIND_TYPOS", localName: "TYPOS" },
+  { no: 2, name: "PERTURBATION_KIND_CASING", localName: "CASING" },
+  { no: 3, name: "PERTURBATION_KIND_WHITESPACE", localName: "WHITESPACE" },
+  { no: 4, name: "PERTURBATION_KIND_PARAPHRASE", localName: "PARAPHRASE" },
+  { no: 5, name: "PERTURBATION_KIND_REORDER_LIST", localName: "REORDER_LIST" },
+]);
+
+/**
+ * EvaluationStatus selects test cases by whether they have results.
+ *
+ * @generated from enum eval.v1.EvaluationStatus
+ */
+export enum EvaluationStatus {
+  /**
+   * @generated from enum value: EVALUATION_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: EVALUATION_STATUS_EVALUATED = 1;
+   */
+  EVALUATED = 1,
+
+  /**
+   * @generated from enum value: EVALUATION_STATUS_NOT_EVALUATED = 2;
+   */
+  NOT_EVALUATED = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(EvaluationStatus)
+proto3.util.setEnumType(EvaluationStatus, "eval.v1.EvaluationStatus", [
+  { no: 0, name: "EVALUATION_STATUS_UNSPECIFIED", localName: "UNSPECIFIED" },
+  { no: 1, name: "EVALUATION_STATUS_EVALUATED", localName: "EVALUATED" },
+  { no: 2, name: "EVALUATION_STATUS_NOT_EVALUATED", localName: "NOT_EVALUATED" },
+]);
+
+/**
+ * @generated from enum eval.v1.TestCaseSort
+ */
+export enum TestCaseSort {
+  /**
+   * sorts by creation time
+   *
+   * @generated from enum value: TEST_CASE_SORT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: TEST_CASE_SORT_CREATED_AT = 1;
+   */
+  CREATED_AT = 1,
+
+  /**
+   * @generated from enum value: TEST_CASE_SORT_UPDATED_AT = 2;
+   */
+  UPDATED_AT = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(TestCaseSort)
+proto3.util.setEnumType(TestCaseSort, "eval.v1.TestCaseSort", [
+  { no: 0, name: "TEST_CASE_SORT_UNSPECIFIED", localName: "UNSPECIFIED" },
+  { no: 1, name: "TEST_CASE_SORT_CREATED_AT", localName: "CREATED_AT" },
+  { no: 2, name: "TEST_CASE_SORT_UPDATED_AT", localName: "UPDATED_AT" },
+]);
+
+/**
+ * @generated from enum eval.v1.DuplicateAction
+ */
+export enum DuplicateAction {
+  /**
+   * skip new cases that are near-duplicates of existing ones
+   *
+   * @generated from enum value: DUPLICATE_ACTION_SKIP = 0;
+   */
+  SKIP = 0,
+
+  /**
+   * create them, tagged "near-duplicate"
+   *
+   * @generated from enum value: DUPLICATE_ACTION_FLAG = 1;
+   */
+  FLAG = 1,
+
+  /**
+   * create them without checking
+   *
+   * @generated from enum value: DUPLICATE_ACTION_ALLOW = 2;
+   */
+  ALLOW = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(DuplicateAction)
+proto3.util.setEnumType(DuplicateAction, "eval.v1.DuplicateAction", [
+  { no: 0, name: "DUPLICATE_ACTION_SKIP", localName: "SKIP" },
+  { no: 1, name: "DUPLICATE_ACTION_FLAG", localName: "FLAG" },
+  { no: 2, name: "DUPLICATE_ACTION_ALLOW", localName: "ALLOW" },
+]);
+
+/**
+ * @generated from enum eval.v1.FileFormat
+ */
+export enum FileFormat {
+  /**
+   * @generated from enum value: FILE_FORMAT_CSV = 0;
+   */
+  CSV = 0,
+
+  /**
+   * @generated from enum value: FILE_FORMAT_JSONL = 1;
+   */
+  JSONL = 1,
+
+  /**
+   * @generated from enum value: FILE_FORMAT_YAML = 2;
+   */
+  YAML = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(FileFormat)
+proto3.util.setEnumType(FileFormat, "eval.v1.FileFormat", [
+  { no: 0, name: "FILE_FORMAT_CSV", localName: "CSV" },
+  { no: 1, name: "FILE_FORMAT_JSONL", localName: "JSONL" },
+  { no: 2, name: "FILE_FORMAT_YAML", localName: "YAML" },
+]);
+
+/**
+ * @generated from enum eval.v1.DiffOp
+ */
+export enum DiffOp {
+  /**
+   * @generated from enum value: DIFF_OP_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: DIFF_OP_EQUAL = 1;
+   */
+  EQUAL = 1,
+
+  /**
+   * @generated from enum value: DIFF_OP_INSERT = 2;
+   */
+  INSERT = 2,
+
+  /**
+   * @generated from enum value: DIFF_OP_DELETE = 3;
+   */
+  DELETE = 3,
+
+  /**
+   * a deleted line paired with the line inserted in its place
+   *
+   * @generated from enum value: DIFF_OP_REPLACE = 4;
+   */
+  REPLACE = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(DiffOp)
+proto3.util.setEnumType(DiffOp, "eval.v1.DiffOp", [
+  { no: 0, name: "DIFF_OP_UNSPECIFIED", localName: "UNSPECIFIED" },
+  { no: 1, name: "DIFF_OP_EQUAL", localName: "EQUAL" },
+  { no: 2, name: "DIFF_OP_INSERT", localName: "INSERT" },
+  { no: 3, name: "DIFF_OP_DELETE", localName: "DELETE" },
+  { no: 4, name: "DIFF_OP_REPLACE", localName: "REPLACE" },
+]);
+
+/**
+ * @generated from enum eval.v1.LintCode
+ */
+export enum LintCode {
+  /**
+   * @generated from enum value: LINT_CODE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * the prompt is not a valid template
+   *
+   * @generated from enum value: LINT_CODE_TEMPLATE_ERROR = 1;
+   */
+  TEMPLATE_ERROR = 1,
+
+  /**
+   * a {{ without a matching }}
+   *
+   * @generated from enum value: LINT_CODE_UNCLOSED_ACTION = 2;
+   */
+  UNCLOSED_ACTION = 2,
+
+  /**
+   * a template variable that no test case provides
+   *
+   * @generated from enum value: LINT_CODE_MISSING_VARIABLE = 3;
+   */
+  MISSING_VARIABLE = 3,
+
+  /**
+   * a test case variable the template never uses
+   *
+   * @generated from enum value: LINT_CODE_UNUSED_VARIABLE = 4;
+   */
+  UNUSED_VARIABLE = 4,
+
+  /**
+   * a variable that is not upper case, which test cases and generated values use
+   *
+   * @generated from enum value: LINT_CODE_LOWERCASE_VARIABLE = 5;
+   */
+  LOWERCASE_VARIABLE = 5,
+
+  /**
+   * XML mode is on, but neither prompt asks for a <reply>
+   *
+   * @generated from enum value: LINT_CODE_XML_MODE_WITHOUT_REPLY = 6;
+   */
+  XML_MODE_WITHOUT_REPLY = 6,
+
+  /**
+   * the rendered prompt and max tokens do not fit a model's context window
+   *
+   * @generated from enum value: LINT_CODE_CONTEXT_WINDOW_EXCEEDED = 7;
+   */
+  CONTEXT_WINDOW_EXCEEDED = 7,
+}
+// Retrieve enum metadata with: proto3.getEnumType(LintCode)
+proto3.util.setEnumType(LintCode, "eval.v1.LintCode", [
+  { no: 0, name: "LINT_CODE_UNSPECIFIED", localName: "UNSPECIFIED" },
+  { no: 1, name: "LINT_CODE_TEMPLATE_ERROR", localName: "TEMPLATE_ERROR" },
+  { no: 2, name: "LINT_CODE_UNCLOSED_ACTION", localName: "UNCLOSED_ACTION" },
+  { no: 3, name: "LINT_CODE_MISSING_VARIABLE", localName: "MISSING_VARIABLE" },
+  { no: 4, name: "LINT_CODE_UNUSED_VARIABLE", localName: "UNUSED_VARIABLE" },
+  { no: 5, name: "LINT_CODE_LOWERCASE_VARIABLE", localName: "LOWERCASE_VARIABLE" },
+  { no: 6, name: "LINT_CODE_XML_MODE_WITHOUT_REPLY", localName: "XML_MODE_WITHOUT_REPLY" },
+  { no: 7, name: "LINT_CODE_CONTEXT_WINDOW_EXCEEDED", localName: "CONTEXT_WINDOW_EXCEEDED" },
+]);
+
+/**
+ * @generated from enum eval.v1.LabelAction
+ */
+export enum LabelAction {
+  /**
+   * @generated from enum value: LABEL_ACTION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: LABEL_ACTION_PROMOTE = 1;
+   */
+  PROMOTE = 1,
+
+  /**
+   * @generated from enum value: LABEL_ACTION_ROLLBACK = 2;
+   */
+  ROLLBACK = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(LabelAction)
+proto3.util.setEnumType(LabelAction, "eval.v1.LabelAction", [
+  { no: 0, name: "LABEL_ACTION_UNSPECIFIED", localName: "UNSPECIFIED" },
+  { no: 1, name: "LABEL_ACTION_PROMOTE", localName: "PROMOTE" },
+  { no: 2, name: "LABEL_ACTION_ROLLBACK", localName: "ROLLBACK" },
+]);
+
+/**
+ * @generated from enum eval.v1.GenerationMode
+ */
+export enum GenerationMode {
+  /**
+   * realistic inputs, like the ones the prompt will typically see
+   *
+   * @generated from enum value: GENERATION_MODE_TYPICAL = 0;
+   */
+  TYPICAL = 0,
+
+  /**
+   * hostile inputs that try to break the prompt, tagged by attack category
+   *
+   * @generated from enum value: GENERATION_MODE_ADVERSARIAL = 1;
+   */
+  ADVERSARIAL = 1,
+}
+// Retrieve enum metadata with: proto3.getEnumType(GenerationMode)
+proto3.util.setEnumType(GenerationMode, "eval.v1.GenerationMode", [
+  { no: 0, name: "GENERATION_MODE_TYPICAL", localName: "TYPICAL" },
+  { no: 1, name: "GENERATION_MODE_ADVERSARIAL", localName: "ADVERSARIAL" },
+]);
+
+/**
+ * @generated from enum eval.v1.AttackCategory
+ */
+export enum AttackCategory {
+  /**
+   * @generated from enum value: ATTACK_CATEGORY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: ATTACK_CATEGORY_PROMPT_INJECTION = 1;
+   */
+  PROMPT_INJECTION = 1,
+
+  /**
+   * @generated from enum value: ATTACK_CATEGORY_JAILBREAK = 2;
+   */
+  JAILBREAK = 2,
+
+  /**
+   * @generated from enum value: ATTACK_CATEGORY_EDGE_CASE_LENGTH = 3;
+   */
+  EDGE_CASE_LENGTH = 3,
+
+  /**
+   * @generated from enum value: ATTACK_CATEGORY_UNICODE = 4;
+   */
+  UNICODE = 4,
+
+  /**
+   * @generated from enum value: ATTACK_CATEGORY_CONTRADICTORY_INSTRUCTIONS = 5;
+   */
+  CONTRADICTORY_INSTRUCTIONS = 5,
+}
+// Retrieve enum metadata with: proto3.getEnumType(AttackCategory)
+proto3.util.setEnumType(AttackCategory, "eval.v1.AttackCategory", [
+  { no: 0, name: "ATTACK_CATEGORY_UNSPECIFIED", localName: "UNSPECIFIED" },
+  { no: 1, name: "ATTACK_CATEGORY_PROMPT_INJECTION", localName: "PROMPT_INJECTION" },
+  { no: 2, name: "ATTACK_CATEGORY_JAILBREAK", localName: "JAILBREAK" },
+  { no: 3, name: "ATTACK_CATEGORY_EDGE_CASE_LENGTH", localName: "EDGE_CASE_LENGTH" },
+  { no: 4, name: "ATTACK_CATEGORY_UNICODE", localName: "UNICODE" },
+  { no: 5, name: "ATTACK_CATEGORY_CONTRADICTORY_INSTRUCTIONS", localName: "CONTRADICTORY_INSTRUCTIONS" },
+]);
+
+/**
+ * @generated from enum eval.v1.ExampleStrategy
+ */
+export enum ExampleStrategy {
+  /**
+   * the oldest examples in the bank
+   *
+   * @generated from enum value: EXAMPLE_STRATEGY_FIXED = 0;
+   */
+  FIXED = 0,
+
+  /**
+   * a random sample, seeded from the settings' seed and the test case
+   *
+   * @generated from enum value: EXAMPLE_STRATEGY_RANDOM = 1;
+   */
+  RANDOM = 1,
+
+  /**
+   * the examples whose inputs share the most words with the test case
+   *
+   * @generated from enum value: EXAMPLE_STRATEGY_SIMILAR = 2;
+   */
+  SIMILAR = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ExampleStrategy)
+proto3.util.setEnumType(ExampleStrategy, "eval.v1.ExampleStrategy", [
+  { no: 0, name: "EXAMPLE_STRATEGY_FIXED", localName: "FIXED" },
+  { no: 1, name: "EXAMPLE_STRATEGY_RANDOM", localName: "RANDOM" },
+  { no: 2, name: "EXAMPLE_STRATEGY_SIMILAR", localName: "SIMILAR" },
+]);
+
+/**
+ * @generated from enum eval.v1.RatingScale
+ */
+export enum RatingScale {
+  /**
+   * @generated from enum value: RATING_SCALE_THUMBS = 0;
+   */
+  THUMBS = 0,
+
+  /**
+   * @generated from enum value: RATING_SCALE_SCORE = 1;
+   */
+  SCORE = 1,
+}
+// Retrieve enum metadata with: proto3.getEnumType(RatingScale)
+proto3.util.setEnumType(RatingScale, "eval.v1.RatingScale", [
+  { no: 0, name: "RATING_SCALE_THUMBS", localName: "THUMBS" },
+  { no: 1, name: "RATING_SCALE_SCORE", localName: "SCORE" },
+]);
+
+/**
+ * @generated from enum eval.v1.GraderType
+ */
+export enum GraderType {
+  /**
+   * @generated from enum value: GRADER_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: GRADER_TYPE_EXTERNAL = 1;
+   */
+  EXTERNAL = 1,
+
+  /**
+   * @generated from enum value: GRADER_TYPE_JUDGE = 2;
+   */
+  JUDGE = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(GraderType)
+proto3.util.setEnumType(GraderType, "eval.v1.GraderType", [
+  { no: 0, name: "GRADER_TYPE_UNSPECIFIED", localName: "UNSPECIFIED" },
+  { no: 1, name: "GRADER_TYPE_EXTERNAL", localName: "EXTERNAL" },
+  { no: 2, name: "GRADER_TYPE_JUDGE", localName: "JUDGE" },
+]);
+
+/**
+ * OptimizationScoring is how candidates are scored. There is no separate assertions
+ * source: assertions run as an external grader, whose pass/fail grades GRADER
+ * scoring uses.
+ *
+ * @generated from enum eval.v1.OptimizationScoring
+ */
+export enum OptimizationScoring {
+  /**
+   * a grader scores every version and picks its failing cases
+   *
+   * @generated from enum value: OPTIMIZATION_SCORING_GRADER = 0;
+   */
+  GRADER = 0,
+
+  /**
+   * rejected by OptimizePrompt: candidates are new versions that nobody has rated
+   *
+   * @generated from enum value: OPTIMIZATION_SCORING_RATINGS = 1;
+   */
+  RATINGS = 1,
+}
+// Retrieve enum metadata with: proto3.getEnumType(OptimizationScoring)
+proto3.util.setEnumType(OptimizationScoring, "eval.v1.OptimizationScoring", [
+  { no: 0, name: "OPTIMIZATION_SCORING_GRADER", localName: "GRADER" },
+  { no: 1, name: "OPTIMIZATION_SCORING_RATINGS", localName: "RATINGS" },
+]);
+
+/**
+ * @generated from enum eval.v1.OptimizationStopReason
+ */
+export enum OptimizationStopReason {
+  /**
+   * @generated from enum value: OPTIMIZATION_STOP_REASON_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: OPTIMIZATION_STOP_REASON_MAX_ROUNDS = 1;
+   */
+  MAX_ROUNDS = 1,
+
+  /**
+   * a round did not improve the best score by min_improvement
+   *
+   * @generated from enum value: OPTIMIZATION_STOP_REASON_PLATEAU = 2;
+   */
+  PLATEAU = 2,
+
+  /**
+   * @generated from enum value: OPTIMIZATION_STOP_REASON_NO_FAILURES = 3;
+   */
+  NO_FAILURES = 3,
+
+  /**
+   * @generated from enum value: OPTIMIZATION_STOP_REASON_NO_CANDIDATES = 4;
+   */
+  NO_CANDIDATES = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(OptimizationStopReason)
+proto3.util.setEnumType(OptimizationStopReason, "eval.v1.OptimizationStopReason", [
+  { no: 0, name: "OPTIMIZATION_STOP_REASON_UNSPECIFIED", localName: "UNSPECIFIED" },
+  { no: 1, name: "OPTIMIZATION_STOP_REASON_MAX_ROUNDS", localName: "MAX_ROUNDS" },
+  { no: 2, name: "OPTIMIZATION_STOP_REASON_PLATEAU", localName: "PLATEAU" },
+  { no: 3, name: "OPTIMIZATION_STOP_REASON_NO_FAILURES", localName: "NO_FAILURES" },
+  { no: 4, name: "OPTIMIZATION_STOP_REASON_NO_CANDIDATES", localName: "NO_CANDIDATES" },
+]);
+
 /**
  * @generated from message eval.v1.Variable
  */
-export class Variable extends Message<Variable> {
+export class Variable extends Message<Variable> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: eval.v1.VariableType type = 2;
+   */
+  type = VariableType.TEXT;
+
+  constructor(data?: PartialMessage<Variable>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.Variable";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "type", kind: "enum", T: proto3.getEnumType(VariableType) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Variable {
+    return new Variable().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Variable {
+    return new Variable().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Variable {
+    return new Variable().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Variable | PlainMessage<Variable> | undefined, b: Variable | PlainMessage<Variable> | undefined): boolean {
+    return proto3.util.equals(Variable, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.VariableValue
+ */
+export class VariableValue extends Message<VariableValue> {
+  /**
+   * @generated from oneof eval.v1.VariableValue.value
+   */
+  value: {
+    /**
+     * @generated from field: string text_value = 1;
+     */
+    value: string;
+    case: "textValue";
+  } | {
+    /**
+     * @generated from field: bytes image_value = 2;
+     */
+    value: Uint8Array;
+    case: "imageValue";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<VariableValue>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.VariableValue";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "text_value", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "value" },
+    { no: 2, name: "image_value", kind: "scalar", T: 12 /* ScalarType.BYTES */, oneof: "value" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VariableValue {
+    return new VariableValue().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VariableValue {
+    return new VariableValue().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VariableValue {
+    return new VariableValue().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: VariableValue | PlainMessage<VariableValue> | undefined, b: VariableValue | PlainMessage<VariableValue> | undefined): boolean {
+    return proto3.util.equals(VariableValue, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.ModelConfig
+ */
+export class ModelConfig extends Message<ModelConfig> {
+  /**
+   * @generated from field: string provider_type = 1;
+   */
+  providerType = "";
+
+  /**
+   * @generated from field: string model_name = 2;
+   */
+  modelName = "";
+
+  /**
+   * @generated from field: string base_url = 3;
+   */
+  baseUrl = "";
+
+  constructor(data?: PartialMessage<ModelConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.ModelConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "provider_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "model_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "base_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ModelConfig {
+    return new ModelConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ModelConfig {
+    return new ModelConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ModelConfig {
+    return new ModelConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ModelConfig | PlainMessage<ModelConfig> | undefined, b: ModelConfig | PlainMessage<ModelConfig> | undefined): boolean {
+    return proto3.util.equals(ModelConfig, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.MessageOptions
+ */
+export class MessageOptions extends Message<MessageOptions> {
+  /**
+   * @generated from field: int32 max_tokens = 1;
+   */
+  maxTokens = 0;
+
+  /**
+   * @generated from field: float temperature = 2;
+   */
+  temperature = 0;
+
+  constructor(data?: PartialMessage<MessageOptions>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.MessageOptions";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "max_tokens", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "temperature", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MessageOptions {
+    return new MessageOptions().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MessageOptions {
+    return new MessageOptions().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MessageOptions {
+    return new MessageOptions().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MessageOptions | PlainMessage<MessageOptions> | undefined, b: MessageOptions | PlainMessage<MessageOptions> | undefined): boolean {
+    return proto3.util.equals(MessageOptions, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.InferMessage
+ */
+export class InferMessage extends Message<InferMessage> {
+  /**
+   * @generated from field: string content = 1;
+   */
+  content = "";
+
+  /**
+   * @generated from field: string role = 2;
+   */
+  role = "";
+
+  /**
+   * @generated from field: bytes image = 3;
+   */
+  image = new Uint8Array(0);
+
+  /**
+   * @generated from field: bool should_cache = 4;
+   */
+  shouldCache = false;
+
+  constructor(data?: PartialMessage<InferMessage>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.InferMessage";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "content", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "image", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 4, name: "should_cache", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): InferMessage {
+    return new InferMessage().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): InferMessage {
+    return new InferMessage().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): InferMessage {
+    return new InferMessage().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: InferMessage | PlainMessage<InferMessage> | undefined, b: InferMessage | PlainMessage<InferMessage> | undefined): boolean {
+    return proto3.util.equals(InferMessage, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.InferRequest
+ */
+export class InferRequest extends Message<InferRequest> {
+  /**
+   * @generated from field: string request_id = 1;
+   */
+  requestId = "";
+
+  /**
+   * @generated from field: repeated eval.v1.InferMessage messages = 2;
+   */
+  messages: InferMessage[] = [];
+
+  /**
+   * @generated from field: eval.v1.ModelConfig model_config = 3;
+   */
+  modelConfig?: ModelConfig;
+
+  /**
+   * @generated from field: eval.v1.MessageOptions message_options = 4;
+   */
+  messageOptions?: MessageOptions;
+
+  constructor(data?: PartialMessage<InferRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.InferRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "request_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "messages", kind: "message", T: InferMessage, repeated: true },
+    { no: 3, name: "model_config", kind: "message", T: ModelConfig },
+    { no: 4, name: "message_options", kind: "message", T: MessageOptions },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): InferRequest {
+    return new InferRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): InferRequest {
+    return new InferRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): InferRequest {
+    return new InferRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: InferRequest | PlainMessage<InferRequest> | undefined, b: InferRequest | PlainMessage<InferRequest> | undefined): boolean {
+    return proto3.util.equals(InferRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.EvaluationRequest
+ */
+export class EvaluationRequest extends Message<EvaluationRequest> {
+  /**
+   * @generated from field: string workspace_id = 1;
+   */
+  workspaceId = "";
+
+  /**
+   * @generated from field: eval.v1.TestCase test_case = 2;
+   */
+  testCase?: TestCase;
+
+  /**
+   * @generated from field: uint32 version_number = 3;
+   */
+  versionNumber = 0;
+
+  /**
+   * @generated from field: uint32 system_prompt_version_number = 4;
+   */
+  systemPromptVersionNumber = 0;
+
+  /**
+   * run the versions a label points at instead of version numbers
+   *
+   * @generated from field: string label = 5;
+   */
+  label = "";
+
+  constructor(data?: PartialMessage<EvaluationRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.EvaluationRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "test_case", kind: "message", T: TestCase },
+    { no: 3, name: "version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "system_prompt_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "label", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EvaluationRequest {
+    return new EvaluationRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EvaluationRequest {
+    return new EvaluationRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EvaluationRequest {
+    return new EvaluationRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: EvaluationRequest | PlainMessage<EvaluationRequest> | undefined, b: EvaluationRequest | PlainMessage<EvaluationRequest> | undefined): boolean {
+    return proto3.util.equals(EvaluationRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.EvaluationResponse
+ */
+export class EvaluationResponse extends Message<EvaluationResponse> {
+  /**
+   * @generated from field: repeated eval.v1.TestResult result = 1;
+   */
+  result: TestResult[] = [];
+
+  constructor(data?: PartialMessage<EvaluationResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.EvaluationResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "result", kind: "message", T: TestResult, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EvaluationResponse {
+    return new EvaluationResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EvaluationResponse {
+    return new EvaluationResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EvaluationResponse {
+    return new EvaluationResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: EvaluationResponse | PlainMessage<EvaluationResponse> | undefined, b: EvaluationResponse | PlainMessage<EvaluationResponse> | undefined): boolean {
+    return proto3.util.equals(EvaluationResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.WorkspaceConfig
+ */
+export class WorkspaceConfig extends Message<WorkspaceConfig> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string model_config_name = 3;
+   */
+  modelConfigName = "";
+
+  /**
+   * @generated from field: eval.v1.MessageOptions message_options = 4;
+   */
+  messageOptions?: MessageOptions;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 5;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 6;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * @generated from field: bool active = 7;
+   */
+  active = false;
+
+  constructor(data?: PartialMessage<WorkspaceConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.WorkspaceConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "model_config_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "message_options", kind: "message", T: MessageOptions },
+    { no: 5, name: "created_at", kind: "message", T: Timestamp },
+    { no: 6, name: "updated_at", kind: "message", T: Timestamp },
+    { no: 7, name: "active", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WorkspaceConfig {
+    return new WorkspaceConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WorkspaceConfig {
+    return new WorkspaceConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WorkspaceConfig {
+    return new WorkspaceConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WorkspaceConfig | PlainMessage<WorkspaceConfig> | undefined, b: WorkspaceConfig | PlainMessage<WorkspaceConfig> | undefined): boolean {
+    return proto3.util.equals(WorkspaceConfig, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.CreateWorkspaceConfigRequest
+ */
+export class CreateWorkspaceConfigRequest extends Message<CreateWorkspaceConfigRequest> {
+  /**
+   * @generated from field: string workspace_id = 1;
+   */
+  workspaceId = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string model_config_name = 3;
+   */
+  modelConfigName = "";
+
+  /**
+   * @generated from field: eval.v1.MessageOptions message_options = 4;
+   */
+  messageOptions?: MessageOptions;
+
+  constructor(data?: PartialMessage<CreateWorkspaceConfigRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.CreateWorkspaceConfigRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "model_config_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "message_options", kind: "message", T: MessageOptions },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWorkspaceConfigRequest {
+    return new CreateWorkspaceConfigRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWorkspaceConfigRequest {
+    return new CreateWorkspaceConfigRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWorkspaceConfigRequest {
+    return new CreateWorkspaceConfigRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateWorkspaceConfigRequest | PlainMessage<CreateWorkspaceConfigRequest> | undefined, b: CreateWorkspaceConfigRequest | PlainMessage<CreateWorkspaceConfigRequest> | undefined): boolean {
+    return proto3.util.equals(CreateWorkspaceConfigRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.CreateWorkspaceConfigResponse
+ */
+export class CreateWorkspaceConfigResponse extends Message<CreateWorkspaceConfigResponse> {
+  /**
+   * @generated from field: eval.v1.WorkspaceConfig workspace_config = 1;
+   */
+  workspaceConfig?: WorkspaceConfig;
+
+  constructor(data?: PartialMessage<CreateWorkspaceConfigResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.CreateWorkspaceConfigResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspace_config", kind: "message", T: WorkspaceConfig },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWorkspaceConfigResponse {
+    return new CreateWorkspaceConfigResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWorkspaceConfigResponse {
+    return new CreateWorkspaceConfigResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWorkspaceConfigResponse {
+    return new CreateWorkspaceConfigResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateWorkspaceConfigResponse | PlainMessage<CreateWorkspaceConfigResponse> | undefined, b: CreateWorkspaceConfigResponse | PlainMessage<CreateWorkspaceConfigResponse> | undefined): boolean {
+    return proto3.util.equals(CreateWorkspaceConfigResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.Workspace
+ */
+export class Workspace extends Message<Workspace> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: repeated string model_config_names = 3;
+   */
+  modelConfigNames: string[] = [];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 5;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * @generated from field: repeated eval.v1.Workspace.Prompt prompts = 6;
+   */
+  prompts: Workspace_Prompt[] = [];
+
+  /**
+   * @generated from field: uint32 current_prompt_version_number = 7;
+   */
+  currentPromptVersionNumber = 0;
+
+  /**
+   * @generated from field: repeated eval.v1.WorkspaceConfig workspace_configs = 8;
+   */
+  workspaceConfigs: WorkspaceConfig[] = [];
+
+  /**
+   * @generated from field: repeated uint32 active_version_numbers = 9;
+   */
+  activeVersionNumbers: number[] = [];
+
+  /**
+   * @generated from field: repeated eval.v1.Workspace.SystemPrompt system_prompts = 10;
+   */
+  systemPrompts: Workspace_SystemPrompt[] = [];
+
+  /**
+   * @generated from field: uint32 current_system_prompt_version_number = 11;
+   */
+  currentSystemPromptVersionNumber = 0;
+
+  /**
+   * @generated from field: bool XMLMode = 12;
+   */
+  XMLMode = false;
+
+  /**
+   * @generated from field: eval.v1.ExampleSettings example_settings = 13;
+   */
+  exampleSettings?: ExampleSettings;
+
+  /**
+   * the workspace this one was cloned from
+   *
+   * @generated from field: optional string source_workspace_id = 14;
+   */
+  sourceWorkspaceId?: string;
+
+  /**
+   * set while the workspace is archived
+   *
+   * @generated from field: google.protobuf.Timestamp archived_at = 15;
+   */
+  archivedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<Workspace>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.Workspace";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "model_config_names", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "created_at", kind: "message", T: Timestamp },
+    { no: 5, name: "updated_at", kind: "message", T: Timestamp },
+    { no: 6, name: "prompts", kind: "message", T: Workspace_Prompt, repeated: true },
+    { no: 7, name: "current_prompt_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 8, name: "workspace_configs", kind: "message", T: WorkspaceConfig, repeated: true },
+    { no: 9, name: "active_version_numbers", kind: "scalar", T: 13 /* ScalarType.UINT32 */, repeated: true },
+    { no: 10, name: "system_prompts", kind: "message", T: Workspace_SystemPrompt, repeated: true },
+    { no: 11, name: "current_system_prompt_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 12, name: "XMLMode", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 13, name: "example_settings", kind: "message", T: ExampleSettings },
+    { no: 14, name: "source_workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 15, name: "archived_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Workspace {
+    return new Workspace().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Workspace {
+    return new Workspace().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Workspace {
+    return new Workspace().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Workspace | PlainMessage<Workspace> | undefined, b: Workspace | PlainMessage<Workspace> | undefined): boolean {
+    return proto3.util.equals(Workspace, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.Workspace.Prompt
+ */
+export class Workspace_Prompt extends Message<Workspace_Prompt> {
+  /**
+   * @generated from field: uint32 version_number = 1;
+   */
+  versionNumber = 0;
+
+  /**
+   * @generated from field: string content = 2;
+   */
+  content = "";
+
+  /**
+   * @generated from field: repeated eval.v1.Variable variables = 3;
+   */
+  variables: Variable[] = [];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * the version this one was edited from; unset for the first version
+   *
+   * @generated from field: optional uint32 parent_version_number = 5;
+   */
+  parentVersionNumber?: number;
+
+  /**
+   * @generated from field: string commit_message = 6;
+   */
+  commitMessage = "";
+
+  constructor(data?: PartialMessage<Workspace_Prompt>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.Workspace.Prompt";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 2, name: "content", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "variables", kind: "message", T: Variable, repeated: true },
+    { no: 4, name: "created_at", kind: "message", T: Timestamp },
+    { no: 5, name: "parent_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+    { no: 6, name: "commit_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Workspace_Prompt {
+    return new Workspace_Prompt().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Workspace_Prompt {
+    return new Workspace_Prompt().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Workspace_Prompt {
+    return new Workspace_Prompt().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Workspace_Prompt | PlainMessage<Workspace_Prompt> | undefined, b: Workspace_Prompt | PlainMessage<Workspace_Prompt> | undefined): boolean {
+    return proto3.util.equals(Workspace_Prompt, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.Workspace.SystemPrompt
+ */
+export class Workspace_SystemPrompt extends Message<Workspace_SystemPrompt> {
+  /**
+   * @generated from field: uint32 version_number = 1;
+   */
+  versionNumber = 0;
+
+  /**
+   * @generated from field: string content = 2;
+   */
+  content = "";
+
+  /**
+   * @generated from field: optional uint32 parent_version_number = 3;
+   */
+  parentVersionNumber?: number;
+
+  /**
+   * @generated from field: string commit_message = 4;
+   */
+  commitMessage = "";
+
+  constructor(data?: PartialMessage<Workspace_SystemPrompt>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.Workspace.SystemPrompt";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 2, name: "content", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "parent_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+    { no: 4, name: "commit_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Workspace_SystemPrompt {
+    return new Workspace_SystemPrompt().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Workspace_SystemPrompt {
+    return new Workspace_SystemPrompt().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Workspace_SystemPrompt {
+    return new Workspace_SystemPrompt().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Workspace_SystemPrompt | PlainMessage<Workspace_SystemPrompt> | undefined, b: Workspace_SystemPrompt | PlainMessage<Workspace_SystemPrompt> | undefined): boolean {
+    return proto3.util.equals(Workspace_SystemPrompt, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.TestCase
+ */
+export class TestCase extends Message<TestCase> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string workspace_id = 2;
+   */
+  workspaceId = "";
+
+  /**
+   * @generated from field: map<string, eval.v1.VariableValue> variable_values = 3;
+   */
+  variableValues: { [key: string]: VariableValue } = {};
+
+  /**
+   * @generated from field: optional string response = 4;
+   */
+  response?: string;
+
+  /**
+   * @generated from field: bool has_been_evaluated = 5;
+   */
+  hasBeenEvaluated = false;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 7;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * @generated from field: repeated string tags = 8;
+   */
+  tags: string[] = [];
+
+  /**
+   * @generated from field: eval.v1.Split split = 9;
+   */
+  split = Split.UNSPECIFIED;
+
+  /**
+   * set only for test cases in the trash
+   *
+   * @generated from field: google.protobuf.Timestamp deleted_at = 10;
+   */
+  deletedAt?: Timestamp;
+
+  /**
+   * set for perturbed variants of another test case
+   *
+   * @generated from field: optional string parent_test_case_id = 11;
+   */
+  parentTestCaseId?: string;
+
+  /**
+   * @generated from field: eval.v1.PerturbationKind perturbation = 12;
+   */
+  perturbation = PerturbationKind.UNSPECIFIED;
+
+  /**
+   * set for test cases owned by a dataset rather than a single workspace
+   *
+   * @generated from field: optional string dataset_id = 13;
+   */
+  datasetId?: string;
+
+  constructor(data?: PartialMessage<TestCase>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.TestCase";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "variable_values", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: VariableValue} },
+    { no: 4, name: "response", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "has_been_evaluated", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "created_at", kind: "message", T: Timestamp },
+    { no: 7, name: "updated_at", kind: "message", T: Timestamp },
+    { no: 8, name: "tags", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 9, name: "split", kind: "enum", T: proto3.getEnumType(Split) },
+    { no: 10, name: "deleted_at", kind: "message", T: Timestamp },
+    { no: 11, name: "parent_test_case_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 12, name: "perturbation", kind: "enum", T: proto3.getEnumType(PerturbationKind) },
+    { no: 13, name: "dataset_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TestCase {
+    return new TestCase().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TestCase {
+    return new TestCase().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TestCase {
+    return new TestCase().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TestCase | PlainMessage<TestCase> | undefined, b: TestCase | PlainMessage<TestCase> | undefined): boolean {
+    return proto3.util.equals(TestCase, a, b);
+  }
+}
+
+/**
+ * TestCaseFilter selects the test cases a request applies to. Empty fields do not
+ * filter.
+ *
+ * @generated from message eval.v1.TestCaseFilter
+ */
+export class TestCaseFilter extends Message<TestCaseFilter> {
+  /**
+   * cases must have all of these tags
+   *
+   * @generated from field: repeated string tags = 1;
+   */
+  tags: string[] = [];
+
+  /**
+   * cases must have none of these tags
+   *
+   * @generated from field: repeated string exclude_tags = 2;
+   */
+  excludeTags: string[] = [];
+
+  /**
+   * cases must be in one of these splits; SPLIT_UNSPECIFIED matches cases without a split
+   *
+   * @generated from field: repeated eval.v1.Split splits = 3;
+   */
+  splits: Split[] = [];
+
+  constructor(data?: PartialMessage<TestCaseFilter>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.TestCaseFilter";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "tags", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "exclude_tags", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "splits", kind: "enum", T: proto3.getEnumType(Split), repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TestCaseFilter {
+    return new TestCaseFilter().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TestCaseFilter {
+    return new TestCaseFilter().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TestCaseFilter {
+    return new TestCaseFilter().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TestCaseFilter | PlainMessage<TestCaseFilter> | undefined, b: TestCaseFilter | PlainMessage<TestCaseFilter> | undefined): boolean {
+    return proto3.util.equals(TestCaseFilter, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.TestResult
+ */
+export class TestResult extends Message<TestResult> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string test_case_id = 2;
+   */
+  testCaseId = "";
+
+  /**
+   * @generated from field: string response = 3;
+   */
+  response = "";
+
+  /**
+   * @generated from field: uint32 prompt_version_number = 4;
+   */
+  promptVersionNumber = 0;
+
+  /**
+   * @generated from field: string model_config_name = 5;
+   */
+  modelConfigName = "";
+
+  /**
+   * @generated from field: eval.v1.MessageOptions message_options = 6;
+   */
+  messageOptions?: MessageOptions;
+
+  /**
+   * @generated from field: string workspace_config_id = 7;
+   */
+  workspaceConfigId = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 8;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 9;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * rating is -1 (thumbs down), 0 (unrated), or 1 (thumbs up)
+   *
+   * @generated from field: int32 rating = 10;
+   */
+  rating = 0;
+
+  /**
+   * @generated from field: repeated eval.v1.Rating ratings = 11;
+   */
+  ratings: Rating[] = [];
+
+  /**
+   * the examples inserted into the prompt for this result, in order
+   *
+   * @generated from field: repeated string example_ids = 12;
+   */
+  exampleIds: string[] = [];
+
+  constructor(data?: PartialMessage<TestResult>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.TestResult";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "test_case_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "response", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "prompt_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "model_config_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "message_options", kind: "message", T: MessageOptions },
+    { no: 7, name: "workspace_config_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "created_at", kind: "message", T: Timestamp },
+    { no: 9, name: "updated_at", kind: "message", T: Timestamp },
+    { no: 10, name: "rating", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 11, name: "ratings", kind: "message", T: Rating, repeated: true },
+    { no: 12, name: "example_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TestResult {
+    return new TestResult().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TestResult {
+    return new TestResult().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TestResult {
+    return new TestResult().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TestResult | PlainMessage<TestResult> | undefined, b: TestResult | PlainMessage<TestResult> | undefined): boolean {
+    return proto3.util.equals(TestResult, a, b);
+  }
+}
+
+/**
+ * Rating is a single rater's judgement of a test result. Raters may be humans or
+ * automated judges, and may give thumbs, a scale score, or both.
+ *
+ * @generated from message eval.v1.Rating
+ */
+export class Rating extends Message<Rating> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string test_result_id = 2;
+   */
+  testResultId = "";
+
+  /**
+   * @generated from field: string rater = 3;
+   */
+  rater = "";
+
+  /**
+   * @generated from field: bool automated = 4;
+   */
+  automated = false;
+
+  /**
+   * thumbs is -1 (thumbs down), 0 (unrated), or 1 (thumbs up)
+   *
+   * @generated from field: int32 thumbs = 5;
+   */
+  thumbs = 0;
+
+  /**
+   * @generated from field: optional double score = 6;
+   */
+  score?: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 8;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * reason is the rater's explanation, if any
+   *
+   * @generated from field: string reason = 9;
+   */
+  reason = "";
+
+  constructor(data?: PartialMessage<Rating>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.Rating";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "test_result_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "rater", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "automated", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "thumbs", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "score", kind: "scalar", T: 1 /* ScalarType.DOUBLE */, opt: true },
+    { no: 7, name: "created_at", kind: "message", T: Timestamp },
+    { no: 8, name: "updated_at", kind: "message", T: Timestamp },
+    { no: 9, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Rating {
+    return new Rating().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Rating {
+    return new Rating().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Rating {
+    return new Rating().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Rating | PlainMessage<Rating> | undefined, b: Rating | PlainMessage<Rating> | undefined): boolean {
+    return proto3.util.equals(Rating, a, b);
+  }
+}
+
+/**
+ * CRUD operation messages
+ *
+ * @generated from message eval.v1.CreateWorkspaceRequest
+ */
+export class CreateWorkspaceRequest extends Message<CreateWorkspaceRequest> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string content = 2;
+   */
+  content = "";
+
+  constructor(data?: PartialMessage<CreateWorkspaceRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.CreateWorkspaceRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "content", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWorkspaceRequest {
+    return new CreateWorkspaceRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWorkspaceRequest {
+    return new CreateWorkspaceRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWorkspaceRequest {
+    return new CreateWorkspaceRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateWorkspaceRequest | PlainMessage<CreateWorkspaceRequest> | undefined, b: CreateWorkspaceRequest | PlainMessage<CreateWorkspaceRequest> | undefined): boolean {
+    return proto3.util.equals(CreateWorkspaceRequest, a, b);
+  }
+}
+
+/**
+ * CloneWorkspaceRequest copies a workspace into a new one with new IDs. The prompt
+ * and system prompt versions are always copied, the rest only when asked for.
+ *
+ * @generated from message eval.v1.CloneWorkspaceRequest
+ */
+export class CloneWorkspaceRequest extends Message<CloneWorkspaceRequest> {
+  /**
+   * @generated from field: string workspace_id = 1;
+   */
+  workspaceId = "";
+
+  /**
+   * defaults to the source's name followed by " (copy)"
+   *
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * copy every version instead of only the current ones
+   *
+   * @generated from field: bool all_versions = 3;
+   */
+  allVersions = false;
+
+  /**
+   * @generated from field: bool copy_workspace_configs = 4;
+   */
+  copyWorkspaceConfigs = false;
+
+  /**
+   * copy the workspace's own test cases and its dataset attachments
+   *
+   * @generated from field: bool copy_test_cases = 5;
+   */
+  copyTestCases = false;
+
+  /**
+   * copy the results of the copied versions, with their ratings; needs
+   * copy_workspace_configs and copy_test_cases
+   *
+   * @generated from field: bool copy_results = 6;
+   */
+  copyResults = false;
+
+  constructor(data?: PartialMessage<CloneWorkspaceRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.CloneWorkspaceRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "all_versions", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "copy_workspace_configs", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "copy_test_cases", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "copy_results", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CloneWorkspaceRequest {
+    return new CloneWorkspaceRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CloneWorkspaceRequest {
+    return new CloneWorkspaceRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CloneWorkspaceRequest {
+    return new CloneWorkspaceRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CloneWorkspaceRequest | PlainMessage<CloneWorkspaceRequest> | undefined, b: CloneWorkspaceRequest | PlainMessage<CloneWorkspaceRequest> | undefined): boolean {
+    return proto3.util.equals(CloneWorkspaceRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.CloneWorkspaceResponse
+ */
+export class CloneWorkspaceResponse extends Message<CloneWorkspaceResponse> {
+  /**
+   * @generated from field: eval.v1.Workspace workspace = 1;
+   */
+  workspace?: Workspace;
+
+  constructor(data?: PartialMessage<CloneWorkspaceResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.CloneWorkspaceResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspace", kind: "message", T: Workspace },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CloneWorkspaceResponse {
+    return new CloneWorkspaceResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CloneWorkspaceResponse {
+    return new CloneWorkspaceResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CloneWorkspaceResponse {
+    return new CloneWorkspaceResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CloneWorkspaceResponse | PlainMessage<CloneWorkspaceResponse> | undefined, b: CloneWorkspaceResponse | PlainMessage<CloneWorkspaceResponse> | undefined): boolean {
+    return proto3.util.equals(CloneWorkspaceResponse, a, b);
+  }
+}
+
+/**
+ * ExportWorkspaceBundleRequest exports a workspace as one YAML bundle, which can
+ * be edited by hand and imported again with ImportWorkspaceBundle.
+ *
+ * @generated from message eval.v1.ExportWorkspaceBundleRequest
+ */
+export class ExportWorkspaceBundleRequest extends Message<ExportWorkspaceBundleRequest> {
+  /**
+   * @generated from field: string workspace_id = 1;
+   */
+  workspaceId = "";
+
+  /**
+   * include the workspace's own test cases; dataset test cases are left out
+   *
+   * @generated from field: bool include_test_cases = 2;
+   */
+  includeTestCases = false;
+
+  /**
+   * include the results of the exported test cases; needs include_test_cases
+   *
+   * @generated from field: bool include_results = 3;
+   */
+  includeResults = false;
+
+  constructor(data?: PartialMessage<ExportWorkspaceBundleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.ExportWorkspaceBundleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "include_test_cases", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "include_results", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExportWorkspaceBundleRequest {
+    return new ExportWorkspaceBundleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExportWorkspaceBundleRequest {
+    return new ExportWorkspaceBundleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExportWorkspaceBundleRequest {
+    return new ExportWorkspaceBundleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExportWorkspaceBundleRequest | PlainMessage<ExportWorkspaceBundleRequest> | undefined, b: ExportWorkspaceBundleRequest | PlainMessage<ExportWorkspaceBundleRequest> | undefined): boolean {
+    return proto3.util.equals(ExportWorkspaceBundleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.ExportWorkspaceBundleResponse
+ */
+export class ExportWorkspaceBundleResponse extends Message<ExportWorkspaceBundleResponse> {
+  /**
+   * @generated from field: bytes bundle = 1;
+   */
+  bundle = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<ExportWorkspaceBundleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.ExportWorkspaceBundleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "bundle", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExportWorkspaceBundleResponse {
+    return new ExportWorkspaceBundleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExportWorkspaceBundleResponse {
+    return new ExportWorkspaceBundleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExportWorkspaceBundleResponse {
+    return new ExportWorkspaceBundleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExportWorkspaceBundleResponse | PlainMessage<ExportWorkspaceBundleResponse> | undefined, b: ExportWorkspaceBundleResponse | PlainMessage<ExportWorkspaceBundleResponse> | undefined): boolean {
+    return proto3.util.equals(ExportWorkspaceBundleResponse, a, b);
+  }
+}
+
+/**
+ * ImportWorkspaceBundleRequest imports a YAML bundle. Importing is idempotent:
+ * versions, configs, test cases and results already in the workspace are
+ * matched instead of created again.
+ *
+ * @generated from message eval.v1.ImportWorkspaceBundleRequest
+ */
+export class ImportWorkspaceBundleRequest extends Message<ImportWorkspaceBundleRequest> {
+  /**
+   * @generated from field: bytes bundle = 1;
+   */
+  bundle = new Uint8Array(0);
+
+  /**
+   * import into this workspace instead of the one the bundle was exported from
+   *
+   * @generated from field: string workspace_id = 2;
+   */
+  workspaceId = "";
+
+  constructor(data?: PartialMessage<ImportWorkspaceBundleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.ImportWorkspaceBundleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "bundle", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ImportWorkspaceBundleRequest {
+    return new ImportWorkspaceBundleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ImportWorkspaceBundleRequest {
+    return new ImportWorkspaceBundleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ImportWorkspaceBundleRequest {
+    return new ImportWorkspaceBundleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ImportWorkspaceBundleRequest | PlainMessage<ImportWorkspaceBundleRequest> | undefined, b: ImportWorkspaceBundleRequest | PlainMessage<ImportWorkspaceBundleRequest> | undefined): boolean {
+    return proto3.util.equals(ImportWorkspaceBundleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.ImportWorkspaceBundleResponse
+ */
+export class ImportWorkspaceBundleResponse extends Message<ImportWorkspaceBundleResponse> {
+  /**
+   * @generated from field: eval.v1.Workspace workspace = 1;
+   */
+  workspace?: Workspace;
+
+  /**
+   * @generated from field: bool workspace_created = 2;
+   */
+  workspaceCreated = false;
+
+  /**
+   * @generated from field: uint32 n_prompts_added = 3;
+   */
+  nPromptsAdded = 0;
+
+  /**
+   * @generated from field: uint32 n_system_prompts_added = 4;
+   */
+  nSystemPromptsAdded = 0;
+
+  /**
+   * @generated from field: uint32 n_workspace_configs_added = 5;
+   */
+  nWorkspaceConfigsAdded = 0;
+
+  /**
+   * @generated from field: uint32 n_test_cases_added = 6;
+   */
+  nTestCasesAdded = 0;
+
+  /**
+   * @generated from field: uint32 n_results_added = 7;
+   */
+  nResultsAdded = 0;
+
+  constructor(data?: PartialMessage<ImportWorkspaceBundleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.ImportWorkspaceBundleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspace", kind: "message", T: Workspace },
+    { no: 2, name: "workspace_created", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "n_prompts_added", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "n_system_prompts_added", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "n_workspace_configs_added", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 6, name: "n_test_cases_added", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 7, name: "n_results_added", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ImportWorkspaceBundleResponse {
+    return new ImportWorkspaceBundleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ImportWorkspaceBundleResponse {
+    return new ImportWorkspaceBundleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ImportWorkspaceBundleResponse {
+    return new ImportWorkspaceBundleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ImportWorkspaceBundleResponse | PlainMessage<ImportWorkspaceBundleResponse> | undefined, b: ImportWorkspaceBundleResponse | PlainMessage<ImportWorkspaceBundleResponse> | undefined): boolean {
+    return proto3.util.equals(ImportWorkspaceBundleResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.GetWorkspaceRequest
+ */
+export class GetWorkspaceRequest extends Message<GetWorkspaceRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<GetWorkspaceRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.GetWorkspaceRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetWorkspaceRequest {
+    return new GetWorkspaceRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetWorkspaceRequest {
+    return new GetWorkspaceRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetWorkspaceRequest {
+    return new GetWorkspaceRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetWorkspaceRequest | PlainMessage<GetWorkspaceRequest> | undefined, b: GetWorkspaceRequest | PlainMessage<GetWorkspaceRequest> | undefined): boolean {
+    return proto3.util.equals(GetWorkspaceRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.ListWorkspacesRequest
+ */
+export class ListWorkspacesRequest extends Message<ListWorkspacesRequest> {
+  /**
+   * @generated from field: int32 page = 1;
+   */
+  page = 0;
+
+  /**
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize = 0;
+
+  /**
+   * list archived workspaces too
+   *
+   * @generated from field: bool include_archived = 3;
+   */
+  includeArchived = false;
+
+  constructor(data?: PartialMessage<ListWorkspacesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.ListWorkspacesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "page", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "include_archived", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWorkspacesRequest {
+    return new ListWorkspacesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWorkspacesRequest {
+    return new ListWorkspacesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWorkspacesRequest {
+    return new ListWorkspacesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListWorkspacesRequest | PlainMessage<ListWorkspacesRequest> | undefined, b: ListWorkspacesRequest | PlainMessage<ListWorkspacesRequest> | undefined): boolean {
+    return proto3.util.equals(ListWorkspacesRequest, a, b);
+  }
+}
+
+/**
+ * DeleteWorkspaceRequest deletes a workspace with its versions, configs, test
+ * cases, results and everything else it owns. Dataset test cases are kept.
+ *
+ * @generated from message eval.v1.DeleteWorkspaceRequest
+ */
+export class DeleteWorkspaceRequest extends Message<DeleteWorkspaceRequest> {
+  /**
+   * @generated from field: string workspace_id = 1;
+   */
+  workspaceId = "";
+
+  constructor(data?: PartialMessage<DeleteWorkspaceRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.DeleteWorkspaceRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteWorkspaceRequest {
+    return new DeleteWorkspaceRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteWorkspaceRequest {
+    return new DeleteWorkspaceRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteWorkspaceRequest {
+    return new DeleteWorkspaceRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteWorkspaceRequest | PlainMessage<DeleteWorkspaceRequest> | undefined, b: DeleteWorkspaceRequest | PlainMessage<DeleteWorkspaceRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteWorkspaceRequest, a, b);
+  }
+}
+
+/**
+ * ArchiveWorkspaceRequest hides a workspace from ListWorkspaces, or shows it
+ * again, without deleting anything.
+ *
+ * @generated from message eval.v1.ArchiveWorkspaceRequest
+ */
+export class ArchiveWorkspaceRequest extends Message<ArchiveWorkspaceRequest> {
+  /**
+   * @generated from field: string workspace_id = 1;
+   */
+  workspaceId = "";
+
+  /**
+   * @generated from field: bool archived = 2;
+   */
+  archived = false;
+
+  constructor(data?: PartialMessage<ArchiveWorkspaceRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.ArchiveWorkspaceRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "archived", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ArchiveWorkspaceRequest {
+    return new ArchiveWorkspaceRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ArchiveWorkspaceRequest {
+    return new ArchiveWorkspaceRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ArchiveWorkspaceRequest {
+    return new ArchiveWorkspaceRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ArchiveWorkspaceRequest | PlainMessage<ArchiveWorkspaceRequest> | undefined, b: ArchiveWorkspaceRequest | PlainMessage<ArchiveWorkspaceRequest> | undefined): boolean {
+    return proto3.util.equals(ArchiveWorkspaceRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.ListWorkspacesResponse
+ */
+export class ListWorkspacesResponse extends Message<ListWorkspacesResponse> {
+  /**
+   * @generated from field: repeated eval.v1.Workspace workspaces = 1;
+   */
+  workspaces: Workspace[] = [];
+
+  /**
+   * @generated from field: int32 total_count = 2;
+   */
+  totalCount = 0;
+
+  constructor(data?: PartialMessage<ListWorkspacesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.ListWorkspacesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspaces", kind: "message", T: Workspace, repeated: true },
+    { no: 2, name: "total_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWorkspacesResponse {
+    return new ListWorkspacesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWorkspacesResponse {
+    return new ListWorkspacesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWorkspacesResponse {
+    return new ListWorkspacesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListWorkspacesResponse | PlainMessage<ListWorkspacesResponse> | undefined, b: ListWorkspacesResponse | PlainMessage<ListWorkspacesResponse> | undefined): boolean {
+    return proto3.util.equals(ListWorkspacesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.GetPromptRequest
+ */
+export class GetPromptRequest extends Message<GetPromptRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<GetPromptRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.GetPromptRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetPromptRequest {
+    return new GetPromptRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetPromptRequest {
+    return new GetPromptRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetPromptRequest {
+    return new GetPromptRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetPromptRequest | PlainMessage<GetPromptRequest> | undefined, b: GetPromptRequest | PlainMessage<GetPromptRequest> | undefined): boolean {
+    return proto3.util.equals(GetPromptRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.ListTestCasesRequest
+ */
+export class ListTestCasesRequest extends Message<ListTestCasesRequest> {
+  /**
+   * @generated from field: string workspace_id = 1;
+   */
+  workspaceId = "";
+
+  /**
+   * page is only used without a page_token; prefer page tokens, which stay fast on
+   * large workspaces
+   *
+   * @generated from field: int32 page = 2;
+   */
+  page = 0;
+
+  /**
+   * all matching test cases are listed if page_size is 0
+   *
+   * @generated from field: int32 page_size = 3;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: eval.v1.TestCaseFilter filter = 4;
+   */
+  filter?: TestCaseFilter;
+
+  /**
+   * next_page_token from the previous page, listed with the same sort
+   *
+   * @generated from field: string page_token = 5;
+   */
+  pageToken = "";
+
+  /**
+   * evaluated cases have a result matching the result filters below, which also
+   * select the results returned; setting any of them implies EVALUATED
+   *
+   * @generated from field: eval.v1.EvaluationStatus evaluation_status = 6;
+   */
+  evaluationStatus = EvaluationStatus.UNSPECIFIED;
+
+  /**
+   * @generated from field: repeated string workspace_config_ids = 7;
+   */
+  workspaceConfigIds: string[] = [];
+
+  /**
+   * @generated from field: optional uint32 prompt_version_number = 8;
+   */
+  promptVersionNumber?: number;
+
+  /**
+   * rating is -1 (thumbs down), 0 (unrated), or 1 (thumbs up)
+   *
+   * @generated from field: optional int32 rating = 9;
+   */
+  rating?: number;
+
+  /**
+   * case-insensitive text in the variable values or reference response
+   *
+   * @generated from field: string search = 10;
+   */
+  search = "";
+
+  /**
+   * @generated from field: eval.v1.TestCaseSort sort = 11;
+   */
+  sort = TestCaseSort.UNSPECIFIED;
+
+  /**
+   * @generated from field: bool descending = 12;
+   */
+  descending = false;
+
+  constructor(data?: PartialMessage<ListTestCasesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.ListTestCasesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "filter", kind: "message", T: TestCaseFilter },
+    { no: 5, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "evaluation_status", kind: "enum", T: proto3.getEnumType(EvaluationStatus) },
+    { no: 7, name: "workspace_config_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 8, name: "prompt_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+    { no: 9, name: "rating", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 10, name: "search", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "sort", kind: "enum", T: proto3.getEnumType(TestCaseSort) },
+    { no: 12, name: "descending", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTestCasesRequest {
+    return new ListTestCasesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTestCasesRequest {
+    return new ListTestCasesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTestCasesRequest {
+    return new ListTestCasesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListTestCasesRequest | PlainMessage<ListTestCasesRequest> | undefined, b: ListTestCasesRequest | PlainMessage<ListTestCasesRequest> | undefined): boolean {
+    return proto3.util.equals(ListTestCasesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.ListTestCasesResponse
+ */
+export class ListTestCasesResponse extends Message<ListTestCasesResponse> {
+  /**
+   * @generated from field: repeated eval.v1.TestCase test_cases = 1;
+   */
+  testCases: TestCase[] = [];
+
+  /**
+   * @generated from field: int32 total_count = 2;
+   */
+  totalCount = 0;
+
+  /**
+   * @generated from field: repeated eval.v1.TestResult test_results = 3;
+   */
+  testResults: TestResult[] = [];
+
+  /**
+   * empty on the last page
+   *
+   * @generated from field: string next_page_token = 4;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListTestCasesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.ListTestCasesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "test_cases", kind: "message", T: TestCase, repeated: true },
+    { no: 2, name: "total_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "test_results", kind: "message", T: TestResult, repeated: true },
+    { no: 4, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTestCasesResponse {
+    return new ListTestCasesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTestCasesResponse {
+    return new ListTestCasesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTestCasesResponse {
+    return new ListTestCasesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListTestCasesResponse | PlainMessage<ListTestCasesResponse> | undefined, b: ListTestCasesResponse | PlainMessage<ListTestCasesResponse> | undefined): boolean {
+    return proto3.util.equals(ListTestCasesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.CreatePromptVersionRequest
+ */
+export class CreatePromptVersionRequest extends Message<CreatePromptVersionRequest> {
+  /**
+   * @generated from field: string prompt_id = 1;
+   */
+  promptId = "";
+
+  /**
+   * @generated from field: string content = 2;
+   */
+  content = "";
+
+  /**
+   * @generated from field: uint32 version_number = 3;
+   */
+  versionNumber = 0;
+
+  constructor(data?: PartialMessage<CreatePromptVersionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.CreatePromptVersionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "prompt_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "content", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreatePromptVersionRequest {
+    return new CreatePromptVersionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreatePromptVersionRequest {
+    return new CreatePromptVersionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreatePromptVersionRequest {
+    return new CreatePromptVersionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreatePromptVersionRequest | PlainMessage<CreatePromptVersionRequest> | undefined, b: CreatePromptVersionRequest | PlainMessage<CreatePromptVersionRequest> | undefined): boolean {
+    return proto3.util.equals(CreatePromptVersionRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.CreateWorkspaceResponse
+ */
+export class CreateWorkspaceResponse extends Message<CreateWorkspaceResponse> {
+  /**
+   * @generated from field: eval.v1.Workspace workspace = 1;
+   */
+  workspace?: Workspace;
+
+  /**
+   * @generated from field: repeated eval.v1.LintFinding lint_findings = 2;
+   */
+  lintFindings: LintFinding[] = [];
+
+  constructor(data?: PartialMessage<CreateWorkspaceResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.CreateWorkspaceResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspace", kind: "message", T: Workspace },
+    { no: 2, name: "lint_findings", kind: "message", T: LintFinding, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWorkspaceResponse {
+    return new CreateWorkspaceResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWorkspaceResponse {
+    return new CreateWorkspaceResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWorkspaceResponse {
+    return new CreateWorkspaceResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateWorkspaceResponse | PlainMessage<CreateWorkspaceResponse> | undefined, b: CreateWorkspaceResponse | PlainMessage<CreateWorkspaceResponse> | undefined): boolean {
+    return proto3.util.equals(CreateWorkspaceResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.GetWorkspaceResponse
+ */
+export class GetWorkspaceResponse extends Message<GetWorkspaceResponse> {
+  /**
+   * @generated from field: eval.v1.Workspace workspace = 1;
+   */
+  workspace?: Workspace;
+
+  constructor(data?: PartialMessage<GetWorkspaceResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.GetWorkspaceResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspace", kind: "message", T: Workspace },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetWorkspaceResponse {
+    return new GetWorkspaceResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetWorkspaceResponse {
+    return new GetWorkspaceResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetWorkspaceResponse {
+    return new GetWorkspaceResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetWorkspaceResponse | PlainMessage<GetWorkspaceResponse> | undefined, b: GetWorkspaceResponse | PlainMessage<GetWorkspaceResponse> | undefined): boolean {
+    return proto3.util.equals(GetWorkspaceResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.CreateTestCaseRequest
+ */
+export class CreateTestCaseRequest extends Message<CreateTestCaseRequest> {
+  /**
+   * @generated from field: string workspace_id = 1;
+   */
+  workspaceId = "";
+
+  /**
+   * @generated from field: map<string, eval.v1.VariableValue> variable_values = 2;
+   */
+  variableValues: { [key: string]: VariableValue } = {};
+
+  /**
+   * response is the reference output for the test case, if known
+   *
+   * @generated from field: optional string response = 3;
+   */
+  response?: string;
+
+  /**
+   * @generated from field: repeated string tags = 4;
+   */
+  tags: string[] = [];
+
+  /**
+   * @generated from field: eval.v1.Split split = 5;
+   */
+  split = Split.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<CreateTestCaseRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.CreateTestCaseRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "variable_values", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: VariableValue} },
+    { no: 3, name: "response", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "tags", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "split", kind: "enum", T: proto3.getEnumType(Split) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateTestCaseRequest {
+    return new CreateTestCaseRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateTestCaseRequest {
+    return new CreateTestCaseRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateTestCaseRequest {
+    return new CreateTestCaseRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateTestCaseRequest | PlainMessage<CreateTestCaseRequest> | undefined, b: CreateTestCaseRequest | PlainMessage<CreateTestCaseRequest> | undefined): boolean {
+    return proto3.util.equals(CreateTestCaseRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.CreateTestCaseResponse
+ */
+export class CreateTestCaseResponse extends Message<CreateTestCaseResponse> {
+  /**
+   * @generated from field: eval.v1.TestCase test_case = 1;
+   */
+  testCase?: TestCase;
+
+  constructor(data?: PartialMessage<CreateTestCaseResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.CreateTestCaseResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "test_case", kind: "message", T: TestCase },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateTestCaseResponse {
+    return new CreateTestCaseResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateTestCaseResponse {
+    return new CreateTestCaseResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateTestCaseResponse {
+    return new CreateTestCaseResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateTestCaseResponse | PlainMessage<CreateTestCaseResponse> | undefined, b: CreateTestCaseResponse | PlainMessage<CreateTestCaseResponse> | undefined): boolean {
+    return proto3.util.equals(CreateTestCaseResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.DeleteTestCaseRequest
+ */
+export class DeleteTestCaseRequest extends Message<DeleteTestCaseRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<DeleteTestCaseRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.DeleteTestCaseRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteTestCaseRequest {
+    return new DeleteTestCaseRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteTestCaseRequest {
+    return new DeleteTestCaseRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteTestCaseRequest {
+    return new DeleteTestCaseRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteTestCaseRequest | PlainMessage<DeleteTestCaseRequest> | undefined, b: DeleteTestCaseRequest | PlainMessage<DeleteTestCaseRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteTestCaseRequest, a, b);
+  }
+}
+
+/**
+ * DedupOptions controls how new test cases that are near-duplicates of existing
+ * ones are handled. Similarity is the Jaccard similarity of the character shingles
+ * of the normalized variable values.
+ *
+ * @generated from message eval.v1.DedupOptions
+ */
+export class DedupOptions extends Message<DedupOptions> {
+  /**
+   * threshold in (0, 1] above which cases are near-duplicates; 0.8 if unset
+   *
+   * @generated from field: double threshold = 1;
+   */
+  threshold = 0;
+
+  /**
+   * @generated from field: eval.v1.DuplicateAction action = 2;
+   */
+  action = DuplicateAction.SKIP;
+
+  constructor(data?: PartialMessage<DedupOptions>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.DedupOptions";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "threshold", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 2, name: "action", kind: "enum", T: proto3.getEnumType(DuplicateAction) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DedupOptions {
+    return new DedupOptions().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DedupOptions {
+    return new DedupOptions().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DedupOptions {
+    return new DedupOptions().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DedupOptions | PlainMessage<DedupOptions> | undefined, b: DedupOptions | PlainMessage<DedupOptions> | undefined): boolean {
+    return proto3.util.equals(DedupOptions, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.NearDuplicate
+ */
+export class NearDuplicate extends Message<NearDuplicate> {
+  /**
+   * test_case_id is the new case, or empty if it was skipped
+   *
+   * @generated from field: string test_case_id = 1;
+   */
+  testCaseId = "";
+
+  /**
+   * duplicate_of is the existing (or earlier new) case it resembles
+   *
+   * @generated from field: string duplicate_of = 2;
+   */
+  duplicateOf = "";
+
+  /**
+   * @generated from field: double similarity = 3;
+   */
+  similarity = 0;
+
+  /**
+   * @generated from field: bool skipped = 4;
+   */
+  skipped = false;
+
+  /**
+   * row is the imported row the case came from, for imports
+   *
+   * @generated from field: uint32 row = 5;
+   */
+  row = 0;
+
+  constructor(data?: PartialMessage<NearDuplicate>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.NearDuplicate";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "test_case_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "duplicate_of", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "similarity", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 4, name: "skipped", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "row", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): NearDuplicate {
+    return new NearDuplicate().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): NearDuplicate {
+    return new NearDuplicate().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): NearDuplicate {
+    return new NearDuplicate().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: NearDuplicate | PlainMessage<NearDuplicate> | undefined, b: NearDuplicate | PlainMessage<NearDuplicate> | undefined): boolean {
+    return proto3.util.equals(NearDuplicate, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.FindDuplicateTestCasesRequest
+ */
+export class FindDuplicateTestCasesRequest extends Message<FindDuplicateTestCasesRequest> {
+  /**
+   * @generated from field: string workspace_id = 1;
+   */
+  workspaceId = "";
+
+  /**
+   * threshold in (0, 1] above which cases are near-duplicates; 0.8 if unset
+   *
+   * @generated from field: double threshold = 2;
+   */
+  threshold = 0;
+
+  /**
+   * @generated from field: eval.v1.TestCaseFilter filter = 3;
+   */
+  filter?: TestCaseFilter;
+
+  /**
+   * soft-delete every case in a group except the oldest
+   *
+   * @generated from field: bool delete = 4;
+   */
+  delete = false;
+
+  constructor(data?: PartialMessage<FindDuplicateTestCasesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.FindDuplicateTestCasesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "threshold", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 3, name: "filter", kind: "message", T: TestCaseFilter },
+    { no: 4, name: "delete", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FindDuplicateTestCasesRequest {
+    return new FindDuplicateTestCasesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FindDuplicateTestCasesRequest {
+    return new FindDuplicateTestCasesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FindDuplicateTestCasesRequest {
+    return new FindDuplicateTestCasesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FindDuplicateTestCasesRequest | PlainMessage<FindDuplicateTestCasesRequest> | undefined, b: FindDuplicateTestCasesRequest | PlainMessage<FindDuplicateTestCasesRequest> | undefined): boolean {
+    return proto3.util.equals(FindDuplicateTestCasesRequest, a, b);
+  }
+}
+
+/**
+ * DuplicateGroup is a set of test cases linked by near-duplicate pairs.
+ *
+ * @generated from message eval.v1.DuplicateGroup
+ */
+export class DuplicateGroup extends Message<DuplicateGroup> {
+  /**
+   * test_case_ids are ordered oldest first
+   *
+   * @generated from field: repeated string test_case_ids = 1;
+   */
+  testCaseIds: string[] = [];
+
+  /**
+   * min_similarity is the lowest similarity among the pairs linking the group
+   *
+   * @generated from field: double min_similarity = 2;
+   */
+  minSimilarity = 0;
+
+  constructor(data?: PartialMessage<DuplicateGroup>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.DuplicateGroup";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "test_case_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "min_similarity", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DuplicateGroup {
+    return new DuplicateGroup().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DuplicateGroup {
+    return new DuplicateGroup().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DuplicateGroup {
+    return new DuplicateGroup().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DuplicateGroup | PlainMessage<DuplicateGroup> | undefined, b: DuplicateGroup | PlainMessage<DuplicateGroup> | undefined): boolean {
+    return proto3.util.equals(DuplicateGroup, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.FindDuplicateTestCasesResponse
+ */
+export class FindDuplicateTestCasesResponse extends Message<FindDuplicateTestCasesResponse> {
+  /**
+   * @generated from field: repeated eval.v1.DuplicateGroup groups = 1;
+   */
+  groups: DuplicateGroup[] = [];
+
+  /**
+   * @generated from field: uint32 n_deleted = 2;
+   */
+  nDeleted = 0;
+
+  constructor(data?: PartialMessage<FindDuplicateTestCasesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.FindDuplicateTestCasesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "groups", kind: "message", T: DuplicateGroup, repeated: true },
+    { no: 2, name: "n_deleted", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FindDuplicateTestCasesResponse {
+    return new FindDuplicateTestCasesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FindDuplicateTestCasesResponse {
+    return new FindDuplicateTestCasesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FindDuplicateTestCasesResponse {
+    return new FindDuplicateTestCasesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FindDuplicateTestCasesResponse | PlainMessage<FindDuplicateTestCasesResponse> | undefined, b: FindDuplicateTestCasesResponse | PlainMessage<FindDuplicateTestCasesResponse> | undefined): boolean {
+    return proto3.util.equals(FindDuplicateTestCasesResponse, a, b);
+  }
+}
+
+/**
+ * ListDeletedTestCasesRequest lists the soft-deleted test cases of a workspace, most
+ * recently deleted first.
+ *
+ * @generated from message eval.v1.ListDeletedTestCasesRequest
+ */
+export class ListDeletedTestCasesRequest extends Message<ListDeletedTestCasesRequest> {
+  /**
+   * @generated from field: string workspace_id = 1;
+   */
+  workspaceId = "";
+
+  /**
+   * @generated from field: int32 page = 2;
+   */
+  page = 0;
+
+  /**
+   * @generated from field: int32 page_size = 3;
+   */
+  pageSize = 0;
+
+  constructor(data?: PartialMessage<ListDeletedTestCasesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.ListDeletedTestCasesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListDeletedTestCasesRequest {
+    return new ListDeletedTestCasesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListDeletedTestCasesRequest {
+    return new ListDeletedTestCasesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListDeletedTestCasesRequest {
+    return new ListDeletedTestCasesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListDeletedTestCasesRequest | PlainMessage<ListDeletedTestCasesRequest> | undefined, b: ListDeletedTestCasesRequest | PlainMessage<ListDeletedTestCasesRequest> | undefined): boolean {
+    return proto3.util.equals(ListDeletedTestCasesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.ListDeletedTestCasesResponse
+ */
+export class ListDeletedTestCasesResponse extends Message<ListDeletedTestCasesResponse> {
+  /**
+   * @generated from field: repeated eval.v1.TestCase test_cases = 1;
+   */
+  testCases: TestCase[] = [];
+
+  /**
+   * @generated from field: int32 total_count = 2;
+   */
+  totalCount = 0;
+
+  constructor(data?: PartialMessage<ListDeletedTestCasesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.ListDeletedTestCasesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "test_cases", kind: "message", T: TestCase, repeated: true },
+    { no: 2, name: "total_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListDeletedTestCasesResponse {
+    return new ListDeletedTestCasesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListDeletedTestCasesResponse {
+    return new ListDeletedTestCasesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListDeletedTestCasesResponse {
+    return new ListDeletedTestCasesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListDeletedTestCasesResponse | PlainMessage<ListDeletedTestCasesResponse> | undefined, b: ListDeletedTestCasesResponse | PlainMessage<ListDeletedTestCasesResponse> | undefined): boolean {
+    return proto3.util.equals(ListDeletedTestCasesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.RestoreTestCaseRequest
+ */
+export class RestoreTestCaseRequest extends Message<RestoreTestCaseRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<RestoreTestCaseRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.RestoreTestCaseRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RestoreTestCaseRequest {
+    return new RestoreTestCaseRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RestoreTestCaseRequest {
+    return new RestoreTestCaseRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RestoreTestCaseRequest {
+    return new RestoreTestCaseRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RestoreTestCaseRequest | PlainMessage<RestoreTestCaseRequest> | undefined, b: RestoreTestCaseRequest | PlainMessage<RestoreTestCaseRequest> | undefined): boolean {
+    return proto3.util.equals(RestoreTestCaseRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.RestoreTestCaseResponse
+ */
+export class RestoreTestCaseResponse extends Message<RestoreTestCaseResponse> {
+  /**
+   * @generated from field: eval.v1.TestCase test_case = 1;
+   */
+  testCase?: TestCase;
+
+  /**
+   * test results deleted with the test case and restored with it
+   *
+   * @generated from field: uint32 n_test_results = 2;
+   */
+  nTestResults = 0;
+
+  constructor(data?: PartialMessage<RestoreTestCaseResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.RestoreTestCaseResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "test_case", kind: "message", T: TestCase },
+    { no: 2, name: "n_test_results", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RestoreTestCaseResponse {
+    return new RestoreTestCaseResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RestoreTestCaseResponse {
+    return new RestoreTestCaseResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RestoreTestCaseResponse {
+    return new RestoreTestCaseResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RestoreTestCaseResponse | PlainMessage<RestoreTestCaseResponse> | undefined, b: RestoreTestCaseResponse | PlainMessage<RestoreTestCaseResponse> | undefined): boolean {
+    return proto3.util.equals(RestoreTestCaseResponse, a, b);
+  }
+}
+
+/**
+ * PurgeTestCasesRequest permanently deletes soft-deleted test cases, along with their
+ * test results and ratings. With no ids, every deleted test case of the workspace is
+ * purged.
+ *
+ * @generated from message eval.v1.PurgeTestCasesRequest
+ */
+export class PurgeTestCasesRequest extends Message<PurgeTestCasesRequest> {
+  /**
+   * @generated from field: string workspace_id = 1;
+   */
+  workspaceId = "";
+
+  /**
+   * @generated from field: repeated string ids = 2;
+   */
+  ids: string[] = [];
+
+  /**
+   * only purge test cases deleted before this time
+   *
+   * @generated from field: google.protobuf.Timestamp deleted_before = 3;
+   */
+  deletedBefore?: Timestamp;
+
+  constructor(data?: PartialMessage<PurgeTestCasesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.PurgeTestCasesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "deleted_before", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PurgeTestCasesRequest {
+    return new PurgeTestCasesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PurgeTestCasesRequest {
+    return new PurgeTestCasesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PurgeTestCasesRequest {
+    return new PurgeTestCasesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PurgeTestCasesRequest | PlainMessage<PurgeTestCasesRequest> | undefined, b: PurgeTestCasesRequest | PlainMessage<PurgeTestCasesRequest> | undefined): boolean {
+    return proto3.util.equals(PurgeTestCasesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.PurgeTestCasesResponse
+ */
+export class PurgeTestCasesResponse extends Message<PurgeTestCasesResponse> {
+  /**
+   * @generated from field: uint32 n_test_cases = 1;
+   */
+  nTestCases = 0;
+
+  /**
+   * @generated from field: uint32 n_test_results = 2;
+   */
+  nTestResults = 0;
+
+  /**
+   * @generated from field: uint32 n_ratings = 3;
+   */
+  nRatings = 0;
+
+  constructor(data?: PartialMessage<PurgeTestCasesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.PurgeTestCasesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "n_test_cases", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 2, name: "n_test_results", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "n_ratings", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PurgeTestCasesResponse {
+    return new PurgeTestCasesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PurgeTestCasesResponse {
+    return new PurgeTestCasesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PurgeTestCasesResponse {
+    return new PurgeTestCasesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PurgeTestCasesResponse | PlainMessage<PurgeTestCasesResponse> | undefined, b: PurgeTestCasesResponse | PlainMessage<PurgeTestCasesResponse> | undefined): boolean {
+    return proto3.util.equals(PurgeTestCasesResponse, a, b);
+  }
+}
+
+/**
+ * UpdateTestCasesRequest changes the tags or split of several test cases at once.
+ *
+ * @generated from message eval.v1.UpdateTestCasesRequest
+ */
+export class UpdateTestCasesRequest extends Message<UpdateTestCasesRequest> {
+  /**
+   * @generated from field: repeated string ids = 1;
+   */
+  ids: string[] = [];
+
+  /**
+   * split is left unchanged if unset
+   *
+   * @generated from field: optional eval.v1.Split split = 2;
+   */
+  split?: Split;
+
+  /**
+   * @generated from field: repeated string add_tags = 3;
+   */
+  addTags: string[] = [];
+
+  /**
+   * @generated from field: repeated string remove_tags = 4;
+   */
+  removeTags: string[] = [];
+
+  constructor(data?: PartialMessage<UpdateTestCasesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.UpdateTestCasesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "split", kind: "enum", T: proto3.getEnumType(Split), opt: true },
+    { no: 3, name: "add_tags", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "remove_tags", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateTestCasesRequest {
+    return new UpdateTestCasesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateTestCasesRequest {
+    return new UpdateTestCasesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateTestCasesRequest {
+    return new UpdateTestCasesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateTestCasesRequest | PlainMessage<UpdateTestCasesRequest> | undefined, b: UpdateTestCasesRequest | PlainMessage<UpdateTestCasesRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateTestCasesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.UpdateTestCasesResponse
+ */
+export class UpdateTestCasesResponse extends Message<UpdateTestCasesResponse> {
+  /**
+   * @generated from field: repeated eval.v1.TestCase test_cases = 1;
+   */
+  testCases: TestCase[] = [];
+
+  constructor(data?: PartialMessage<UpdateTestCasesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.UpdateTestCasesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "test_cases", kind: "message", T: TestCase, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateTestCasesResponse {
+    return new UpdateTestCasesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateTestCasesResponse {
+    return new UpdateTestCasesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateTestCasesResponse {
+    return new UpdateTestCasesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateTestCasesResponse | PlainMessage<UpdateTestCasesResponse> | undefined, b: UpdateTestCasesResponse | PlainMessage<UpdateTestCasesResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateTestCasesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.ImportTestCasesRequest
+ */
+export class ImportTestCasesRequest extends Message<ImportTestCasesRequest> {
+  /**
+   * @generated from field: string workspace_id = 1;
+   */
+  workspaceId = "";
+
+  /**
+   * @generated from field: eval.v1.FileFormat format = 2;
+   */
+  format = FileFormat.CSV;
+
+  /**
+   * @generated from field: bytes data = 3;
+   */
+  data = new Uint8Array(0);
+
+  /**
+   * column_mapping maps source columns to prompt variables. Columns named after a
+   * prompt variable are mapped to it without an entry here.
+   *
+   * @generated from field: map<string, string> column_mapping = 4;
+   */
+  columnMapping: { [key: string]: string } = {};
+
+  /**
+   * reference_column holds the reference output for each case, if any
+   *
+   * @generated from field: optional string reference_column = 5;
+   */
+  referenceColumn?: string;
+
+  /**
+   * skip_invalid imports the valid rows even if other rows fail. Otherwise any
+   * row error aborts the whole import.
+   *
+   * @generated from field: bool skip_invalid = 6;
+   */
+  skipInvalid = false;
+
+  /**
+   * dry_run validates the rows without writing anything
+   *
+   * @generated from field: bool dry_run = 7;
+   */
+  dryRun = false;
+
+  /**
+   * tags and split are given to every imported case
+   *
+   * @generated from field: repeated string tags = 8;
+   */
+  tags: string[] = [];
+
+  /**
+   * @generated from field: eval.v1.Split split = 9;
+   */
+  split = Split.UNSPECIFIED;
+
+  /**
+   * @generated from field: eval.v1.DedupOptions dedup = 10;
+   */
+  dedup?: DedupOptions;
+
+  /**
+   * if set, the cases are created in this dataset, which must be attached to the
+   * workspace. Variables are named as in the workspace and stored under the
+   * dataset's names.
+   *
+   * @generated from field: optional string dataset_id = 11;
+   */
+  datasetId?: string;
+
+  constructor(data?: PartialMessage<ImportTestCasesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.ImportTestCasesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "format", kind: "enum", T: proto3.getEnumType(FileFormat) },
+    { no: 3, name: "data", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 4, name: "column_mapping", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 5, name: "reference_column", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 6, name: "skip_invalid", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 7, name: "dry_run", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "tags", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 9, name: "split", kind: "enum", T: proto3.getEnumType(Split) },
+    { no: 10, name: "dedup", kind: "message", T: DedupOptions },
+    { no: 11, name: "dataset_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ImportTestCasesRequest {
+    return new ImportTestCasesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ImportTestCasesRequest {
+    return new ImportTestCasesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ImportTestCasesRequest {
+    return new ImportTestCasesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ImportTestCasesRequest | PlainMessage<ImportTestCasesRequest> | undefined, b: ImportTestCasesRequest | PlainMessage<ImportTestCasesRequest> | undefined): boolean {
+    return proto3.util.equals(ImportTestCasesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.ImportRowError
+ */
+export class ImportRowError extends Message<ImportRowError> {
+  /**
+   * row is the line of the file the row starts on, starting at 1
+   *
+   * @generated from field: uint32 row = 1;
+   */
+  row = 0;
+
+  /**
+   * @generated from field: string error = 2;
+   */
+  error = "";
+
+  constructor(data?: PartialMessage<ImportRowError>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.ImportRowError";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "row", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 2, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ImportRowError {
+    return new ImportRowError().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ImportRowError {
+    return new ImportRowError().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ImportRowError {
+    return new ImportRowError().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ImportRowError | PlainMessage<ImportRowError> | undefined, b: ImportRowError | PlainMessage<ImportRowError> | undefined): boolean {
+    return proto3.util.equals(ImportRowError, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.ImportTestCasesResponse
+ */
+export class ImportTestCasesResponse extends Message<ImportTestCasesResponse> {
+  /**
+   * @generated from field: repeated eval.v1.TestCase test_cases = 1;
+   */
+  testCases: TestCase[] = [];
+
+  /**
+   * n_duplicates counts rows that matched an existing case or an earlier row
+   *
+   * @generated from field: uint32 n_duplicates = 2;
+   */
+  nDuplicates = 0;
+
+  /**
+   * @generated from field: repeated eval.v1.ImportRowError errors = 3;
+   */
+  errors: ImportRowError[] = [];
+
+  /**
+   * @generated from field: repeated eval.v1.NearDuplicate near_duplicates = 4;
+   */
+  nearDuplicates: NearDuplicate[] = [];
+
+  constructor(data?: PartialMessage<ImportTestCasesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.ImportTestCasesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "test_cases", kind: "message", T: TestCase, repeated: true },
+    { no: 2, name: "n_duplicates", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "errors", kind: "message", T: ImportRowError, repeated: true },
+    { no: 4, name: "near_duplicates", kind: "message", T: NearDuplicate, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ImportTestCasesResponse {
+    return new ImportTestCasesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ImportTestCasesResponse {
+    return new ImportTestCasesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ImportTestCasesResponse {
+    return new ImportTestCasesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ImportTestCasesResponse | PlainMessage<ImportTestCasesResponse> | undefined, b: ImportTestCasesResponse | PlainMessage<ImportTestCasesResponse> | undefined): boolean {
+    return proto3.util.equals(ImportTestCasesResponse, a, b);
+  }
+}
+
+/**
+ * GeneratePromptRequest uses LLM to generate a prompt
+ *
+ * @generated from message eval.v1.GeneratePromptRequest
+ */
+export class GeneratePromptRequest extends Message<GeneratePromptRequest> {
+  /**
+   * @generated from field: string prompt = 1;
+   */
+  prompt = "";
+
+  /**
+   * @generated from field: string model_config_name = 2;
+   */
+  modelConfigName = "";
+
+  constructor(data?: PartialMessage<GeneratePromptRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.GeneratePromptRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "prompt", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "model_config_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GeneratePromptRequest {
+    return new GeneratePromptRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GeneratePromptRequest {
+    return new GeneratePromptRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GeneratePromptRequest {
+    return new GeneratePromptRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GeneratePromptRequest | PlainMessage<GeneratePromptRequest> | undefined, b: GeneratePromptRequest | PlainMessage<GeneratePromptRequest> | undefined): boolean {
+    return proto3.util.equals(GeneratePromptRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.GeneratePromptResponse
+ */
+export class GeneratePromptResponse extends Message<GeneratePromptResponse> {
+  /**
+   * @generated from field: string generated_prompt = 1;
+   */
+  generatedPrompt = "";
+
+  constructor(data?: PartialMessage<GeneratePromptResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.GeneratePromptResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "generated_prompt", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GeneratePromptResponse {
+    return new GeneratePromptResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GeneratePromptResponse {
+    return new GeneratePromptResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GeneratePromptResponse {
+    return new GeneratePromptResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GeneratePromptResponse | PlainMessage<GeneratePromptResponse> | undefined, b: GeneratePromptResponse | PlainMessage<GeneratePromptResponse> | undefined): boolean {
+    return proto3.util.equals(GeneratePromptResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.ListModelConfigsRequest
+ */
+export class ListModelConfigsRequest extends Message<ListModelConfigsRequest> {
+  constructor(data?: PartialMessage<ListModelConfigsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.ListModelConfigsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListModelConfigsRequest {
+    return new ListModelConfigsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListModelConfigsRequest {
+    return new ListModelConfigsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListModelConfigsRequest {
+    return new ListModelConfigsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListModelConfigsRequest | PlainMessage<ListModelConfigsRequest> | undefined, b: ListModelConfigsRequest | PlainMessage<ListModelConfigsRequest> | undefined): boolean {
+    return proto3.util.equals(ListModelConfigsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.ListModelConfigsResponse
+ */
+export class ListModelConfigsResponse extends Message<ListModelConfigsResponse> {
+  /**
+   * @generated from field: map<string, eval.v1.ModelConfig> model_configs = 1;
+   */
+  modelConfigs: { [key: string]: ModelConfig } = {};
+
+  constructor(data?: PartialMessage<ListModelConfigsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.ListModelConfigsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "model_configs", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: ModelConfig} },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListModelConfigsResponse {
+    return new ListModelConfigsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListModelConfigsResponse {
+    return new ListModelConfigsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListModelConfigsResponse {
+    return new ListModelConfigsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListModelConfigsResponse | PlainMessage<ListModelConfigsResponse> | undefined, b: ListModelConfigsResponse | PlainMessage<ListModelConfigsResponse> | undefined): boolean {
+    return proto3.util.equals(ListModelConfigsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.SetDefaultSmallModelConfigRequest
+ */
+export class SetDefaultSmallModelConfigRequest extends Message<SetDefaultSmallModelConfigRequest> {
+  /**
+   * @generated from field: string model_config_name = 1;
+   */
+  modelConfigName = "";
+
+  constructor(data?: PartialMessage<SetDefaultSmallModelConfigRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.SetDefaultSmallModelConfigRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "model_config_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetDefaultSmallModelConfigRequest {
+    return new SetDefaultSmallModelConfigRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetDefaultSmallModelConfigRequest {
+    return new SetDefaultSmallModelConfigRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetDefaultSmallModelConfigRequest {
+    return new SetDefaultSmallModelConfigRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetDefaultSmallModelConfigRequest | PlainMessage<SetDefaultSmallModelConfigRequest> | undefined, b: SetDefaultSmallModelConfigRequest | PlainMessage<SetDefaultSmallModelConfigRequest> | undefined): boolean {
+    return proto3.util.equals(SetDefaultSmallModelConfigRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.SetDefaultLargeModelConfigRequest
+ */
+export class SetDefaultLargeModelConfigRequest extends Message<SetDefaultLargeModelConfigRequest> {
+  /**
+   * @generated from field: string model_config_name = 1;
+   */
+  modelConfigName = "";
+
+  constructor(data?: PartialMessage<SetDefaultLargeModelConfigRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.SetDefaultLargeModelConfigRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "model_config_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetDefaultLargeModelConfigRequest {
+    return new SetDefaultLargeModelConfigRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetDefaultLargeModelConfigRequest {
+    return new SetDefaultLargeModelConfigRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetDefaultLargeModelConfigRequest {
+    return new SetDefaultLargeModelConfigRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetDefaultLargeModelConfigRequest | PlainMessage<SetDefaultLargeModelConfigRequest> | undefined, b: SetDefaultLargeModelConfigRequest | PlainMessage<SetDefaultLargeModelConfigRequest> | undefined): boolean {
+    return proto3.util.equals(SetDefaultLargeModelConfigRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.GetModelConfigResponse
+ */
+export class GetModelConfigResponse extends Message<GetModelConfigResponse> {
+  /**
+   * @generated from field: eval.v1.ModelConfig model_config = 1;
+   */
+  modelConfig?: ModelConfig;
+
+  /**
+   * @generated from field: string model_config_name = 2;
+   */
+  modelConfigName = "";
+
+  constructor(data?: PartialMessage<GetModelConfigResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.GetModelConfigResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "model_config", kind: "message", T: ModelConfig },
+    { no: 2, name: "model_config_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetModelConfigResponse {
+    return new GetModelConfigResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetModelConfigResponse {
+    return new GetModelConfigResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetModelConfigResponse {
+    return new GetModelConfigResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetModelConfigResponse | PlainMessage<GetModelConfigResponse> | undefined, b: GetModelConfigResponse | PlainMessage<GetModelConfigResponse> | undefined): boolean {
+    return proto3.util.equals(GetModelConfigResponse, a, b);
+  }
+}
+
+/**
+ * UpdateWorkspaceRequest creates a new prompt version and a new system prompt version
+ * when their content differs from the current versions.
+ *
+ * @generated from message eval.v1.UpdateWorkspaceRequest
+ */
+export class UpdateWorkspaceRequest extends Message<UpdateWorkspaceRequest> {
+  /**
+   * @generated from field: string workspace_id = 1;
+   */
+  workspaceId = "";
+
+  /**
+   * @generated from field: string new_content = 2;
+   */
+  newContent = "";
+
+  /**
+   * @generated from field: string new_system_prompt = 3;
+   */
+  newSystemPrompt = "";
+
+  /**
+   * @generated from field: optional string new_title = 4;
+   */
+  newTitle?: string;
+
+  /**
+   * recorded on the new versions
+   *
+   * @generated from field: string commit_message = 5;
+   */
+  commitMessage = "";
+
+  /**
+   * the prompt version the new content was edited from; defaults to the current version
+   *
+   * @generated from field: optional uint32 parent_version_number = 6;
+   */
+  parentVersionNumber?: number;
+
+  constructor(data?: PartialMessage<UpdateWorkspaceRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.UpdateWorkspaceRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "new_content", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "new_system_prompt", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "new_title", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "commit_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "parent_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateWorkspaceRequest {
+    return new UpdateWorkspaceRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateWorkspaceRequest {
+    return new UpdateWorkspaceRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateWorkspaceRequest {
+    return new UpdateWorkspaceRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateWorkspaceRequest | PlainMessage<UpdateWorkspaceRequest> | undefined, b: UpdateWorkspaceRequest | PlainMessage<UpdateWorkspaceRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateWorkspaceRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.UpdateWorkspaceResponse
+ */
+export class UpdateWorkspaceResponse extends Message<UpdateWorkspaceResponse> {
+  /**
+   * the current prompt version, which is only new if prompt_changed
+   *
+   * @generated from field: uint32 new_version_number = 1;
+   */
+  newVersionNumber = 0;
+
+  /**
+   * @generated from field: string content = 2;
+   */
+  content = "";
+
+  /**
+   * @generated from field: string system_prompt = 3;
+   */
+  systemPrompt = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 4;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * @generated from field: bool prompt_changed = 5;
+   */
+  promptChanged = false;
+
+  /**
+   * @generated from field: bool system_prompt_changed = 6;
+   */
+  systemPromptChanged = false;
+
+  /**
+   * @generated from field: uint32 system_prompt_version_number = 7;
+   */
+  systemPromptVersionNumber = 0;
+
+  /**
+   * lint findings for the current prompt and system prompt
+   *
+   * @generated from field: repeated eval.v1.LintFinding lint_findings = 8;
+   */
+  lintFindings: LintFinding[] = [];
+
+  constructor(data?: PartialMessage<UpdateWorkspaceResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.UpdateWorkspaceResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "new_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 2, name: "content", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "system_prompt", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "updated_at", kind: "message", T: Timestamp },
+    { no: 5, name: "prompt_changed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "system_prompt_changed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 7, name: "system_prompt_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 8, name: "lint_findings", kind: "message", T: LintFinding, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateWorkspaceResponse {
+    return new UpdateWorkspaceResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateWorkspaceResponse {
+    return new UpdateWorkspaceResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateWorkspaceResponse {
+    return new UpdateWorkspaceResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateWorkspaceResponse | PlainMessage<UpdateWorkspaceResponse> | undefined, b: UpdateWorkspaceResponse | PlainMessage<UpdateWorkspaceResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateWorkspaceResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.WordDiff
+ */
+export class WordDiff extends Message<WordDiff> {
+  /**
+   * DIFF_OP_EQUAL, DIFF_OP_INSERT or DIFF_OP_DELETE
+   *
+   * @generated from field: eval.v1.DiffOp op = 1;
+   */
+  op = DiffOp.UNSPECIFIED;
+
+  /**
+   * @generated from field: string text = 2;
+   */
+  text = "";
+
+  constructor(data?: PartialMessage<WordDiff>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.WordDiff";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "op", kind: "enum", T: proto3.getEnumType(DiffOp) },
+    { no: 2, name: "text", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WordDiff {
+    return new WordDiff().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WordDiff {
+    return new WordDiff().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WordDiff {
+    return new WordDiff().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WordDiff | PlainMessage<WordDiff> | undefined, b: WordDiff | PlainMessage<WordDiff> | undefined): boolean {
+    return proto3.util.equals(WordDiff, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.LineDiff
+ */
+export class LineDiff extends Message<LineDiff> {
+  /**
+   * @generated from field: eval.v1.DiffOp op = 1;
+   */
+  op = DiffOp.UNSPECIFIED;
+
+  /**
+   * @generated from field: string old_text = 2;
+   */
+  oldText = "";
+
+  /**
+   * @generated from field: string new_text = 3;
+   */
+  newText = "";
+
+  /**
+   * line numbers start at 1, and are 0 for a line that is not in that version
+   *
+   * @generated from field: uint32 old_line = 4;
+   */
+  oldLine = 0;
+
+  /**
+   * @generated from field: uint32 new_line = 5;
+   */
+  newLine = 0;
+
+  /**
+   * word-level diff of a DIFF_OP_REPLACE line
+   *
+   * @generated from field: repeated eval.v1.WordDiff words = 6;
+   */
+  words: WordDiff[] = [];
+
+  constructor(data?: PartialMessage<LineDiff>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.LineDiff";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "op", kind: "enum", T: proto3.getEnumType(DiffOp) },
+    { no: 2, name: "old_text", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "new_text", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "old_line", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "new_line", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 6, name: "words", kind: "message", T: WordDiff, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LineDiff {
+    return new LineDiff().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LineDiff {
+    return new LineDiff().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LineDiff {
+    return new LineDiff().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LineDiff | PlainMessage<LineDiff> | undefined, b: LineDiff | PlainMessage<LineDiff> | undefined): boolean {
+    return proto3.util.equals(LineDiff, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.TextDiff
+ */
+export class TextDiff extends Message<TextDiff> {
+  /**
+   * @generated from field: repeated eval.v1.LineDiff lines = 1;
+   */
+  lines: LineDiff[] = [];
+
+  /**
+   * @generated from field: uint32 n_inserted = 2;
+   */
+  nInserted = 0;
+
+  /**
+   * @generated from field: uint32 n_deleted = 3;
+   */
+  nDeleted = 0;
+
+  /**
+   * @generated from field: uint32 n_replaced = 4;
+   */
+  nReplaced = 0;
+
+  constructor(data?: PartialMessage<TextDiff>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.TextDiff";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "lines", kind: "message", T: LineDiff, repeated: true },
+    { no: 2, name: "n_inserted", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "n_deleted", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "n_replaced", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TextDiff {
+    return new TextDiff().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TextDiff {
+    return new TextDiff().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TextDiff {
+    return new TextDiff().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TextDiff | PlainMessage<TextDiff> | undefined, b: TextDiff | PlainMessage<TextDiff> | undefined): boolean {
+    return proto3.util.equals(TextDiff, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.DiffPromptVersionsRequest
+ */
+export class DiffPromptVersionsRequest extends Message<DiffPromptVersionsRequest> {
+  /**
+   * @generated from field: string workspace_id = 1;
+   */
+  workspaceId = "";
+
+  /**
+   * @generated from field: uint32 from_version_number = 2;
+   */
+  fromVersionNumber = 0;
+
+  /**
+   * @generated from field: uint32 to_version_number = 3;
+   */
+  toVersionNumber = 0;
+
+  /**
+   * system prompts are versioned separately, and are only diffed when both
+   * versions are given
+   *
+   * @generated from field: optional uint32 from_system_prompt_version_number = 4;
+   */
+  fromSystemPromptVersionNumber?: number;
+
+  /**
+   * @generated from field: optional uint32 to_system_prompt_version_number = 5;
+   */
+  toSystemPromptVersionNumber?: number;
+
+  constructor(data?: PartialMessage<DiffPromptVersionsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.DiffPromptVersionsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "from_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "to_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "from_system_prompt_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+    { no: 5, name: "to_system_prompt_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DiffPromptVersionsRequest {
+    return new DiffPromptVersionsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DiffPromptVersionsRequest {
+    return new DiffPromptVersionsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DiffPromptVersionsRequest {
+    return new DiffPromptVersionsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DiffPromptVersionsRequest | PlainMessage<DiffPromptVersionsRequest> | undefined, b: DiffPromptVersionsRequest | PlainMessage<DiffPromptVersionsRequest> | undefined): boolean {
+    return proto3.util.equals(DiffPromptVersionsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.DiffPromptVersionsResponse
+ */
+export class DiffPromptVersionsResponse extends Message<DiffPromptVersionsResponse> {
+  /**
+   * @generated from field: eval.v1.TextDiff prompt = 1;
+   */
+  prompt?: TextDiff;
+
+  /**
+   * @generated from field: eval.v1.TextDiff system_prompt = 2;
+   */
+  systemPrompt?: TextDiff;
+
+  /**
+   * variables used by the "to" version but not the "from" version, and the reverse
+   *
+   * @generated from field: repeated string added_variables = 3;
+   */
+  addedVariables: string[] = [];
+
+  /**
+   * @generated from field: repeated string removed_variables = 4;
+   */
+  removedVariables: string[] = [];
+
+  constructor(data?: PartialMessage<DiffPromptVersionsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.DiffPromptVersionsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "prompt", kind: "message", T: TextDiff },
+    { no: 2, name: "system_prompt", kind: "message", T: TextDiff },
+    { no: 3, name: "added_variables", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "removed_variables", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DiffPromptVersionsResponse {
+    return new DiffPromptVersionsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DiffPromptVersionsResponse {
+    return new DiffPromptVersionsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DiffPromptVersionsResponse {
+    return new DiffPromptVersionsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DiffPromptVersionsResponse | PlainMessage<DiffPromptVersionsResponse> | undefined, b: DiffPromptVersionsResponse | PlainMessage<DiffPromptVersionsResponse> | undefined): boolean {
+    return proto3.util.equals(DiffPromptVersionsResponse, a, b);
+  }
+}
+
+/**
+ * LintFinding is a warning about a prompt. Findings do not stop a prompt from
+ * being saved or evaluated, except that CreateWorkspace and UpdateWorkspace
+ * reject an invalid template with INVALID_ARGUMENT and its finding as an error
+ * detail.
+ *
+ * @generated from message eval.v1.LintFinding
+ */
+export class LintFinding extends Message<LintFinding> {
+  /**
+   * @generated from field: eval.v1.LintCode code = 1;
+   */
+  code = LintCode.UNSPECIFIED;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message = "";
+
+  /**
+   * line in the prompt, starting at 1, or 0 if the finding has no line
+   *
+   * @generated from field: uint32 line = 3;
+   */
+  line = 0;
+
+  /**
+   * @generated from field: string variable = 4;
+   */
+  variable = "";
+
+  /**
+   * the test case and workspace config of a context window finding
+   *
+   * @generated from field: string test_case_id = 5;
+   */
+  testCaseId = "";
+
+  /**
+   * @generated from field: string workspace_config_id = 6;
+   */
+  workspaceConfigId = "";
+
+  constructor(data?: PartialMessage<LintFinding>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.LintFinding";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "code", kind: "enum", T: proto3.getEnumType(LintCode) },
+    { no: 2, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "line", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "variable", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "test_case_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "workspace_config_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LintFinding {
+    return new LintFinding().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LintFinding {
+    return new LintFinding().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LintFinding {
+    return new LintFinding().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LintFinding | PlainMessage<LintFinding> | undefined, b: LintFinding | PlainMessage<LintFinding> | undefined): boolean {
+    return proto3.util.equals(LintFinding, a, b);
+  }
+}
+
+/**
+ * LintPromptRequest lints a prompt against a workspace's test cases and configs.
+ *
+ * @generated from message eval.v1.LintPromptRequest
+ */
+export class LintPromptRequest extends Message<LintPromptRequest> {
+  /**
+   * @generated from field: string workspace_id = 1;
+   */
+  workspaceId = "";
+
+  /**
+   * lint this content instead of a saved version
+   *
+   * @generated from field: optional string content = 2;
+   */
+  content?: string;
+
+  /**
+   * the version to lint; defaults to the current version
+   *
+   * @generated from field: optional uint32 version_number = 3;
+   */
+  versionNumber?: number;
+
+  /**
+   * defaults to the current system prompt
+   *
+   * @generated from field: optional string system_prompt = 4;
+   */
+  systemPrompt?: string;
+
+  constructor(data?: PartialMessage<LintPromptRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.LintPromptRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "content", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+    { no: 4, name: "system_prompt", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LintPromptRequest {
+    return new LintPromptRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LintPromptRequest {
+    return new LintPromptRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LintPromptRequest {
+    return new LintPromptRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LintPromptRequest | PlainMessage<LintPromptRequest> | undefined, b: LintPromptRequest | PlainMessage<LintPromptRequest> | undefined): boolean {
+    return proto3.util.equals(LintPromptRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.LintPromptResponse
+ */
+export class LintPromptResponse extends Message<LintPromptResponse> {
+  /**
+   * @generated from field: repeated eval.v1.LintFinding findings = 1;
+   */
+  findings: LintFinding[] = [];
+
+  constructor(data?: PartialMessage<LintPromptResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.LintPromptResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "findings", kind: "message", T: LintFinding, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LintPromptResponse {
+    return new LintPromptResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LintPromptResponse {
+    return new LintPromptResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LintPromptResponse {
+    return new LintPromptResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LintPromptResponse | PlainMessage<LintPromptResponse> | undefined, b: LintPromptResponse | PlainMessage<LintPromptResponse> | undefined): boolean {
+    return proto3.util.equals(LintPromptResponse, a, b);
+  }
+}
+
+/**
+ * PromptLabel is a movable name, like production or staging, for a prompt version
+ * and a system prompt version of a workspace.
+ *
+ * @generated from message eval.v1.PromptLabel
+ */
+export class PromptLabel extends Message<PromptLabel> {
+  /**
+   * @generated from field: string workspace_id = 1;
+   */
+  workspaceId = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: uint32 prompt_version_number = 3;
+   */
+  promptVersionNumber = 0;
+
+  /**
+   * 0 for no system prompt
+   *
+   * @generated from field: uint32 system_prompt_version_number = 4;
+   */
+  systemPromptVersionNumber = 0;
+
+  /**
+   * @generated from field: string moved_by = 5;
+   */
+  movedBy = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 6;
+   */
+  updatedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<PromptLabel>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.PromptLabel";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "prompt_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "system_prompt_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "moved_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "updated_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromptLabel {
+    return new PromptLabel().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromptLabel {
+    return new PromptLabel().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromptLabel {
+    return new PromptLabel().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromptLabel | PlainMessage<PromptLabel> | undefined, b: PromptLabel | PlainMessage<PromptLabel> | undefined): boolean {
+    return proto3.util.equals(PromptLabel, a, b);
+  }
+}
+
+/**
+ * PromptLabelEvent records a label being moved.
+ *
+ * @generated from message eval.v1.PromptLabelEvent
+ */
+export class PromptLabelEvent extends Message<PromptLabelEvent> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label = "";
+
+  /**
+   * @generated from field: eval.v1.LabelAction action = 3;
+   */
+  action = LabelAction.UNSPECIFIED;
+
+  /**
+   * @generated from field: uint32 prompt_version_number = 4;
+   */
+  promptVersionNumber = 0;
+
+  /**
+   * @generated from field: uint32 system_prompt_version_number = 5;
+   */
+  systemPromptVersionNumber = 0;
+
+  /**
+   * where the label pointed before; unset when the label was created
+   *
+   * @generated from field: optional uint32 previous_prompt_version_number = 6;
+   */
+  previousPromptVersionNumber?: number;
+
+  /**
+   * @generated from field: optional uint32 previous_system_prompt_version_number = 7;
+   */
+  previousSystemPromptVersionNumber?: number;
+
+  /**
+   * @generated from field: string moved_by = 8;
+   */
+  movedBy = "";
+
+  /**
+   * @generated from field: string reason = 9;
+   */
+  reason = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 10;
+   */
+  createdAt?: Timestamp;
+
+  constructor(data?: PartialMessage<PromptLabelEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.PromptLabelEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "label", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "action", kind: "enum", T: proto3.getEnumType(LabelAction) },
+    { no: 4, name: "prompt_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "system_prompt_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 6, name: "previous_prompt_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+    { no: 7, name: "previous_system_prompt_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+    { no: 8, name: "moved_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "created_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromptLabelEvent {
+    return new PromptLabelEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromptLabelEvent {
+    return new PromptLabelEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromptLabelEvent {
+    return new PromptLabelEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromptLabelEvent | PlainMessage<PromptLabelEvent> | undefined, b: PromptLabelEvent | PlainMessage<PromptLabelEvent> | undefined): boolean {
+    return proto3.util.equals(PromptLabelEvent, a, b);
+  }
+}
+
+/**
+ * CompleteRequest renders a prompt version with variable values and calls a
+ * workspace config's model with it, so applications can serve prompts from
+ * evalite instead of copying them.
+ *
+ * Without a label, the current prompt and current system prompt are served unless
+ * version numbers are given. With a label, only the label's versions are served: a
+ * label without a system prompt serves none, not the current one.
+ *
+ * @generated from message eval.v1.CompleteRequest
+ */
+export class CompleteRequest extends Message<CompleteRequest> {
+  /**
+   * @generated from field: string workspace_id = 1;
+   */
+  workspaceId = "";
+
+  /**
+   * serve the versions a label points at; cannot be combined with version numbers
+   *
+   * @generated from field: string label = 2;
+   */
+  label = "";
+
+  /**
+   * defaults to the current version when no label is given
+   *
+   * @generated from field: uint32 version_number = 3;
+   */
+  versionNumber = 0;
+
+  /**
+   * defaults to the current system prompt version when no label is given
+   *
+   * @generated from field: uint32 system_prompt_version_number = 4;
+   */
+  systemPromptVersionNumber = 0;
+
+  /**
+   * every variable the prompt uses is required, though it may be empty
+   *
+   * @generated from field: map<string, string> variables = 5;
+   */
+  variables: { [key: string]: string } = {};
+
+  /**
+   * the ID or name of the workspace config to call; defaults to the only active one
+   *
+   * @generated from field: string workspace_config = 6;
+   */
+  workspaceConfig = "";
+
+  constructor(data?: PartialMessage<CompleteRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.CompleteRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "label", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "system_prompt_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "variables", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 6, name: "workspace_config", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CompleteRequest {
+    return new CompleteRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CompleteRequest {
+    return new CompleteRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CompleteRequest {
+    return new CompleteRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CompleteRequest | PlainMessage<CompleteRequest> | undefined, b: CompleteRequest | PlainMessage<CompleteRequest> | undefined): boolean {
+    return proto3.util.equals(CompleteRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.CompleteResponse
+ */
+export class CompleteResponse extends Message<CompleteResponse> {
+  /**
+   * @generated from field: string response = 1;
+   */
+  response = "";
+
+  /**
+   * the trace the call was logged as; empty if logging failed
+   *
+   * @generated from field: string trace_id = 2;
+   */
+  traceId = "";
+
+  /**
+   * @generated from field: uint32 prompt_version_number = 3;
+   */
+  promptVersionNumber = 0;
+
+  /**
+   * @generated from field: uint32 system_prompt_version_number = 4;
+   */
+  systemPromptVersionNumber = 0;
+
+  /**
+   * @generated from field: string workspace_config_id = 5;
+   */
+  workspaceConfigId = "";
+
+  /**
+   * @generated from field: string model_config_name = 6;
+   */
+  modelConfigName = "";
+
+  constructor(data?: PartialMessage<CompleteResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.CompleteResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "response", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "trace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "prompt_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "system_prompt_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "workspace_config_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "model_config_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CompleteResponse {
+    return new CompleteResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CompleteResponse {
+    return new CompleteResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CompleteResponse {
+    return new CompleteResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CompleteResponse | PlainMessage<CompleteResponse> | undefined, b: CompleteResponse | PlainMessage<CompleteResponse> | undefined): boolean {
+    return proto3.util.equals(CompleteResponse, a, b);
+  }
+}
+
+/**
+ * Trace is a call served by Complete.
+ *
+ * @generated from message eval.v1.Trace
+ */
+export class Trace extends Message<Trace> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string workspace_id = 2;
+   */
+  workspaceId = "";
+
+  /**
+   * @generated from field: string label = 3;
+   */
+  label = "";
+
+  /**
+   * @generated from field: uint32 prompt_version_number = 4;
+   */
+  promptVersionNumber = 0;
+
+  /**
+   * @generated from field: uint32 system_prompt_version_number = 5;
+   */
+  systemPromptVersionNumber = 0;
+
+  /**
+   * @generated from field: string workspace_config_id = 6;
+   */
+  workspaceConfigId = "";
+
+  /**
+   * @generated from field: string model_config_name = 7;
+   */
+  modelConfigName = "";
+
+  /**
+   * @generated from field: map<string, string> variables = 8;
+   */
+  variables: { [key: string]: string } = {};
+
+  /**
+   * @generated from field: repeated string example_ids = 9;
+   */
+  exampleIds: string[] = [];
+
+  /**
+   * @generated from field: string response = 10;
+   */
+  response = "";
+
+  /**
+   * set when the model call failed
+   *
+   * @generated from field: string error = 11;
+   */
+  error = "";
+
+  /**
+   * @generated from field: uint32 latency_ms = 12;
+   */
+  latencyMs = 0;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 13;
+   */
+  createdAt?: Timestamp;
+
+  constructor(data?: PartialMessage<Trace>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.Trace";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "label", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "prompt_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "system_prompt_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 6, name: "workspace_config_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "model_config_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "variables", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 9, name: "example_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 10, name: "response", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 12, name: "latency_ms", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 13, name: "created_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Trace {
+    return new Trace().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Trace {
+    return new Trace().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Trace {
+    return new Trace().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Trace | PlainMessage<Trace> | undefined, b: Trace | PlainMessage<Trace> | undefined): boolean {
+    return proto3.util.equals(Trace, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.ListTracesRequest
+ */
+export class ListTracesRequest extends Message<ListTracesRequest> {
+  /**
+   * @generated from field: string workspace_id = 1;
+   */
+  workspaceId = "";
+
+  /**
+   * @generated from field: int32 page = 2;
+   */
+  page = 0;
+
+  /**
+   * @generated from field: int32 page_size = 3;
+   */
+  pageSize = 0;
+
+  /**
+   * only list the traces served through this label
+   *
+   * @generated from field: string label = 4;
+   */
+  label = "";
+
+  /**
+   * only list failed calls
+   *
+   * @generated from field: bool errors_only = 5;
+   */
+  errorsOnly = false;
+
+  constructor(data?: PartialMessage<ListTracesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.ListTracesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "label", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "errors_only", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTracesRequest {
+    return new ListTracesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTracesRequest {
+    return new ListTracesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTracesRequest {
+    return new ListTracesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListTracesRequest | PlainMessage<ListTracesRequest> | undefined, b: ListTracesRequest | PlainMessage<ListTracesRequest> | undefined): boolean {
+    return proto3.util.equals(ListTracesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.ListTracesResponse
+ */
+export class ListTracesResponse extends Message<ListTracesResponse> {
+  /**
+   * @generated from field: repeated eval.v1.Trace traces = 1;
+   */
+  traces: Trace[] = [];
+
+  /**
+   * @generated from field: int32 total_count = 2;
+   */
+  totalCount = 0;
+
+  constructor(data?: PartialMessage<ListTracesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.ListTracesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "traces", kind: "message", T: Trace, repeated: true },
+    { no: 2, name: "total_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTracesResponse {
+    return new ListTracesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTracesResponse {
+    return new ListTracesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTracesResponse {
+    return new ListTracesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListTracesResponse | PlainMessage<ListTracesResponse> | undefined, b: ListTracesResponse | PlainMessage<ListTracesResponse> | undefined): boolean {
+    return proto3.util.equals(ListTracesResponse, a, b);
+  }
+}
+
+/**
+ * PromoteVersionRequest points a label at prompt versions, creating the label if
+ * needed. The versions are either given, or copied from from_label, e.g. to promote
+ * staging to production.
+ *
+ * @generated from message eval.v1.PromoteVersionRequest
+ */
+export class PromoteVersionRequest extends Message<PromoteVersionRequest> {
+  /**
+   * @generated from field: string workspace_id = 1;
+   */
+  workspaceId = "";
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label = "";
+
+  /**
+   * @generated from field: uint32 prompt_version_number = 3;
+   */
+  promptVersionNumber = 0;
+
+  /**
+   * defaults to the current system prompt version; 0 for no system prompt
+   *
+   * @generated from field: optional uint32 system_prompt_version_number = 4;
+   */
+  systemPromptVersionNumber?: number;
+
+  /**
+   * @generated from field: string from_label = 5;
+   */
+  fromLabel = "";
+
+  /**
+   * @generated from field: string moved_by = 6;
+   */
+  movedBy = "";
+
+  /**
+   * @generated from field: string reason = 7;
+   */
+  reason = "";
+
+  constructor(data?: PartialMessage<PromoteVersionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.PromoteVersionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "label", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "prompt_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "system_prompt_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+    { no: 5, name: "from_label", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "moved_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromoteVersionRequest {
+    return new PromoteVersionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromoteVersionRequest {
+    return new PromoteVersionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromoteVersionRequest {
+    return new PromoteVersionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromoteVersionRequest | PlainMessage<PromoteVersionRequest> | undefined, b: PromoteVersionRequest | PlainMessage<PromoteVersionRequest> | undefined): boolean {
+    return proto3.util.equals(PromoteVersionRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.PromoteVersionResponse
+ */
+export class PromoteVersionResponse extends Message<PromoteVersionResponse> {
+  /**
+   * @generated from field: eval.v1.PromptLabel label = 1;
+   */
+  label?: PromptLabel;
+
+  /**
+   * unset if the label already pointed at the versions
+   *
+   * @generated from field: eval.v1.PromptLabelEvent event = 2;
+   */
+  event?: PromptLabelEvent;
+
+  constructor(data?: PartialMessage<PromoteVersionResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.PromoteVersionResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "label", kind: "message", T: PromptLabel },
+    { no: 2, name: "event", kind: "message", T: PromptLabelEvent },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromoteVersionResponse {
+    return new PromoteVersionResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromoteVersionResponse {
+    return new PromoteVersionResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromoteVersionResponse {
+    return new PromoteVersionResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromoteVersionResponse | PlainMessage<PromoteVersionResponse> | undefined, b: PromoteVersionResponse | PlainMessage<PromoteVersionResponse> | undefined): boolean {
+    return proto3.util.equals(PromoteVersionResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.RollbackLabelRequest
+ */
+export class RollbackLabelRequest extends Message<RollbackLabelRequest> {
+  /**
+   * @generated from field: string workspace_id = 1;
+   */
+  workspaceId = "";
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label = "";
+
+  /**
+   * the history event whose versions to move back to; defaults to where the label
+   * pointed before its last promotion, skipping any rollbacks since
+   *
+   * @generated from field: optional string to_event_id = 3;
+   */
+  toEventId?: string;
+
+  /**
+   * @generated from field: string moved_by = 4;
+   */
+  movedBy = "";
+
+  /**
+   * @generated from field: string reason = 5;
+   */
+  reason = "";
+
+  constructor(data?: PartialMessage<RollbackLabelRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.RollbackLabelRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "label", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "to_event_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "moved_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RollbackLabelRequest {
+    return new RollbackLabelRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RollbackLabelRequest {
+    return new RollbackLabelRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RollbackLabelRequest {
+    return new RollbackLabelRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RollbackLabelRequest | PlainMessage<RollbackLabelRequest> | undefined, b: RollbackLabelRequest | PlainMessage<RollbackLabelRequest> | undefined): boolean {
+    return proto3.util.equals(RollbackLabelRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.RollbackLabelResponse
+ */
+export class RollbackLabelResponse extends Message<RollbackLabelResponse> {
+  /**
+   * @generated from field: eval.v1.PromptLabel label = 1;
+   */
+  label?: PromptLabel;
+
+  /**
+   * @generated from field: eval.v1.PromptLabelEvent event = 2;
+   */
+  event?: PromptLabelEvent;
+
+  constructor(data?: PartialMessage<RollbackLabelResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.RollbackLabelResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "label", kind: "message", T: PromptLabel },
+    { no: 2, name: "event", kind: "message", T: PromptLabelEvent },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RollbackLabelResponse {
+    return new RollbackLabelResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RollbackLabelResponse {
+    return new RollbackLabelResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RollbackLabelResponse {
+    return new RollbackLabelResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RollbackLabelResponse | PlainMessage<RollbackLabelResponse> | undefined, b: RollbackLabelResponse | PlainMessage<RollbackLabelResponse> | undefined): boolean {
+    return proto3.util.equals(RollbackLabelResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.ListLabelsRequest
+ */
+export class ListLabelsRequest extends Message<ListLabelsRequest> {
+  /**
+   * @generated from field: string workspace_id = 1;
+   */
+  workspaceId = "";
+
+  constructor(data?: PartialMessage<ListLabelsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.ListLabelsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListLabelsRequest {
+    return new ListLabelsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListLabelsRequest {
+    return new ListLabelsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListLabelsRequest {
+    return new ListLabelsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListLabelsRequest | PlainMessage<ListLabelsRequest> | undefined, b: ListLabelsRequest | PlainMessage<ListLabelsRequest> | undefined): boolean {
+    return proto3.util.equals(ListLabelsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.ListLabelsResponse
+ */
+export class ListLabelsResponse extends Message<ListLabelsResponse> {
+  /**
+   * @generated from field: repeated eval.v1.PromptLabel labels = 1;
+   */
+  labels: PromptLabel[] = [];
+
+  constructor(data?: PartialMessage<ListLabelsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.ListLabelsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "labels", kind: "message", T: PromptLabel, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListLabelsResponse {
+    return new ListLabelsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListLabelsResponse {
+    return new ListLabelsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListLabelsResponse {
+    return new ListLabelsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListLabelsResponse | PlainMessage<ListLabelsResponse> | undefined, b: ListLabelsResponse | PlainMessage<ListLabelsResponse> | undefined): boolean {
+    return proto3.util.equals(ListLabelsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.ListLabelHistoryRequest
+ */
+export class ListLabelHistoryRequest extends Message<ListLabelHistoryRequest> {
+  /**
+   * @generated from field: string workspace_id = 1;
+   */
+  workspaceId = "";
+
+  /**
+   * all labels if empty
+   *
+   * @generated from field: string label = 2;
+   */
+  label = "";
+
+  /**
+   * @generated from field: int32 page = 3;
+   */
+  page = 0;
+
+  /**
+   * @generated from field: int32 page_size = 4;
+   */
+  pageSize = 0;
+
+  constructor(data?: PartialMessage<ListLabelHistoryRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.ListLabelHistoryRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "label", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "page", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListLabelHistoryRequest {
+    return new ListLabelHistoryRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListLabelHistoryRequest {
+    return new ListLabelHistoryRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListLabelHistoryRequest {
+    return new ListLabelHistoryRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListLabelHistoryRequest | PlainMessage<ListLabelHistoryRequest> | undefined, b: ListLabelHistoryRequest | PlainMessage<ListLabelHistoryRequest> | undefined): boolean {
+    return proto3.util.equals(ListLabelHistoryRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.ListLabelHistoryResponse
+ */
+export class ListLabelHistoryResponse extends Message<ListLabelHistoryResponse> {
+  /**
+   * newest first
+   *
+   * @generated from field: repeated eval.v1.PromptLabelEvent events = 1;
+   */
+  events: PromptLabelEvent[] = [];
+
+  /**
+   * @generated from field: int32 total_count = 2;
+   */
+  totalCount = 0;
+
+  constructor(data?: PartialMessage<ListLabelHistoryResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.ListLabelHistoryResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "events", kind: "message", T: PromptLabelEvent, repeated: true },
+    { no: 2, name: "total_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListLabelHistoryResponse {
+    return new ListLabelHistoryResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListLabelHistoryResponse {
+    return new ListLabelHistoryResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListLabelHistoryResponse {
+    return new ListLabelHistoryResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListLabelHistoryResponse | PlainMessage<ListLabelHistoryResponse> | undefined, b: ListLabelHistoryResponse | PlainMessage<ListLabelHistoryResponse> | undefined): boolean {
+    return proto3.util.equals(ListLabelHistoryResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.GenerateTestCaseRequest
+ */
+export class GenerateTestCaseRequest extends Message<GenerateTestCaseRequest> {
+  /**
+   * @generated from field: string workspace_id = 1;
+   */
+  workspaceId = "";
+
+  /**
+   * @generated from field: uint32 version_number = 2;
+   */
+  versionNumber = 0;
+
+  /**
+   * @generated from field: repeated eval.v1.TestCase test_cases = 3;
+   */
+  testCases: TestCase[] = [];
+
+  /**
+   * @generated from field: optional string custom_cot = 4;
+   */
+  customCot?: string;
+
+  /**
+   * @generated from field: uint32 n_test_cases = 5;
+   */
+  nTestCases = 0;
+
+  /**
+   * @generated from field: optional string seed_prompt = 6;
+   */
+  seedPrompt?: string;
+
+  /**
+   * @generated from field: eval.v1.DedupOptions dedup = 7;
+   */
+  dedup?: DedupOptions;
+
+  /**
+   * model_config_name defaults to the default small model config
+   *
+   * @generated from field: optional string model_config_name = 8;
+   */
+  modelConfigName?: string;
+
+  /**
+   * temperature defaults to 1.2
+   *
+   * @generated from field: optional float temperature = 9;
+   */
+  temperature?: number;
+
+  /**
+   * max_tokens defaults to 4000
+   *
+   * @generated from field: optional int32 max_tokens = 10;
+   */
+  maxTokens?: number;
+
+  /**
+   * max_attempts bounds how often the model is re-prompted after an unparseable
+   * reply or rejected cases; defaults to 3
+   *
+   * @generated from field: uint32 max_attempts = 11;
+   */
+  maxAttempts = 0;
+
+  /**
+   * @generated from field: eval.v1.GenerationMode mode = 12;
+   */
+  mode = GenerationMode.TYPICAL;
+
+  /**
+   * attack_categories are the categories generated in adversarial mode, all of them
+   * if empty. n_test_cases are generated per category, and each case is tagged
+   * "adversarial" and "adversarial:<category>", e.g. "adversarial:jailbreak".
+   *
+   * @generated from field: repeated eval.v1.AttackCategory attack_categories = 13;
+   */
+  attackCategories: AttackCategory[] = [];
+
+  constructor(data?: PartialMessage<GenerateTestCaseRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.GenerateTestCaseRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "test_cases", kind: "message", T: TestCase, repeated: true },
+    { no: 4, name: "custom_cot", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "n_test_cases", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 6, name: "seed_prompt", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 7, name: "dedup", kind: "message", T: DedupOptions },
+    { no: 8, name: "model_config_name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 9, name: "temperature", kind: "scalar", T: 2 /* ScalarType.FLOAT */, opt: true },
+    { no: 10, name: "max_tokens", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 11, name: "max_attempts", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 12, name: "mode", kind: "enum", T: proto3.getEnumType(GenerationMode) },
+    { no: 13, name: "attack_categories", kind: "enum", T: proto3.getEnumType(AttackCategory), repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateTestCaseRequest {
+    return new GenerateTestCaseRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GenerateTestCaseRequest {
+    return new GenerateTestCaseRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GenerateTestCaseRequest {
+    return new GenerateTestCaseRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GenerateTestCaseRequest | PlainMessage<GenerateTestCaseRequest> | undefined, b: GenerateTestCaseRequest | PlainMessage<GenerateTestCaseRequest> | undefined): boolean {
+    return proto3.util.equals(GenerateTestCaseRequest, a, b);
+  }
+}
+
+/**
+ * RejectedTestCase is a generated case that failed validation, or a reply that
+ * could not be parsed, in which case variable_values is empty.
+ *
+ * @generated from message eval.v1.RejectedTestCase
+ */
+export class RejectedTestCase extends Message<RejectedTestCase> {
+  /**
+   * @generated from field: map<string, string> variable_values = 1;
+   */
+  variableValues: { [key: string]: string } = {};
+
+  /**
+   * @generated from field: string reason = 2;
+   */
+  reason = "";
+
+  /**
+   * @generated from field: uint32 attempt = 3;
+   */
+  attempt = 0;
+
+  constructor(data?: PartialMessage<RejectedTestCase>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.RejectedTestCase";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "variable_values", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 2, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "attempt", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RejectedTestCase {
+    return new RejectedTestCase().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RejectedTestCase {
+    return new RejectedTestCase().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RejectedTestCase {
+    return new RejectedTestCase().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RejectedTestCase | PlainMessage<RejectedTestCase> | undefined, b: RejectedTestCase | PlainMessage<RejectedTestCase> | undefined): boolean {
+    return proto3.util.equals(RejectedTestCase, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.GenerateTestCaseResponse
+ */
+export class GenerateTestCaseResponse extends Message<GenerateTestCaseResponse> {
+  /**
+   * @generated from field: repeated eval.v1.TestCase test_cases = 1;
+   */
+  testCases: TestCase[] = [];
+
+  /**
+   * @generated from field: repeated eval.v1.NearDuplicate near_duplicates = 2;
+   */
+  nearDuplicates: NearDuplicate[] = [];
+
+  /**
+   * @generated from field: repeated eval.v1.RejectedTestCase rejected = 3;
+   */
+  rejected: RejectedTestCase[] = [];
+
+  /**
+   * @generated from field: uint32 n_attempts = 4;
+   */
+  nAttempts = 0;
+
+  constructor(data?: PartialMessage<GenerateTestCaseResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.GenerateTestCaseResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "test_cases", kind: "message", T: TestCase, repeated: true },
+    { no: 2, name: "near_duplicates", kind: "message", T: NearDuplicate, repeated: true },
+    { no: 3, name: "rejected", kind: "message", T: RejectedTestCase, repeated: true },
+    { no: 4, name: "n_attempts", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateTestCaseResponse {
+    return new GenerateTestCaseResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GenerateTestCaseResponse {
+    return new GenerateTestCaseResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GenerateTestCaseResponse {
+    return new GenerateTestCaseResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GenerateTestCaseResponse | PlainMessage<GenerateTestCaseResponse> | undefined, b: GenerateTestCaseResponse | PlainMessage<GenerateTestCaseResponse> | undefined): boolean {
+    return proto3.util.equals(GenerateTestCaseResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.DeleteWorkspaceConfigRequest
+ */
+export class DeleteWorkspaceConfigRequest extends Message<DeleteWorkspaceConfigRequest> {
+  /**
+   * @generated from field: string workspace_id = 1;
+   */
+  workspaceId = "";
+
+  /**
+   * @generated from field: string workspace_config_id = 2;
+   */
+  workspaceConfigId = "";
+
+  constructor(data?: PartialMessage<DeleteWorkspaceConfigRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.DeleteWorkspaceConfigRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "workspace_config_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteWorkspaceConfigRequest {
+    return new DeleteWorkspaceConfigRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteWorkspaceConfigRequest {
+    return new DeleteWorkspaceConfigRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteWorkspaceConfigRequest {
+    return new DeleteWorkspaceConfigRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteWorkspaceConfigRequest | PlainMessage<DeleteWorkspaceConfigRequest> | undefined, b: DeleteWorkspaceConfigRequest | PlainMessage<DeleteWorkspaceConfigRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteWorkspaceConfigRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.SetWorkspaceConfigActiveRequest
+ */
+export class SetWorkspaceConfigActiveRequest extends Message<SetWorkspaceConfigActiveRequest> {
+  /**
+   * @generated from field: string workspace_id = 1;
+   */
+  workspaceId = "";
+
+  /**
+   * @generated from field: string workspace_config_id = 2;
+   */
+  workspaceConfigId = "";
+
+  /**
+   * @generated from field: bool active = 3;
+   */
+  active = false;
+
+  constructor(data?: PartialMessage<SetWorkspaceConfigActiveRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.SetWorkspaceConfigActiveRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "workspace_config_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "active", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetWorkspaceConfigActiveRequest {
+    return new SetWorkspaceConfigActiveRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetWorkspaceConfigActiveRequest {
+    return new SetWorkspaceConfigActiveRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetWorkspaceConfigActiveRequest {
+    return new SetWorkspaceConfigActiveRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetWorkspaceConfigActiveRequest | PlainMessage<SetWorkspaceConfigActiveRequest> | undefined, b: SetWorkspaceConfigActiveRequest | PlainMessage<SetWorkspaceConfigActiveRequest> | undefined): boolean {
+    return proto3.util.equals(SetWorkspaceConfigActiveRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.SetVersionActiveRequest
+ */
+export class SetVersionActiveRequest extends Message<SetVersionActiveRequest> {
+  /**
+   * @generated from field: string workspace_id = 1;
+   */
+  workspaceId = "";
+
+  /**
+   * @generated from field: uint32 version_number = 2;
+   */
+  versionNumber = 0;
+
+  /**
+   * @generated from field: bool active = 3;
+   */
+  active = false;
+
+  constructor(data?: PartialMessage<SetVersionActiveRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.SetVersionActiveRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "active", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetVersionActiveRequest {
+    return new SetVersionActiveRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetVersionActiveRequest {
+    return new SetVersionActiveRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetVersionActiveRequest {
+    return new SetVersionActiveRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetVersionActiveRequest | PlainMessage<SetVersionActiveRequest> | undefined, b: SetVersionActiveRequest | PlainMessage<SetVersionActiveRequest> | undefined): boolean {
+    return proto3.util.equals(SetVersionActiveRequest, a, b);
+  }
+}
+
+/**
+ * ExampleSettings control how many examples from the workspace's example bank are
+ * inserted where a prompt uses {{EXAMPLES}}, and which.
+ *
+ * @generated from message eval.v1.ExampleSettings
+ */
+export class ExampleSettings extends Message<ExampleSettings> {
+  /**
+   * defaults to 3
+   *
+   * @generated from field: uint32 count = 1;
+   */
+  count = 0;
+
+  /**
+   * @generated from field: eval.v1.ExampleStrategy strategy = 2;
+   */
+  strategy = ExampleStrategy.FIXED;
+
+  /**
+   * @generated from field: int64 seed = 3;
+   */
+  seed = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ExampleSettings>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.ExampleSettings";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "count", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 2, name: "strategy", kind: "enum", T: proto3.getEnumType(ExampleStrategy) },
+    { no: 3, name: "seed", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExampleSettings {
+    return new ExampleSettings().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExampleSettings {
+    return new ExampleSettings().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExampleSettings {
+    return new ExampleSettings().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExampleSettings | PlainMessage<ExampleSettings> | undefined, b: ExampleSettings | PlainMessage<ExampleSettings> | undefined): boolean {
+    return proto3.util.equals(ExampleSettings, a, b);
+  }
+}
+
+/**
+ * Example is a worked input and output in a workspace's example bank.
+ *
+ * @generated from message eval.v1.Example
+ */
+export class Example extends Message<Example> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string workspace_id = 2;
+   */
+  workspaceId = "";
+
+  /**
+   * @generated from field: map<string, eval.v1.VariableValue> variable_values = 3;
+   */
+  variableValues: { [key: string]: VariableValue } = {};
+
+  /**
+   * @generated from field: string output = 4;
+   */
+  output = "";
+
+  /**
+   * the rated result the example was taken from, if any
+   *
+   * @generated from field: optional string test_result_id = 5;
+   */
+  testResultId?: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp;
+
+  constructor(data?: PartialMessage<Example>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.Example";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "variable_values", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: VariableValue} },
+    { no: 4, name: "output", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "test_result_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 6, name: "created_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Example {
+    return new Example().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Example {
+    return new Example().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Example {
+    return new Example().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Example | PlainMessage<Example> | undefined, b: Example | PlainMessage<Example> | undefined): boolean {
+    return proto3.util.equals(Example, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.CreateExampleRequest
+ */
+export class CreateExampleRequest extends Message<CreateExampleRequest> {
+  /**
+   * @generated from field: string workspace_id = 1;
+   */
+  workspaceId = "";
+
+  /**
+   * @generated from field: map<string, eval.v1.VariableValue> variable_values = 2;
+   */
+  variableValues: { [key: string]: VariableValue } = {};
+
+  /**
+   * @generated from field: string output = 3;
+   */
+  output = "";
+
+  constructor(data?: PartialMessage<CreateExampleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.CreateExampleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "variable_values", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: VariableValue} },
+    { no: 3, name: "output", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateExampleRequest {
+    return new CreateExampleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateExampleRequest {
+    return new CreateExampleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateExampleRequest {
+    return new CreateExampleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateExampleRequest | PlainMessage<CreateExampleRequest> | undefined, b: CreateExampleRequest | PlainMessage<CreateExampleRequest> | undefined): boolean {
+    return proto3.util.equals(CreateExampleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.CreateExampleResponse
+ */
+export class CreateExampleResponse extends Message<CreateExampleResponse> {
+  /**
+   * @generated from field: eval.v1.Example example = 1;
+   */
+  example?: Example;
+
+  constructor(data?: PartialMessage<CreateExampleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.CreateExampleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "example", kind: "message", T: Example },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateExampleResponse {
+    return new CreateExampleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateExampleResponse {
+    return new CreateExampleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateExampleResponse {
+    return new CreateExampleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateExampleResponse | PlainMessage<CreateExampleResponse> | undefined, b: CreateExampleResponse | PlainMessage<CreateExampleResponse> | undefined): boolean {
+    return proto3.util.equals(CreateExampleResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.ListExamplesRequest
+ */
+export class ListExamplesRequest extends Message<ListExamplesRequest> {
+  /**
+   * @generated from field: string workspace_id = 1;
+   */
+  workspaceId = "";
+
+  constructor(data?: PartialMessage<ListExamplesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.ListExamplesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListExamplesRequest {
+    return new ListExamplesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListExamplesRequest {
+    return new ListExamplesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListExamplesRequest {
+    return new ListExamplesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListExamplesRequest | PlainMessage<ListExamplesRequest> | undefined, b: ListExamplesRequest | PlainMessage<ListExamplesRequest> | undefined): boolean {
+    return proto3.util.equals(ListExamplesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.ListExamplesResponse
+ */
+export class ListExamplesResponse extends Message<ListExamplesResponse> {
   /**
-   * @generated from field: string name = 1;
+   * @generated from field: repeated eval.v1.Example examples = 1;
    */
-  name = "";
+  examples: Example[] = [];
+
+  constructor(data?: PartialMessage<ListExamplesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
 
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.ListExamplesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "examples", kind: "message", T: Example, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListExamplesResponse {
+    return new ListExamplesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListExamplesResponse {
+    return new ListExamplesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListExamplesResponse {
+    return new ListExamplesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListExamplesResponse | PlainMessage<ListExamplesResponse> | undefined, b: ListExamplesResponse | PlainMessage<ListExamplesResponse> | undefined): boolean {
+    return proto3.util.equals(ListExamplesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.DeleteExampleRequest
+ */
+export class DeleteExampleRequest extends Message<DeleteExampleRequest> {
   /**
-   * @generated from field: eval.v1.VariableType type = 2;
+   * @generated from field: string id = 1;
    */
-  type = VariableType.TEXT;
+  id = "";
 
-  constructor(data?: PartialMessage<Variable>) {
+  constructor(data?: PartialMessage<DeleteExampleRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.Variable";
+  static readonly typeName = "eval.v1.DeleteExampleRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "type", kind: "enum", T: proto3.getEnumType(VariableType) },
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Variable {
-    return new Variable().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteExampleRequest {
+    return new DeleteExampleRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Variable {
-    return new Variable().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteExampleRequest {
+    return new DeleteExampleRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Variable {
-    return new Variable().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteExampleRequest {
+    return new DeleteExampleRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Variable | PlainMessage<Variable> | undefined, b: Variable | PlainMessage<Variable> | undefined): boolean {
-    return proto3.util.equals(Variable, a, b);
+  static equals(a: DeleteExampleRequest | PlainMessage<DeleteExampleRequest> | undefined, b: DeleteExampleRequest | PlainMessage<DeleteExampleRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteExampleRequest, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.VariableValue
+ * AddExamplesFromResultsRequest copies rated results into the example bank. Without
+ * test_result_ids it takes every thumbs-up result, or with rater and min_score every
+ * result that rater scored at least min_score.
+ *
+ * @generated from message eval.v1.AddExamplesFromResultsRequest
  */
-export class VariableValue extends Message<VariableValue> {
+export class AddExamplesFromResultsRequest extends Message<AddExamplesFromResultsRequest> {
   /**
-   * @generated from oneof eval.v1.VariableValue.value
+   * @generated from field: string workspace_id = 1;
    */
-  value: {
-    /**
-     * @generated from field: string text_value = 1;
-     */
-    value: string;
-    case: "textValue";
-  } | {
-    /**
-     * @generated from field: bytes image_value = 2;
-     */
-    value: Uint8Array;
-    case: "imageValue";
-  } | { case: undefined; value?: undefined } = { case: undefined };
+  workspaceId = "";
 
-  constructor(data?: PartialMessage<VariableValue>) {
+  /**
+   * @generated from field: repeated string test_result_ids = 2;
+   */
+  testResultIds: string[] = [];
+
+  /**
+   * @generated from field: string rater = 3;
+   */
+  rater = "";
+
+  /**
+   * @generated from field: optional double min_score = 4;
+   */
+  minScore?: number;
+
+  constructor(data?: PartialMessage<AddExamplesFromResultsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.VariableValue";
+  static readonly typeName = "eval.v1.AddExamplesFromResultsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "text_value", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "value" },
-    { no: 2, name: "image_value", kind: "scalar", T: 12 /* ScalarType.BYTES */, oneof: "value" },
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "test_result_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "rater", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "min_score", kind: "scalar", T: 1 /* ScalarType.DOUBLE */, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VariableValue {
-    return new VariableValue().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddExamplesFromResultsRequest {
+    return new AddExamplesFromResultsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VariableValue {
-    return new VariableValue().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddExamplesFromResultsRequest {
+    return new AddExamplesFromResultsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VariableValue {
-    return new VariableValue().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddExamplesFromResultsRequest {
+    return new AddExamplesFromResultsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: VariableValue | PlainMessage<VariableValue> | undefined, b: VariableValue | PlainMessage<VariableValue> | undefined): boolean {
-    return proto3.util.equals(VariableValue, a, b);
+  static equals(a: AddExamplesFromResultsRequest | PlainMessage<AddExamplesFromResultsRequest> | undefined, b: AddExamplesFromResultsRequest | PlainMessage<AddExamplesFromResultsRequest> | undefined): boolean {
+    return proto3.util.equals(AddExamplesFromResultsRequest, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.ModelConfig
+ * @generated from message eval.v1.AddExamplesFromResultsResponse
  */
-export class ModelConfig extends Message<ModelConfig> {
+export class AddExamplesFromResultsResponse extends Message<AddExamplesFromResultsResponse> {
   /**
-   * @generated from field: string provider_type = 1;
+   * @generated from field: repeated eval.v1.Example examples = 1;
    */
-  providerType = "";
+  examples: Example[] = [];
 
   /**
-   * @generated from field: string model_name = 2;
+   * results that are already in the bank
+   *
+   * @generated from field: uint32 n_skipped = 2;
    */
-  modelName = "";
+  nSkipped = 0;
 
+  constructor(data?: PartialMessage<AddExamplesFromResultsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "eval.v1.AddExamplesFromResultsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "examples", kind: "message", T: Example, repeated: true },
+    { no: 2, name: "n_skipped", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddExamplesFromResultsResponse {
+    return new AddExamplesFromResultsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddExamplesFromResultsResponse {
+    return new AddExamplesFromResultsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddExamplesFromResultsResponse {
+    return new AddExamplesFromResultsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AddExamplesFromResultsResponse | PlainMessage<AddExamplesFromResultsResponse> | undefined, b: AddExamplesFromResultsResponse | PlainMessage<AddExamplesFromResultsResponse> | undefined): boolean {
+    return proto3.util.equals(AddExamplesFromResultsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message eval.v1.SetExampleSettingsRequest
+ */
+export class SetExampleSettingsRequest extends Message<SetExampleSettingsRequest> {
   /**
-   * @generated from field: string base_url = 3;
+   * @generated from field: string workspace_id = 1;
    */
-  baseUrl = "";
+  workspaceId = "";
 
-  constructor(data?: PartialMessage<ModelConfig>) {
+  /**
+   * @generated from field: eval.v1.ExampleSettings settings = 2;
+   */
+  settings?: ExampleSettings;
+
+  constructor(data?: PartialMessage<SetExampleSettingsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.ModelConfig";
+  static readonly typeName = "eval.v1.SetExampleSettingsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "provider_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "model_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "base_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "settings", kind: "message", T: ExampleSettings },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ModelConfig {
-    return new ModelConfig().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetExampleSettingsRequest {
+    return new SetExampleSettingsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ModelConfig {
-    return new ModelConfig().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetExampleSettingsRequest {
+    return new SetExampleSettingsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ModelConfig {
-    return new ModelConfig().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetExampleSettingsRequest {
+    return new SetExampleSettingsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ModelConfig | PlainMessage<ModelConfig> | undefined, b: ModelConfig | PlainMessage<ModelConfig> | undefined): boolean {
-    return proto3.util.equals(ModelConfig, a, b);
+  static equals(a: SetExampleSettingsRequest | PlainMessage<SetExampleSettingsRequest> | undefined, b: SetExampleSettingsRequest | PlainMessage<SetExampleSettingsRequest> | undefined): boolean {
+    return proto3.util.equals(SetExampleSettingsRequest, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.MessageOptions
+ * @generated from message eval.v1.SetXMLModeRequest
  */
-export class MessageOptions extends Message<MessageOptions> {
+export class SetXMLModeRequest extends Message<SetXMLModeRequest> {
   /**
-   * @generated from field: int32 max_tokens = 1;
+   * @generated from field: string workspace_id = 1;
    */
-  maxTokens = 0;
+  workspaceId = "";
 
   /**
-   * @generated from field: float temperature = 2;
+   * @generated from field: bool XMLMode = 2;
    */
-  temperature = 0;
+  XMLMode = false;
 
-  constructor(data?: PartialMessage<MessageOptions>) {
+  constructor(data?: PartialMessage<SetXMLModeRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.MessageOptions";
+  static readonly typeName = "eval.v1.SetXMLModeRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "max_tokens", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 2, name: "temperature", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "XMLMode", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MessageOptions {
-    return new MessageOptions().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetXMLModeRequest {
+    return new SetXMLModeRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MessageOptions {
-    return new MessageOptions().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetXMLModeRequest {
+    return new SetXMLModeRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MessageOptions {
-    return new MessageOptions().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetXMLModeRequest {
+    return new SetXMLModeRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: MessageOptions | PlainMessage<MessageOptions> | undefined, b: MessageOptions | PlainMessage<MessageOptions> | undefined): boolean {
-    return proto3.util.equals(MessageOptions, a, b);
+  static equals(a: SetXMLModeRequest | PlainMessage<SetXMLModeRequest> | undefined, b: SetXMLModeRequest | PlainMessage<SetXMLModeRequest> | undefined): boolean {
+    return proto3.util.equals(SetXMLModeRequest, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.InferMessage
+ * @generated from message eval.v1.RateTestResultRequest
  */
-export class InferMessage extends Message<InferMessage> {
+export class RateTestResultRequest extends Message<RateTestResultRequest> {
   /**
-   * @generated from field: string content = 1;
+   * @generated from field: string test_result_id = 1;
    */
-  content = "";
+  testResultId = "";
 
   /**
-   * @generated from field: string role = 2;
+   * @generated from field: int32 rating = 2;
    */
-  role = "";
+  rating = 0;
 
   /**
-   * @generated from field: bytes image = 3;
+   * rater identifies who gave the rating. If empty, the rating is stored as the
+   * default rater and also becomes the test result's primary rating.
+   *
+   * @generated from field: string rater = 3;
    */
-  image = new Uint8Array(0);
+  rater = "";
 
   /**
-   * @generated from field: bool should_cache = 4;
+   * @generated from field: bool automated = 4;
    */
-  shouldCache = false;
+  automated = false;
 
-  constructor(data?: PartialMessage<InferMessage>) {
+  /**
+   * @generated from field: optional double score = 5;
+   */
+  score?: number;
+
+  constructor(data?: PartialMessage<RateTestResultRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.InferMessage";
+  static readonly typeName = "eval.v1.RateTestResultRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "content", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "image", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
-    { no: 4, name: "should_cache", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 1, name: "test_result_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "rating", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "rater", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "automated", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "score", kind: "scalar", T: 1 /* ScalarType.DOUBLE */, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): InferMessage {
-    return new InferMessage().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RateTestResultRequest {
+    return new RateTestResultRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): InferMessage {
-    return new InferMessage().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RateTestResultRequest {
+    return new RateTestResultRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): InferMessage {
-    return new InferMessage().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RateTestResultRequest {
+    return new RateTestResultRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: InferMessage | PlainMessage<InferMessage> | undefined, b: InferMessage | PlainMessage<InferMessage> | undefined): boolean {
-    return proto3.util.equals(InferMessage, a, b);
+  static equals(a: RateTestResultRequest | PlainMessage<RateTestResultRequest> | undefined, b: RateTestResultRequest | PlainMessage<RateTestResultRequest> | undefined): boolean {
+    return proto3.util.equals(RateTestResultRequest, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.InferRequest
+ * @generated from message eval.v1.ComputeAgreementRequest
  */
-export class InferRequest extends Message<InferRequest> {
+export class ComputeAgreementRequest extends Message<ComputeAgreementRequest> {
   /**
-   * @generated from field: string request_id = 1;
+   * @generated from field: string workspace_id = 1;
    */
-  requestId = "";
+  workspaceId = "";
 
   /**
-   * @generated from field: repeated eval.v1.InferMessage messages = 2;
+   * @generated from field: eval.v1.RatingScale scale = 2;
    */
-  messages: InferMessage[] = [];
+  scale = RatingScale.THUMBS;
 
   /**
-   * @generated from field: eval.v1.ModelConfig model_config = 3;
+   * restrict to these raters; all raters in the workspace are used if empty
+   *
+   * @generated from field: repeated string raters = 3;
    */
-  modelConfig?: ModelConfig;
+  raters: string[] = [];
 
   /**
-   * @generated from field: eval.v1.MessageOptions message_options = 4;
+   * @generated from field: uint32 max_disagreements = 4;
    */
-  messageOptions?: MessageOptions;
+  maxDisagreements = 0;
 
-  constructor(data?: PartialMessage<InferRequest>) {
+  /**
+   * @generated from field: eval.v1.TestCaseFilter filter = 5;
+   */
+  filter?: TestCaseFilter;
+
+  constructor(data?: PartialMessage<ComputeAgreementRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.InferRequest";
+  static readonly typeName = "eval.v1.ComputeAgreementRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "request_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "messages", kind: "message", T: InferMessage, repeated: true },
-    { no: 3, name: "model_config", kind: "message", T: ModelConfig },
-    { no: 4, name: "message_options", kind: "message", T: MessageOptions },
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "scale", kind: "enum", T: proto3.getEnumType(RatingScale) },
+    { no: 3, name: "raters", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "max_disagreements", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "filter", kind: "message", T: TestCaseFilter },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): InferRequest {
-    return new InferRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ComputeAgreementRequest {
+    return new ComputeAgreementRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): InferRequest {
-    return new InferRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ComputeAgreementRequest {
+    return new ComputeAgreementRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): InferRequest {
-    return new InferRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ComputeAgreementRequest {
+    return new ComputeAgreementRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: InferRequest | PlainMessage<InferRequest> | undefined, b: InferRequest | PlainMessage<InferRequest> | undefined): boolean {
-    return proto3.util.equals(InferRequest, a, b);
+  static equals(a: ComputeAgreementRequest | PlainMessage<ComputeAgreementRequest> | undefined, b: ComputeAgreementRequest | PlainMessage<ComputeAgreementRequest> | undefined): boolean {
+    return proto3.util.equals(ComputeAgreementRequest, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.EvaluationRequest
+ * @generated from message eval.v1.RaterPairAgreement
  */
-export class EvaluationRequest extends Message<EvaluationRequest> {
+export class RaterPairAgreement extends Message<RaterPairAgreement> {
+  /**
+   * @generated from field: string rater_a = 1;
+   */
+  raterA = "";
+
+  /**
+   * @generated from field: string rater_b = 2;
+   */
+  raterB = "";
+
   /**
-   * @generated from field: string workspace_id = 1;
+   * @generated from field: uint32 n_items = 3;
    */
-  workspaceId = "";
+  nItems = 0;
 
   /**
-   * @generated from field: eval.v1.TestCase test_case = 2;
+   * @generated from field: optional double percent_agreement = 4;
    */
-  testCase?: TestCase;
+  percentAgreement?: number;
 
   /**
-   * @generated from field: uint32 version_number = 3;
+   * @generated from field: optional double cohens_kappa = 5;
    */
-  versionNumber = 0;
+  cohensKappa?: number;
 
   /**
-   * @generated from field: uint32 system_prompt_version_number = 4;
+   * @generated from field: optional double krippendorff_alpha = 6;
    */
-  systemPromptVersionNumber = 0;
+  krippendorffAlpha?: number;
 
-  constructor(data?: PartialMessage<EvaluationRequest>) {
+  constructor(data?: PartialMessage<RaterPairAgreement>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.EvaluationRequest";
+  static readonly typeName = "eval.v1.RaterPairAgreement";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "test_case", kind: "message", T: TestCase },
-    { no: 3, name: "version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
-    { no: 4, name: "system_prompt_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 1, name: "rater_a", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "rater_b", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "n_items", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "percent_agreement", kind: "scalar", T: 1 /* ScalarType.DOUBLE */, opt: true },
+    { no: 5, name: "cohens_kappa", kind: "scalar", T: 1 /* ScalarType.DOUBLE */, opt: true },
+    { no: 6, name: "krippendorff_alpha", kind: "scalar", T: 1 /* ScalarType.DOUBLE */, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EvaluationRequest {
-    return new EvaluationRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RaterPairAgreement {
+    return new RaterPairAgreement().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EvaluationRequest {
-    return new EvaluationRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RaterPairAgreement {
+    return new RaterPairAgreement().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EvaluationRequest {
-    return new EvaluationRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RaterPairAgreement {
+    return new RaterPairAgreement().fromJsonString(jsonString, options);
   }
 
-  static equals(a: EvaluationRequest | PlainMessage<EvaluationRequest> | undefined, b: EvaluationRequest | PlainMessage<EvaluationRequest> | undefined): boolean {
-    return proto3.util.equals(EvaluationRequest, a, b);
+  static equals(a: RaterPairAgreement | PlainMessage<RaterPairAgreement> | undefined, b: RaterPairAgreement | PlainMessage<RaterPairAgreement> | undefined): boolean {
+    return proto3.util.equals(RaterPairAgreement, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.EvaluationResponse
+ * @generated from message eval.v1.DisagreementItem
  */
-export class EvaluationResponse extends Message<EvaluationResponse> {
+export class DisagreementItem extends Message<DisagreementItem> {
   /**
-   * @generated from field: repeated eval.v1.TestResult result = 1;
+   * @generated from field: string test_result_id = 1;
    */
-  result: TestResult[] = [];
+  testResultId = "";
 
-  constructor(data?: PartialMessage<EvaluationResponse>) {
+  /**
+   * @generated from field: string test_case_id = 2;
+   */
+  testCaseId = "";
+
+  /**
+   * @generated from field: map<string, double> values = 3;
+   */
+  values: { [key: string]: number } = {};
+
+  /**
+   * @generated from field: double spread = 4;
+   */
+  spread = 0;
+
+  constructor(data?: PartialMessage<DisagreementItem>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.EvaluationResponse";
+  static readonly typeName = "eval.v1.DisagreementItem";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "result", kind: "message", T: TestResult, repeated: true },
+    { no: 1, name: "test_result_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "test_case_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "values", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 1 /* ScalarType.DOUBLE */} },
+    { no: 4, name: "spread", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EvaluationResponse {
-    return new EvaluationResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DisagreementItem {
+    return new DisagreementItem().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EvaluationResponse {
-    return new EvaluationResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DisagreementItem {
+    return new DisagreementItem().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EvaluationResponse {
-    return new EvaluationResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DisagreementItem {
+    return new DisagreementItem().fromJsonString(jsonString, options);
   }
 
-  static equals(a: EvaluationResponse | PlainMessage<EvaluationResponse> | undefined, b: EvaluationResponse | PlainMessage<EvaluationResponse> | undefined): boolean {
-    return proto3.util.equals(EvaluationResponse, a, b);
+  static equals(a: DisagreementItem | PlainMessage<DisagreementItem> | undefined, b: DisagreementItem | PlainMessage<DisagreementItem> | undefined): boolean {
+    return proto3.util.equals(DisagreementItem, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.WorkspaceConfig
+ * @generated from message eval.v1.ComputeAgreementResponse
  */
-export class WorkspaceConfig extends Message<WorkspaceConfig> {
+export class ComputeAgreementResponse extends Message<ComputeAgreementResponse> {
   /**
-   * @generated from field: string id = 1;
+   * @generated from field: repeated string raters = 1;
    */
-  id = "";
+  raters: string[] = [];
 
   /**
-   * @generated from field: string name = 2;
+   * @generated from field: uint32 n_items = 2;
    */
-  name = "";
+  nItems = 0;
 
   /**
-   * @generated from field: string model_config_name = 3;
+   * fleiss_kappa only uses items rated by every rater
+   *
+   * @generated from field: uint32 n_complete_items = 3;
    */
-  modelConfigName = "";
+  nCompleteItems = 0;
 
   /**
-   * @generated from field: eval.v1.MessageOptions message_options = 4;
+   * @generated from field: optional double fleiss_kappa = 4;
    */
-  messageOptions?: MessageOptions;
+  fleissKappa?: number;
 
   /**
-   * @generated from field: google.protobuf.Timestamp created_at = 5;
+   * @generated from field: optional double krippendorff_alpha = 5;
    */
-  createdAt?: Timestamp;
+  krippendorffAlpha?: number;
 
   /**
-   * @generated from field: google.protobuf.Timestamp updated_at = 6;
+   * @generated from field: repeated eval.v1.RaterPairAgreement pairs = 6;
    */
-  updatedAt?: Timestamp;
+  pairs: RaterPairAgreement[] = [];
 
   /**
-   * @generated from field: bool active = 7;
+   * @generated from field: repeated eval.v1.DisagreementItem disagreements = 7;
    */
-  active = false;
+  disagreements: DisagreementItem[] = [];
 
-  constructor(data?: PartialMessage<WorkspaceConfig>) {
+  constructor(data?: PartialMessage<ComputeAgreementResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.WorkspaceConfig";
+  static readonly typeName = "eval.v1.ComputeAgreementResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "model_config_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "message_options", kind: "message", T: MessageOptions },
-    { no: 5, name: "created_at", kind: "message", T: Timestamp },
-    { no: 6, name: "updated_at", kind: "message", T: Timestamp },
-    { no: 7, name: "active", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 1, name: "raters", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "n_items", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "n_complete_items", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "fleiss_kappa", kind: "scalar", T: 1 /* ScalarType.DOUBLE */, opt: true },
+    { no: 5, name: "krippendorff_alpha", kind: "scalar", T: 1 /* ScalarType.DOUBLE */, opt: true },
+    { no: 6, name: "pairs", kind: "message", T: RaterPairAgreement, repeated: true },
+    { no: 7, name: "disagreements", kind: "message", T: DisagreementItem, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WorkspaceConfig {
-    return new WorkspaceConfig().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ComputeAgreementResponse {
+    return new ComputeAgreementResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WorkspaceConfig {
-    return new WorkspaceConfig().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ComputeAgreementResponse {
+    return new ComputeAgreementResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WorkspaceConfig {
-    return new WorkspaceConfig().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ComputeAgreementResponse {
+    return new ComputeAgreementResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: WorkspaceConfig | PlainMessage<WorkspaceConfig> | undefined, b: WorkspaceConfig | PlainMessage<WorkspaceConfig> | undefined): boolean {
-    return proto3.util.equals(WorkspaceConfig, a, b);
+  static equals(a: ComputeAgreementResponse | PlainMessage<ComputeAgreementResponse> | undefined, b: ComputeAgreementResponse | PlainMessage<ComputeAgreementResponse> | undefined): boolean {
+    return proto3.util.equals(ComputeAgreementResponse, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.CreateWorkspaceConfigRequest
+ * CompareVersionsRequest pairs the results of two prompt versions by test case.
+ * If no configs are given, results are paired within the same workspace config.
+ *
+ * @generated from message eval.v1.CompareVersionsRequest
  */
-export class CreateWorkspaceConfigRequest extends Message<CreateWorkspaceConfigRequest> {
+export class CompareVersionsRequest extends Message<CompareVersionsRequest> {
   /**
    * @generated from field: string workspace_id = 1;
    */
   workspaceId = "";
 
   /**
-   * @generated from field: string name = 2;
+   * @generated from field: uint32 version_number_a = 2;
    */
-  name = "";
+  versionNumberA = 0;
 
   /**
-   * @generated from field: string model_config_name = 3;
+   * @generated from field: uint32 version_number_b = 3;
    */
-  modelConfigName = "";
+  versionNumberB = 0;
 
   /**
-   * @generated from field: eval.v1.MessageOptions message_options = 4;
+   * @generated from field: optional string workspace_config_id_a = 4;
    */
-  messageOptions?: MessageOptions;
+  workspaceConfigIdA?: string;
 
-  constructor(data?: PartialMessage<CreateWorkspaceConfigRequest>) {
+  /**
+   * @generated from field: optional string workspace_config_id_b = 5;
+   */
+  workspaceConfigIdB?: string;
+
+  /**
+   * rater whose judgements are compared. If empty, the primary thumbs rating
+   * and the mean score across raters are used.
+   *
+   * @generated from field: string rater = 6;
+   */
+  rater = "";
+
+  /**
+   * @generated from field: eval.v1.TestCaseFilter filter = 7;
+   */
+  filter?: TestCaseFilter;
+
+  constructor(data?: PartialMessage<CompareVersionsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.CreateWorkspaceConfigRequest";
+  static readonly typeName = "eval.v1.CompareVersionsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "model_config_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "message_options", kind: "message", T: MessageOptions },
+    { no: 2, name: "version_number_a", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "version_number_b", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "workspace_config_id_a", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "workspace_config_id_b", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 6, name: "rater", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "filter", kind: "message", T: TestCaseFilter },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWorkspaceConfigRequest {
-    return new CreateWorkspaceConfigRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CompareVersionsRequest {
+    return new CompareVersionsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWorkspaceConfigRequest {
-    return new CreateWorkspaceConfigRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CompareVersionsRequest {
+    return new CompareVersionsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWorkspaceConfigRequest {
-    return new CreateWorkspaceConfigRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CompareVersionsRequest {
+    return new CompareVersionsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateWorkspaceConfigRequest | PlainMessage<CreateWorkspaceConfigRequest> | undefined, b: CreateWorkspaceConfigRequest | PlainMessage<CreateWorkspaceConfigRequest> | undefined): boolean {
-    return proto3.util.equals(CreateWorkspaceConfigRequest, a, b);
+  static equals(a: CompareVersionsRequest | PlainMessage<CompareVersionsRequest> | undefined, b: CompareVersionsRequest | PlainMessage<CompareVersionsRequest> | undefined): boolean {
+    return proto3.util.equals(CompareVersionsRequest, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.CreateWorkspaceConfigResponse
+ * @generated from message eval.v1.FlippedCase
  */
-export class CreateWorkspaceConfigResponse extends Message<CreateWorkspaceConfigResponse> {
+export class FlippedCase extends Message<FlippedCase> {
   /**
-   * @generated from field: eval.v1.WorkspaceConfig workspace_config = 1;
+   * @generated from field: string test_case_id = 1;
    */
-  workspaceConfig?: WorkspaceConfig;
+  testCaseId = "";
 
-  constructor(data?: PartialMessage<CreateWorkspaceConfigResponse>) {
+  /**
+   * @generated from field: string test_result_id_a = 2;
+   */
+  testResultIdA = "";
+
+  /**
+   * @generated from field: string test_result_id_b = 3;
+   */
+  testResultIdB = "";
+
+  /**
+   * @generated from field: string workspace_config_id = 4;
+   */
+  workspaceConfigId = "";
+
+  constructor(data?: PartialMessage<FlippedCase>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.CreateWorkspaceConfigResponse";
+  static readonly typeName = "eval.v1.FlippedCase";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "workspace_config", kind: "message", T: WorkspaceConfig },
+    { no: 1, name: "test_case_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "test_result_id_a", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "test_result_id_b", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "workspace_config_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWorkspaceConfigResponse {
-    return new CreateWorkspaceConfigResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FlippedCase {
+    return new FlippedCase().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWorkspaceConfigResponse {
-    return new CreateWorkspaceConfigResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FlippedCase {
+    return new FlippedCase().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWorkspaceConfigResponse {
-    return new CreateWorkspaceConfigResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FlippedCase {
+    return new FlippedCase().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateWorkspaceConfigResponse | PlainMessage<CreateWorkspaceConfigResponse> | undefined, b: CreateWorkspaceConfigResponse | PlainMessage<CreateWorkspaceConfigResponse> | undefined): boolean {
-    return proto3.util.equals(CreateWorkspaceConfigResponse, a, b);
+  static equals(a: FlippedCase | PlainMessage<FlippedCase> | undefined, b: FlippedCase | PlainMessage<FlippedCase> | undefined): boolean {
+    return proto3.util.equals(FlippedCase, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.Workspace
+ * PassFailComparison is McNemar's test over thumbs ratings.
+ *
+ * @generated from message eval.v1.PassFailComparison
  */
-export class Workspace extends Message<Workspace> {
-  /**
-   * @generated from field: string id = 1;
-   */
-  id = "";
-
-  /**
-   * @generated from field: string name = 2;
-   */
-  name = "";
-
+export class PassFailComparison extends Message<PassFailComparison> {
   /**
-   * @generated from field: repeated string model_config_names = 3;
+   * @generated from field: uint32 n_pairs = 1;
    */
-  modelConfigNames: string[] = [];
+  nPairs = 0;
 
   /**
-   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   * @generated from field: uint32 both_pass = 2;
    */
-  createdAt?: Timestamp;
+  bothPass = 0;
 
   /**
-   * @generated from field: google.protobuf.Timestamp updated_at = 5;
+   * @generated from field: uint32 both_fail = 3;
    */
-  updatedAt?: Timestamp;
+  bothFail = 0;
 
   /**
-   * @generated from field: repeated eval.v1.Workspace.Prompt prompts = 6;
+   * improved went from fail in a to pass in b; regressed the other way around
+   *
+   * @generated from field: uint32 improved = 4;
    */
-  prompts: Workspace_Prompt[] = [];
+  improved = 0;
 
   /**
-   * @generated from field: uint32 current_prompt_version_number = 7;
+   * @generated from field: uint32 regressed = 5;
    */
-  currentPromptVersionNumber = 0;
+  regressed = 0;
 
   /**
-   * @generated from field: repeated eval.v1.WorkspaceConfig workspace_configs = 8;
+   * @generated from field: double pass_rate_a = 6;
    */
-  workspaceConfigs: WorkspaceConfig[] = [];
+  passRateA = 0;
 
   /**
-   * @generated from field: repeated uint32 active_version_numbers = 9;
+   * @generated from field: double pass_rate_b = 7;
    */
-  activeVersionNumbers: number[] = [];
+  passRateB = 0;
 
   /**
-   * @generated from field: repeated eval.v1.Workspace.SystemPrompt system_prompts = 10;
+   * @generated from field: double pass_rate_difference = 8;
    */
-  systemPrompts: Workspace_SystemPrompt[] = [];
+  passRateDifference = 0;
 
   /**
-   * @generated from field: uint32 current_system_prompt_version_number = 11;
+   * odds_ratio is improved / regressed; above 1 means b is better
+   *
+   * @generated from field: optional double odds_ratio = 9;
    */
-  currentSystemPromptVersionNumber = 0;
+  oddsRatio?: number;
 
   /**
-   * @generated from field: bool XMLMode = 12;
+   * @generated from field: double p_value = 10;
    */
-  XMLMode = false;
+  pValue = 0;
 
-  constructor(data?: PartialMessage<Workspace>) {
+  constructor(data?: PartialMessage<PassFailComparison>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.Workspace";
+  static readonly typeName = "eval.v1.PassFailComparison";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "model_config_names", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
-    { no: 4, name: "created_at", kind: "message", T: Timestamp },
-    { no: 5, name: "updated_at", kind: "message", T: Timestamp },
-    { no: 6, name: "prompts", kind: "message", T: Workspace_Prompt, repeated: true },
-    { no: 7, name: "current_prompt_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
-    { no: 8, name: "workspace_configs", kind: "message", T: WorkspaceConfig, repeated: true },
-    { no: 9, name: "active_version_numbers", kind: "scalar", T: 13 /* ScalarType.UINT32 */, repeated: true },
-    { no: 10, name: "system_prompts", kind: "message", T: Workspace_SystemPrompt, repeated: true },
-    { no: 11, name: "current_system_prompt_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
-    { no: 12, name: "XMLMode", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 1, name: "n_pairs", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 2, name: "both_pass", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "both_fail", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "improved", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "regressed", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 6, name: "pass_rate_a", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 7, name: "pass_rate_b", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 8, name: "pass_rate_difference", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 9, name: "odds_ratio", kind: "scalar", T: 1 /* ScalarType.DOUBLE */, opt: true },
+    { no: 10, name: "p_value", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Workspace {
-    return new Workspace().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PassFailComparison {
+    return new PassFailComparison().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Workspace {
-    return new Workspace().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PassFailComparison {
+    return new PassFailComparison().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Workspace {
-    return new Workspace().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PassFailComparison {
+    return new PassFailComparison().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Workspace | PlainMessage<Workspace> | undefined, b: Workspace | PlainMessage<Workspace> | undefined): boolean {
-    return proto3.util.equals(Workspace, a, b);
+  static equals(a: PassFailComparison | PlainMessage<PassFailComparison> | undefined, b: PassFailComparison | PlainMessage<PassFailComparison> | undefined): boolean {
+    return proto3.util.equals(PassFailComparison, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.Workspace.Prompt
+ * ScoreComparison is the Wilcoxon signed-rank test over scale scores.
+ *
+ * @generated from message eval.v1.ScoreComparison
  */
-export class Workspace_Prompt extends Message<Workspace_Prompt> {
+export class ScoreComparison extends Message<ScoreComparison> {
   /**
-   * @generated from field: uint32 version_number = 1;
+   * @generated from field: uint32 n_pairs = 1;
    */
-  versionNumber = 0;
+  nPairs = 0;
 
   /**
-   * @generated from field: string content = 2;
+   * @generated from field: double mean_a = 2;
    */
-  content = "";
+  meanA = 0;
 
   /**
-   * @generated from field: repeated eval.v1.Variable variables = 3;
+   * @generated from field: double mean_b = 3;
    */
-  variables: Variable[] = [];
+  meanB = 0;
 
   /**
-   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   * @generated from field: double mean_difference = 4;
    */
-  createdAt?: Timestamp;
-
-  constructor(data?: PartialMessage<Workspace_Prompt>) {
-    super();
-    proto3.util.initPartial(data, this);
-  }
-
-  static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.Workspace.Prompt";
-  static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
-    { no: 2, name: "content", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "variables", kind: "message", T: Variable, repeated: true },
-    { no: 4, name: "created_at", kind: "message", T: Timestamp },
-  ]);
-
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Workspace_Prompt {
-    return new Workspace_Prompt().fromBinary(bytes, options);
-  }
-
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Workspace_Prompt {
-    return new Workspace_Prompt().fromJson(jsonValue, options);
-  }
+  meanDifference = 0;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Workspace_Prompt {
-    return new Workspace_Prompt().fromJsonString(jsonString, options);
-  }
+  /**
+   * rank_biserial is the matched-pairs rank-biserial correlation; positive means b scored higher
+   *
+   * @generated from field: optional double rank_biserial = 5;
+   */
+  rankBiserial?: number;
 
-  static equals(a: Workspace_Prompt | PlainMessage<Workspace_Prompt> | undefined, b: Workspace_Prompt | PlainMessage<Workspace_Prompt> | undefined): boolean {
-    return proto3.util.equals(Workspace_Prompt, a, b);
-  }
-}
+  /**
+   * @generated from field: double w_plus = 6;
+   */
+  wPlus = 0;
 
-/**
- * @generated from message eval.v1.Workspace.SystemPrompt
- */
-export class Workspace_SystemPrompt extends Message<Workspace_SystemPrompt> {
   /**
-   * @generated from field: uint32 version_number = 1;
+   * @generated from field: double w_minus = 7;
    */
-  versionNumber = 0;
+  wMinus = 0;
 
   /**
-   * @generated from field: string content = 2;
+   * @generated from field: double p_value = 8;
    */
-  content = "";
+  pValue = 0;
 
-  constructor(data?: PartialMessage<Workspace_SystemPrompt>) {
+  constructor(data?: PartialMessage<ScoreComparison>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.Workspace.SystemPrompt";
+  static readonly typeName = "eval.v1.ScoreComparison";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
-    { no: 2, name: "content", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "n_pairs", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 2, name: "mean_a", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 3, name: "mean_b", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 4, name: "mean_difference", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 5, name: "rank_biserial", kind: "scalar", T: 1 /* ScalarType.DOUBLE */, opt: true },
+    { no: 6, name: "w_plus", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 7, name: "w_minus", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 8, name: "p_value", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Workspace_SystemPrompt {
-    return new Workspace_SystemPrompt().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ScoreComparison {
+    return new ScoreComparison().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Workspace_SystemPrompt {
-    return new Workspace_SystemPrompt().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ScoreComparison {
+    return new ScoreComparison().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Workspace_SystemPrompt {
-    return new Workspace_SystemPrompt().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ScoreComparison {
+    return new ScoreComparison().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Workspace_SystemPrompt | PlainMessage<Workspace_SystemPrompt> | undefined, b: Workspace_SystemPrompt | PlainMessage<Workspace_SystemPrompt> | undefined): boolean {
-    return proto3.util.equals(Workspace_SystemPrompt, a, b);
+  static equals(a: ScoreComparison | PlainMessage<ScoreComparison> | undefined, b: ScoreComparison | PlainMessage<ScoreComparison> | undefined): boolean {
+    return proto3.util.equals(ScoreComparison, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.TestCase
+ * @generated from message eval.v1.CompareVersionsResponse
  */
-export class TestCase extends Message<TestCase> {
-  /**
-   * @generated from field: string id = 1;
-   */
-  id = "";
-
-  /**
-   * @generated from field: string workspace_id = 2;
-   */
-  workspaceId = "";
-
-  /**
-   * @generated from field: map<string, eval.v1.VariableValue> variable_values = 3;
-   */
-  variableValues: { [key: string]: VariableValue } = {};
-
+export class CompareVersionsResponse extends Message<CompareVersionsResponse> {
   /**
-   * @generated from field: optional string response = 4;
+   * @generated from field: eval.v1.PassFailComparison pass_fail = 1;
    */
-  response?: string;
+  passFail?: PassFailComparison;
 
   /**
-   * @generated from field: bool has_been_evaluated = 5;
+   * @generated from field: eval.v1.ScoreComparison scores = 2;
    */
-  hasBeenEvaluated = false;
+  scores?: ScoreComparison;
 
   /**
-   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   * @generated from field: repeated eval.v1.FlippedCase regressions = 3;
    */
-  createdAt?: Timestamp;
+  regressions: FlippedCase[] = [];
 
   /**
-   * @generated from field: google.protobuf.Timestamp updated_at = 7;
+   * @generated from field: repeated eval.v1.FlippedCase improvements = 4;
    */
-  updatedAt?: Timestamp;
+  improvements: FlippedCase[] = [];
 
-  constructor(data?: PartialMessage<TestCase>) {
+  constructor(data?: PartialMessage<CompareVersionsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.TestCase";
+  static readonly typeName = "eval.v1.CompareVersionsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "variable_values", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: VariableValue} },
-    { no: 4, name: "response", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
-    { no: 5, name: "has_been_evaluated", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 6, name: "created_at", kind: "message", T: Timestamp },
-    { no: 7, name: "updated_at", kind: "message", T: Timestamp },
+    { no: 1, name: "pass_fail", kind: "message", T: PassFailComparison },
+    { no: 2, name: "scores", kind: "message", T: ScoreComparison },
+    { no: 3, name: "regressions", kind: "message", T: FlippedCase, repeated: true },
+    { no: 4, name: "improvements", kind: "message", T: FlippedCase, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TestCase {
-    return new TestCase().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CompareVersionsResponse {
+    return new CompareVersionsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TestCase {
-    return new TestCase().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CompareVersionsResponse {
+    return new CompareVersionsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TestCase {
-    return new TestCase().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CompareVersionsResponse {
+    return new CompareVersionsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: TestCase | PlainMessage<TestCase> | undefined, b: TestCase | PlainMessage<TestCase> | undefined): boolean {
-    return proto3.util.equals(TestCase, a, b);
+  static equals(a: CompareVersionsResponse | PlainMessage<CompareVersionsResponse> | undefined, b: CompareVersionsResponse | PlainMessage<CompareVersionsResponse> | undefined): boolean {
+    return proto3.util.equals(CompareVersionsResponse, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.TestResult
+ * Grader scores test results automatically. External graders run a local
+ * executable that reads JSON on stdin and writes a JSON score/pass/reason on stdout.
+ * Judge graders ask a model to score each result against a rubric.
+ *
+ * @generated from message eval.v1.Grader
  */
-export class TestResult extends Message<TestResult> {
+export class Grader extends Message<Grader> {
   /**
    * @generated from field: string id = 1;
    */
   id = "";
 
   /**
-   * @generated from field: string test_case_id = 2;
+   * @generated from field: string workspace_id = 2;
    */
-  testCaseId = "";
+  workspaceId = "";
 
   /**
-   * @generated from field: string response = 3;
+   * @generated from field: string name = 3;
    */
-  response = "";
+  name = "";
 
   /**
-   * @generated from field: uint32 prompt_version_number = 4;
+   * @generated from field: eval.v1.GraderType type = 4;
    */
-  promptVersionNumber = 0;
+  type = GraderType.UNSPECIFIED;
 
   /**
-   * @generated from field: string model_config_name = 5;
+   * @generated from field: string command = 5;
    */
-  modelConfigName = "";
+  command = "";
 
   /**
-   * @generated from field: eval.v1.MessageOptions message_options = 6;
+   * @generated from field: repeated string args = 6;
    */
-  messageOptions?: MessageOptions;
+  args: string[] = [];
 
   /**
-   * @generated from field: string workspace_config_id = 7;
+   * batch sends a JSON array of up to batch_size results per invocation
+   *
+   * @generated from field: bool batch = 7;
    */
-  workspaceConfigId = "";
+  batch = false;
 
   /**
-   * @generated from field: google.protobuf.Timestamp created_at = 8;
+   * @generated from field: uint32 batch_size = 8;
+   */
+  batchSize = 0;
+
+  /**
+   * @generated from field: uint32 timeout_seconds = 9;
+   */
+  timeoutSeconds = 0;
+
+  /**
+   * @generated from field: uint32 concurrency = 10;
+   */
+  concurrency = 0;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 11;
    */
   createdAt?: Timestamp;
 
   /**
-   * @generated from field: google.protobuf.Timestamp updated_at = 9;
+   * @generated from field: google.protobuf.Timestamp updated_at = 12;
    */
   updatedAt?: Timestamp;
 
   /**
-   * rating is -1 (thumbs down), 0 (unrated), or 1 (thumbs up)
+   * judge graders only; model_config_name defaults to the default large model config
    *
-   * @generated from field: int32 rating = 10;
+   * @generated from field: string model_config_name = 13;
    */
-  rating = 0;
+  modelConfigName = "";
 
-  constructor(data?: PartialMessage<TestResult>) {
+  /**
+   * @generated from field: string rubric = 14;
+   */
+  rubric = "";
+
+  constructor(data?: PartialMessage<Grader>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.TestResult";
+  static readonly typeName = "eval.v1.Grader";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "test_case_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "response", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "prompt_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
-    { no: 5, name: "model_config_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 6, name: "message_options", kind: "message", T: MessageOptions },
-    { no: 7, name: "workspace_config_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 8, name: "created_at", kind: "message", T: Timestamp },
-    { no: 9, name: "updated_at", kind: "message", T: Timestamp },
-    { no: 10, name: "rating", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "type", kind: "enum", T: proto3.getEnumType(GraderType) },
+    { no: 5, name: "command", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "args", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 7, name: "batch", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "batch_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 9, name: "timeout_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 10, name: "concurrency", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 11, name: "created_at", kind: "message", T: Timestamp },
+    { no: 12, name: "updated_at", kind: "message", T: Timestamp },
+    { no: 13, name: "model_config_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 14, name: "rubric", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TestResult {
-    return new TestResult().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Grader {
+    return new Grader().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TestResult {
-    return new TestResult().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Grader {
+    return new Grader().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TestResult {
-    return new TestResult().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Grader {
+    return new Grader().fromJsonString(jsonString, options);
   }
 
-  static equals(a: TestResult | PlainMessage<TestResult> | undefined, b: TestResult | PlainMessage<TestResult> | undefined): boolean {
-    return proto3.util.equals(TestResult, a, b);
+  static equals(a: Grader | PlainMessage<Grader> | undefined, b: Grader | PlainMessage<Grader> | undefined): boolean {
+    return proto3.util.equals(Grader, a, b);
   }
 }
 
 /**
- * CRUD operation messages
- *
- * @generated from message eval.v1.CreateWorkspaceRequest
+ * @generated from message eval.v1.CreateGraderRequest
  */
-export class CreateWorkspaceRequest extends Message<CreateWorkspaceRequest> {
+export class CreateGraderRequest extends Message<CreateGraderRequest> {
   /**
-   * @generated from field: string name = 1;
+   * @generated from field: string workspace_id = 1;
+   */
+  workspaceId = "";
+
+  /**
+   * @generated from field: string name = 2;
    */
   name = "";
 
   /**
-   * @generated from field: string content = 2;
+   * @generated from field: eval.v1.GraderType type = 3;
    */
-  content = "";
+  type = GraderType.UNSPECIFIED;
 
-  constructor(data?: PartialMessage<CreateWorkspaceRequest>) {
+  /**
+   * @generated from field: string command = 4;
+   */
+  command = "";
+
+  /**
+   * @generated from field: repeated string args = 5;
+   */
+  args: string[] = [];
+
+  /**
+   * @generated from field: bool batch = 6;
+   */
+  batch = false;
+
+  /**
+   * @generated from field: uint32 batch_size = 7;
+   */
+  batchSize = 0;
+
+  /**
+   * @generated from field: uint32 timeout_seconds = 8;
+   */
+  timeoutSeconds = 0;
+
+  /**
+   * @generated from field: uint32 concurrency = 9;
+   */
+  concurrency = 0;
+
+  /**
+   * @generated from field: string model_config_name = 10;
+   */
+  modelConfigName = "";
+
+  /**
+   * @generated from field: string rubric = 11;
+   */
+  rubric = "";
+
+  constructor(data?: PartialMessage<CreateGraderRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.CreateWorkspaceRequest";
+  static readonly typeName = "eval.v1.CreateGraderRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "content", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "type", kind: "enum", T: proto3.getEnumType(GraderType) },
+    { no: 4, name: "command", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "args", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 6, name: "batch", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 7, name: "batch_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 8, name: "timeout_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 9, name: "concurrency", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 10, name: "model_config_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "rubric", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWorkspaceRequest {
-    return new CreateWorkspaceRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateGraderRequest {
+    return new CreateGraderRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWorkspaceRequest {
-    return new CreateWorkspaceRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateGraderRequest {
+    return new CreateGraderRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWorkspaceRequest {
-    return new CreateWorkspaceRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateGraderRequest {
+    return new CreateGraderRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateWorkspaceRequest | PlainMessage<CreateWorkspaceRequest> | undefined, b: CreateWorkspaceRequest | PlainMessage<CreateWorkspaceRequest> | undefined): boolean {
-    return proto3.util.equals(CreateWorkspaceRequest, a, b);
+  static equals(a: CreateGraderRequest | PlainMessage<CreateGraderRequest> | undefined, b: CreateGraderRequest | PlainMessage<CreateGraderRequest> | undefined): boolean {
+    return proto3.util.equals(CreateGraderRequest, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.GetWorkspaceRequest
+ * @generated from message eval.v1.CreateGraderResponse
  */
-export class GetWorkspaceRequest extends Message<GetWorkspaceRequest> {
+export class CreateGraderResponse extends Message<CreateGraderResponse> {
   /**
-   * @generated from field: string id = 1;
+   * @generated from field: eval.v1.Grader grader = 1;
    */
-  id = "";
+  grader?: Grader;
 
-  constructor(data?: PartialMessage<GetWorkspaceRequest>) {
+  constructor(data?: PartialMessage<CreateGraderResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.GetWorkspaceRequest";
+  static readonly typeName = "eval.v1.CreateGraderResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "grader", kind: "message", T: Grader },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetWorkspaceRequest {
-    return new GetWorkspaceRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateGraderResponse {
+    return new CreateGraderResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetWorkspaceRequest {
-    return new GetWorkspaceRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateGraderResponse {
+    return new CreateGraderResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetWorkspaceRequest {
-    return new GetWorkspaceRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateGraderResponse {
+    return new CreateGraderResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetWorkspaceRequest | PlainMessage<GetWorkspaceRequest> | undefined, b: GetWorkspaceRequest | PlainMessage<GetWorkspaceRequest> | undefined): boolean {
-    return proto3.util.equals(GetWorkspaceRequest, a, b);
+  static equals(a: CreateGraderResponse | PlainMessage<CreateGraderResponse> | undefined, b: CreateGraderResponse | PlainMessage<CreateGraderResponse> | undefined): boolean {
+    return proto3.util.equals(CreateGraderResponse, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.ListWorkspacesRequest
+ * @generated from message eval.v1.ListGradersRequest
  */
-export class ListWorkspacesRequest extends Message<ListWorkspacesRequest> {
-  /**
-   * @generated from field: int32 page = 1;
-   */
-  page = 0;
-
+export class ListGradersRequest extends Message<ListGradersRequest> {
   /**
-   * @generated from field: int32 page_size = 2;
+   * @generated from field: string workspace_id = 1;
    */
-  pageSize = 0;
+  workspaceId = "";
 
-  constructor(data?: PartialMessage<ListWorkspacesRequest>) {
+  constructor(data?: PartialMessage<ListGradersRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.ListWorkspacesRequest";
+  static readonly typeName = "eval.v1.ListGradersRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "page", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 2, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWorkspacesRequest {
-    return new ListWorkspacesRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListGradersRequest {
+    return new ListGradersRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWorkspacesRequest {
-    return new ListWorkspacesRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListGradersRequest {
+    return new ListGradersRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWorkspacesRequest {
-    return new ListWorkspacesRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListGradersRequest {
+    return new ListGradersRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListWorkspacesRequest | PlainMessage<ListWorkspacesRequest> | undefined, b: ListWorkspacesRequest | PlainMessage<ListWorkspacesRequest> | undefined): boolean {
-    return proto3.util.equals(ListWorkspacesRequest, a, b);
+  static equals(a: ListGradersRequest | PlainMessage<ListGradersRequest> | undefined, b: ListGradersRequest | PlainMessage<ListGradersRequest> | undefined): boolean {
+    return proto3.util.equals(ListGradersRequest, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.ListWorkspacesResponse
+ * @generated from message eval.v1.ListGradersResponse
  */
-export class ListWorkspacesResponse extends Message<ListWorkspacesResponse> {
-  /**
-   * @generated from field: repeated eval.v1.Workspace workspaces = 1;
-   */
-  workspaces: Workspace[] = [];
-
+export class ListGradersResponse extends Message<ListGradersResponse> {
   /**
-   * @generated from field: int32 total_count = 2;
+   * @generated from field: repeated eval.v1.Grader graders = 1;
    */
-  totalCount = 0;
+  graders: Grader[] = [];
 
-  constructor(data?: PartialMessage<ListWorkspacesResponse>) {
+  constructor(data?: PartialMessage<ListGradersResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.ListWorkspacesResponse";
+  static readonly typeName = "eval.v1.ListGradersResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "workspaces", kind: "message", T: Workspace, repeated: true },
-    { no: 2, name: "total_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 1, name: "graders", kind: "message", T: Grader, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWorkspacesResponse {
-    return new ListWorkspacesResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListGradersResponse {
+    return new ListGradersResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWorkspacesResponse {
-    return new ListWorkspacesResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListGradersResponse {
+    return new ListGradersResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWorkspacesResponse {
-    return new ListWorkspacesResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListGradersResponse {
+    return new ListGradersResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListWorkspacesResponse | PlainMessage<ListWorkspacesResponse> | undefined, b: ListWorkspacesResponse | PlainMessage<ListWorkspacesResponse> | undefined): boolean {
-    return proto3.util.equals(ListWorkspacesResponse, a, b);
+  static equals(a: ListGradersResponse | PlainMessage<ListGradersResponse> | undefined, b: ListGradersResponse | PlainMessage<ListGradersResponse> | undefined): boolean {
+    return proto3.util.equals(ListGradersResponse, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.GetPromptRequest
+ * @generated from message eval.v1.DeleteGraderRequest
  */
-export class GetPromptRequest extends Message<GetPromptRequest> {
+export class DeleteGraderRequest extends Message<DeleteGraderRequest> {
   /**
    * @generated from field: string id = 1;
    */
   id = "";
 
-  constructor(data?: PartialMessage<GetPromptRequest>) {
+  constructor(data?: PartialMessage<DeleteGraderRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.GetPromptRequest";
+  static readonly typeName = "eval.v1.DeleteGraderRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetPromptRequest {
-    return new GetPromptRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteGraderRequest {
+    return new DeleteGraderRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetPromptRequest {
-    return new GetPromptRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteGraderRequest {
+    return new DeleteGraderRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetPromptRequest {
-    return new GetPromptRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteGraderRequest {
+    return new DeleteGraderRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetPromptRequest | PlainMessage<GetPromptRequest> | undefined, b: GetPromptRequest | PlainMessage<GetPromptRequest> | undefined): boolean {
-    return proto3.util.equals(GetPromptRequest, a, b);
+  static equals(a: DeleteGraderRequest | PlainMessage<DeleteGraderRequest> | undefined, b: DeleteGraderRequest | PlainMessage<DeleteGraderRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteGraderRequest, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.ListTestCasesRequest
+ * @generated from message eval.v1.RunGraderRequest
  */
-export class ListTestCasesRequest extends Message<ListTestCasesRequest> {
+export class RunGraderRequest extends Message<RunGraderRequest> {
   /**
-   * @generated from field: string workspace_id = 1;
+   * @generated from field: string grader_id = 1;
    */
-  workspaceId = "";
+  graderId = "";
 
   /**
-   * @generated from field: int32 page = 2;
+   * only grade results for this prompt version
+   *
+   * @generated from field: optional uint32 version_number = 2;
    */
-  page = 0;
+  versionNumber?: number;
 
   /**
-   * @generated from field: int32 page_size = 3;
+   * only grade these results; all results in the workspace if empty
+   *
+   * @generated from field: repeated string test_result_ids = 3;
    */
-  pageSize = 0;
+  testResultIds: string[] = [];
 
-  constructor(data?: PartialMessage<ListTestCasesRequest>) {
+  /**
+   * regrade results this grader has already scored
+   *
+   * @generated from field: bool regrade = 4;
+   */
+  regrade = false;
+
+  /**
+   * @generated from field: eval.v1.TestCaseFilter filter = 5;
+   */
+  filter?: TestCaseFilter;
+
+  constructor(data?: PartialMessage<RunGraderRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.ListTestCasesRequest";
+  static readonly typeName = "eval.v1.RunGraderRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "page", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 3, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 1, name: "grader_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+    { no: 3, name: "test_result_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "regrade", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "filter", kind: "message", T: TestCaseFilter },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTestCasesRequest {
-    return new ListTestCasesRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RunGraderRequest {
+    return new RunGraderRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTestCasesRequest {
-    return new ListTestCasesRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RunGraderRequest {
+    return new RunGraderRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTestCasesRequest {
-    return new ListTestCasesRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RunGraderRequest {
+    return new RunGraderRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListTestCasesRequest | PlainMessage<ListTestCasesRequest> | undefined, b: ListTestCasesRequest | PlainMessage<ListTestCasesRequest> | undefined): boolean {
-    return proto3.util.equals(ListTestCasesRequest, a, b);
+  static equals(a: RunGraderRequest | PlainMessage<RunGraderRequest> | undefined, b: RunGraderRequest | PlainMessage<RunGraderRequest> | undefined): boolean {
+    return proto3.util.equals(RunGraderRequest, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.ListTestCasesResponse
+ * @generated from message eval.v1.GradeError
  */
-export class ListTestCasesResponse extends Message<ListTestCasesResponse> {
-  /**
-   * @generated from field: repeated eval.v1.TestCase test_cases = 1;
-   */
-  testCases: TestCase[] = [];
-
+export class GradeError extends Message<GradeError> {
   /**
-   * @generated from field: int32 total_count = 2;
+   * @generated from field: string test_result_id = 1;
    */
-  totalCount = 0;
+  testResultId = "";
 
   /**
-   * @generated from field: repeated eval.v1.TestResult test_results = 3;
+   * @generated from field: string error = 2;
    */
-  testResults: TestResult[] = [];
+  error = "";
 
-  constructor(data?: PartialMessage<ListTestCasesResponse>) {
+  constructor(data?: PartialMessage<GradeError>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.ListTestCasesResponse";
+  static readonly typeName = "eval.v1.GradeError";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "test_cases", kind: "message", T: TestCase, repeated: true },
-    { no: 2, name: "total_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 3, name: "test_results", kind: "message", T: TestResult, repeated: true },
+    { no: 1, name: "test_result_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTestCasesResponse {
-    return new ListTestCasesResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GradeError {
+    return new GradeError().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTestCasesResponse {
-    return new ListTestCasesResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GradeError {
+    return new GradeError().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTestCasesResponse {
-    return new ListTestCasesResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GradeError {
+    return new GradeError().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListTestCasesResponse | PlainMessage<ListTestCasesResponse> | undefined, b: ListTestCasesResponse | PlainMessage<ListTestCasesResponse> | undefined): boolean {
-    return proto3.util.equals(ListTestCasesResponse, a, b);
+  static equals(a: GradeError | PlainMessage<GradeError> | undefined, b: GradeError | PlainMessage<GradeError> | undefined): boolean {
+    return proto3.util.equals(GradeError, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.CreatePromptVersionRequest
+ * @generated from message eval.v1.RunGraderResponse
  */
-export class CreatePromptVersionRequest extends Message<CreatePromptVersionRequest> {
+export class RunGraderResponse extends Message<RunGraderResponse> {
   /**
-   * @generated from field: string prompt_id = 1;
+   * @generated from field: repeated eval.v1.Rating ratings = 1;
    */
-  promptId = "";
+  ratings: Rating[] = [];
 
   /**
-   * @generated from field: string content = 2;
+   * @generated from field: repeated eval.v1.GradeError errors = 2;
    */
-  content = "";
+  errors: GradeError[] = [];
 
   /**
-   * @generated from field: uint32 version_number = 3;
+   * @generated from field: uint32 n_skipped = 3;
    */
-  versionNumber = 0;
+  nSkipped = 0;
 
-  constructor(data?: PartialMessage<CreatePromptVersionRequest>) {
+  constructor(data?: PartialMessage<RunGraderResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.CreatePromptVersionRequest";
+  static readonly typeName = "eval.v1.RunGraderResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "prompt_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "content", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 1, name: "ratings", kind: "message", T: Rating, repeated: true },
+    { no: 2, name: "errors", kind: "message", T: GradeError, repeated: true },
+    { no: 3, name: "n_skipped", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreatePromptVersionRequest {
-    return new CreatePromptVersionRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RunGraderResponse {
+    return new RunGraderResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreatePromptVersionRequest {
-    return new CreatePromptVersionRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RunGraderResponse {
+    return new RunGraderResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreatePromptVersionRequest {
-    return new CreatePromptVersionRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RunGraderResponse {
+    return new RunGraderResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreatePromptVersionRequest | PlainMessage<CreatePromptVersionRequest> | undefined, b: CreatePromptVersionRequest | PlainMessage<CreatePromptVersionRequest> | undefined): boolean {
-    return proto3.util.equals(CreatePromptVersionRequest, a, b);
+  static equals(a: RunGraderResponse | PlainMessage<RunGraderResponse> | undefined, b: RunGraderResponse | PlainMessage<RunGraderResponse> | undefined): boolean {
+    return proto3.util.equals(RunGraderResponse, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.CreateWorkspaceResponse
+ * @generated from message eval.v1.ExportWorkspaceRequest
  */
-export class CreateWorkspaceResponse extends Message<CreateWorkspaceResponse> {
+export class ExportWorkspaceRequest extends Message<ExportWorkspaceRequest> {
   /**
-   * @generated from field: eval.v1.Workspace workspace = 1;
+   * @generated from field: string workspace_id = 1;
    */
-  workspace?: Workspace;
+  workspaceId = "";
 
-  constructor(data?: PartialMessage<CreateWorkspaceResponse>) {
+  /**
+   * @generated from field: eval.v1.FileFormat format = 2;
+   */
+  format = FileFormat.CSV;
+
+  /**
+   * only export results for this prompt version
+   *
+   * @generated from field: optional uint32 version_number = 3;
+   */
+  versionNumber?: number;
+
+  /**
+   * @generated from field: eval.v1.TestCaseFilter filter = 4;
+   */
+  filter?: TestCaseFilter;
+
+  constructor(data?: PartialMessage<ExportWorkspaceRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.CreateWorkspaceResponse";
+  static readonly typeName = "eval.v1.ExportWorkspaceRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "workspace", kind: "message", T: Workspace },
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "format", kind: "enum", T: proto3.getEnumType(FileFormat) },
+    { no: 3, name: "version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+    { no: 4, name: "filter", kind: "message", T: TestCaseFilter },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWorkspaceResponse {
-    return new CreateWorkspaceResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExportWorkspaceRequest {
+    return new ExportWorkspaceRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateWorkspaceResponse {
-    return new CreateWorkspaceResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExportWorkspaceRequest {
+    return new ExportWorkspaceRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateWorkspaceResponse {
-    return new CreateWorkspaceResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExportWorkspaceRequest {
+    return new ExportWorkspaceRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateWorkspaceResponse | PlainMessage<CreateWorkspaceResponse> | undefined, b: CreateWorkspaceResponse | PlainMessage<CreateWorkspaceResponse> | undefined): boolean {
-    return proto3.util.equals(CreateWorkspaceResponse, a, b);
+  static equals(a: ExportWorkspaceRequest | PlainMessage<ExportWorkspaceRequest> | undefined, b: ExportWorkspaceRequest | PlainMessage<ExportWorkspaceRequest> | undefined): boolean {
+    return proto3.util.equals(ExportWorkspaceRequest, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.GetWorkspaceResponse
+ * ExportWorkspaceResponse carries the next chunk of the exported file. Each test
+ * result is one row; test cases without results get a row of their own.
+ *
+ * @generated from message eval.v1.ExportWorkspaceResponse
  */
-export class GetWorkspaceResponse extends Message<GetWorkspaceResponse> {
+export class ExportWorkspaceResponse extends Message<ExportWorkspaceResponse> {
   /**
-   * @generated from field: eval.v1.Workspace workspace = 1;
+   * @generated from field: bytes chunk = 1;
    */
-  workspace?: Workspace;
+  chunk = new Uint8Array(0);
 
-  constructor(data?: PartialMessage<GetWorkspaceResponse>) {
+  constructor(data?: PartialMessage<ExportWorkspaceResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.GetWorkspaceResponse";
+  static readonly typeName = "eval.v1.ExportWorkspaceResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "workspace", kind: "message", T: Workspace },
+    { no: 1, name: "chunk", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetWorkspaceResponse {
-    return new GetWorkspaceResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExportWorkspaceResponse {
+    return new ExportWorkspaceResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetWorkspaceResponse {
-    return new GetWorkspaceResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExportWorkspaceResponse {
+    return new ExportWorkspaceResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetWorkspaceResponse {
-    return new GetWorkspaceResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExportWorkspaceResponse {
+    return new ExportWorkspaceResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetWorkspaceResponse | PlainMessage<GetWorkspaceResponse> | undefined, b: GetWorkspaceResponse | PlainMessage<GetWorkspaceResponse> | undefined): boolean {
-    return proto3.util.equals(GetWorkspaceResponse, a, b);
+  static equals(a: ExportWorkspaceResponse | PlainMessage<ExportWorkspaceResponse> | undefined, b: ExportWorkspaceResponse | PlainMessage<ExportWorkspaceResponse> | undefined): boolean {
+    return proto3.util.equals(ExportWorkspaceResponse, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.CreateTestCaseRequest
+ * generate all results for a workspace and model config
+ *
+ * @generated from message eval.v1.SyntheticGenerationRequest
  */
-export class CreateTestCaseRequest extends Message<CreateTestCaseRequest> {
+export class SyntheticGenerationRequest extends Message<SyntheticGenerationRequest> {
   /**
    * @generated from field: string workspace_id = 1;
    */
   workspaceId = "";
 
   /**
-   * @generated from field: map<string, eval.v1.VariableValue> variable_values = 2;
+   * @generated from field: string model_config_name = 2;
    */
-  variableValues: { [key: string]: VariableValue } = {};
+  modelConfigName = "";
 
-  constructor(data?: PartialMessage<CreateTestCaseRequest>) {
+  /**
+   * @generated from field: uint32 version_number = 3;
+   */
+  versionNumber = 0;
+
+  /**
+   * @generated from field: uint32 system_prompt_version_number = 4;
+   */
+  systemPromptVersionNumber = 0;
+
+  /**
+   * only run the test cases matching the filter
+   *
+   * @generated from field: eval.v1.TestCaseFilter filter = 5;
+   */
+  filter?: TestCaseFilter;
+
+  /**
+   * run the versions a label points at instead of version numbers
+   *
+   * @generated from field: string label = 6;
+   */
+  label = "";
+
+  constructor(data?: PartialMessage<SyntheticGenerationRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.CreateTestCaseRequest";
+  static readonly typeName = "eval.v1.SyntheticGenerationRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "variable_values", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: VariableValue} },
+    { no: 2, name: "model_config_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "system_prompt_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "filter", kind: "message", T: TestCaseFilter },
+    { no: 6, name: "label", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateTestCaseRequest {
-    return new CreateTestCaseRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SyntheticGenerationRequest {
+    return new SyntheticGenerationRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateTestCaseRequest {
-    return new CreateTestCaseRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SyntheticGenerationRequest {
+    return new SyntheticGenerationRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateTestCaseRequest {
-    return new CreateTestCaseRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SyntheticGenerationRequest {
+    return new SyntheticGenerationRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateTestCaseRequest | PlainMessage<CreateTestCaseRequest> | undefined, b: CreateTestCaseRequest | PlainMessage<CreateTestCaseRequest> | undefined): boolean {
-    return proto3.util.equals(CreateTestCaseRequest, a, b);
+  static equals(a: SyntheticGenerationRequest | PlainMessage<SyntheticGenerationRequest> | undefined, b: SyntheticGenerationRequest | PlainMessage<SyntheticGenerationRequest> | undefined): boolean {
+    return proto3.util.equals(SyntheticGenerationRequest, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.CreateTestCaseResponse
+ * Extended EvaluationService
+ * RunPerturbationsRequest creates perturbed variants of test cases as child test
+ * cases, evaluates originals and variants with the same configs, and measures how
+ * often the result or grade changes.
+ *
+ * @generated from message eval.v1.RunPerturbationsRequest
  */
-export class CreateTestCaseResponse extends Message<CreateTestCaseResponse> {
+export class RunPerturbationsRequest extends Message<RunPerturbationsRequest> {
   /**
-   * @generated from field: eval.v1.TestCase test_case = 1;
+   * @generated from field: string workspace_id = 1;
    */
-  testCase?: TestCase;
+  workspaceId = "";
 
-  constructor(data?: PartialMessage<CreateTestCaseResponse>) {
+  /**
+   * test cases to perturb; all test cases matching filter if empty
+   *
+   * @generated from field: repeated string test_case_ids = 2;
+   */
+  testCaseIds: string[] = [];
+
+  /**
+   * @generated from field: eval.v1.TestCaseFilter filter = 3;
+   */
+  filter?: TestCaseFilter;
+
+  /**
+   * all kinds if empty
+   *
+   * @generated from field: repeated eval.v1.PerturbationKind kinds = 4;
+   */
+  kinds: PerturbationKind[] = [];
+
+  /**
+   * variants per test case and kind; defaults to 1
+   *
+   * @generated from field: uint32 n_variants = 5;
+   */
+  nVariants = 0;
+
+  /**
+   * paraphrase_model_config_name defaults to the default small model config
+   *
+   * @generated from field: optional string paraphrase_model_config_name = 6;
+   */
+  paraphraseModelConfigName?: string;
+
+  /**
+   * seed makes the perturbations reproducible; random if 0
+   *
+   * @generated from field: int64 seed = 7;
+   */
+  seed = protoInt64.zero;
+
+  /**
+   * version_number defaults to the current prompt version
+   *
+   * @generated from field: optional uint32 version_number = 8;
+   */
+  versionNumber?: number;
+
+  /**
+   * configs to evaluate with; the active configs if empty
+   *
+   * @generated from field: repeated string workspace_config_ids = 9;
+   */
+  workspaceConfigIds: string[] = [];
+
+  /**
+   * if set, originals and variants are graded and grades are compared too
+   *
+   * @generated from field: optional string grader_id = 10;
+   */
+  graderId?: string;
+
+  /**
+   * run the versions a label points at instead of version_number and the current
+   * system prompt
+   *
+   * @generated from field: string label = 11;
+   */
+  label = "";
+
+  constructor(data?: PartialMessage<RunPerturbationsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.CreateTestCaseResponse";
+  static readonly typeName = "eval.v1.RunPerturbationsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "test_case", kind: "message", T: TestCase },
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "test_case_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "filter", kind: "message", T: TestCaseFilter },
+    { no: 4, name: "kinds", kind: "enum", T: proto3.getEnumType(PerturbationKind), repeated: true },
+    { no: 5, name: "n_variants", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 6, name: "paraphrase_model_config_name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 7, name: "seed", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+    { no: 9, name: "workspace_config_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 10, name: "grader_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 11, name: "label", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateTestCaseResponse {
-    return new CreateTestCaseResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RunPerturbationsRequest {
+    return new RunPerturbationsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateTestCaseResponse {
-    return new CreateTestCaseResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RunPerturbationsRequest {
+    return new RunPerturbationsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateTestCaseResponse {
-    return new CreateTestCaseResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RunPerturbationsRequest {
+    return new RunPerturbationsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateTestCaseResponse | PlainMessage<CreateTestCaseResponse> | undefined, b: CreateTestCaseResponse | PlainMessage<CreateTestCaseResponse> | undefined): boolean {
-    return proto3.util.equals(CreateTestCaseResponse, a, b);
+  static equals(a: RunPerturbationsRequest | PlainMessage<RunPerturbationsRequest> | undefined, b: RunPerturbationsRequest | PlainMessage<RunPerturbationsRequest> | undefined): boolean {
+    return proto3.util.equals(RunPerturbationsRequest, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.DeleteTestCaseRequest
+ * PerturbationStability compares the results of originals and their variants for one
+ * config, over all perturbation kinds if kind is unspecified. A result changes if its
+ * XML fields differ, or its text does when it has none, ignoring whitespace.
+ *
+ * @generated from message eval.v1.PerturbationStability
  */
-export class DeleteTestCaseRequest extends Message<DeleteTestCaseRequest> {
+export class PerturbationStability extends Message<PerturbationStability> {
   /**
-   * @generated from field: string id = 1;
+   * @generated from field: string workspace_config_id = 1;
    */
-  id = "";
+  workspaceConfigId = "";
 
-  constructor(data?: PartialMessage<DeleteTestCaseRequest>) {
+  /**
+   * @generated from field: string model_config_name = 2;
+   */
+  modelConfigName = "";
+
+  /**
+   * @generated from field: eval.v1.PerturbationKind kind = 3;
+   */
+  kind = PerturbationKind.UNSPECIFIED;
+
+  /**
+   * @generated from field: uint32 n_pairs = 4;
+   */
+  nPairs = 0;
+
+  /**
+   * @generated from field: uint32 n_result_changed = 5;
+   */
+  nResultChanged = 0;
+
+  /**
+   * pairs where both results have a grade from the grader
+   *
+   * @generated from field: uint32 n_graded_pairs = 6;
+   */
+  nGradedPairs = 0;
+
+  /**
+   * @generated from field: uint32 n_grade_changed = 7;
+   */
+  nGradeChanged = 0;
+
+  /**
+   * stability is the fraction of pairs where neither the result nor the grade changed
+   *
+   * @generated from field: double stability = 8;
+   */
+  stability = 0;
+
+  constructor(data?: PartialMessage<PerturbationStability>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.DeleteTestCaseRequest";
+  static readonly typeName = "eval.v1.PerturbationStability";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "workspace_config_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "model_config_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "kind", kind: "enum", T: proto3.getEnumType(PerturbationKind) },
+    { no: 4, name: "n_pairs", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "n_result_changed", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 6, name: "n_graded_pairs", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 7, name: "n_grade_changed", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 8, name: "stability", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteTestCaseRequest {
-    return new DeleteTestCaseRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PerturbationStability {
+    return new PerturbationStability().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteTestCaseRequest {
-    return new DeleteTestCaseRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PerturbationStability {
+    return new PerturbationStability().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteTestCaseRequest {
-    return new DeleteTestCaseRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PerturbationStability {
+    return new PerturbationStability().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteTestCaseRequest | PlainMessage<DeleteTestCaseRequest> | undefined, b: DeleteTestCaseRequest | PlainMessage<DeleteTestCaseRequest> | undefined): boolean {
-    return proto3.util.equals(DeleteTestCaseRequest, a, b);
+  static equals(a: PerturbationStability | PlainMessage<PerturbationStability> | undefined, b: PerturbationStability | PlainMessage<PerturbationStability> | undefined): boolean {
+    return proto3.util.equals(PerturbationStability, a, b);
   }
 }
 
 /**
- * GeneratePromptRequest uses LLM to generate a prompt
- *
- * @generated from message eval.v1.GeneratePromptRequest
+ * @generated from message eval.v1.RunPerturbationsResponse
  */
-export class GeneratePromptRequest extends Message<GeneratePromptRequest> {
+export class RunPerturbationsResponse extends Message<RunPerturbationsResponse> {
   /**
-   * @generated from field: string prompt = 1;
+   * @generated from field: repeated eval.v1.TestCase variants = 1;
    */
-  prompt = "";
+  variants: TestCase[] = [];
 
   /**
-   * @generated from field: string model_config_name = 2;
+   * @generated from field: repeated eval.v1.TestResult results = 2;
    */
-  modelConfigName = "";
+  results: TestResult[] = [];
 
-  constructor(data?: PartialMessage<GeneratePromptRequest>) {
+  /**
+   * @generated from field: repeated eval.v1.PerturbationStability stability = 3;
+   */
+  stability: PerturbationStability[] = [];
+
+  /**
+   * perturbations, evaluations or grades that failed
+   *
+   * @generated from field: repeated string errors = 4;
+   */
+  errors: string[] = [];
+
+  constructor(data?: PartialMessage<RunPerturbationsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.GeneratePromptRequest";
+  static readonly typeName = "eval.v1.RunPerturbationsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "prompt", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "model_config_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "variants", kind: "message", T: TestCase, repeated: true },
+    { no: 2, name: "results", kind: "message", T: TestResult, repeated: true },
+    { no: 3, name: "stability", kind: "message", T: PerturbationStability, repeated: true },
+    { no: 4, name: "errors", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GeneratePromptRequest {
-    return new GeneratePromptRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RunPerturbationsResponse {
+    return new RunPerturbationsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GeneratePromptRequest {
-    return new GeneratePromptRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RunPerturbationsResponse {
+    return new RunPerturbationsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GeneratePromptRequest {
-    return new GeneratePromptRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RunPerturbationsResponse {
+    return new RunPerturbationsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GeneratePromptRequest | PlainMessage<GeneratePromptRequest> | undefined, b: GeneratePromptRequest | PlainMessage<GeneratePromptRequest> | undefined): boolean {
-    return proto3.util.equals(GeneratePromptRequest, a, b);
+  static equals(a: RunPerturbationsResponse | PlainMessage<RunPerturbationsResponse> | undefined, b: RunPerturbationsResponse | PlainMessage<RunPerturbationsResponse> | undefined): boolean {
+    return proto3.util.equals(RunPerturbationsResponse, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.GeneratePromptResponse
+ * OptimizePromptRequest starts from a prompt version and, each round, asks a model
+ * to revise the best version so far using its failing cases. Every candidate is saved
+ * as a new prompt version and scored with the grader on the dev split. The current
+ * version of the workspace is left as it is.
+ *
+ * @generated from message eval.v1.OptimizePromptRequest
  */
-export class GeneratePromptResponse extends Message<GeneratePromptResponse> {
+export class OptimizePromptRequest extends Message<OptimizePromptRequest> {
   /**
-   * @generated from field: string generated_prompt = 1;
+   * @generated from field: string workspace_id = 1;
    */
-  generatedPrompt = "";
+  workspaceId = "";
 
-  constructor(data?: PartialMessage<GeneratePromptResponse>) {
+  /**
+   * version_number defaults to the current prompt version
+   *
+   * @generated from field: optional uint32 version_number = 2;
+   */
+  versionNumber?: number;
+
+  /**
+   * grader_id scores every version; use an external grader for assertions or a
+   * judge grader for judge scores
+   *
+   * @generated from field: string grader_id = 3;
+   */
+  graderId = "";
+
+  /**
+   * @generated from field: eval.v1.OptimizationScoring scoring = 4;
+   */
+  scoring = OptimizationScoring.GRADER;
+
+  /**
+   * model config that proposes revisions; defaults to the default large model config
+   *
+   * @generated from field: optional string model_config_name = 5;
+   */
+  modelConfigName?: string;
+
+  /**
+   * defaults to 3
+   *
+   * @generated from field: uint32 max_rounds = 6;
+   */
+  maxRounds = 0;
+
+  /**
+   * candidates per round; defaults to 3
+   *
+   * @generated from field: uint32 n_candidates = 7;
+   */
+  nCandidates = 0;
+
+  /**
+   * stop once a round improves the best score by less than this; defaults to 0.01
+   *
+   * @generated from field: optional double min_improvement = 8;
+   */
+  minImprovement?: number;
+
+  /**
+   * grades without a pass count as failing below this score; defaults to 0.5
+   *
+   * @generated from field: optional double pass_threshold = 9;
+   */
+  passThreshold?: number;
+
+  /**
+   * configs to evaluate with; the active configs if empty
+   *
+   * @generated from field: repeated string workspace_config_ids = 10;
+   */
+  workspaceConfigIds: string[] = [];
+
+  constructor(data?: PartialMessage<OptimizePromptRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.GeneratePromptResponse";
+  static readonly typeName = "eval.v1.OptimizePromptRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "generated_prompt", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+    { no: 3, name: "grader_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "scoring", kind: "enum", T: proto3.getEnumType(OptimizationScoring) },
+    { no: 5, name: "model_config_name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 6, name: "max_rounds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 7, name: "n_candidates", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 8, name: "min_improvement", kind: "scalar", T: 1 /* ScalarType.DOUBLE */, opt: true },
+    { no: 9, name: "pass_threshold", kind: "scalar", T: 1 /* ScalarType.DOUBLE */, opt: true },
+    { no: 10, name: "workspace_config_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GeneratePromptResponse {
-    return new GeneratePromptResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OptimizePromptRequest {
+    return new OptimizePromptRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GeneratePromptResponse {
-    return new GeneratePromptResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OptimizePromptRequest {
+    return new OptimizePromptRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GeneratePromptResponse {
-    return new GeneratePromptResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OptimizePromptRequest {
+    return new OptimizePromptRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GeneratePromptResponse | PlainMessage<GeneratePromptResponse> | undefined, b: GeneratePromptResponse | PlainMessage<GeneratePromptResponse> | undefined): boolean {
-    return proto3.util.equals(GeneratePromptResponse, a, b);
+  static equals(a: OptimizePromptRequest | PlainMessage<OptimizePromptRequest> | undefined, b: OptimizePromptRequest | PlainMessage<OptimizePromptRequest> | undefined): boolean {
+    return proto3.util.equals(OptimizePromptRequest, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.ListModelConfigsRequest
+ * @generated from message eval.v1.OptimizationCandidate
  */
-export class ListModelConfigsRequest extends Message<ListModelConfigsRequest> {
-  constructor(data?: PartialMessage<ListModelConfigsRequest>) {
+export class OptimizationCandidate extends Message<OptimizationCandidate> {
+  /**
+   * @generated from field: uint32 round = 1;
+   */
+  round = 0;
+
+  /**
+   * @generated from field: uint32 prompt_version_number = 2;
+   */
+  promptVersionNumber = 0;
+
+  /**
+   * @generated from field: uint32 parent_version_number = 3;
+   */
+  parentVersionNumber = 0;
+
+  /**
+   * score is the mean grade of the version's results on the dev split
+   *
+   * @generated from field: double score = 4;
+   */
+  score = 0;
+
+  /**
+   * @generated from field: uint32 n_scored = 5;
+   */
+  nScored = 0;
+
+  /**
+   * @generated from field: uint32 n_failed = 6;
+   */
+  nFailed = 0;
+
+  constructor(data?: PartialMessage<OptimizationCandidate>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.ListModelConfigsRequest";
+  static readonly typeName = "eval.v1.OptimizationCandidate";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "round", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 2, name: "prompt_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "parent_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "score", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 5, name: "n_scored", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 6, name: "n_failed", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListModelConfigsRequest {
-    return new ListModelConfigsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OptimizationCandidate {
+    return new OptimizationCandidate().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListModelConfigsRequest {
-    return new ListModelConfigsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OptimizationCandidate {
+    return new OptimizationCandidate().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListModelConfigsRequest {
-    return new ListModelConfigsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OptimizationCandidate {
+    return new OptimizationCandidate().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListModelConfigsRequest | PlainMessage<ListModelConfigsRequest> | undefined, b: ListModelConfigsRequest | PlainMessage<ListModelConfigsRequest> | undefined): boolean {
-    return proto3.util.equals(ListModelConfigsRequest, a, b);
+  static equals(a: OptimizationCandidate | PlainMessage<OptimizationCandidate> | undefined, b: OptimizationCandidate | PlainMessage<OptimizationCandidate> | undefined): boolean {
+    return proto3.util.equals(OptimizationCandidate, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.ListModelConfigsResponse
+ * @generated from message eval.v1.OptimizationRun
  */
-export class ListModelConfigsResponse extends Message<ListModelConfigsResponse> {
+export class OptimizationRun extends Message<OptimizationRun> {
   /**
-   * @generated from field: map<string, eval.v1.ModelConfig> model_configs = 1;
+   * @generated from field: string id = 1;
    */
-  modelConfigs: { [key: string]: ModelConfig } = {};
+  id = "";
 
-  constructor(data?: PartialMessage<ListModelConfigsResponse>) {
+  /**
+   * @generated from field: string workspace_id = 2;
+   */
+  workspaceId = "";
+
+  /**
+   * @generated from field: string grader_id = 3;
+   */
+  graderId = "";
+
+  /**
+   * @generated from field: eval.v1.OptimizationScoring scoring = 4;
+   */
+  scoring = OptimizationScoring.GRADER;
+
+  /**
+   * @generated from field: string model_config_name = 5;
+   */
+  modelConfigName = "";
+
+  /**
+   * @generated from field: uint32 start_version_number = 6;
+   */
+  startVersionNumber = 0;
+
+  /**
+   * @generated from field: double start_score = 7;
+   */
+  startScore = 0;
+
+  /**
+   * @generated from field: uint32 best_version_number = 8;
+   */
+  bestVersionNumber = 0;
+
+  /**
+   * @generated from field: double best_score = 9;
+   */
+  bestScore = 0;
+
+  /**
+   * @generated from field: uint32 n_rounds = 10;
+   */
+  nRounds = 0;
+
+  /**
+   * @generated from field: eval.v1.OptimizationStopReason stop_reason = 11;
+   */
+  stopReason = OptimizationStopReason.UNSPECIFIED;
+
+  /**
+   * @generated from field: repeated eval.v1.OptimizationCandidate candidates = 12;
+   */
+  candidates: OptimizationCandidate[] = [];
+
+  /**
+   * @generated from field: repeated string errors = 13;
+   */
+  errors: string[] = [];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 14;
+   */
+  createdAt?: Timestamp;
+
+  constructor(data?: PartialMessage<OptimizationRun>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.ListModelConfigsResponse";
+  static readonly typeName = "eval.v1.OptimizationRun";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "model_configs", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: ModelConfig} },
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "grader_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "scoring", kind: "enum", T: proto3.getEnumType(OptimizationScoring) },
+    { no: 5, name: "model_config_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "start_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 7, name: "start_score", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 8, name: "best_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 9, name: "best_score", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 10, name: "n_rounds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 11, name: "stop_reason", kind: "enum", T: proto3.getEnumType(OptimizationStopReason) },
+    { no: 12, name: "candidates", kind: "message", T: OptimizationCandidate, repeated: true },
+    { no: 13, name: "errors", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 14, name: "created_at", kind: "message", T: Timestamp },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListModelConfigsResponse {
-    return new ListModelConfigsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OptimizationRun {
+    return new OptimizationRun().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListModelConfigsResponse {
-    return new ListModelConfigsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OptimizationRun {
+    return new OptimizationRun().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListModelConfigsResponse {
-    return new ListModelConfigsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OptimizationRun {
+    return new OptimizationRun().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListModelConfigsResponse | PlainMessage<ListModelConfigsResponse> | undefined, b: ListModelConfigsResponse | PlainMessage<ListModelConfigsResponse> | undefined): boolean {
-    return proto3.util.equals(ListModelConfigsResponse, a, b);
+  static equals(a: OptimizationRun | PlainMessage<OptimizationRun> | undefined, b: OptimizationRun | PlainMessage<OptimizationRun> | undefined): boolean {
+    return proto3.util.equals(OptimizationRun, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.SetDefaultSmallModelConfigRequest
+ * @generated from message eval.v1.OptimizePromptResponse
  */
-export class SetDefaultSmallModelConfigRequest extends Message<SetDefaultSmallModelConfigRequest> {
+export class OptimizePromptResponse extends Message<OptimizePromptResponse> {
   /**
-   * @generated from field: string model_config_name = 1;
+   * @generated from field: eval.v1.OptimizationRun run = 1;
    */
-  modelConfigName = "";
+  run?: OptimizationRun;
 
-  constructor(data?: PartialMessage<SetDefaultSmallModelConfigRequest>) {
+  constructor(data?: PartialMessage<OptimizePromptResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.SetDefaultSmallModelConfigRequest";
+  static readonly typeName = "eval.v1.OptimizePromptResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "model_config_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "run", kind: "message", T: OptimizationRun },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetDefaultSmallModelConfigRequest {
-    return new SetDefaultSmallModelConfigRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OptimizePromptResponse {
+    return new OptimizePromptResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetDefaultSmallModelConfigRequest {
-    return new SetDefaultSmallModelConfigRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OptimizePromptResponse {
+    return new OptimizePromptResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetDefaultSmallModelConfigRequest {
-    return new SetDefaultSmallModelConfigRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OptimizePromptResponse {
+    return new OptimizePromptResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SetDefaultSmallModelConfigRequest | PlainMessage<SetDefaultSmallModelConfigRequest> | undefined, b: SetDefaultSmallModelConfigRequest | PlainMessage<SetDefaultSmallModelConfigRequest> | undefined): boolean {
-    return proto3.util.equals(SetDefaultSmallModelConfigRequest, a, b);
+  static equals(a: OptimizePromptResponse | PlainMessage<OptimizePromptResponse> | undefined, b: OptimizePromptResponse | PlainMessage<OptimizePromptResponse> | undefined): boolean {
+    return proto3.util.equals(OptimizePromptResponse, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.SetDefaultLargeModelConfigRequest
+ * @generated from message eval.v1.ListOptimizationRunsRequest
  */
-export class SetDefaultLargeModelConfigRequest extends Message<SetDefaultLargeModelConfigRequest> {
+export class ListOptimizationRunsRequest extends Message<ListOptimizationRunsRequest> {
   /**
-   * @generated from field: string model_config_name = 1;
+   * @generated from field: string workspace_id = 1;
    */
-  modelConfigName = "";
+  workspaceId = "";
 
-  constructor(data?: PartialMessage<SetDefaultLargeModelConfigRequest>) {
+  constructor(data?: PartialMessage<ListOptimizationRunsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.SetDefaultLargeModelConfigRequest";
+  static readonly typeName = "eval.v1.ListOptimizationRunsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "model_config_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetDefaultLargeModelConfigRequest {
-    return new SetDefaultLargeModelConfigRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOptimizationRunsRequest {
+    return new ListOptimizationRunsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetDefaultLargeModelConfigRequest {
-    return new SetDefaultLargeModelConfigRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOptimizationRunsRequest {
+    return new ListOptimizationRunsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetDefaultLargeModelConfigRequest {
-    return new SetDefaultLargeModelConfigRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOptimizationRunsRequest {
+    return new ListOptimizationRunsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SetDefaultLargeModelConfigRequest | PlainMessage<SetDefaultLargeModelConfigRequest> | undefined, b: SetDefaultLargeModelConfigRequest | PlainMessage<SetDefaultLargeModelConfigRequest> | undefined): boolean {
-    return proto3.util.equals(SetDefaultLargeModelConfigRequest, a, b);
+  static equals(a: ListOptimizationRunsRequest | PlainMessage<ListOptimizationRunsRequest> | undefined, b: ListOptimizationRunsRequest | PlainMessage<ListOptimizationRunsRequest> | undefined): boolean {
+    return proto3.util.equals(ListOptimizationRunsRequest, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.GetModelConfigResponse
+ * @generated from message eval.v1.ListOptimizationRunsResponse
  */
-export class GetModelConfigResponse extends Message<GetModelConfigResponse> {
-  /**
-   * @generated from field: eval.v1.ModelConfig model_config = 1;
-   */
-  modelConfig?: ModelConfig;
-
+export class ListOptimizationRunsResponse extends Message<ListOptimizationRunsResponse> {
   /**
-   * @generated from field: string model_config_name = 2;
+   * @generated from field: repeated eval.v1.OptimizationRun runs = 1;
    */
-  modelConfigName = "";
+  runs: OptimizationRun[] = [];
 
-  constructor(data?: PartialMessage<GetModelConfigResponse>) {
+  constructor(data?: PartialMessage<ListOptimizationRunsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.GetModelConfigResponse";
+  static readonly typeName = "eval.v1.ListOptimizationRunsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "model_config", kind: "message", T: ModelConfig },
-    { no: 2, name: "model_config_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "runs", kind: "message", T: OptimizationRun, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetModelConfigResponse {
-    return new GetModelConfigResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOptimizationRunsResponse {
+    return new ListOptimizationRunsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetModelConfigResponse {
-    return new GetModelConfigResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOptimizationRunsResponse {
+    return new ListOptimizationRunsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetModelConfigResponse {
-    return new GetModelConfigResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOptimizationRunsResponse {
+    return new ListOptimizationRunsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetModelConfigResponse | PlainMessage<GetModelConfigResponse> | undefined, b: GetModelConfigResponse | PlainMessage<GetModelConfigResponse> | undefined): boolean {
-    return proto3.util.equals(GetModelConfigResponse, a, b);
+  static equals(a: ListOptimizationRunsResponse | PlainMessage<ListOptimizationRunsResponse> | undefined, b: ListOptimizationRunsResponse | PlainMessage<ListOptimizationRunsResponse> | undefined): boolean {
+    return proto3.util.equals(ListOptimizationRunsResponse, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.UpdateWorkspaceRequest
+ * Dataset is a set of test cases that can be attached to several workspaces. Each
+ * workspace keeps its own results for the dataset's test cases.
+ *
+ * @generated from message eval.v1.Dataset
  */
-export class UpdateWorkspaceRequest extends Message<UpdateWorkspaceRequest> {
+export class Dataset extends Message<Dataset> {
   /**
-   * @generated from field: string workspace_id = 1;
+   * @generated from field: string id = 1;
    */
-  workspaceId = "";
+  id = "";
 
   /**
-   * @generated from field: string new_content = 2;
+   * @generated from field: string name = 2;
    */
-  newContent = "";
+  name = "";
 
   /**
-   * @generated from field: string new_system_prompt = 3;
+   * @generated from field: string description = 3;
    */
-  newSystemPrompt = "";
+  description = "";
 
   /**
-   * @generated from field: optional string new_title = 4;
+   * @generated from field: uint32 n_test_cases = 4;
    */
-  newTitle?: string;
+  nTestCases = 0;
+
+  /**
+   * @generated from field: repeated eval.v1.DatasetAttachment attachments = 5;
+   */
+  attachments: DatasetAttachment[] = [];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 7;
+   */
+  updatedAt?: Timestamp;
 
-  constructor(data?: PartialMessage<UpdateWorkspaceRequest>) {
+  constructor(data?: PartialMessage<Dataset>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.UpdateWorkspaceRequest";
+  static readonly typeName = "eval.v1.Dataset";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "new_content", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "new_system_prompt", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "new_title", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "n_test_cases", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "attachments", kind: "message", T: DatasetAttachment, repeated: true },
+    { no: 6, name: "created_at", kind: "message", T: Timestamp },
+    { no: 7, name: "updated_at", kind: "message", T: Timestamp },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateWorkspaceRequest {
-    return new UpdateWorkspaceRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Dataset {
+    return new Dataset().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateWorkspaceRequest {
-    return new UpdateWorkspaceRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Dataset {
+    return new Dataset().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateWorkspaceRequest {
-    return new UpdateWorkspaceRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Dataset {
+    return new Dataset().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UpdateWorkspaceRequest | PlainMessage<UpdateWorkspaceRequest> | undefined, b: UpdateWorkspaceRequest | PlainMessage<UpdateWorkspaceRequest> | undefined): boolean {
-    return proto3.util.equals(UpdateWorkspaceRequest, a, b);
+  static equals(a: Dataset | PlainMessage<Dataset> | undefined, b: Dataset | PlainMessage<Dataset> | undefined): boolean {
+    return proto3.util.equals(Dataset, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.UpdateWorkspaceResponse
+ * @generated from message eval.v1.DatasetAttachment
  */
-export class UpdateWorkspaceResponse extends Message<UpdateWorkspaceResponse> {
+export class DatasetAttachment extends Message<DatasetAttachment> {
   /**
-   * @generated from field: uint32 new_version_number = 1;
+   * @generated from field: string workspace_id = 1;
    */
-  newVersionNumber = 0;
+  workspaceId = "";
 
   /**
-   * @generated from field: string content = 2;
+   * @generated from field: string dataset_id = 2;
    */
-  content = "";
+  datasetId = "";
 
   /**
-   * @generated from field: string system_prompt = 3;
+   * variable_mapping maps dataset variable names to the workspace's variable names.
+   * Variables without an entry keep their name.
+   *
+   * @generated from field: map<string, string> variable_mapping = 3;
    */
-  systemPrompt = "";
+  variableMapping: { [key: string]: string } = {};
 
   /**
-   * @generated from field: google.protobuf.Timestamp updated_at = 4;
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
    */
-  updatedAt?: Timestamp;
+  createdAt?: Timestamp;
 
-  constructor(data?: PartialMessage<UpdateWorkspaceResponse>) {
+  constructor(data?: PartialMessage<DatasetAttachment>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.UpdateWorkspaceResponse";
+  static readonly typeName = "eval.v1.DatasetAttachment";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "new_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
-    { no: 2, name: "content", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "system_prompt", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "updated_at", kind: "message", T: Timestamp },
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "dataset_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "variable_mapping", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 4, name: "created_at", kind: "message", T: Timestamp },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateWorkspaceResponse {
-    return new UpdateWorkspaceResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DatasetAttachment {
+    return new DatasetAttachment().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateWorkspaceResponse {
-    return new UpdateWorkspaceResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DatasetAttachment {
+    return new DatasetAttachment().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateWorkspaceResponse {
-    return new UpdateWorkspaceResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DatasetAttachment {
+    return new DatasetAttachment().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UpdateWorkspaceResponse | PlainMessage<UpdateWorkspaceResponse> | undefined, b: UpdateWorkspaceResponse | PlainMessage<UpdateWorkspaceResponse> | undefined): boolean {
-    return proto3.util.equals(UpdateWorkspaceResponse, a, b);
+  static equals(a: DatasetAttachment | PlainMessage<DatasetAttachment> | undefined, b: DatasetAttachment | PlainMessage<DatasetAttachment> | undefined): boolean {
+    return proto3.util.equals(DatasetAttachment, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.GenerateTestCaseRequest
+ * @generated from message eval.v1.CreateDatasetRequest
  */
-export class GenerateTestCaseRequest extends Message<GenerateTestCaseRequest> {
-  /**
-   * @generated from field: string workspace_id = 1;
-   */
-  workspaceId = "";
-
-  /**
-   * @generated from field: uint32 version_number = 2;
-   */
-  versionNumber = 0;
-
+export class CreateDatasetRequest extends Message<CreateDatasetRequest> {
   /**
-   * @generated from field: repeated eval.v1.TestCase test_cases = 3;
+   * @generated from field: string name = 1;
    */
-  testCases: TestCase[] = [];
+  name = "";
 
   /**
-   * @generated from field: optional string custom_cot = 4;
+   * @generated from field: string description = 2;
    */
-  customCot?: string;
+  description = "";
 
   /**
-   * @generated from field: uint32 n_test_cases = 5;
+   * if set, the workspace's own test cases matching filter are moved into the new
+   * dataset along with their results, and the dataset is attached to the workspace
+   *
+   * @generated from field: optional string source_workspace_id = 3;
    */
-  nTestCases = 0;
+  sourceWorkspaceId?: string;
 
   /**
-   * @generated from field: optional string seed_prompt = 6;
+   * @generated from field: eval.v1.TestCaseFilter filter = 4;
    */
-  seedPrompt?: string;
+  filter?: TestCaseFilter;
 
-  constructor(data?: PartialMessage<GenerateTestCaseRequest>) {
+  constructor(data?: PartialMessage<CreateDatasetRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.GenerateTestCaseRequest";
+  static readonly typeName = "eval.v1.CreateDatasetRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
-    { no: 3, name: "test_cases", kind: "message", T: TestCase, repeated: true },
-    { no: 4, name: "custom_cot", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
-    { no: 5, name: "n_test_cases", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
-    { no: 6, name: "seed_prompt", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "source_workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "filter", kind: "message", T: TestCaseFilter },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateTestCaseRequest {
-    return new GenerateTestCaseRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateDatasetRequest {
+    return new CreateDatasetRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GenerateTestCaseRequest {
-    return new GenerateTestCaseRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateDatasetRequest {
+    return new CreateDatasetRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GenerateTestCaseRequest {
-    return new GenerateTestCaseRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateDatasetRequest {
+    return new CreateDatasetRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GenerateTestCaseRequest | PlainMessage<GenerateTestCaseRequest> | undefined, b: GenerateTestCaseRequest | PlainMessage<GenerateTestCaseRequest> | undefined): boolean {
-    return proto3.util.equals(GenerateTestCaseRequest, a, b);
+  static equals(a: CreateDatasetRequest | PlainMessage<CreateDatasetRequest> | undefined, b: CreateDatasetRequest | PlainMessage<CreateDatasetRequest> | undefined): boolean {
+    return proto3.util.equals(CreateDatasetRequest, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.GenerateTestCaseResponse
+ * @generated from message eval.v1.CreateDatasetResponse
  */
-export class GenerateTestCaseResponse extends Message<GenerateTestCaseResponse> {
+export class CreateDatasetResponse extends Message<CreateDatasetResponse> {
   /**
-   * @generated from field: repeated eval.v1.TestCase test_cases = 1;
+   * @generated from field: eval.v1.Dataset dataset = 1;
    */
-  testCases: TestCase[] = [];
+  dataset?: Dataset;
 
-  constructor(data?: PartialMessage<GenerateTestCaseResponse>) {
+  /**
+   * @generated from field: uint32 n_moved = 2;
+   */
+  nMoved = 0;
+
+  constructor(data?: PartialMessage<CreateDatasetResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.GenerateTestCaseResponse";
+  static readonly typeName = "eval.v1.CreateDatasetResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "test_cases", kind: "message", T: TestCase, repeated: true },
+    { no: 1, name: "dataset", kind: "message", T: Dataset },
+    { no: 2, name: "n_moved", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateTestCaseResponse {
-    return new GenerateTestCaseResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateDatasetResponse {
+    return new CreateDatasetResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GenerateTestCaseResponse {
-    return new GenerateTestCaseResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateDatasetResponse {
+    return new CreateDatasetResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GenerateTestCaseResponse {
-    return new GenerateTestCaseResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateDatasetResponse {
+    return new CreateDatasetResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GenerateTestCaseResponse | PlainMessage<GenerateTestCaseResponse> | undefined, b: GenerateTestCaseResponse | PlainMessage<GenerateTestCaseResponse> | undefined): boolean {
-    return proto3.util.equals(GenerateTestCaseResponse, a, b);
+  static equals(a: CreateDatasetResponse | PlainMessage<CreateDatasetResponse> | undefined, b: CreateDatasetResponse | PlainMessage<CreateDatasetResponse> | undefined): boolean {
+    return proto3.util.equals(CreateDatasetResponse, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.DeleteWorkspaceConfigRequest
+ * @generated from message eval.v1.ListDatasetsRequest
  */
-export class DeleteWorkspaceConfigRequest extends Message<DeleteWorkspaceConfigRequest> {
-  /**
-   * @generated from field: string workspace_id = 1;
-   */
-  workspaceId = "";
-
+export class ListDatasetsRequest extends Message<ListDatasetsRequest> {
   /**
-   * @generated from field: string workspace_config_id = 2;
+   * only list datasets attached to this workspace
+   *
+   * @generated from field: optional string workspace_id = 1;
    */
-  workspaceConfigId = "";
+  workspaceId?: string;
 
-  constructor(data?: PartialMessage<DeleteWorkspaceConfigRequest>) {
+  constructor(data?: PartialMessage<ListDatasetsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.DeleteWorkspaceConfigRequest";
+  static readonly typeName = "eval.v1.ListDatasetsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "workspace_config_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteWorkspaceConfigRequest {
-    return new DeleteWorkspaceConfigRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListDatasetsRequest {
+    return new ListDatasetsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteWorkspaceConfigRequest {
-    return new DeleteWorkspaceConfigRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListDatasetsRequest {
+    return new ListDatasetsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteWorkspaceConfigRequest {
-    return new DeleteWorkspaceConfigRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListDatasetsRequest {
+    return new ListDatasetsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteWorkspaceConfigRequest | PlainMessage<DeleteWorkspaceConfigRequest> | undefined, b: DeleteWorkspaceConfigRequest | PlainMessage<DeleteWorkspaceConfigRequest> | undefined): boolean {
-    return proto3.util.equals(DeleteWorkspaceConfigRequest, a, b);
+  static equals(a: ListDatasetsRequest | PlainMessage<ListDatasetsRequest> | undefined, b: ListDatasetsRequest | PlainMessage<ListDatasetsRequest> | undefined): boolean {
+    return proto3.util.equals(ListDatasetsRequest, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.SetWorkspaceConfigActiveRequest
+ * @generated from message eval.v1.ListDatasetsResponse
  */
-export class SetWorkspaceConfigActiveRequest extends Message<SetWorkspaceConfigActiveRequest> {
-  /**
-   * @generated from field: string workspace_id = 1;
-   */
-  workspaceId = "";
-
-  /**
-   * @generated from field: string workspace_config_id = 2;
-   */
-  workspaceConfigId = "";
-
+export class ListDatasetsResponse extends Message<ListDatasetsResponse> {
   /**
-   * @generated from field: bool active = 3;
+   * @generated from field: repeated eval.v1.Dataset datasets = 1;
    */
-  active = false;
+  datasets: Dataset[] = [];
 
-  constructor(data?: PartialMessage<SetWorkspaceConfigActiveRequest>) {
+  constructor(data?: PartialMessage<ListDatasetsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.SetWorkspaceConfigActiveRequest";
+  static readonly typeName = "eval.v1.ListDatasetsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "workspace_config_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "active", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 1, name: "datasets", kind: "message", T: Dataset, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetWorkspaceConfigActiveRequest {
-    return new SetWorkspaceConfigActiveRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListDatasetsResponse {
+    return new ListDatasetsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetWorkspaceConfigActiveRequest {
-    return new SetWorkspaceConfigActiveRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListDatasetsResponse {
+    return new ListDatasetsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetWorkspaceConfigActiveRequest {
-    return new SetWorkspaceConfigActiveRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListDatasetsResponse {
+    return new ListDatasetsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SetWorkspaceConfigActiveRequest | PlainMessage<SetWorkspaceConfigActiveRequest> | undefined, b: SetWorkspaceConfigActiveRequest | PlainMessage<SetWorkspaceConfigActiveRequest> | undefined): boolean {
-    return proto3.util.equals(SetWorkspaceConfigActiveRequest, a, b);
+  static equals(a: ListDatasetsResponse | PlainMessage<ListDatasetsResponse> | undefined, b: ListDatasetsResponse | PlainMessage<ListDatasetsResponse> | undefined): boolean {
+    return proto3.util.equals(ListDatasetsResponse, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.SetVersionActiveRequest
+ * DeleteDatasetRequest permanently deletes a dataset with its test cases and their
+ * results. The dataset must be detached from every workspace first.
+ *
+ * @generated from message eval.v1.DeleteDatasetRequest
  */
-export class SetVersionActiveRequest extends Message<SetVersionActiveRequest> {
+export class DeleteDatasetRequest extends Message<DeleteDatasetRequest> {
   /**
-   * @generated from field: string workspace_id = 1;
-   */
-  workspaceId = "";
-
-  /**
-   * @generated from field: uint32 version_number = 2;
-   */
-  versionNumber = 0;
-
-  /**
-   * @generated from field: bool active = 3;
+   * @generated from field: string id = 1;
    */
-  active = false;
+  id = "";
 
-  constructor(data?: PartialMessage<SetVersionActiveRequest>) {
+  constructor(data?: PartialMessage<DeleteDatasetRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.SetVersionActiveRequest";
+  static readonly typeName = "eval.v1.DeleteDatasetRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
-    { no: 3, name: "active", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetVersionActiveRequest {
-    return new SetVersionActiveRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteDatasetRequest {
+    return new DeleteDatasetRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetVersionActiveRequest {
-    return new SetVersionActiveRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteDatasetRequest {
+    return new DeleteDatasetRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetVersionActiveRequest {
-    return new SetVersionActiveRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteDatasetRequest {
+    return new DeleteDatasetRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SetVersionActiveRequest | PlainMessage<SetVersionActiveRequest> | undefined, b: SetVersionActiveRequest | PlainMessage<SetVersionActiveRequest> | undefined): boolean {
-    return proto3.util.equals(SetVersionActiveRequest, a, b);
+  static equals(a: DeleteDatasetRequest | PlainMessage<DeleteDatasetRequest> | undefined, b: DeleteDatasetRequest | PlainMessage<DeleteDatasetRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteDatasetRequest, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.SetXMLModeRequest
+ * AttachDatasetRequest attaches a dataset to a workspace, or updates the variable
+ * mapping if it is already attached.
+ *
+ * @generated from message eval.v1.AttachDatasetRequest
  */
-export class SetXMLModeRequest extends Message<SetXMLModeRequest> {
+export class AttachDatasetRequest extends Message<AttachDatasetRequest> {
   /**
    * @generated from field: string workspace_id = 1;
    */
   workspaceId = "";
 
   /**
-   * @generated from field: bool XMLMode = 2;
+   * @generated from field: string dataset_id = 2;
    */
-  XMLMode = false;
+  datasetId = "";
 
-  constructor(data?: PartialMessage<SetXMLModeRequest>) {
+  /**
+   * @generated from field: map<string, string> variable_mapping = 3;
+   */
+  variableMapping: { [key: string]: string } = {};
+
+  constructor(data?: PartialMessage<AttachDatasetRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.SetXMLModeRequest";
+  static readonly typeName = "eval.v1.AttachDatasetRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "XMLMode", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "dataset_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "variable_mapping", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetXMLModeRequest {
-    return new SetXMLModeRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AttachDatasetRequest {
+    return new AttachDatasetRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetXMLModeRequest {
-    return new SetXMLModeRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AttachDatasetRequest {
+    return new AttachDatasetRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetXMLModeRequest {
-    return new SetXMLModeRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AttachDatasetRequest {
+    return new AttachDatasetRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SetXMLModeRequest | PlainMessage<SetXMLModeRequest> | undefined, b: SetXMLModeRequest | PlainMessage<SetXMLModeRequest> | undefined): boolean {
-    return proto3.util.equals(SetXMLModeRequest, a, b);
+  static equals(a: AttachDatasetRequest | PlainMessage<AttachDatasetRequest> | undefined, b: AttachDatasetRequest | PlainMessage<AttachDatasetRequest> | undefined): boolean {
+    return proto3.util.equals(AttachDatasetRequest, a, b);
   }
 }
 
 /**
- * @generated from message eval.v1.RateTestResultRequest
+ * @generated from message eval.v1.AttachDatasetResponse
  */
-export class RateTestResultRequest extends Message<RateTestResultRequest> {
-  /**
-   * @generated from field: string test_result_id = 1;
-   */
-  testResultId = "";
-
+export class AttachDatasetResponse extends Message<AttachDatasetResponse> {
   /**
-   * @generated from field: int32 rating = 2;
+   * @generated from field: eval.v1.DatasetAttachment attachment = 1;
    */
-  rating = 0;
+  attachment?: DatasetAttachment;
 
-  constructor(data?: PartialMessage<RateTestResultRequest>) {
+  constructor(data?: PartialMessage<AttachDatasetResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.RateTestResultRequest";
+  static readonly typeName = "eval.v1.AttachDatasetResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "test_result_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "rating", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 1, name: "attachment", kind: "message", T: DatasetAttachment },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RateTestResultRequest {
-    return new RateTestResultRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AttachDatasetResponse {
+    return new AttachDatasetResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RateTestResultRequest {
-    return new RateTestResultRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AttachDatasetResponse {
+    return new AttachDatasetResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RateTestResultRequest {
-    return new RateTestResultRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AttachDatasetResponse {
+    return new AttachDatasetResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RateTestResultRequest | PlainMessage<RateTestResultRequest> | undefined, b: RateTestResultRequest | PlainMessage<RateTestResultRequest> | undefined): boolean {
-    return proto3.util.equals(RateTestResultRequest, a, b);
+  static equals(a: AttachDatasetResponse | PlainMessage<AttachDatasetResponse> | undefined, b: AttachDatasetResponse | PlainMessage<AttachDatasetResponse> | undefined): boolean {
+    return proto3.util.equals(AttachDatasetResponse, a, b);
   }
 }
 
 /**
- * generate all results for a workspace and model config
+ * DetachDatasetRequest detaches a dataset from a workspace. The workspace's results
+ * for the dataset's test cases are kept, and show up again if it is re-attached.
  *
- * @generated from message eval.v1.SyntheticGenerationRequest
+ * @generated from message eval.v1.DetachDatasetRequest
  */
-export class SyntheticGenerationRequest extends Message<SyntheticGenerationRequest> {
+export class DetachDatasetRequest extends Message<DetachDatasetRequest> {
   /**
    * @generated from field: string workspace_id = 1;
    */
   workspaceId = "";
 
   /**
-   * @generated from field: string model_config_name = 2;
-   */
-  modelConfigName = "";
-
-  /**
-   * @generated from field: uint32 version_number = 3;
-   */
-  versionNumber = 0;
-
-  /**
-   * @generated from field: uint32 system_prompt_version_number = 4;
+   * @generated from field: string dataset_id = 2;
    */
-  systemPromptVersionNumber = 0;
+  datasetId = "";
 
-  constructor(data?: PartialMessage<SyntheticGenerationRequest>) {
+  constructor(data?: PartialMessage<DetachDatasetRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "eval.v1.SyntheticGenerationRequest";
+  static readonly typeName = "eval.v1.DetachDatasetRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "model_config_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
-    { no: 4, name: "system_prompt_version_number", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 2, name: "dataset_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SyntheticGenerationRequest {
-    return new SyntheticGenerationRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DetachDatasetRequest {
+    return new DetachDatasetRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SyntheticGenerationRequest {
-    return new SyntheticGenerationRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DetachDatasetRequest {
+    return new DetachDatasetRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SyntheticGenerationRequest {
-    return new SyntheticGenerationRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DetachDatasetRequest {
+    return new DetachDatasetRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SyntheticGenerationRequest | PlainMessage<SyntheticGenerationRequest> | undefined, b: SyntheticGenerationRequest | PlainMessage<SyntheticGenerationRequest> | undefined): boolean {
-    return proto3.util.equals(SyntheticGenerationRequest, a, b);
+  static equals(a: DetachDatasetRequest | PlainMessage<DetachDatasetRequest> | undefined, b: DetachDatasetRequest | PlainMessage<DetachDatasetRequest> | undefined): boolean {
+    return proto3.util.equals(DetachDatasetRequest, a, b);
   }
 }
 
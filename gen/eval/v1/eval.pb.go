@@ -68,711 +68,883 @@ func (VariableType) EnumDescriptor() ([]byte, []int) {
 	return file_eval_v1_eval_proto_rawDescGZIP(), []int{0}
 }
 
-type Variable struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// Split assigns a test case to a dataset split, so prompts can be iterated on
+// with the dev set and only checked against the holdout set before shipping.
+type Split int32
 
-	Name string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Type VariableType `protobuf:"varint,2,opt,name=type,proto3,enum=eval.v1.VariableType" json:"type,omitempty"`
-}
+const (
+	Split_SPLIT_UNSPECIFIED Split = 0
+	Split_SPLIT_DEV         Split = 1
+	Split_SPLIT_TEST        Split = 2
+	Split_SPLIT_HOLDOUT     Split = 3
+)
 
-func (x *Variable) Reset() {
-	*x = Variable{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for Split.
+var (
+	Split_name = map[int32]string{
+		0: "SPLIT_UNSPECIFIED",
+		1: "SPLIT_DEV",
+		2: "SPLIT_TEST",
+		3: "SPLIT_HOLDOUT",
+	}
+	Split_value = map[string]int32{
+		"SPLIT_UNSPECIFIED": 0,
+		"SPLIT_DEV":         1,
+		"SPLIT_TEST":        2,
+		"SPLIT_HOLDOUT":     3,
 	}
-}
+)
 
-func (x *Variable) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x Split) Enum() *Split {
+	p := new(Split)
+	*p = x
+	return p
 }
 
-func (*Variable) ProtoMessage() {}
+func (x Split) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *Variable) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (Split) Descriptor() protoreflect.EnumDescriptor {
+	return file_eval_v1_eval_proto_enumTypes[1].Descriptor()
 }
 
-// Deprecated: Use Variable.ProtoReflect.Descriptor instead.
-func (*Variable) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{0}
+func (Split) Type() protoreflect.EnumType {
+	return &file_eval_v1_eval_proto_enumTypes[1]
 }
 
-func (x *Variable) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+func (x Split) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Variable) GetType() VariableType {
-	if x != nil {
-		return x.Type
-	}
-	return VariableType_TEXT
+// Deprecated: Use Split.Descriptor instead.
+func (Split) EnumDescriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{1}
 }
 
-type VariableValue struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type PerturbationKind int32
 
-	// Types that are assignable to Value:
-	//
-	//	*VariableValue_TextValue
-	//	*VariableValue_ImageValue
-	Value isVariableValue_Value `protobuf_oneof:"value"`
-}
+const (
+	PerturbationKind_PERTURBATION_KIND_UNSPECIFIED PerturbationKind = 0
+	PerturbationKind_PERTURBATION_KIND_TYPOS       PerturbationKind = 1
+	PerturbationKind_PERTURBATION_KIND_CASING      PerturbationKind = 2
+	PerturbationKind_PERTURBATION_KIND_WHITESPACE  PerturbationKind = 3
+	// rewritten by a model
+	PerturbationKind_PERTURBATION_KIND_PARAPHRASE PerturbationKind = 4
+	// list items in a different order; only applies to inputs with lists
+	PerturbationKind_PERTURBATION_KIND_REORDER_LIST PerturbationKind = 5
+)
 
-func (x *VariableValue) Reset() {
-	*x = VariableValue{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for PerturbationKind.
+var (
+	PerturbationKind_name = map[int32]string{
+		0: "PERTURBATION_KIND_UNSPECIFIED",
+		1: "PERTURBATION_KIND_TYPOS",
+		2: "PERTURBATION_KIND_CASING",
+		3: "PERTURBATION_KIND_WHITESPACE",
+		4: "PERTURBATION_KIND_PARAPHRASE",
+		5: "PERTURBATION_KIND_REORDER_LIST",
+	}
+	PerturbationKind_value = map[string]int32{
+		"PERTURBATION_KIND_UNSPECIFIED":  0,
+		"PERTURBATION_KIND_TYPOS":        1,
+		"PERTURBATION_KIND_CASING":       2,
+		"PERTURBATION_KIND_WHITESPACE":   3,
+		"PERTURBATION_KIND_PARAPHRASE":   4,
+		"PERTURBATION_KIND_REORDER_LIST": 5,
 	}
-}
+)
 
-func (x *VariableValue) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x PerturbationKind) Enum() *PerturbationKind {
+	p := new(PerturbationKind)
+	*p = x
+	return p
 }
 
-func (*VariableValue) ProtoMessage() {}
+func (x PerturbationKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *VariableValue) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (PerturbationKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_eval_v1_eval_proto_enumTypes[2].Descriptor()
 }
 
-// Deprecated: Use VariableValue.ProtoReflect.Descriptor instead.
-func (*VariableValue) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{1}
+func (PerturbationKind) Type() protoreflect.EnumType {
+	return &file_eval_v1_eval_proto_enumTypes[2]
 }
 
-func (m *VariableValue) GetValue() isVariableValue_Value {
-	if m != nil {
-		return m.Value
-	}
-	return nil
+func (x PerturbationKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *VariableValue) GetTextValue() string {
-	if x, ok := x.GetValue().(*VariableValue_TextValue); ok {
-		return x.TextValue
-	}
-	return ""
+// Deprecated: Use PerturbationKind.Descriptor instead.
+func (PerturbationKind) EnumDescriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *VariableValue) GetImageValue() []byte {
-	if x, ok := x.GetValue().(*VariableValue_ImageValue); ok {
-		return x.ImageValue
+// EvaluationStatus selects test cases by whether they have results.
+type EvaluationStatus int32
+
+const (
+	EvaluationStatus_EVALUATION_STATUS_UNSPECIFIED   EvaluationStatus = 0
+	EvaluationStatus_EVALUATION_STATUS_EVALUATED     EvaluationStatus = 1
+	EvaluationStatus_EVALUATION_STATUS_NOT_EVALUATED EvaluationStatus = 2
+)
+
+// Enum value maps for EvaluationStatus.
+var (
+	EvaluationStatus_name = map[int32]string{
+		0: "EVALUATION_STATUS_UNSPECIFIED",
+		1: "EVALUATION_STATUS_EVALUATED",
+		2: "EVALUATION_STATUS_NOT_EVALUATED",
 	}
-	return nil
+	EvaluationStatus_value = map[string]int32{
+		"EVALUATION_STATUS_UNSPECIFIED":   0,
+		"EVALUATION_STATUS_EVALUATED":     1,
+		"EVALUATION_STATUS_NOT_EVALUATED": 2,
+	}
+)
+
+func (x EvaluationStatus) Enum() *EvaluationStatus {
+	p := new(EvaluationStatus)
+	*p = x
+	return p
 }
 
-type isVariableValue_Value interface {
-	isVariableValue_Value()
+func (x EvaluationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type VariableValue_TextValue struct {
-	TextValue string `protobuf:"bytes,1,opt,name=text_value,json=textValue,proto3,oneof"`
+func (EvaluationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_eval_v1_eval_proto_enumTypes[3].Descriptor()
 }
 
-type VariableValue_ImageValue struct {
-	ImageValue []byte `protobuf:"bytes,2,opt,name=image_value,json=imageValue,proto3,oneof"`
+func (EvaluationStatus) Type() protoreflect.EnumType {
+	return &file_eval_v1_eval_proto_enumTypes[3]
 }
 
-func (*VariableValue_TextValue) isVariableValue_Value() {}
+func (x EvaluationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
 
-func (*VariableValue_ImageValue) isVariableValue_Value() {}
+// Deprecated: Use EvaluationStatus.Descriptor instead.
+func (EvaluationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{3}
+}
 
-type ModelConfig struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type TestCaseSort int32
 
-	ProviderType string `protobuf:"bytes,1,opt,name=provider_type,json=providerType,proto3" json:"provider_type,omitempty"`
-	ModelName    string `protobuf:"bytes,2,opt,name=model_name,json=modelName,proto3" json:"model_name,omitempty"`
-	BaseUrl      string `protobuf:"bytes,3,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`
-}
+const (
+	// sorts by creation time
+	TestCaseSort_TEST_CASE_SORT_UNSPECIFIED TestCaseSort = 0
+	TestCaseSort_TEST_CASE_SORT_CREATED_AT  TestCaseSort = 1
+	TestCaseSort_TEST_CASE_SORT_UPDATED_AT  TestCaseSort = 2
+)
 
-func (x *ModelConfig) Reset() {
-	*x = ModelConfig{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for TestCaseSort.
+var (
+	TestCaseSort_name = map[int32]string{
+		0: "TEST_CASE_SORT_UNSPECIFIED",
+		1: "TEST_CASE_SORT_CREATED_AT",
+		2: "TEST_CASE_SORT_UPDATED_AT",
+	}
+	TestCaseSort_value = map[string]int32{
+		"TEST_CASE_SORT_UNSPECIFIED": 0,
+		"TEST_CASE_SORT_CREATED_AT":  1,
+		"TEST_CASE_SORT_UPDATED_AT":  2,
 	}
+)
+
+func (x TestCaseSort) Enum() *TestCaseSort {
+	p := new(TestCaseSort)
+	*p = x
+	return p
 }
 
-func (x *ModelConfig) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x TestCaseSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*ModelConfig) ProtoMessage() {}
+func (TestCaseSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_eval_v1_eval_proto_enumTypes[4].Descriptor()
+}
 
-func (x *ModelConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (TestCaseSort) Type() protoreflect.EnumType {
+	return &file_eval_v1_eval_proto_enumTypes[4]
 }
 
-// Deprecated: Use ModelConfig.ProtoReflect.Descriptor instead.
-func (*ModelConfig) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{2}
+func (x TestCaseSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *ModelConfig) GetProviderType() string {
-	if x != nil {
-		return x.ProviderType
-	}
-	return ""
+// Deprecated: Use TestCaseSort.Descriptor instead.
+func (TestCaseSort) EnumDescriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ModelConfig) GetModelName() string {
-	if x != nil {
-		return x.ModelName
+type DuplicateAction int32
+
+const (
+	// skip new cases that are near-duplicates of existing ones
+	DuplicateAction_DUPLICATE_ACTION_SKIP DuplicateAction = 0
+	// create them, tagged "near-duplicate"
+	DuplicateAction_DUPLICATE_ACTION_FLAG DuplicateAction = 1
+	// create them without checking
+	DuplicateAction_DUPLICATE_ACTION_ALLOW DuplicateAction = 2
+)
+
+// Enum value maps for DuplicateAction.
+var (
+	DuplicateAction_name = map[int32]string{
+		0: "DUPLICATE_ACTION_SKIP",
+		1: "DUPLICATE_ACTION_FLAG",
+		2: "DUPLICATE_ACTION_ALLOW",
 	}
-	return ""
+	DuplicateAction_value = map[string]int32{
+		"DUPLICATE_ACTION_SKIP":  0,
+		"DUPLICATE_ACTION_FLAG":  1,
+		"DUPLICATE_ACTION_ALLOW": 2,
+	}
+)
+
+func (x DuplicateAction) Enum() *DuplicateAction {
+	p := new(DuplicateAction)
+	*p = x
+	return p
 }
 
-func (x *ModelConfig) GetBaseUrl() string {
-	if x != nil {
-		return x.BaseUrl
-	}
-	return ""
+func (x DuplicateAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type MessageOptions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (DuplicateAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_eval_v1_eval_proto_enumTypes[5].Descriptor()
+}
 
-	MaxTokens   int32   `protobuf:"varint,1,opt,name=max_tokens,json=maxTokens,proto3" json:"max_tokens,omitempty"`
-	Temperature float32 `protobuf:"fixed32,2,opt,name=temperature,proto3" json:"temperature,omitempty"`
+func (DuplicateAction) Type() protoreflect.EnumType {
+	return &file_eval_v1_eval_proto_enumTypes[5]
 }
 
-func (x *MessageOptions) Reset() {
-	*x = MessageOptions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x DuplicateAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *MessageOptions) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use DuplicateAction.Descriptor instead.
+func (DuplicateAction) EnumDescriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{5}
 }
 
-func (*MessageOptions) ProtoMessage() {}
+type FileFormat int32
 
-func (x *MessageOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	FileFormat_FILE_FORMAT_CSV   FileFormat = 0
+	FileFormat_FILE_FORMAT_JSONL FileFormat = 1
+	FileFormat_FILE_FORMAT_YAML  FileFormat = 2
+)
+
+// Enum value maps for FileFormat.
+var (
+	FileFormat_name = map[int32]string{
+		0: "FILE_FORMAT_CSV",
+		1: "FILE_FORMAT_JSONL",
+		2: "FILE_FORMAT_YAML",
 	}
-	return mi.MessageOf(x)
+	FileFormat_value = map[string]int32{
+		"FILE_FORMAT_CSV":   0,
+		"FILE_FORMAT_JSONL": 1,
+		"FILE_FORMAT_YAML":  2,
+	}
+)
+
+func (x FileFormat) Enum() *FileFormat {
+	p := new(FileFormat)
+	*p = x
+	return p
 }
 
-// Deprecated: Use MessageOptions.ProtoReflect.Descriptor instead.
-func (*MessageOptions) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{3}
+func (x FileFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *MessageOptions) GetMaxTokens() int32 {
-	if x != nil {
-		return x.MaxTokens
-	}
-	return 0
+func (FileFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_eval_v1_eval_proto_enumTypes[6].Descriptor()
 }
 
-func (x *MessageOptions) GetTemperature() float32 {
-	if x != nil {
-		return x.Temperature
-	}
-	return 0
+func (FileFormat) Type() protoreflect.EnumType {
+	return &file_eval_v1_eval_proto_enumTypes[6]
 }
 
-type InferMessage struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x FileFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
 
-	Content     string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	Role        string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
-	Image       []byte `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
-	ShouldCache bool   `protobuf:"varint,4,opt,name=should_cache,json=shouldCache,proto3" json:"should_cache,omitempty"`
+// Deprecated: Use FileFormat.Descriptor instead.
+func (FileFormat) EnumDescriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *InferMessage) Reset() {
-	*x = InferMessage{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+type DiffOp int32
+
+const (
+	DiffOp_DIFF_OP_UNSPECIFIED DiffOp = 0
+	DiffOp_DIFF_OP_EQUAL       DiffOp = 1
+	DiffOp_DIFF_OP_INSERT      DiffOp = 2
+	DiffOp_DIFF_OP_DELETE      DiffOp = 3
+	// a deleted line paired with the line inserted in its place
+	DiffOp_DIFF_OP_REPLACE DiffOp = 4
+)
+
+// Enum value maps for DiffOp.
+var (
+	DiffOp_name = map[int32]string{
+		0: "DIFF_OP_UNSPECIFIED",
+		1: "DIFF_OP_EQUAL",
+		2: "DIFF_OP_INSERT",
+		3: "DIFF_OP_DELETE",
+		4: "DIFF_OP_REPLACE",
+	}
+	DiffOp_value = map[string]int32{
+		"DIFF_OP_UNSPECIFIED": 0,
+		"DIFF_OP_EQUAL":       1,
+		"DIFF_OP_INSERT":      2,
+		"DIFF_OP_DELETE":      3,
+		"DIFF_OP_REPLACE":     4,
 	}
-}
+)
 
-func (x *InferMessage) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x DiffOp) Enum() *DiffOp {
+	p := new(DiffOp)
+	*p = x
+	return p
 }
 
-func (*InferMessage) ProtoMessage() {}
+func (x DiffOp) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *InferMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (DiffOp) Descriptor() protoreflect.EnumDescriptor {
+	return file_eval_v1_eval_proto_enumTypes[7].Descriptor()
 }
 
-// Deprecated: Use InferMessage.ProtoReflect.Descriptor instead.
-func (*InferMessage) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{4}
+func (DiffOp) Type() protoreflect.EnumType {
+	return &file_eval_v1_eval_proto_enumTypes[7]
 }
 
-func (x *InferMessage) GetContent() string {
-	if x != nil {
-		return x.Content
-	}
-	return ""
+func (x DiffOp) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *InferMessage) GetRole() string {
-	if x != nil {
-		return x.Role
-	}
-	return ""
+// Deprecated: Use DiffOp.Descriptor instead.
+func (DiffOp) EnumDescriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *InferMessage) GetImage() []byte {
-	if x != nil {
-		return x.Image
+type LintCode int32
+
+const (
+	LintCode_LINT_CODE_UNSPECIFIED LintCode = 0
+	// the prompt is not a valid template
+	LintCode_LINT_CODE_TEMPLATE_ERROR LintCode = 1
+	// a {{ without a matching }}
+	LintCode_LINT_CODE_UNCLOSED_ACTION LintCode = 2
+	// a template variable that no test case provides
+	LintCode_LINT_CODE_MISSING_VARIABLE LintCode = 3
+	// a test case variable the template never uses
+	LintCode_LINT_CODE_UNUSED_VARIABLE LintCode = 4
+	// a variable that is not upper case, which test cases and generated values use
+	LintCode_LINT_CODE_LOWERCASE_VARIABLE LintCode = 5
+	// XML mode is on, but neither prompt asks for a <reply>
+	LintCode_LINT_CODE_XML_MODE_WITHOUT_REPLY LintCode = 6
+	// the rendered prompt and max tokens do not fit a model's context window
+	LintCode_LINT_CODE_CONTEXT_WINDOW_EXCEEDED LintCode = 7
+)
+
+// Enum value maps for LintCode.
+var (
+	LintCode_name = map[int32]string{
+		0: "LINT_CODE_UNSPECIFIED",
+		1: "LINT_CODE_TEMPLATE_ERROR",
+		2: "LINT_CODE_UNCLOSED_ACTION",
+		3: "LINT_CODE_MISSING_VARIABLE",
+		4: "LINT_CODE_UNUSED_VARIABLE",
+		5: "LINT_CODE_LOWERCASE_VARIABLE",
+		6: "LINT_CODE_XML_MODE_WITHOUT_REPLY",
+		7: "LINT_CODE_CONTEXT_WINDOW_EXCEEDED",
+	}
+	LintCode_value = map[string]int32{
+		"LINT_CODE_UNSPECIFIED":             0,
+		"LINT_CODE_TEMPLATE_ERROR":          1,
+		"LINT_CODE_UNCLOSED_ACTION":         2,
+		"LINT_CODE_MISSING_VARIABLE":        3,
+		"LINT_CODE_UNUSED_VARIABLE":         4,
+		"LINT_CODE_LOWERCASE_VARIABLE":      5,
+		"LINT_CODE_XML_MODE_WITHOUT_REPLY":  6,
+		"LINT_CODE_CONTEXT_WINDOW_EXCEEDED": 7,
 	}
-	return nil
+)
+
+func (x LintCode) Enum() *LintCode {
+	p := new(LintCode)
+	*p = x
+	return p
 }
 
-func (x *InferMessage) GetShouldCache() bool {
-	if x != nil {
-		return x.ShouldCache
-	}
-	return false
+func (x LintCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type InferRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (LintCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_eval_v1_eval_proto_enumTypes[8].Descriptor()
+}
 
-	RequestId      string          `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Messages       []*InferMessage `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
-	ModelConfig    *ModelConfig    `protobuf:"bytes,3,opt,name=model_config,json=modelConfig,proto3" json:"model_config,omitempty"`
-	MessageOptions *MessageOptions `protobuf:"bytes,4,opt,name=message_options,json=messageOptions,proto3" json:"message_options,omitempty"`
+func (LintCode) Type() protoreflect.EnumType {
+	return &file_eval_v1_eval_proto_enumTypes[8]
 }
 
-func (x *InferRequest) Reset() {
-	*x = InferRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x LintCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *InferRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use LintCode.Descriptor instead.
+func (LintCode) EnumDescriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{8}
 }
 
-func (*InferRequest) ProtoMessage() {}
+type LabelAction int32
 
-func (x *InferRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	LabelAction_LABEL_ACTION_UNSPECIFIED LabelAction = 0
+	LabelAction_LABEL_ACTION_PROMOTE     LabelAction = 1
+	LabelAction_LABEL_ACTION_ROLLBACK    LabelAction = 2
+)
+
+// Enum value maps for LabelAction.
+var (
+	LabelAction_name = map[int32]string{
+		0: "LABEL_ACTION_UNSPECIFIED",
+		1: "LABEL_ACTION_PROMOTE",
+		2: "LABEL_ACTION_ROLLBACK",
 	}
-	return mi.MessageOf(x)
+	LabelAction_value = map[string]int32{
+		"LABEL_ACTION_UNSPECIFIED": 0,
+		"LABEL_ACTION_PROMOTE":     1,
+		"LABEL_ACTION_ROLLBACK":    2,
+	}
+)
+
+func (x LabelAction) Enum() *LabelAction {
+	p := new(LabelAction)
+	*p = x
+	return p
 }
 
-// Deprecated: Use InferRequest.ProtoReflect.Descriptor instead.
-func (*InferRequest) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{5}
+func (x LabelAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *InferRequest) GetRequestId() string {
-	if x != nil {
-		return x.RequestId
-	}
-	return ""
+func (LabelAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_eval_v1_eval_proto_enumTypes[9].Descriptor()
 }
 
-func (x *InferRequest) GetMessages() []*InferMessage {
-	if x != nil {
-		return x.Messages
-	}
-	return nil
+func (LabelAction) Type() protoreflect.EnumType {
+	return &file_eval_v1_eval_proto_enumTypes[9]
 }
 
-func (x *InferRequest) GetModelConfig() *ModelConfig {
-	if x != nil {
-		return x.ModelConfig
-	}
-	return nil
+func (x LabelAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *InferRequest) GetMessageOptions() *MessageOptions {
-	if x != nil {
-		return x.MessageOptions
-	}
-	return nil
+// Deprecated: Use LabelAction.Descriptor instead.
+func (LabelAction) EnumDescriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{9}
 }
 
-type EvaluationRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type GenerationMode int32
 
-	WorkspaceId               string    `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
-	TestCase                  *TestCase `protobuf:"bytes,2,opt,name=test_case,json=testCase,proto3" json:"test_case,omitempty"`
-	VersionNumber             uint32    `protobuf:"varint,3,opt,name=version_number,json=versionNumber,proto3" json:"version_number,omitempty"`
-	SystemPromptVersionNumber uint32    `protobuf:"varint,4,opt,name=system_prompt_version_number,json=systemPromptVersionNumber,proto3" json:"system_prompt_version_number,omitempty"`
-}
+const (
+	// realistic inputs, like the ones the prompt will typically see
+	GenerationMode_GENERATION_MODE_TYPICAL GenerationMode = 0
+	// hostile inputs that try to break the prompt, tagged by attack category
+	GenerationMode_GENERATION_MODE_ADVERSARIAL GenerationMode = 1
+)
 
-func (x *EvaluationRequest) Reset() {
-	*x = EvaluationRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for GenerationMode.
+var (
+	GenerationMode_name = map[int32]string{
+		0: "GENERATION_MODE_TYPICAL",
+		1: "GENERATION_MODE_ADVERSARIAL",
 	}
-}
+	GenerationMode_value = map[string]int32{
+		"GENERATION_MODE_TYPICAL":     0,
+		"GENERATION_MODE_ADVERSARIAL": 1,
+	}
+)
 
-func (x *EvaluationRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x GenerationMode) Enum() *GenerationMode {
+	p := new(GenerationMode)
+	*p = x
+	return p
 }
 
-func (*EvaluationRequest) ProtoMessage() {}
+func (x GenerationMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *EvaluationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (GenerationMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_eval_v1_eval_proto_enumTypes[10].Descriptor()
 }
 
-// Deprecated: Use EvaluationRequest.ProtoReflect.Descriptor instead.
-func (*EvaluationRequest) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{6}
+func (GenerationMode) Type() protoreflect.EnumType {
+	return &file_eval_v1_eval_proto_enumTypes[10]
 }
 
-func (x *EvaluationRequest) GetWorkspaceId() string {
-	if x != nil {
-		return x.WorkspaceId
-	}
-	return ""
+func (x GenerationMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *EvaluationRequest) GetTestCase() *TestCase {
-	if x != nil {
-		return x.TestCase
-	}
-	return nil
+// Deprecated: Use GenerationMode.Descriptor instead.
+func (GenerationMode) EnumDescriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *EvaluationRequest) GetVersionNumber() uint32 {
-	if x != nil {
-		return x.VersionNumber
+type AttackCategory int32
+
+const (
+	AttackCategory_ATTACK_CATEGORY_UNSPECIFIED                AttackCategory = 0
+	AttackCategory_ATTACK_CATEGORY_PROMPT_INJECTION           AttackCategory = 1
+	AttackCategory_ATTACK_CATEGORY_JAILBREAK                  AttackCategory = 2
+	AttackCategory_ATTACK_CATEGORY_EDGE_CASE_LENGTH           AttackCategory = 3
+	AttackCategory_ATTACK_CATEGORY_UNICODE                    AttackCategory = 4
+	AttackCategory_ATTACK_CATEGORY_CONTRADICTORY_INSTRUCTIONS AttackCategory = 5
+)
+
+// Enum value maps for AttackCategory.
+var (
+	AttackCategory_name = map[int32]string{
+		0: "ATTACK_CATEGORY_UNSPECIFIED",
+		1: "ATTACK_CATEGORY_PROMPT_INJECTION",
+		2: "ATTACK_CATEGORY_JAILBREAK",
+		3: "ATTACK_CATEGORY_EDGE_CASE_LENGTH",
+		4: "ATTACK_CATEGORY_UNICODE",
+		5: "ATTACK_CATEGORY_CONTRADICTORY_INSTRUCTIONS",
+	}
+	AttackCategory_value = map[string]int32{
+		"ATTACK_CATEGORY_UNSPECIFIED":                0,
+		"ATTACK_CATEGORY_PROMPT_INJECTION":           1,
+		"ATTACK_CATEGORY_JAILBREAK":                  2,
+		"ATTACK_CATEGORY_EDGE_CASE_LENGTH":           3,
+		"ATTACK_CATEGORY_UNICODE":                    4,
+		"ATTACK_CATEGORY_CONTRADICTORY_INSTRUCTIONS": 5,
 	}
-	return 0
+)
+
+func (x AttackCategory) Enum() *AttackCategory {
+	p := new(AttackCategory)
+	*p = x
+	return p
 }
 
-func (x *EvaluationRequest) GetSystemPromptVersionNumber() uint32 {
-	if x != nil {
-		return x.SystemPromptVersionNumber
-	}
-	return 0
+func (x AttackCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type EvaluationResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (AttackCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_eval_v1_eval_proto_enumTypes[11].Descriptor()
+}
 
-	Result []*TestResult `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+func (AttackCategory) Type() protoreflect.EnumType {
+	return &file_eval_v1_eval_proto_enumTypes[11]
 }
 
-func (x *EvaluationResponse) Reset() {
-	*x = EvaluationResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x AttackCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *EvaluationResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use AttackCategory.Descriptor instead.
+func (AttackCategory) EnumDescriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{11}
 }
 
-func (*EvaluationResponse) ProtoMessage() {}
+type ExampleStrategy int32
 
-func (x *EvaluationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	// the oldest examples in the bank
+	ExampleStrategy_EXAMPLE_STRATEGY_FIXED ExampleStrategy = 0
+	// a random sample, seeded from the settings' seed and the test case
+	ExampleStrategy_EXAMPLE_STRATEGY_RANDOM ExampleStrategy = 1
+	// the examples whose inputs share the most words with the test case
+	ExampleStrategy_EXAMPLE_STRATEGY_SIMILAR ExampleStrategy = 2
+)
+
+// Enum value maps for ExampleStrategy.
+var (
+	ExampleStrategy_name = map[int32]string{
+		0: "EXAMPLE_STRATEGY_FIXED",
+		1: "EXAMPLE_STRATEGY_RANDOM",
+		2: "EXAMPLE_STRATEGY_SIMILAR",
 	}
-	return mi.MessageOf(x)
+	ExampleStrategy_value = map[string]int32{
+		"EXAMPLE_STRATEGY_FIXED":   0,
+		"EXAMPLE_STRATEGY_RANDOM":  1,
+		"EXAMPLE_STRATEGY_SIMILAR": 2,
+	}
+)
+
+func (x ExampleStrategy) Enum() *ExampleStrategy {
+	p := new(ExampleStrategy)
+	*p = x
+	return p
 }
 
-// Deprecated: Use EvaluationResponse.ProtoReflect.Descriptor instead.
-func (*EvaluationResponse) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{7}
+func (x ExampleStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *EvaluationResponse) GetResult() []*TestResult {
-	if x != nil {
-		return x.Result
-	}
-	return nil
+func (ExampleStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_eval_v1_eval_proto_enumTypes[12].Descriptor()
 }
 
-type WorkspaceConfig struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (ExampleStrategy) Type() protoreflect.EnumType {
+	return &file_eval_v1_eval_proto_enumTypes[12]
+}
 
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	ModelConfigName string                 `protobuf:"bytes,3,opt,name=model_config_name,json=modelConfigName,proto3" json:"model_config_name,omitempty"`
-	MessageOptions  *MessageOptions        `protobuf:"bytes,4,opt,name=message_options,json=messageOptions,proto3" json:"message_options,omitempty"`
-	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Active          bool                   `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
+func (x ExampleStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *WorkspaceConfig) Reset() {
-	*x = WorkspaceConfig{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Deprecated: Use ExampleStrategy.Descriptor instead.
+func (ExampleStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{12}
+}
+
+type RatingScale int32
+
+const (
+	RatingScale_RATING_SCALE_THUMBS RatingScale = 0
+	RatingScale_RATING_SCALE_SCORE  RatingScale = 1
+)
+
+// Enum value maps for RatingScale.
+var (
+	RatingScale_name = map[int32]string{
+		0: "RATING_SCALE_THUMBS",
+		1: "RATING_SCALE_SCORE",
 	}
+	RatingScale_value = map[string]int32{
+		"RATING_SCALE_THUMBS": 0,
+		"RATING_SCALE_SCORE":  1,
+	}
+)
+
+func (x RatingScale) Enum() *RatingScale {
+	p := new(RatingScale)
+	*p = x
+	return p
 }
 
-func (x *WorkspaceConfig) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x RatingScale) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*WorkspaceConfig) ProtoMessage() {}
+func (RatingScale) Descriptor() protoreflect.EnumDescriptor {
+	return file_eval_v1_eval_proto_enumTypes[13].Descriptor()
+}
 
-func (x *WorkspaceConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (RatingScale) Type() protoreflect.EnumType {
+	return &file_eval_v1_eval_proto_enumTypes[13]
 }
 
-// Deprecated: Use WorkspaceConfig.ProtoReflect.Descriptor instead.
-func (*WorkspaceConfig) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{8}
+func (x RatingScale) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *WorkspaceConfig) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use RatingScale.Descriptor instead.
+func (RatingScale) EnumDescriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *WorkspaceConfig) GetName() string {
-	if x != nil {
-		return x.Name
+type GraderType int32
+
+const (
+	GraderType_GRADER_TYPE_UNSPECIFIED GraderType = 0
+	GraderType_GRADER_TYPE_EXTERNAL    GraderType = 1
+	GraderType_GRADER_TYPE_JUDGE       GraderType = 2
+)
+
+// Enum value maps for GraderType.
+var (
+	GraderType_name = map[int32]string{
+		0: "GRADER_TYPE_UNSPECIFIED",
+		1: "GRADER_TYPE_EXTERNAL",
+		2: "GRADER_TYPE_JUDGE",
 	}
-	return ""
+	GraderType_value = map[string]int32{
+		"GRADER_TYPE_UNSPECIFIED": 0,
+		"GRADER_TYPE_EXTERNAL":    1,
+		"GRADER_TYPE_JUDGE":       2,
+	}
+)
+
+func (x GraderType) Enum() *GraderType {
+	p := new(GraderType)
+	*p = x
+	return p
 }
 
-func (x *WorkspaceConfig) GetModelConfigName() string {
-	if x != nil {
-		return x.ModelConfigName
-	}
-	return ""
+func (x GraderType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *WorkspaceConfig) GetMessageOptions() *MessageOptions {
-	if x != nil {
-		return x.MessageOptions
-	}
-	return nil
+func (GraderType) Descriptor() protoreflect.EnumDescriptor {
+	return file_eval_v1_eval_proto_enumTypes[14].Descriptor()
 }
 
-func (x *WorkspaceConfig) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+func (GraderType) Type() protoreflect.EnumType {
+	return &file_eval_v1_eval_proto_enumTypes[14]
 }
 
-func (x *WorkspaceConfig) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return nil
+func (x GraderType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *WorkspaceConfig) GetActive() bool {
-	if x != nil {
-		return x.Active
+// Deprecated: Use GraderType.Descriptor instead.
+func (GraderType) EnumDescriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{14}
+}
+
+// OptimizationScoring is how candidates are scored. There is no separate assertions
+// source: assertions run as an external grader, whose pass/fail grades GRADER
+// scoring uses.
+type OptimizationScoring int32
+
+const (
+	// a grader scores every version and picks its failing cases
+	OptimizationScoring_OPTIMIZATION_SCORING_GRADER OptimizationScoring = 0
+	// rejected by OptimizePrompt: candidates are new versions that nobody has rated
+	OptimizationScoring_OPTIMIZATION_SCORING_RATINGS OptimizationScoring = 1
+)
+
+// Enum value maps for OptimizationScoring.
+var (
+	OptimizationScoring_name = map[int32]string{
+		0: "OPTIMIZATION_SCORING_GRADER",
+		1: "OPTIMIZATION_SCORING_RATINGS",
 	}
-	return false
+	OptimizationScoring_value = map[string]int32{
+		"OPTIMIZATION_SCORING_GRADER":  0,
+		"OPTIMIZATION_SCORING_RATINGS": 1,
+	}
+)
+
+func (x OptimizationScoring) Enum() *OptimizationScoring {
+	p := new(OptimizationScoring)
+	*p = x
+	return p
 }
 
-type CreateWorkspaceConfigRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x OptimizationScoring) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-	WorkspaceId     string          `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
-	Name            string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	ModelConfigName string          `protobuf:"bytes,3,opt,name=model_config_name,json=modelConfigName,proto3" json:"model_config_name,omitempty"`
-	MessageOptions  *MessageOptions `protobuf:"bytes,4,opt,name=message_options,json=messageOptions,proto3" json:"message_options,omitempty"`
+func (OptimizationScoring) Descriptor() protoreflect.EnumDescriptor {
+	return file_eval_v1_eval_proto_enumTypes[15].Descriptor()
 }
 
-func (x *CreateWorkspaceConfigRequest) Reset() {
-	*x = CreateWorkspaceConfigRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (OptimizationScoring) Type() protoreflect.EnumType {
+	return &file_eval_v1_eval_proto_enumTypes[15]
 }
 
-func (x *CreateWorkspaceConfigRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x OptimizationScoring) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (*CreateWorkspaceConfigRequest) ProtoMessage() {}
+// Deprecated: Use OptimizationScoring.Descriptor instead.
+func (OptimizationScoring) EnumDescriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{15}
+}
 
-func (x *CreateWorkspaceConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+type OptimizationStopReason int32
+
+const (
+	OptimizationStopReason_OPTIMIZATION_STOP_REASON_UNSPECIFIED OptimizationStopReason = 0
+	OptimizationStopReason_OPTIMIZATION_STOP_REASON_MAX_ROUNDS  OptimizationStopReason = 1
+	// a round did not improve the best score by min_improvement
+	OptimizationStopReason_OPTIMIZATION_STOP_REASON_PLATEAU       OptimizationStopReason = 2
+	OptimizationStopReason_OPTIMIZATION_STOP_REASON_NO_FAILURES   OptimizationStopReason = 3
+	OptimizationStopReason_OPTIMIZATION_STOP_REASON_NO_CANDIDATES OptimizationStopReason = 4
+)
+
+// Enum value maps for OptimizationStopReason.
+var (
+	OptimizationStopReason_name = map[int32]string{
+		0: "OPTIMIZATION_STOP_REASON_UNSPECIFIED",
+		1: "OPTIMIZATION_STOP_REASON_MAX_ROUNDS",
+		2: "OPTIMIZATION_STOP_REASON_PLATEAU",
+		3: "OPTIMIZATION_STOP_REASON_NO_FAILURES",
+		4: "OPTIMIZATION_STOP_REASON_NO_CANDIDATES",
+	}
+	OptimizationStopReason_value = map[string]int32{
+		"OPTIMIZATION_STOP_REASON_UNSPECIFIED":   0,
+		"OPTIMIZATION_STOP_REASON_MAX_ROUNDS":    1,
+		"OPTIMIZATION_STOP_REASON_PLATEAU":       2,
+		"OPTIMIZATION_STOP_REASON_NO_FAILURES":   3,
+		"OPTIMIZATION_STOP_REASON_NO_CANDIDATES": 4,
 	}
-	return mi.MessageOf(x)
+)
+
+func (x OptimizationStopReason) Enum() *OptimizationStopReason {
+	p := new(OptimizationStopReason)
+	*p = x
+	return p
 }
 
-// Deprecated: Use CreateWorkspaceConfigRequest.ProtoReflect.Descriptor instead.
-func (*CreateWorkspaceConfigRequest) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{9}
+func (x OptimizationStopReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *CreateWorkspaceConfigRequest) GetWorkspaceId() string {
-	if x != nil {
-		return x.WorkspaceId
-	}
-	return ""
+func (OptimizationStopReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_eval_v1_eval_proto_enumTypes[16].Descriptor()
 }
 
-func (x *CreateWorkspaceConfigRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+func (OptimizationStopReason) Type() protoreflect.EnumType {
+	return &file_eval_v1_eval_proto_enumTypes[16]
 }
 
-func (x *CreateWorkspaceConfigRequest) GetModelConfigName() string {
-	if x != nil {
-		return x.ModelConfigName
-	}
-	return ""
+func (x OptimizationStopReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *CreateWorkspaceConfigRequest) GetMessageOptions() *MessageOptions {
-	if x != nil {
-		return x.MessageOptions
-	}
-	return nil
+// Deprecated: Use OptimizationStopReason.Descriptor instead.
+func (OptimizationStopReason) EnumDescriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{16}
 }
 
-type CreateWorkspaceConfigResponse struct {
+type Variable struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WorkspaceConfig *WorkspaceConfig `protobuf:"bytes,1,opt,name=workspace_config,json=workspaceConfig,proto3" json:"workspace_config,omitempty"`
+	Name string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type VariableType `protobuf:"varint,2,opt,name=type,proto3,enum=eval.v1.VariableType" json:"type,omitempty"`
 }
 
-func (x *CreateWorkspaceConfigResponse) Reset() {
-	*x = CreateWorkspaceConfigResponse{}
+func (x *Variable) Reset() {
+	*x = Variable{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[10]
+		mi := &file_eval_v1_eval_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateWorkspaceConfigResponse) String() string {
+func (x *Variable) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateWorkspaceConfigResponse) ProtoMessage() {}
+func (*Variable) ProtoMessage() {}
 
-func (x *CreateWorkspaceConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[10]
+func (x *Variable) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -783,54 +955,54 @@ func (x *CreateWorkspaceConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateWorkspaceConfigResponse.ProtoReflect.Descriptor instead.
-func (*CreateWorkspaceConfigResponse) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use Variable.ProtoReflect.Descriptor instead.
+func (*Variable) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *CreateWorkspaceConfigResponse) GetWorkspaceConfig() *WorkspaceConfig {
+func (x *Variable) GetName() string {
 	if x != nil {
-		return x.WorkspaceConfig
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type Workspace struct {
+func (x *Variable) GetType() VariableType {
+	if x != nil {
+		return x.Type
+	}
+	return VariableType_TEXT
+}
+
+type VariableValue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                               string                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name                             string                    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	ModelConfigNames                 []string                  `protobuf:"bytes,3,rep,name=model_config_names,json=modelConfigNames,proto3" json:"model_config_names,omitempty"`
-	CreatedAt                        *timestamppb.Timestamp    `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt                        *timestamppb.Timestamp    `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Prompts                          []*Workspace_Prompt       `protobuf:"bytes,6,rep,name=prompts,proto3" json:"prompts,omitempty"`
-	CurrentPromptVersionNumber       uint32                    `protobuf:"varint,7,opt,name=current_prompt_version_number,json=currentPromptVersionNumber,proto3" json:"current_prompt_version_number,omitempty"`
-	WorkspaceConfigs                 []*WorkspaceConfig        `protobuf:"bytes,8,rep,name=workspace_configs,json=workspaceConfigs,proto3" json:"workspace_configs,omitempty"`
-	ActiveVersionNumbers             []uint32                  `protobuf:"varint,9,rep,packed,name=active_version_numbers,json=activeVersionNumbers,proto3" json:"active_version_numbers,omitempty"`
-	SystemPrompts                    []*Workspace_SystemPrompt `protobuf:"bytes,10,rep,name=system_prompts,json=systemPrompts,proto3" json:"system_prompts,omitempty"`
-	CurrentSystemPromptVersionNumber uint32                    `protobuf:"varint,11,opt,name=current_system_prompt_version_number,json=currentSystemPromptVersionNumber,proto3" json:"current_system_prompt_version_number,omitempty"`
-	XMLMode                          bool                      `protobuf:"varint,12,opt,name=XMLMode,proto3" json:"XMLMode,omitempty"`
+	// Types that are assignable to Value:
+	//
+	//	*VariableValue_TextValue
+	//	*VariableValue_ImageValue
+	Value isVariableValue_Value `protobuf_oneof:"value"`
 }
 
-func (x *Workspace) Reset() {
-	*x = Workspace{}
+func (x *VariableValue) Reset() {
+	*x = VariableValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[11]
+		mi := &file_eval_v1_eval_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Workspace) String() string {
+func (x *VariableValue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Workspace) ProtoMessage() {}
+func (*VariableValue) ProtoMessage() {}
 
-func (x *Workspace) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[11]
+func (x *VariableValue) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -841,126 +1013,137 @@ func (x *Workspace) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Workspace.ProtoReflect.Descriptor instead.
-func (*Workspace) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use VariableValue.ProtoReflect.Descriptor instead.
+func (*VariableValue) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *Workspace) GetId() string {
-	if x != nil {
-		return x.Id
+func (m *VariableValue) GetValue() isVariableValue_Value {
+	if m != nil {
+		return m.Value
 	}
-	return ""
+	return nil
 }
 
-func (x *Workspace) GetName() string {
-	if x != nil {
-		return x.Name
+func (x *VariableValue) GetTextValue() string {
+	if x, ok := x.GetValue().(*VariableValue_TextValue); ok {
+		return x.TextValue
 	}
 	return ""
 }
 
-func (x *Workspace) GetModelConfigNames() []string {
-	if x != nil {
-		return x.ModelConfigNames
+func (x *VariableValue) GetImageValue() []byte {
+	if x, ok := x.GetValue().(*VariableValue_ImageValue); ok {
+		return x.ImageValue
 	}
 	return nil
 }
 
-func (x *Workspace) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+type isVariableValue_Value interface {
+	isVariableValue_Value()
 }
 
-func (x *Workspace) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return nil
+type VariableValue_TextValue struct {
+	TextValue string `protobuf:"bytes,1,opt,name=text_value,json=textValue,proto3,oneof"`
 }
 
-func (x *Workspace) GetPrompts() []*Workspace_Prompt {
-	if x != nil {
-		return x.Prompts
-	}
-	return nil
+type VariableValue_ImageValue struct {
+	ImageValue []byte `protobuf:"bytes,2,opt,name=image_value,json=imageValue,proto3,oneof"`
 }
 
-func (x *Workspace) GetCurrentPromptVersionNumber() uint32 {
-	if x != nil {
-		return x.CurrentPromptVersionNumber
-	}
-	return 0
+func (*VariableValue_TextValue) isVariableValue_Value() {}
+
+func (*VariableValue_ImageValue) isVariableValue_Value() {}
+
+type ModelConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProviderType string `protobuf:"bytes,1,opt,name=provider_type,json=providerType,proto3" json:"provider_type,omitempty"`
+	ModelName    string `protobuf:"bytes,2,opt,name=model_name,json=modelName,proto3" json:"model_name,omitempty"`
+	BaseUrl      string `protobuf:"bytes,3,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`
 }
 
-func (x *Workspace) GetWorkspaceConfigs() []*WorkspaceConfig {
-	if x != nil {
-		return x.WorkspaceConfigs
+func (x *ModelConfig) Reset() {
+	*x = ModelConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Workspace) GetActiveVersionNumbers() []uint32 {
-	if x != nil {
-		return x.ActiveVersionNumbers
+func (x *ModelConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelConfig) ProtoMessage() {}
+
+func (x *ModelConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Workspace) GetSystemPrompts() []*Workspace_SystemPrompt {
+// Deprecated: Use ModelConfig.ProtoReflect.Descriptor instead.
+func (*ModelConfig) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ModelConfig) GetProviderType() string {
 	if x != nil {
-		return x.SystemPrompts
+		return x.ProviderType
 	}
-	return nil
+	return ""
 }
 
-func (x *Workspace) GetCurrentSystemPromptVersionNumber() uint32 {
+func (x *ModelConfig) GetModelName() string {
 	if x != nil {
-		return x.CurrentSystemPromptVersionNumber
+		return x.ModelName
 	}
-	return 0
+	return ""
 }
 
-func (x *Workspace) GetXMLMode() bool {
+func (x *ModelConfig) GetBaseUrl() string {
 	if x != nil {
-		return x.XMLMode
+		return x.BaseUrl
 	}
-	return false
+	return ""
 }
 
-type TestCase struct {
+type MessageOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id               string                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	WorkspaceId      string                    `protobuf:"bytes,2,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
-	VariableValues   map[string]*VariableValue `protobuf:"bytes,3,rep,name=variable_values,json=variableValues,proto3" json:"variable_values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Response         *string                   `protobuf:"bytes,4,opt,name=response,proto3,oneof" json:"response,omitempty"`
-	HasBeenEvaluated bool                      `protobuf:"varint,5,opt,name=has_been_evaluated,json=hasBeenEvaluated,proto3" json:"has_been_evaluated,omitempty"`
-	CreatedAt        *timestamppb.Timestamp    `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt        *timestamppb.Timestamp    `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	MaxTokens   int32   `protobuf:"varint,1,opt,name=max_tokens,json=maxTokens,proto3" json:"max_tokens,omitempty"`
+	Temperature float32 `protobuf:"fixed32,2,opt,name=temperature,proto3" json:"temperature,omitempty"`
 }
 
-func (x *TestCase) Reset() {
-	*x = TestCase{}
+func (x *MessageOptions) Reset() {
+	*x = MessageOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[12]
+		mi := &file_eval_v1_eval_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TestCase) String() string {
+func (x *MessageOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TestCase) ProtoMessage() {}
+func (*MessageOptions) ProtoMessage() {}
 
-func (x *TestCase) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[12]
+func (x *MessageOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -971,95 +1154,124 @@ func (x *TestCase) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TestCase.ProtoReflect.Descriptor instead.
-func (*TestCase) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use MessageOptions.ProtoReflect.Descriptor instead.
+func (*MessageOptions) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *TestCase) GetId() string {
+func (x *MessageOptions) GetMaxTokens() int32 {
 	if x != nil {
-		return x.Id
+		return x.MaxTokens
 	}
-	return ""
+	return 0
 }
 
-func (x *TestCase) GetWorkspaceId() string {
+func (x *MessageOptions) GetTemperature() float32 {
 	if x != nil {
-		return x.WorkspaceId
+		return x.Temperature
 	}
-	return ""
+	return 0
 }
 
-func (x *TestCase) GetVariableValues() map[string]*VariableValue {
-	if x != nil {
-		return x.VariableValues
+type InferMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content     string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Role        string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Image       []byte `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	ShouldCache bool   `protobuf:"varint,4,opt,name=should_cache,json=shouldCache,proto3" json:"should_cache,omitempty"`
+}
+
+func (x *InferMessage) Reset() {
+	*x = InferMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *TestCase) GetResponse() string {
-	if x != nil && x.Response != nil {
-		return *x.Response
+func (x *InferMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InferMessage) ProtoMessage() {}
+
+func (x *InferMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InferMessage.ProtoReflect.Descriptor instead.
+func (*InferMessage) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *InferMessage) GetContent() string {
+	if x != nil {
+		return x.Content
 	}
 	return ""
 }
 
-func (x *TestCase) GetHasBeenEvaluated() bool {
+func (x *InferMessage) GetRole() string {
 	if x != nil {
-		return x.HasBeenEvaluated
+		return x.Role
 	}
-	return false
+	return ""
 }
 
-func (x *TestCase) GetCreatedAt() *timestamppb.Timestamp {
+func (x *InferMessage) GetImage() []byte {
 	if x != nil {
-		return x.CreatedAt
+		return x.Image
 	}
 	return nil
 }
 
-func (x *TestCase) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *InferMessage) GetShouldCache() bool {
 	if x != nil {
-		return x.UpdatedAt
+		return x.ShouldCache
 	}
-	return nil
+	return false
 }
 
-type TestResult struct {
+type InferRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TestCaseId          string                 `protobuf:"bytes,2,opt,name=test_case_id,json=testCaseId,proto3" json:"test_case_id,omitempty"`
-	Response            string                 `protobuf:"bytes,3,opt,name=response,proto3" json:"response,omitempty"`
-	PromptVersionNumber uint32                 `protobuf:"varint,4,opt,name=prompt_version_number,json=promptVersionNumber,proto3" json:"prompt_version_number,omitempty"`
-	ModelConfigName     string                 `protobuf:"bytes,5,opt,name=model_config_name,json=modelConfigName,proto3" json:"model_config_name,omitempty"`
-	MessageOptions      *MessageOptions        `protobuf:"bytes,6,opt,name=message_options,json=messageOptions,proto3" json:"message_options,omitempty"`
-	WorkspaceConfigId   string                 `protobuf:"bytes,7,opt,name=workspace_config_id,json=workspaceConfigId,proto3" json:"workspace_config_id,omitempty"`
-	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt           *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	// rating is -1 (thumbs down), 0 (unrated), or 1 (thumbs up)
-	Rating int32 `protobuf:"varint,10,opt,name=rating,proto3" json:"rating,omitempty"`
+	RequestId      string          `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Messages       []*InferMessage `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	ModelConfig    *ModelConfig    `protobuf:"bytes,3,opt,name=model_config,json=modelConfig,proto3" json:"model_config,omitempty"`
+	MessageOptions *MessageOptions `protobuf:"bytes,4,opt,name=message_options,json=messageOptions,proto3" json:"message_options,omitempty"`
 }
 
-func (x *TestResult) Reset() {
-	*x = TestResult{}
+func (x *InferRequest) Reset() {
+	*x = InferRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[13]
+		mi := &file_eval_v1_eval_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TestResult) String() string {
+func (x *InferRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TestResult) ProtoMessage() {}
+func (*InferRequest) ProtoMessage() {}
 
-func (x *TestResult) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[13]
+func (x *InferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1070,108 +1282,69 @@ func (x *TestResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TestResult.ProtoReflect.Descriptor instead.
-func (*TestResult) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use InferRequest.ProtoReflect.Descriptor instead.
+func (*InferRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *TestResult) GetId() string {
+func (x *InferRequest) GetRequestId() string {
 	if x != nil {
-		return x.Id
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *TestResult) GetTestCaseId() string {
+func (x *InferRequest) GetMessages() []*InferMessage {
 	if x != nil {
-		return x.TestCaseId
+		return x.Messages
 	}
-	return ""
+	return nil
 }
 
-func (x *TestResult) GetResponse() string {
-	if x != nil {
-		return x.Response
-	}
-	return ""
-}
-
-func (x *TestResult) GetPromptVersionNumber() uint32 {
-	if x != nil {
-		return x.PromptVersionNumber
-	}
-	return 0
-}
-
-func (x *TestResult) GetModelConfigName() string {
-	if x != nil {
-		return x.ModelConfigName
-	}
-	return ""
-}
-
-func (x *TestResult) GetMessageOptions() *MessageOptions {
-	if x != nil {
-		return x.MessageOptions
-	}
-	return nil
-}
-
-func (x *TestResult) GetWorkspaceConfigId() string {
-	if x != nil {
-		return x.WorkspaceConfigId
-	}
-	return ""
-}
-
-func (x *TestResult) GetCreatedAt() *timestamppb.Timestamp {
+func (x *InferRequest) GetModelConfig() *ModelConfig {
 	if x != nil {
-		return x.CreatedAt
+		return x.ModelConfig
 	}
 	return nil
 }
 
-func (x *TestResult) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *InferRequest) GetMessageOptions() *MessageOptions {
 	if x != nil {
-		return x.UpdatedAt
+		return x.MessageOptions
 	}
 	return nil
 }
 
-func (x *TestResult) GetRating() int32 {
-	if x != nil {
-		return x.Rating
-	}
-	return 0
-}
-
-// CRUD operation messages
-type CreateWorkspaceRequest struct {
+type EvaluationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	WorkspaceId               string    `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	TestCase                  *TestCase `protobuf:"bytes,2,opt,name=test_case,json=testCase,proto3" json:"test_case,omitempty"`
+	VersionNumber             uint32    `protobuf:"varint,3,opt,name=version_number,json=versionNumber,proto3" json:"version_number,omitempty"`
+	SystemPromptVersionNumber uint32    `protobuf:"varint,4,opt,name=system_prompt_version_number,json=systemPromptVersionNumber,proto3" json:"system_prompt_version_number,omitempty"`
+	// run the versions a label points at instead of version numbers
+	Label string `protobuf:"bytes,5,opt,name=label,proto3" json:"label,omitempty"`
 }
 
-func (x *CreateWorkspaceRequest) Reset() {
-	*x = CreateWorkspaceRequest{}
+func (x *EvaluationRequest) Reset() {
+	*x = EvaluationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[14]
+		mi := &file_eval_v1_eval_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateWorkspaceRequest) String() string {
+func (x *EvaluationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateWorkspaceRequest) ProtoMessage() {}
+func (*EvaluationRequest) ProtoMessage() {}
 
-func (x *CreateWorkspaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[14]
+func (x *EvaluationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1182,50 +1355,71 @@ func (x *CreateWorkspaceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateWorkspaceRequest.ProtoReflect.Descriptor instead.
-func (*CreateWorkspaceRequest) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use EvaluationRequest.ProtoReflect.Descriptor instead.
+func (*EvaluationRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *CreateWorkspaceRequest) GetName() string {
+func (x *EvaluationRequest) GetWorkspaceId() string {
 	if x != nil {
-		return x.Name
+		return x.WorkspaceId
 	}
 	return ""
 }
 
-func (x *CreateWorkspaceRequest) GetContent() string {
+func (x *EvaluationRequest) GetTestCase() *TestCase {
 	if x != nil {
-		return x.Content
+		return x.TestCase
+	}
+	return nil
+}
+
+func (x *EvaluationRequest) GetVersionNumber() uint32 {
+	if x != nil {
+		return x.VersionNumber
+	}
+	return 0
+}
+
+func (x *EvaluationRequest) GetSystemPromptVersionNumber() uint32 {
+	if x != nil {
+		return x.SystemPromptVersionNumber
+	}
+	return 0
+}
+
+func (x *EvaluationRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
 	}
 	return ""
 }
 
-type GetWorkspaceRequest struct {
+type EvaluationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Result []*TestResult `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
 }
 
-func (x *GetWorkspaceRequest) Reset() {
-	*x = GetWorkspaceRequest{}
+func (x *EvaluationResponse) Reset() {
+	*x = EvaluationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[15]
+		mi := &file_eval_v1_eval_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetWorkspaceRequest) String() string {
+func (x *EvaluationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWorkspaceRequest) ProtoMessage() {}
+func (*EvaluationResponse) ProtoMessage() {}
 
-func (x *GetWorkspaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[15]
+func (x *EvaluationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1236,44 +1430,49 @@ func (x *GetWorkspaceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWorkspaceRequest.ProtoReflect.Descriptor instead.
-func (*GetWorkspaceRequest) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use EvaluationResponse.ProtoReflect.Descriptor instead.
+func (*EvaluationResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetWorkspaceRequest) GetId() string {
+func (x *EvaluationResponse) GetResult() []*TestResult {
 	if x != nil {
-		return x.Id
+		return x.Result
 	}
-	return ""
+	return nil
 }
 
-type ListWorkspacesRequest struct {
+type WorkspaceConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page     int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ModelConfigName string                 `protobuf:"bytes,3,opt,name=model_config_name,json=modelConfigName,proto3" json:"model_config_name,omitempty"`
+	MessageOptions  *MessageOptions        `protobuf:"bytes,4,opt,name=message_options,json=messageOptions,proto3" json:"message_options,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Active          bool                   `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
 }
 
-func (x *ListWorkspacesRequest) Reset() {
-	*x = ListWorkspacesRequest{}
+func (x *WorkspaceConfig) Reset() {
+	*x = WorkspaceConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[16]
+		mi := &file_eval_v1_eval_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListWorkspacesRequest) String() string {
+func (x *WorkspaceConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListWorkspacesRequest) ProtoMessage() {}
+func (*WorkspaceConfig) ProtoMessage() {}
 
-func (x *ListWorkspacesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[16]
+func (x *WorkspaceConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1284,105 +1483,88 @@ func (x *ListWorkspacesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListWorkspacesRequest.ProtoReflect.Descriptor instead.
-func (*ListWorkspacesRequest) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use WorkspaceConfig.ProtoReflect.Descriptor instead.
+func (*WorkspaceConfig) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ListWorkspacesRequest) GetPage() int32 {
+func (x *WorkspaceConfig) GetId() string {
 	if x != nil {
-		return x.Page
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *ListWorkspacesRequest) GetPageSize() int32 {
+func (x *WorkspaceConfig) GetName() string {
 	if x != nil {
-		return x.PageSize
+		return x.Name
 	}
-	return 0
-}
-
-type ListWorkspacesResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Workspaces []*Workspace `protobuf:"bytes,1,rep,name=workspaces,proto3" json:"workspaces,omitempty"`
-	TotalCount int32        `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	return ""
 }
 
-func (x *ListWorkspacesResponse) Reset() {
-	*x = ListWorkspacesResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *WorkspaceConfig) GetModelConfigName() string {
+	if x != nil {
+		return x.ModelConfigName
 	}
+	return ""
 }
 
-func (x *ListWorkspacesResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListWorkspacesResponse) ProtoMessage() {}
-
-func (x *ListWorkspacesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *WorkspaceConfig) GetMessageOptions() *MessageOptions {
+	if x != nil {
+		return x.MessageOptions
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ListWorkspacesResponse.ProtoReflect.Descriptor instead.
-func (*ListWorkspacesResponse) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{17}
+func (x *WorkspaceConfig) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *ListWorkspacesResponse) GetWorkspaces() []*Workspace {
+func (x *WorkspaceConfig) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Workspaces
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-func (x *ListWorkspacesResponse) GetTotalCount() int32 {
+func (x *WorkspaceConfig) GetActive() bool {
 	if x != nil {
-		return x.TotalCount
+		return x.Active
 	}
-	return 0
+	return false
 }
 
-type GetPromptRequest struct {
+type CreateWorkspaceConfigRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WorkspaceId     string          `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Name            string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ModelConfigName string          `protobuf:"bytes,3,opt,name=model_config_name,json=modelConfigName,proto3" json:"model_config_name,omitempty"`
+	MessageOptions  *MessageOptions `protobuf:"bytes,4,opt,name=message_options,json=messageOptions,proto3" json:"message_options,omitempty"`
 }
 
-func (x *GetPromptRequest) Reset() {
-	*x = GetPromptRequest{}
+func (x *CreateWorkspaceConfigRequest) Reset() {
+	*x = CreateWorkspaceConfigRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[18]
+		mi := &file_eval_v1_eval_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPromptRequest) String() string {
+func (x *CreateWorkspaceConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPromptRequest) ProtoMessage() {}
+func (*CreateWorkspaceConfigRequest) ProtoMessage() {}
 
-func (x *GetPromptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[18]
+func (x *CreateWorkspaceConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1393,45 +1575,64 @@ func (x *GetPromptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPromptRequest.ProtoReflect.Descriptor instead.
-func (*GetPromptRequest) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use CreateWorkspaceConfigRequest.ProtoReflect.Descriptor instead.
+func (*CreateWorkspaceConfigRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetPromptRequest) GetId() string {
+func (x *CreateWorkspaceConfigRequest) GetWorkspaceId() string {
 	if x != nil {
-		return x.Id
+		return x.WorkspaceId
 	}
 	return ""
 }
 
-type ListTestCasesRequest struct {
+func (x *CreateWorkspaceConfigRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateWorkspaceConfigRequest) GetModelConfigName() string {
+	if x != nil {
+		return x.ModelConfigName
+	}
+	return ""
+}
+
+func (x *CreateWorkspaceConfigRequest) GetMessageOptions() *MessageOptions {
+	if x != nil {
+		return x.MessageOptions
+	}
+	return nil
+}
+
+type CreateWorkspaceConfigResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WorkspaceId string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
-	Page        int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize    int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	WorkspaceConfig *WorkspaceConfig `protobuf:"bytes,1,opt,name=workspace_config,json=workspaceConfig,proto3" json:"workspace_config,omitempty"`
 }
 
-func (x *ListTestCasesRequest) Reset() {
-	*x = ListTestCasesRequest{}
+func (x *CreateWorkspaceConfigResponse) Reset() {
+	*x = CreateWorkspaceConfigResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[19]
+		mi := &file_eval_v1_eval_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListTestCasesRequest) String() string {
+func (x *CreateWorkspaceConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTestCasesRequest) ProtoMessage() {}
+func (*CreateWorkspaceConfigResponse) ProtoMessage() {}
 
-func (x *ListTestCasesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[19]
+func (x *CreateWorkspaceConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1442,59 +1643,59 @@ func (x *ListTestCasesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTestCasesRequest.ProtoReflect.Descriptor instead.
-func (*ListTestCasesRequest) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *ListTestCasesRequest) GetWorkspaceId() string {
-	if x != nil {
-		return x.WorkspaceId
-	}
-	return ""
-}
-
-func (x *ListTestCasesRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
+// Deprecated: Use CreateWorkspaceConfigResponse.ProtoReflect.Descriptor instead.
+func (*CreateWorkspaceConfigResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ListTestCasesRequest) GetPageSize() int32 {
+func (x *CreateWorkspaceConfigResponse) GetWorkspaceConfig() *WorkspaceConfig {
 	if x != nil {
-		return x.PageSize
+		return x.WorkspaceConfig
 	}
-	return 0
+	return nil
 }
 
-type ListTestCasesResponse struct {
+type Workspace struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TestCases   []*TestCase   `protobuf:"bytes,1,rep,name=test_cases,json=testCases,proto3" json:"test_cases,omitempty"`
-	TotalCount  int32         `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	TestResults []*TestResult `protobuf:"bytes,3,rep,name=test_results,json=testResults,proto3" json:"test_results,omitempty"`
+	Id                               string                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                             string                    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ModelConfigNames                 []string                  `protobuf:"bytes,3,rep,name=model_config_names,json=modelConfigNames,proto3" json:"model_config_names,omitempty"`
+	CreatedAt                        *timestamppb.Timestamp    `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt                        *timestamppb.Timestamp    `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Prompts                          []*Workspace_Prompt       `protobuf:"bytes,6,rep,name=prompts,proto3" json:"prompts,omitempty"`
+	CurrentPromptVersionNumber       uint32                    `protobuf:"varint,7,opt,name=current_prompt_version_number,json=currentPromptVersionNumber,proto3" json:"current_prompt_version_number,omitempty"`
+	WorkspaceConfigs                 []*WorkspaceConfig        `protobuf:"bytes,8,rep,name=workspace_configs,json=workspaceConfigs,proto3" json:"workspace_configs,omitempty"`
+	ActiveVersionNumbers             []uint32                  `protobuf:"varint,9,rep,packed,name=active_version_numbers,json=activeVersionNumbers,proto3" json:"active_version_numbers,omitempty"`
+	SystemPrompts                    []*Workspace_SystemPrompt `protobuf:"bytes,10,rep,name=system_prompts,json=systemPrompts,proto3" json:"system_prompts,omitempty"`
+	CurrentSystemPromptVersionNumber uint32                    `protobuf:"varint,11,opt,name=current_system_prompt_version_number,json=currentSystemPromptVersionNumber,proto3" json:"current_system_prompt_version_number,omitempty"`
+	XMLMode                          bool                      `protobuf:"varint,12,opt,name=XMLMode,proto3" json:"XMLMode,omitempty"`
+	ExampleSettings                  *ExampleSettings          `protobuf:"bytes,13,opt,name=example_settings,json=exampleSettings,proto3" json:"example_settings,omitempty"`
+	// the workspace this one was cloned from
+	SourceWorkspaceId *string `protobuf:"bytes,14,opt,name=source_workspace_id,json=sourceWorkspaceId,proto3,oneof" json:"source_workspace_id,omitempty"`
+	// set while the workspace is archived
+	ArchivedAt *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
 }
 
-func (x *ListTestCasesResponse) Reset() {
-	*x = ListTestCasesResponse{}
+func (x *Workspace) Reset() {
+	*x = Workspace{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[20]
+		mi := &file_eval_v1_eval_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListTestCasesResponse) String() string {
+func (x *Workspace) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTestCasesResponse) ProtoMessage() {}
+func (*Workspace) ProtoMessage() {}
 
-func (x *ListTestCasesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[20]
+func (x *Workspace) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1505,120 +1706,156 @@ func (x *ListTestCasesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTestCasesResponse.ProtoReflect.Descriptor instead.
-func (*ListTestCasesResponse) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use Workspace.ProtoReflect.Descriptor instead.
+func (*Workspace) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ListTestCasesResponse) GetTestCases() []*TestCase {
+func (x *Workspace) GetId() string {
 	if x != nil {
-		return x.TestCases
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *ListTestCasesResponse) GetTotalCount() int32 {
+func (x *Workspace) GetName() string {
 	if x != nil {
-		return x.TotalCount
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *ListTestCasesResponse) GetTestResults() []*TestResult {
+func (x *Workspace) GetModelConfigNames() []string {
 	if x != nil {
-		return x.TestResults
+		return x.ModelConfigNames
 	}
 	return nil
 }
 
-type CreatePromptVersionRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Workspace) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
 
-	PromptId      string `protobuf:"bytes,1,opt,name=prompt_id,json=promptId,proto3" json:"prompt_id,omitempty"`
-	Content       string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	VersionNumber uint32 `protobuf:"varint,3,opt,name=version_number,json=versionNumber,proto3" json:"version_number,omitempty"`
+func (x *Workspace) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
 }
 
-func (x *CreatePromptVersionRequest) Reset() {
-	*x = CreatePromptVersionRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Workspace) GetPrompts() []*Workspace_Prompt {
+	if x != nil {
+		return x.Prompts
 	}
+	return nil
 }
 
-func (x *CreatePromptVersionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Workspace) GetCurrentPromptVersionNumber() uint32 {
+	if x != nil {
+		return x.CurrentPromptVersionNumber
+	}
+	return 0
 }
 
-func (*CreatePromptVersionRequest) ProtoMessage() {}
+func (x *Workspace) GetWorkspaceConfigs() []*WorkspaceConfig {
+	if x != nil {
+		return x.WorkspaceConfigs
+	}
+	return nil
+}
 
-func (x *CreatePromptVersionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[21]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Workspace) GetActiveVersionNumbers() []uint32 {
+	if x != nil {
+		return x.ActiveVersionNumbers
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use CreatePromptVersionRequest.ProtoReflect.Descriptor instead.
-func (*CreatePromptVersionRequest) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{21}
+func (x *Workspace) GetSystemPrompts() []*Workspace_SystemPrompt {
+	if x != nil {
+		return x.SystemPrompts
+	}
+	return nil
 }
 
-func (x *CreatePromptVersionRequest) GetPromptId() string {
+func (x *Workspace) GetCurrentSystemPromptVersionNumber() uint32 {
 	if x != nil {
-		return x.PromptId
+		return x.CurrentSystemPromptVersionNumber
 	}
-	return ""
+	return 0
 }
 
-func (x *CreatePromptVersionRequest) GetContent() string {
+func (x *Workspace) GetXMLMode() bool {
 	if x != nil {
-		return x.Content
+		return x.XMLMode
+	}
+	return false
+}
+
+func (x *Workspace) GetExampleSettings() *ExampleSettings {
+	if x != nil {
+		return x.ExampleSettings
+	}
+	return nil
+}
+
+func (x *Workspace) GetSourceWorkspaceId() string {
+	if x != nil && x.SourceWorkspaceId != nil {
+		return *x.SourceWorkspaceId
 	}
 	return ""
 }
 
-func (x *CreatePromptVersionRequest) GetVersionNumber() uint32 {
+func (x *Workspace) GetArchivedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.VersionNumber
+		return x.ArchivedAt
 	}
-	return 0
+	return nil
 }
 
-type CreateWorkspaceResponse struct {
+type TestCase struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Workspace *Workspace `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	Id               string                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WorkspaceId      string                    `protobuf:"bytes,2,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	VariableValues   map[string]*VariableValue `protobuf:"bytes,3,rep,name=variable_values,json=variableValues,proto3" json:"variable_values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Response         *string                   `protobuf:"bytes,4,opt,name=response,proto3,oneof" json:"response,omitempty"`
+	HasBeenEvaluated bool                      `protobuf:"varint,5,opt,name=has_been_evaluated,json=hasBeenEvaluated,proto3" json:"has_been_evaluated,omitempty"`
+	CreatedAt        *timestamppb.Timestamp    `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp    `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Tags             []string                  `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	Split            Split                     `protobuf:"varint,9,opt,name=split,proto3,enum=eval.v1.Split" json:"split,omitempty"`
+	// set only for test cases in the trash
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// set for perturbed variants of another test case
+	ParentTestCaseId *string          `protobuf:"bytes,11,opt,name=parent_test_case_id,json=parentTestCaseId,proto3,oneof" json:"parent_test_case_id,omitempty"`
+	Perturbation     PerturbationKind `protobuf:"varint,12,opt,name=perturbation,proto3,enum=eval.v1.PerturbationKind" json:"perturbation,omitempty"`
+	// set for test cases owned by a dataset rather than a single workspace
+	DatasetId *string `protobuf:"bytes,13,opt,name=dataset_id,json=datasetId,proto3,oneof" json:"dataset_id,omitempty"`
 }
 
-func (x *CreateWorkspaceResponse) Reset() {
-	*x = CreateWorkspaceResponse{}
+func (x *TestCase) Reset() {
+	*x = TestCase{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[22]
+		mi := &file_eval_v1_eval_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateWorkspaceResponse) String() string {
+func (x *TestCase) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateWorkspaceResponse) ProtoMessage() {}
+func (*TestCase) ProtoMessage() {}
 
-func (x *CreateWorkspaceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[22]
+func (x *TestCase) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1629,91 +1866,134 @@ func (x *CreateWorkspaceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateWorkspaceResponse.ProtoReflect.Descriptor instead.
-func (*CreateWorkspaceResponse) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use TestCase.ProtoReflect.Descriptor instead.
+func (*TestCase) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *CreateWorkspaceResponse) GetWorkspace() *Workspace {
+func (x *TestCase) GetId() string {
 	if x != nil {
-		return x.Workspace
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type GetWorkspaceResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *TestCase) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
 
-	Workspace *Workspace `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
+func (x *TestCase) GetVariableValues() map[string]*VariableValue {
+	if x != nil {
+		return x.VariableValues
+	}
+	return nil
 }
 
-func (x *GetWorkspaceResponse) Reset() {
-	*x = GetWorkspaceResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[23]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *TestCase) GetResponse() string {
+	if x != nil && x.Response != nil {
+		return *x.Response
 	}
+	return ""
 }
 
-func (x *GetWorkspaceResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TestCase) GetHasBeenEvaluated() bool {
+	if x != nil {
+		return x.HasBeenEvaluated
+	}
+	return false
 }
 
-func (*GetWorkspaceResponse) ProtoMessage() {}
+func (x *TestCase) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
 
-func (x *GetWorkspaceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[23]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *TestCase) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetWorkspaceResponse.ProtoReflect.Descriptor instead.
-func (*GetWorkspaceResponse) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{23}
+func (x *TestCase) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
 }
 
-func (x *GetWorkspaceResponse) GetWorkspace() *Workspace {
+func (x *TestCase) GetSplit() Split {
 	if x != nil {
-		return x.Workspace
+		return x.Split
+	}
+	return Split_SPLIT_UNSPECIFIED
+}
+
+func (x *TestCase) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
 	}
 	return nil
 }
 
-type CreateTestCaseRequest struct {
+func (x *TestCase) GetParentTestCaseId() string {
+	if x != nil && x.ParentTestCaseId != nil {
+		return *x.ParentTestCaseId
+	}
+	return ""
+}
+
+func (x *TestCase) GetPerturbation() PerturbationKind {
+	if x != nil {
+		return x.Perturbation
+	}
+	return PerturbationKind_PERTURBATION_KIND_UNSPECIFIED
+}
+
+func (x *TestCase) GetDatasetId() string {
+	if x != nil && x.DatasetId != nil {
+		return *x.DatasetId
+	}
+	return ""
+}
+
+// TestCaseFilter selects the test cases a request applies to. Empty fields do not
+// filter.
+type TestCaseFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WorkspaceId    string                    `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
-	VariableValues map[string]*VariableValue `protobuf:"bytes,2,rep,name=variable_values,json=variableValues,proto3" json:"variable_values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// cases must have all of these tags
+	Tags []string `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	// cases must have none of these tags
+	ExcludeTags []string `protobuf:"bytes,2,rep,name=exclude_tags,json=excludeTags,proto3" json:"exclude_tags,omitempty"`
+	// cases must be in one of these splits; SPLIT_UNSPECIFIED matches cases without a split
+	Splits []Split `protobuf:"varint,3,rep,packed,name=splits,proto3,enum=eval.v1.Split" json:"splits,omitempty"`
 }
 
-func (x *CreateTestCaseRequest) Reset() {
-	*x = CreateTestCaseRequest{}
+func (x *TestCaseFilter) Reset() {
+	*x = TestCaseFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[24]
+		mi := &file_eval_v1_eval_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateTestCaseRequest) String() string {
+func (x *TestCaseFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTestCaseRequest) ProtoMessage() {}
+func (*TestCaseFilter) ProtoMessage() {}
 
-func (x *CreateTestCaseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[24]
+func (x *TestCaseFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1724,50 +2004,70 @@ func (x *CreateTestCaseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTestCaseRequest.ProtoReflect.Descriptor instead.
-func (*CreateTestCaseRequest) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use TestCaseFilter.ProtoReflect.Descriptor instead.
+func (*TestCaseFilter) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CreateTestCaseRequest) GetWorkspaceId() string {
+func (x *TestCaseFilter) GetTags() []string {
 	if x != nil {
-		return x.WorkspaceId
+		return x.Tags
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateTestCaseRequest) GetVariableValues() map[string]*VariableValue {
+func (x *TestCaseFilter) GetExcludeTags() []string {
 	if x != nil {
-		return x.VariableValues
+		return x.ExcludeTags
 	}
 	return nil
 }
 
-type CreateTestCaseResponse struct {
+func (x *TestCaseFilter) GetSplits() []Split {
+	if x != nil {
+		return x.Splits
+	}
+	return nil
+}
+
+type TestResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TestCase *TestCase `protobuf:"bytes,1,opt,name=test_case,json=testCase,proto3" json:"test_case,omitempty"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TestCaseId          string                 `protobuf:"bytes,2,opt,name=test_case_id,json=testCaseId,proto3" json:"test_case_id,omitempty"`
+	Response            string                 `protobuf:"bytes,3,opt,name=response,proto3" json:"response,omitempty"`
+	PromptVersionNumber uint32                 `protobuf:"varint,4,opt,name=prompt_version_number,json=promptVersionNumber,proto3" json:"prompt_version_number,omitempty"`
+	ModelConfigName     string                 `protobuf:"bytes,5,opt,name=model_config_name,json=modelConfigName,proto3" json:"model_config_name,omitempty"`
+	MessageOptions      *MessageOptions        `protobuf:"bytes,6,opt,name=message_options,json=messageOptions,proto3" json:"message_options,omitempty"`
+	WorkspaceConfigId   string                 `protobuf:"bytes,7,opt,name=workspace_config_id,json=workspaceConfigId,proto3" json:"workspace_config_id,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt           *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// rating is -1 (thumbs down), 0 (unrated), or 1 (thumbs up)
+	Rating  int32     `protobuf:"varint,10,opt,name=rating,proto3" json:"rating,omitempty"`
+	Ratings []*Rating `protobuf:"bytes,11,rep,name=ratings,proto3" json:"ratings,omitempty"`
+	// the examples inserted into the prompt for this result, in order
+	ExampleIds []string `protobuf:"bytes,12,rep,name=example_ids,json=exampleIds,proto3" json:"example_ids,omitempty"`
 }
 
-func (x *CreateTestCaseResponse) Reset() {
-	*x = CreateTestCaseResponse{}
+func (x *TestResult) Reset() {
+	*x = TestResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[25]
+		mi := &file_eval_v1_eval_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateTestCaseResponse) String() string {
+func (x *TestResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTestCaseResponse) ProtoMessage() {}
+func (*TestResult) ProtoMessage() {}
 
-func (x *CreateTestCaseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[25]
+func (x *TestResult) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1778,92 +2078,132 @@ func (x *CreateTestCaseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTestCaseResponse.ProtoReflect.Descriptor instead.
-func (*CreateTestCaseResponse) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use TestResult.ProtoReflect.Descriptor instead.
+func (*TestResult) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *CreateTestCaseResponse) GetTestCase() *TestCase {
+func (x *TestResult) GetId() string {
 	if x != nil {
-		return x.TestCase
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type DeleteTestCaseRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *TestResult) GetTestCaseId() string {
+	if x != nil {
+		return x.TestCaseId
+	}
+	return ""
+}
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+func (x *TestResult) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
 }
 
-func (x *DeleteTestCaseRequest) Reset() {
-	*x = DeleteTestCaseRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[26]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *TestResult) GetPromptVersionNumber() uint32 {
+	if x != nil {
+		return x.PromptVersionNumber
 	}
+	return 0
 }
 
-func (x *DeleteTestCaseRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TestResult) GetModelConfigName() string {
+	if x != nil {
+		return x.ModelConfigName
+	}
+	return ""
 }
 
-func (*DeleteTestCaseRequest) ProtoMessage() {}
+func (x *TestResult) GetMessageOptions() *MessageOptions {
+	if x != nil {
+		return x.MessageOptions
+	}
+	return nil
+}
 
-func (x *DeleteTestCaseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[26]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *TestResult) GetWorkspaceConfigId() string {
+	if x != nil {
+		return x.WorkspaceConfigId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DeleteTestCaseRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTestCaseRequest) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{26}
+func (x *TestResult) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *DeleteTestCaseRequest) GetId() string {
+func (x *TestResult) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Id
+		return x.UpdatedAt
 	}
-	return ""
+	return nil
 }
 
-// GeneratePromptRequest uses LLM to generate a prompt
-type GeneratePromptRequest struct {
+func (x *TestResult) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *TestResult) GetRatings() []*Rating {
+	if x != nil {
+		return x.Ratings
+	}
+	return nil
+}
+
+func (x *TestResult) GetExampleIds() []string {
+	if x != nil {
+		return x.ExampleIds
+	}
+	return nil
+}
+
+// Rating is a single rater's judgement of a test result. Raters may be humans or
+// automated judges, and may give thumbs, a scale score, or both.
+type Rating struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Prompt          string `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
-	ModelConfigName string `protobuf:"bytes,2,opt,name=model_config_name,json=modelConfigName,proto3" json:"model_config_name,omitempty"`
-}
-
-func (x *GeneratePromptRequest) Reset() {
-	*x = GeneratePromptRequest{}
+	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TestResultId string `protobuf:"bytes,2,opt,name=test_result_id,json=testResultId,proto3" json:"test_result_id,omitempty"`
+	Rater        string `protobuf:"bytes,3,opt,name=rater,proto3" json:"rater,omitempty"`
+	Automated    bool   `protobuf:"varint,4,opt,name=automated,proto3" json:"automated,omitempty"`
+	// thumbs is -1 (thumbs down), 0 (unrated), or 1 (thumbs up)
+	Thumbs    int32                  `protobuf:"varint,5,opt,name=thumbs,proto3" json:"thumbs,omitempty"`
+	Score     *float64               `protobuf:"fixed64,6,opt,name=score,proto3,oneof" json:"score,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// reason is the rater's explanation, if any
+	Reason string `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *Rating) Reset() {
+	*x = Rating{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[27]
+		mi := &file_eval_v1_eval_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GeneratePromptRequest) String() string {
+func (x *Rating) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GeneratePromptRequest) ProtoMessage() {}
+func (*Rating) ProtoMessage() {}
 
-func (x *GeneratePromptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[27]
+func (x *Rating) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1874,95 +2214,101 @@ func (x *GeneratePromptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GeneratePromptRequest.ProtoReflect.Descriptor instead.
-func (*GeneratePromptRequest) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use Rating.ProtoReflect.Descriptor instead.
+func (*Rating) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GeneratePromptRequest) GetPrompt() string {
+func (x *Rating) GetId() string {
 	if x != nil {
-		return x.Prompt
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GeneratePromptRequest) GetModelConfigName() string {
+func (x *Rating) GetTestResultId() string {
 	if x != nil {
-		return x.ModelConfigName
+		return x.TestResultId
 	}
 	return ""
 }
 
-type GeneratePromptResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Rating) GetRater() string {
+	if x != nil {
+		return x.Rater
+	}
+	return ""
+}
 
-	GeneratedPrompt string `protobuf:"bytes,1,opt,name=generated_prompt,json=generatedPrompt,proto3" json:"generated_prompt,omitempty"`
+func (x *Rating) GetAutomated() bool {
+	if x != nil {
+		return x.Automated
+	}
+	return false
 }
 
-func (x *GeneratePromptResponse) Reset() {
-	*x = GeneratePromptResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[28]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Rating) GetThumbs() int32 {
+	if x != nil {
+		return x.Thumbs
 	}
+	return 0
 }
 
-func (x *GeneratePromptResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Rating) GetScore() float64 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
 }
 
-func (*GeneratePromptResponse) ProtoMessage() {}
-
-func (x *GeneratePromptResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[28]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Rating) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GeneratePromptResponse.ProtoReflect.Descriptor instead.
-func (*GeneratePromptResponse) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{28}
+func (x *Rating) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
 }
 
-func (x *GeneratePromptResponse) GetGeneratedPrompt() string {
+func (x *Rating) GetReason() string {
 	if x != nil {
-		return x.GeneratedPrompt
+		return x.Reason
 	}
 	return ""
 }
 
-type ListModelConfigsRequest struct {
+// CRUD operation messages
+type CreateWorkspaceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
 }
 
-func (x *ListModelConfigsRequest) Reset() {
-	*x = ListModelConfigsRequest{}
+func (x *CreateWorkspaceRequest) Reset() {
+	*x = CreateWorkspaceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[29]
+		mi := &file_eval_v1_eval_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListModelConfigsRequest) String() string {
+func (x *CreateWorkspaceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListModelConfigsRequest) ProtoMessage() {}
+func (*CreateWorkspaceRequest) ProtoMessage() {}
 
-func (x *ListModelConfigsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[29]
+func (x *CreateWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1973,36 +2319,62 @@ func (x *ListModelConfigsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListModelConfigsRequest.ProtoReflect.Descriptor instead.
-func (*ListModelConfigsRequest) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use CreateWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*CreateWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{16}
 }
 
-type ListModelConfigsResponse struct {
+func (x *CreateWorkspaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateWorkspaceRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// CloneWorkspaceRequest copies a workspace into a new one with new IDs. The prompt
+// and system prompt versions are always copied, the rest only when asked for.
+type CloneWorkspaceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ModelConfigs map[string]*ModelConfig `protobuf:"bytes,1,rep,name=model_configs,json=modelConfigs,proto3" json:"model_configs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-}
-
-func (x *ListModelConfigsResponse) Reset() {
-	*x = ListModelConfigsResponse{}
+	WorkspaceId string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	// defaults to the source's name followed by " (copy)"
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// copy every version instead of only the current ones
+	AllVersions          bool `protobuf:"varint,3,opt,name=all_versions,json=allVersions,proto3" json:"all_versions,omitempty"`
+	CopyWorkspaceConfigs bool `protobuf:"varint,4,opt,name=copy_workspace_configs,json=copyWorkspaceConfigs,proto3" json:"copy_workspace_configs,omitempty"`
+	// copy the workspace's own test cases and its dataset attachments
+	CopyTestCases bool `protobuf:"varint,5,opt,name=copy_test_cases,json=copyTestCases,proto3" json:"copy_test_cases,omitempty"`
+	// copy the results of the copied versions, with their ratings; needs
+	// copy_workspace_configs and copy_test_cases
+	CopyResults bool `protobuf:"varint,6,opt,name=copy_results,json=copyResults,proto3" json:"copy_results,omitempty"`
+}
+
+func (x *CloneWorkspaceRequest) Reset() {
+	*x = CloneWorkspaceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[30]
+		mi := &file_eval_v1_eval_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListModelConfigsResponse) String() string {
+func (x *CloneWorkspaceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListModelConfigsResponse) ProtoMessage() {}
+func (*CloneWorkspaceRequest) ProtoMessage() {}
 
-func (x *ListModelConfigsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[30]
+func (x *CloneWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2013,43 +2385,78 @@ func (x *ListModelConfigsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListModelConfigsResponse.ProtoReflect.Descriptor instead.
-func (*ListModelConfigsResponse) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use CloneWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*CloneWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ListModelConfigsResponse) GetModelConfigs() map[string]*ModelConfig {
+func (x *CloneWorkspaceRequest) GetWorkspaceId() string {
 	if x != nil {
-		return x.ModelConfigs
+		return x.WorkspaceId
 	}
-	return nil
+	return ""
 }
 
-type SetDefaultSmallModelConfigRequest struct {
+func (x *CloneWorkspaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CloneWorkspaceRequest) GetAllVersions() bool {
+	if x != nil {
+		return x.AllVersions
+	}
+	return false
+}
+
+func (x *CloneWorkspaceRequest) GetCopyWorkspaceConfigs() bool {
+	if x != nil {
+		return x.CopyWorkspaceConfigs
+	}
+	return false
+}
+
+func (x *CloneWorkspaceRequest) GetCopyTestCases() bool {
+	if x != nil {
+		return x.CopyTestCases
+	}
+	return false
+}
+
+func (x *CloneWorkspaceRequest) GetCopyResults() bool {
+	if x != nil {
+		return x.CopyResults
+	}
+	return false
+}
+
+type CloneWorkspaceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ModelConfigName string `protobuf:"bytes,1,opt,name=model_config_name,json=modelConfigName,proto3" json:"model_config_name,omitempty"`
+	Workspace *Workspace `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
 }
 
-func (x *SetDefaultSmallModelConfigRequest) Reset() {
-	*x = SetDefaultSmallModelConfigRequest{}
+func (x *CloneWorkspaceResponse) Reset() {
+	*x = CloneWorkspaceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[31]
+		mi := &file_eval_v1_eval_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetDefaultSmallModelConfigRequest) String() string {
+func (x *CloneWorkspaceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetDefaultSmallModelConfigRequest) ProtoMessage() {}
+func (*CloneWorkspaceResponse) ProtoMessage() {}
 
-func (x *SetDefaultSmallModelConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[31]
+func (x *CloneWorkspaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2060,43 +2467,49 @@ func (x *SetDefaultSmallModelConfigRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetDefaultSmallModelConfigRequest.ProtoReflect.Descriptor instead.
-func (*SetDefaultSmallModelConfigRequest) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use CloneWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*CloneWorkspaceResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *SetDefaultSmallModelConfigRequest) GetModelConfigName() string {
+func (x *CloneWorkspaceResponse) GetWorkspace() *Workspace {
 	if x != nil {
-		return x.ModelConfigName
+		return x.Workspace
 	}
-	return ""
+	return nil
 }
 
-type SetDefaultLargeModelConfigRequest struct {
+// ExportWorkspaceBundleRequest exports a workspace as one YAML bundle, which can
+// be edited by hand and imported again with ImportWorkspaceBundle.
+type ExportWorkspaceBundleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ModelConfigName string `protobuf:"bytes,1,opt,name=model_config_name,json=modelConfigName,proto3" json:"model_config_name,omitempty"`
+	WorkspaceId string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	// include the workspace's own test cases; dataset test cases are left out
+	IncludeTestCases bool `protobuf:"varint,2,opt,name=include_test_cases,json=includeTestCases,proto3" json:"include_test_cases,omitempty"`
+	// include the results of the exported test cases; needs include_test_cases
+	IncludeResults bool `protobuf:"varint,3,opt,name=include_results,json=includeResults,proto3" json:"include_results,omitempty"`
 }
 
-func (x *SetDefaultLargeModelConfigRequest) Reset() {
-	*x = SetDefaultLargeModelConfigRequest{}
+func (x *ExportWorkspaceBundleRequest) Reset() {
+	*x = ExportWorkspaceBundleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[32]
+		mi := &file_eval_v1_eval_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetDefaultLargeModelConfigRequest) String() string {
+func (x *ExportWorkspaceBundleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetDefaultLargeModelConfigRequest) ProtoMessage() {}
+func (*ExportWorkspaceBundleRequest) ProtoMessage() {}
 
-func (x *SetDefaultLargeModelConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[32]
+func (x *ExportWorkspaceBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2107,44 +2520,57 @@ func (x *SetDefaultLargeModelConfigRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetDefaultLargeModelConfigRequest.ProtoReflect.Descriptor instead.
-func (*SetDefaultLargeModelConfigRequest) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use ExportWorkspaceBundleRequest.ProtoReflect.Descriptor instead.
+func (*ExportWorkspaceBundleRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *SetDefaultLargeModelConfigRequest) GetModelConfigName() string {
+func (x *ExportWorkspaceBundleRequest) GetWorkspaceId() string {
 	if x != nil {
-		return x.ModelConfigName
+		return x.WorkspaceId
 	}
 	return ""
 }
 
-type GetModelConfigResponse struct {
+func (x *ExportWorkspaceBundleRequest) GetIncludeTestCases() bool {
+	if x != nil {
+		return x.IncludeTestCases
+	}
+	return false
+}
+
+func (x *ExportWorkspaceBundleRequest) GetIncludeResults() bool {
+	if x != nil {
+		return x.IncludeResults
+	}
+	return false
+}
+
+type ExportWorkspaceBundleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ModelConfig     *ModelConfig `protobuf:"bytes,1,opt,name=model_config,json=modelConfig,proto3" json:"model_config,omitempty"`
-	ModelConfigName string       `protobuf:"bytes,2,opt,name=model_config_name,json=modelConfigName,proto3" json:"model_config_name,omitempty"`
+	Bundle []byte `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
 }
 
-func (x *GetModelConfigResponse) Reset() {
-	*x = GetModelConfigResponse{}
+func (x *ExportWorkspaceBundleResponse) Reset() {
+	*x = ExportWorkspaceBundleResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[33]
+		mi := &file_eval_v1_eval_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetModelConfigResponse) String() string {
+func (x *ExportWorkspaceBundleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetModelConfigResponse) ProtoMessage() {}
+func (*ExportWorkspaceBundleResponse) ProtoMessage() {}
 
-func (x *GetModelConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[33]
+func (x *ExportWorkspaceBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2155,53 +2581,48 @@ func (x *GetModelConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetModelConfigResponse.ProtoReflect.Descriptor instead.
-func (*GetModelConfigResponse) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use ExportWorkspaceBundleResponse.ProtoReflect.Descriptor instead.
+func (*ExportWorkspaceBundleResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetModelConfigResponse) GetModelConfig() *ModelConfig {
+func (x *ExportWorkspaceBundleResponse) GetBundle() []byte {
 	if x != nil {
-		return x.ModelConfig
+		return x.Bundle
 	}
 	return nil
 }
 
-func (x *GetModelConfigResponse) GetModelConfigName() string {
-	if x != nil {
-		return x.ModelConfigName
-	}
-	return ""
-}
-
-type UpdateWorkspaceRequest struct {
+// ImportWorkspaceBundleRequest imports a YAML bundle. Importing is idempotent:
+// versions, configs, test cases and results already in the workspace are
+// matched instead of created again.
+type ImportWorkspaceBundleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WorkspaceId     string  `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
-	NewContent      string  `protobuf:"bytes,2,opt,name=new_content,json=newContent,proto3" json:"new_content,omitempty"`
-	NewSystemPrompt string  `protobuf:"bytes,3,opt,name=new_system_prompt,json=newSystemPrompt,proto3" json:"new_system_prompt,omitempty"`
-	NewTitle        *string `protobuf:"bytes,4,opt,name=new_title,json=newTitle,proto3,oneof" json:"new_title,omitempty"`
+	Bundle []byte `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	// import into this workspace instead of the one the bundle was exported from
+	WorkspaceId string `protobuf:"bytes,2,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
 }
 
-func (x *UpdateWorkspaceRequest) Reset() {
-	*x = UpdateWorkspaceRequest{}
+func (x *ImportWorkspaceBundleRequest) Reset() {
+	*x = ImportWorkspaceBundleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[34]
+		mi := &file_eval_v1_eval_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateWorkspaceRequest) String() string {
+func (x *ImportWorkspaceBundleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateWorkspaceRequest) ProtoMessage() {}
+func (*ImportWorkspaceBundleRequest) ProtoMessage() {}
 
-func (x *UpdateWorkspaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[34]
+func (x *ImportWorkspaceBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2212,67 +2633,56 @@ func (x *UpdateWorkspaceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateWorkspaceRequest.ProtoReflect.Descriptor instead.
-func (*UpdateWorkspaceRequest) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{34}
-}
-
-func (x *UpdateWorkspaceRequest) GetWorkspaceId() string {
-	if x != nil {
-		return x.WorkspaceId
-	}
-	return ""
+// Deprecated: Use ImportWorkspaceBundleRequest.ProtoReflect.Descriptor instead.
+func (*ImportWorkspaceBundleRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *UpdateWorkspaceRequest) GetNewContent() string {
+func (x *ImportWorkspaceBundleRequest) GetBundle() []byte {
 	if x != nil {
-		return x.NewContent
+		return x.Bundle
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateWorkspaceRequest) GetNewSystemPrompt() string {
+func (x *ImportWorkspaceBundleRequest) GetWorkspaceId() string {
 	if x != nil {
-		return x.NewSystemPrompt
-	}
-	return ""
-}
-
-func (x *UpdateWorkspaceRequest) GetNewTitle() string {
-	if x != nil && x.NewTitle != nil {
-		return *x.NewTitle
+		return x.WorkspaceId
 	}
 	return ""
 }
 
-type UpdateWorkspaceResponse struct {
+type ImportWorkspaceBundleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NewVersionNumber uint32                 `protobuf:"varint,1,opt,name=new_version_number,json=newVersionNumber,proto3" json:"new_version_number,omitempty"`
-	Content          string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	SystemPrompt     string                 `protobuf:"bytes,3,opt,name=system_prompt,json=systemPrompt,proto3" json:"system_prompt,omitempty"`
-	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Workspace              *Workspace `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	WorkspaceCreated       bool       `protobuf:"varint,2,opt,name=workspace_created,json=workspaceCreated,proto3" json:"workspace_created,omitempty"`
+	NPromptsAdded          uint32     `protobuf:"varint,3,opt,name=n_prompts_added,json=nPromptsAdded,proto3" json:"n_prompts_added,omitempty"`
+	NSystemPromptsAdded    uint32     `protobuf:"varint,4,opt,name=n_system_prompts_added,json=nSystemPromptsAdded,proto3" json:"n_system_prompts_added,omitempty"`
+	NWorkspaceConfigsAdded uint32     `protobuf:"varint,5,opt,name=n_workspace_configs_added,json=nWorkspaceConfigsAdded,proto3" json:"n_workspace_configs_added,omitempty"`
+	NTestCasesAdded        uint32     `protobuf:"varint,6,opt,name=n_test_cases_added,json=nTestCasesAdded,proto3" json:"n_test_cases_added,omitempty"`
+	NResultsAdded          uint32     `protobuf:"varint,7,opt,name=n_results_added,json=nResultsAdded,proto3" json:"n_results_added,omitempty"`
 }
 
-func (x *UpdateWorkspaceResponse) Reset() {
-	*x = UpdateWorkspaceResponse{}
+func (x *ImportWorkspaceBundleResponse) Reset() {
+	*x = ImportWorkspaceBundleResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[35]
+		mi := &file_eval_v1_eval_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateWorkspaceResponse) String() string {
+func (x *ImportWorkspaceBundleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateWorkspaceResponse) ProtoMessage() {}
+func (*ImportWorkspaceBundleResponse) ProtoMessage() {}
 
-func (x *UpdateWorkspaceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[35]
+func (x *ImportWorkspaceBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2283,69 +2693,85 @@ func (x *UpdateWorkspaceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateWorkspaceResponse.ProtoReflect.Descriptor instead.
-func (*UpdateWorkspaceResponse) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use ImportWorkspaceBundleResponse.ProtoReflect.Descriptor instead.
+func (*ImportWorkspaceBundleResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *UpdateWorkspaceResponse) GetNewVersionNumber() uint32 {
+func (x *ImportWorkspaceBundleResponse) GetWorkspace() *Workspace {
 	if x != nil {
-		return x.NewVersionNumber
+		return x.Workspace
+	}
+	return nil
+}
+
+func (x *ImportWorkspaceBundleResponse) GetWorkspaceCreated() bool {
+	if x != nil {
+		return x.WorkspaceCreated
+	}
+	return false
+}
+
+func (x *ImportWorkspaceBundleResponse) GetNPromptsAdded() uint32 {
+	if x != nil {
+		return x.NPromptsAdded
 	}
 	return 0
 }
 
-func (x *UpdateWorkspaceResponse) GetContent() string {
+func (x *ImportWorkspaceBundleResponse) GetNSystemPromptsAdded() uint32 {
 	if x != nil {
-		return x.Content
+		return x.NSystemPromptsAdded
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateWorkspaceResponse) GetSystemPrompt() string {
+func (x *ImportWorkspaceBundleResponse) GetNWorkspaceConfigsAdded() uint32 {
 	if x != nil {
-		return x.SystemPrompt
+		return x.NWorkspaceConfigsAdded
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateWorkspaceResponse) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *ImportWorkspaceBundleResponse) GetNTestCasesAdded() uint32 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.NTestCasesAdded
 	}
-	return nil
+	return 0
 }
 
-type GenerateTestCaseRequest struct {
+func (x *ImportWorkspaceBundleResponse) GetNResultsAdded() uint32 {
+	if x != nil {
+		return x.NResultsAdded
+	}
+	return 0
+}
+
+type GetWorkspaceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WorkspaceId   string      `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
-	VersionNumber uint32      `protobuf:"varint,2,opt,name=version_number,json=versionNumber,proto3" json:"version_number,omitempty"`
-	TestCases     []*TestCase `protobuf:"bytes,3,rep,name=test_cases,json=testCases,proto3" json:"test_cases,omitempty"`
-	CustomCot     *string     `protobuf:"bytes,4,opt,name=custom_cot,json=customCot,proto3,oneof" json:"custom_cot,omitempty"`
-	NTestCases    uint32      `protobuf:"varint,5,opt,name=n_test_cases,json=nTestCases,proto3" json:"n_test_cases,omitempty"`
-	SeedPrompt    *string     `protobuf:"bytes,6,opt,name=seed_prompt,json=seedPrompt,proto3,oneof" json:"seed_prompt,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GenerateTestCaseRequest) Reset() {
-	*x = GenerateTestCaseRequest{}
+func (x *GetWorkspaceRequest) Reset() {
+	*x = GetWorkspaceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[36]
+		mi := &file_eval_v1_eval_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GenerateTestCaseRequest) String() string {
+func (x *GetWorkspaceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateTestCaseRequest) ProtoMessage() {}
+func (*GetWorkspaceRequest) ProtoMessage() {}
 
-func (x *GenerateTestCaseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[36]
+func (x *GetWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2356,78 +2782,46 @@ func (x *GenerateTestCaseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateTestCaseRequest.ProtoReflect.Descriptor instead.
-func (*GenerateTestCaseRequest) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{36}
-}
-
-func (x *GenerateTestCaseRequest) GetWorkspaceId() string {
-	if x != nil {
-		return x.WorkspaceId
-	}
-	return ""
-}
-
-func (x *GenerateTestCaseRequest) GetVersionNumber() uint32 {
-	if x != nil {
-		return x.VersionNumber
-	}
-	return 0
-}
-
-func (x *GenerateTestCaseRequest) GetTestCases() []*TestCase {
-	if x != nil {
-		return x.TestCases
-	}
-	return nil
-}
-
-func (x *GenerateTestCaseRequest) GetCustomCot() string {
-	if x != nil && x.CustomCot != nil {
-		return *x.CustomCot
-	}
-	return ""
+// Deprecated: Use GetWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GenerateTestCaseRequest) GetNTestCases() uint32 {
+func (x *GetWorkspaceRequest) GetId() string {
 	if x != nil {
-		return x.NTestCases
-	}
-	return 0
-}
-
-func (x *GenerateTestCaseRequest) GetSeedPrompt() string {
-	if x != nil && x.SeedPrompt != nil {
-		return *x.SeedPrompt
+		return x.Id
 	}
 	return ""
 }
 
-type GenerateTestCaseResponse struct {
+type ListWorkspacesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TestCases []*TestCase `protobuf:"bytes,1,rep,name=test_cases,json=testCases,proto3" json:"test_cases,omitempty"`
+	Page     int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// list archived workspaces too
+	IncludeArchived bool `protobuf:"varint,3,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
 }
 
-func (x *GenerateTestCaseResponse) Reset() {
-	*x = GenerateTestCaseResponse{}
+func (x *ListWorkspacesRequest) Reset() {
+	*x = ListWorkspacesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[37]
+		mi := &file_eval_v1_eval_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GenerateTestCaseResponse) String() string {
+func (x *ListWorkspacesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateTestCaseResponse) ProtoMessage() {}
+func (*ListWorkspacesRequest) ProtoMessage() {}
 
-func (x *GenerateTestCaseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[37]
+func (x *ListWorkspacesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2438,44 +2832,59 @@ func (x *GenerateTestCaseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateTestCaseResponse.ProtoReflect.Descriptor instead.
-func (*GenerateTestCaseResponse) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use ListWorkspacesRequest.ProtoReflect.Descriptor instead.
+func (*ListWorkspacesRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GenerateTestCaseResponse) GetTestCases() []*TestCase {
+func (x *ListWorkspacesRequest) GetPage() int32 {
 	if x != nil {
-		return x.TestCases
+		return x.Page
 	}
-	return nil
+	return 0
 }
 
-type DeleteWorkspaceConfigRequest struct {
+func (x *ListWorkspacesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListWorkspacesRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+// DeleteWorkspaceRequest deletes a workspace with its versions, configs, test
+// cases, results and everything else it owns. Dataset test cases are kept.
+type DeleteWorkspaceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WorkspaceId       string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
-	WorkspaceConfigId string `protobuf:"bytes,2,opt,name=workspace_config_id,json=workspaceConfigId,proto3" json:"workspace_config_id,omitempty"`
+	WorkspaceId string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
 }
 
-func (x *DeleteWorkspaceConfigRequest) Reset() {
-	*x = DeleteWorkspaceConfigRequest{}
+func (x *DeleteWorkspaceRequest) Reset() {
+	*x = DeleteWorkspaceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[38]
+		mi := &file_eval_v1_eval_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteWorkspaceConfigRequest) String() string {
+func (x *DeleteWorkspaceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteWorkspaceConfigRequest) ProtoMessage() {}
+func (*DeleteWorkspaceRequest) ProtoMessage() {}
 
-func (x *DeleteWorkspaceConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[38]
+func (x *DeleteWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2486,52 +2895,46 @@ func (x *DeleteWorkspaceConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteWorkspaceConfigRequest.ProtoReflect.Descriptor instead.
-func (*DeleteWorkspaceConfigRequest) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use DeleteWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *DeleteWorkspaceConfigRequest) GetWorkspaceId() string {
+func (x *DeleteWorkspaceRequest) GetWorkspaceId() string {
 	if x != nil {
 		return x.WorkspaceId
 	}
 	return ""
 }
 
-func (x *DeleteWorkspaceConfigRequest) GetWorkspaceConfigId() string {
-	if x != nil {
-		return x.WorkspaceConfigId
-	}
-	return ""
-}
-
-type SetWorkspaceConfigActiveRequest struct {
+// ArchiveWorkspaceRequest hides a workspace from ListWorkspaces, or shows it
+// again, without deleting anything.
+type ArchiveWorkspaceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WorkspaceId       string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
-	WorkspaceConfigId string `protobuf:"bytes,2,opt,name=workspace_config_id,json=workspaceConfigId,proto3" json:"workspace_config_id,omitempty"`
-	Active            bool   `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	WorkspaceId string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Archived    bool   `protobuf:"varint,2,opt,name=archived,proto3" json:"archived,omitempty"`
 }
 
-func (x *SetWorkspaceConfigActiveRequest) Reset() {
-	*x = SetWorkspaceConfigActiveRequest{}
+func (x *ArchiveWorkspaceRequest) Reset() {
+	*x = ArchiveWorkspaceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[39]
+		mi := &file_eval_v1_eval_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetWorkspaceConfigActiveRequest) String() string {
+func (x *ArchiveWorkspaceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetWorkspaceConfigActiveRequest) ProtoMessage() {}
+func (*ArchiveWorkspaceRequest) ProtoMessage() {}
 
-func (x *SetWorkspaceConfigActiveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[39]
+func (x *ArchiveWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2542,59 +2945,51 @@ func (x *SetWorkspaceConfigActiveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetWorkspaceConfigActiveRequest.ProtoReflect.Descriptor instead.
-func (*SetWorkspaceConfigActiveRequest) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use ArchiveWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *SetWorkspaceConfigActiveRequest) GetWorkspaceId() string {
+func (x *ArchiveWorkspaceRequest) GetWorkspaceId() string {
 	if x != nil {
 		return x.WorkspaceId
 	}
 	return ""
 }
 
-func (x *SetWorkspaceConfigActiveRequest) GetWorkspaceConfigId() string {
-	if x != nil {
-		return x.WorkspaceConfigId
-	}
-	return ""
-}
-
-func (x *SetWorkspaceConfigActiveRequest) GetActive() bool {
+func (x *ArchiveWorkspaceRequest) GetArchived() bool {
 	if x != nil {
-		return x.Active
+		return x.Archived
 	}
 	return false
 }
 
-type SetVersionActiveRequest struct {
+type ListWorkspacesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WorkspaceId   string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
-	VersionNumber uint32 `protobuf:"varint,2,opt,name=version_number,json=versionNumber,proto3" json:"version_number,omitempty"`
-	Active        bool   `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	Workspaces []*Workspace `protobuf:"bytes,1,rep,name=workspaces,proto3" json:"workspaces,omitempty"`
+	TotalCount int32        `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 }
 
-func (x *SetVersionActiveRequest) Reset() {
-	*x = SetVersionActiveRequest{}
+func (x *ListWorkspacesResponse) Reset() {
+	*x = ListWorkspacesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[40]
+		mi := &file_eval_v1_eval_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetVersionActiveRequest) String() string {
+func (x *ListWorkspacesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetVersionActiveRequest) ProtoMessage() {}
+func (*ListWorkspacesResponse) ProtoMessage() {}
 
-func (x *SetVersionActiveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[40]
+func (x *ListWorkspacesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2605,58 +3000,50 @@ func (x *SetVersionActiveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetVersionActiveRequest.ProtoReflect.Descriptor instead.
-func (*SetVersionActiveRequest) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use ListWorkspacesResponse.ProtoReflect.Descriptor instead.
+func (*ListWorkspacesResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *SetVersionActiveRequest) GetWorkspaceId() string {
+func (x *ListWorkspacesResponse) GetWorkspaces() []*Workspace {
 	if x != nil {
-		return x.WorkspaceId
+		return x.Workspaces
 	}
-	return ""
+	return nil
 }
 
-func (x *SetVersionActiveRequest) GetVersionNumber() uint32 {
+func (x *ListWorkspacesResponse) GetTotalCount() int32 {
 	if x != nil {
-		return x.VersionNumber
+		return x.TotalCount
 	}
 	return 0
 }
 
-func (x *SetVersionActiveRequest) GetActive() bool {
-	if x != nil {
-		return x.Active
-	}
-	return false
-}
-
-type SetXMLModeRequest struct {
+type GetPromptRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WorkspaceId string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
-	XMLMode     bool   `protobuf:"varint,2,opt,name=XMLMode,proto3" json:"XMLMode,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *SetXMLModeRequest) Reset() {
-	*x = SetXMLModeRequest{}
+func (x *GetPromptRequest) Reset() {
+	*x = GetPromptRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[41]
+		mi := &file_eval_v1_eval_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetXMLModeRequest) String() string {
+func (x *GetPromptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetXMLModeRequest) ProtoMessage() {}
+func (*GetPromptRequest) ProtoMessage() {}
 
-func (x *SetXMLModeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[41]
+func (x *GetPromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2667,51 +3054,62 @@ func (x *SetXMLModeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetXMLModeRequest.ProtoReflect.Descriptor instead.
-func (*SetXMLModeRequest) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{41}
-}
-
-func (x *SetXMLModeRequest) GetWorkspaceId() string {
-	if x != nil {
-		return x.WorkspaceId
-	}
-	return ""
+// Deprecated: Use GetPromptRequest.ProtoReflect.Descriptor instead.
+func (*GetPromptRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *SetXMLModeRequest) GetXMLMode() bool {
+func (x *GetPromptRequest) GetId() string {
 	if x != nil {
-		return x.XMLMode
+		return x.Id
 	}
-	return false
+	return ""
 }
 
-type RateTestResultRequest struct {
+type ListTestCasesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TestResultId string `protobuf:"bytes,1,opt,name=test_result_id,json=testResultId,proto3" json:"test_result_id,omitempty"`
-	Rating       int32  `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"`
+	WorkspaceId string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	// page is only used without a page_token; prefer page tokens, which stay fast on
+	// large workspaces
+	Page int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	// all matching test cases are listed if page_size is 0
+	PageSize int32           `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Filter   *TestCaseFilter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// next_page_token from the previous page, listed with the same sort
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// evaluated cases have a result matching the result filters below, which also
+	// select the results returned; setting any of them implies EVALUATED
+	EvaluationStatus    EvaluationStatus `protobuf:"varint,6,opt,name=evaluation_status,json=evaluationStatus,proto3,enum=eval.v1.EvaluationStatus" json:"evaluation_status,omitempty"`
+	WorkspaceConfigIds  []string         `protobuf:"bytes,7,rep,name=workspace_config_ids,json=workspaceConfigIds,proto3" json:"workspace_config_ids,omitempty"`
+	PromptVersionNumber *uint32          `protobuf:"varint,8,opt,name=prompt_version_number,json=promptVersionNumber,proto3,oneof" json:"prompt_version_number,omitempty"`
+	// rating is -1 (thumbs down), 0 (unrated), or 1 (thumbs up)
+	Rating *int32 `protobuf:"varint,9,opt,name=rating,proto3,oneof" json:"rating,omitempty"`
+	// case-insensitive text in the variable values or reference response
+	Search     string       `protobuf:"bytes,10,opt,name=search,proto3" json:"search,omitempty"`
+	Sort       TestCaseSort `protobuf:"varint,11,opt,name=sort,proto3,enum=eval.v1.TestCaseSort" json:"sort,omitempty"`
+	Descending bool         `protobuf:"varint,12,opt,name=descending,proto3" json:"descending,omitempty"`
 }
 
-func (x *RateTestResultRequest) Reset() {
-	*x = RateTestResultRequest{}
+func (x *ListTestCasesRequest) Reset() {
+	*x = ListTestCasesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[42]
+		mi := &file_eval_v1_eval_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RateTestResultRequest) String() string {
+func (x *ListTestCasesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RateTestResultRequest) ProtoMessage() {}
+func (*ListTestCasesRequest) ProtoMessage() {}
 
-func (x *RateTestResultRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[42]
+func (x *ListTestCasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2722,54 +3120,124 @@ func (x *RateTestResultRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RateTestResultRequest.ProtoReflect.Descriptor instead.
-func (*RateTestResultRequest) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use ListTestCasesRequest.ProtoReflect.Descriptor instead.
+func (*ListTestCasesRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *RateTestResultRequest) GetTestResultId() string {
+func (x *ListTestCasesRequest) GetWorkspaceId() string {
 	if x != nil {
-		return x.TestResultId
+		return x.WorkspaceId
 	}
 	return ""
 }
 
-func (x *RateTestResultRequest) GetRating() int32 {
+func (x *ListTestCasesRequest) GetPage() int32 {
 	if x != nil {
-		return x.Rating
+		return x.Page
 	}
 	return 0
 }
 
-// generate all results for a workspace and model config
-type SyntheticGenerationRequest struct {
+func (x *ListTestCasesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTestCasesRequest) GetFilter() *TestCaseFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListTestCasesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListTestCasesRequest) GetEvaluationStatus() EvaluationStatus {
+	if x != nil {
+		return x.EvaluationStatus
+	}
+	return EvaluationStatus_EVALUATION_STATUS_UNSPECIFIED
+}
+
+func (x *ListTestCasesRequest) GetWorkspaceConfigIds() []string {
+	if x != nil {
+		return x.WorkspaceConfigIds
+	}
+	return nil
+}
+
+func (x *ListTestCasesRequest) GetPromptVersionNumber() uint32 {
+	if x != nil && x.PromptVersionNumber != nil {
+		return *x.PromptVersionNumber
+	}
+	return 0
+}
+
+func (x *ListTestCasesRequest) GetRating() int32 {
+	if x != nil && x.Rating != nil {
+		return *x.Rating
+	}
+	return 0
+}
+
+func (x *ListTestCasesRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ListTestCasesRequest) GetSort() TestCaseSort {
+	if x != nil {
+		return x.Sort
+	}
+	return TestCaseSort_TEST_CASE_SORT_UNSPECIFIED
+}
+
+func (x *ListTestCasesRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+type ListTestCasesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WorkspaceId               string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
-	ModelConfigName           string `protobuf:"bytes,2,opt,name=model_config_name,json=modelConfigName,proto3" json:"model_config_name,omitempty"`
-	VersionNumber             uint32 `protobuf:"varint,3,opt,name=version_number,json=versionNumber,proto3" json:"version_number,omitempty"`
-	SystemPromptVersionNumber uint32 `protobuf:"varint,4,opt,name=system_prompt_version_number,json=systemPromptVersionNumber,proto3" json:"system_prompt_version_number,omitempty"`
+	TestCases   []*TestCase   `protobuf:"bytes,1,rep,name=test_cases,json=testCases,proto3" json:"test_cases,omitempty"`
+	TotalCount  int32         `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	TestResults []*TestResult `protobuf:"bytes,3,rep,name=test_results,json=testResults,proto3" json:"test_results,omitempty"`
+	// empty on the last page
+	NextPageToken string `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
-func (x *SyntheticGenerationRequest) Reset() {
-	*x = SyntheticGenerationRequest{}
+func (x *ListTestCasesResponse) Reset() {
+	*x = ListTestCasesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[43]
+		mi := &file_eval_v1_eval_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SyntheticGenerationRequest) String() string {
+func (x *ListTestCasesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyntheticGenerationRequest) ProtoMessage() {}
+func (*ListTestCasesResponse) ProtoMessage() {}
 
-func (x *SyntheticGenerationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[43]
+func (x *ListTestCasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2780,67 +3248,66 @@ func (x *SyntheticGenerationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyntheticGenerationRequest.ProtoReflect.Descriptor instead.
-func (*SyntheticGenerationRequest) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use ListTestCasesResponse.ProtoReflect.Descriptor instead.
+func (*ListTestCasesResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *SyntheticGenerationRequest) GetWorkspaceId() string {
+func (x *ListTestCasesResponse) GetTestCases() []*TestCase {
 	if x != nil {
-		return x.WorkspaceId
+		return x.TestCases
 	}
-	return ""
+	return nil
 }
 
-func (x *SyntheticGenerationRequest) GetModelConfigName() string {
+func (x *ListTestCasesResponse) GetTotalCount() int32 {
 	if x != nil {
-		return x.ModelConfigName
+		return x.TotalCount
 	}
-	return ""
+	return 0
 }
 
-func (x *SyntheticGenerationRequest) GetVersionNumber() uint32 {
+func (x *ListTestCasesResponse) GetTestResults() []*TestResult {
 	if x != nil {
-		return x.VersionNumber
+		return x.TestResults
 	}
-	return 0
+	return nil
 }
 
-func (x *SyntheticGenerationRequest) GetSystemPromptVersionNumber() uint32 {
+func (x *ListTestCasesResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.SystemPromptVersionNumber
+		return x.NextPageToken
 	}
-	return 0
+	return ""
 }
 
-type Workspace_Prompt struct {
+type CreatePromptVersionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VersionNumber uint32                 `protobuf:"varint,1,opt,name=version_number,json=versionNumber,proto3" json:"version_number,omitempty"`
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	Variables     []*Variable            `protobuf:"bytes,3,rep,name=variables,proto3" json:"variables,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	PromptId      string `protobuf:"bytes,1,opt,name=prompt_id,json=promptId,proto3" json:"prompt_id,omitempty"`
+	Content       string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	VersionNumber uint32 `protobuf:"varint,3,opt,name=version_number,json=versionNumber,proto3" json:"version_number,omitempty"`
 }
 
-func (x *Workspace_Prompt) Reset() {
-	*x = Workspace_Prompt{}
+func (x *CreatePromptVersionRequest) Reset() {
+	*x = CreatePromptVersionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[44]
+		mi := &file_eval_v1_eval_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Workspace_Prompt) String() string {
+func (x *CreatePromptVersionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Workspace_Prompt) ProtoMessage() {}
+func (*CreatePromptVersionRequest) ProtoMessage() {}
 
-func (x *Workspace_Prompt) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[44]
+func (x *CreatePromptVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2851,65 +3318,58 @@ func (x *Workspace_Prompt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Workspace_Prompt.ProtoReflect.Descriptor instead.
-func (*Workspace_Prompt) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{11, 0}
+// Deprecated: Use CreatePromptVersionRequest.ProtoReflect.Descriptor instead.
+func (*CreatePromptVersionRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *Workspace_Prompt) GetVersionNumber() uint32 {
+func (x *CreatePromptVersionRequest) GetPromptId() string {
 	if x != nil {
-		return x.VersionNumber
+		return x.PromptId
 	}
-	return 0
+	return ""
 }
 
-func (x *Workspace_Prompt) GetContent() string {
+func (x *CreatePromptVersionRequest) GetContent() string {
 	if x != nil {
 		return x.Content
 	}
 	return ""
 }
 
-func (x *Workspace_Prompt) GetVariables() []*Variable {
-	if x != nil {
-		return x.Variables
-	}
-	return nil
-}
-
-func (x *Workspace_Prompt) GetCreatedAt() *timestamppb.Timestamp {
+func (x *CreatePromptVersionRequest) GetVersionNumber() uint32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.VersionNumber
 	}
-	return nil
+	return 0
 }
 
-type Workspace_SystemPrompt struct {
+type CreateWorkspaceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VersionNumber uint32 `protobuf:"varint,1,opt,name=version_number,json=versionNumber,proto3" json:"version_number,omitempty"`
-	Content       string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Workspace    *Workspace     `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	LintFindings []*LintFinding `protobuf:"bytes,2,rep,name=lint_findings,json=lintFindings,proto3" json:"lint_findings,omitempty"`
 }
 
-func (x *Workspace_SystemPrompt) Reset() {
-	*x = Workspace_SystemPrompt{}
+func (x *CreateWorkspaceResponse) Reset() {
+	*x = CreateWorkspaceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_eval_v1_eval_proto_msgTypes[45]
+		mi := &file_eval_v1_eval_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Workspace_SystemPrompt) String() string {
+func (x *CreateWorkspaceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Workspace_SystemPrompt) ProtoMessage() {}
+func (*CreateWorkspaceResponse) ProtoMessage() {}
 
-func (x *Workspace_SystemPrompt) ProtoReflect() protoreflect.Message {
-	mi := &file_eval_v1_eval_proto_msgTypes[45]
+func (x *CreateWorkspaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2920,755 +3380,11834 @@ func (x *Workspace_SystemPrompt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Workspace_SystemPrompt.ProtoReflect.Descriptor instead.
-func (*Workspace_SystemPrompt) Descriptor() ([]byte, []int) {
-	return file_eval_v1_eval_proto_rawDescGZIP(), []int{11, 1}
+// Deprecated: Use CreateWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*CreateWorkspaceResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *Workspace_SystemPrompt) GetVersionNumber() uint32 {
+func (x *CreateWorkspaceResponse) GetWorkspace() *Workspace {
 	if x != nil {
-		return x.VersionNumber
+		return x.Workspace
 	}
-	return 0
+	return nil
 }
 
-func (x *Workspace_SystemPrompt) GetContent() string {
+func (x *CreateWorkspaceResponse) GetLintFindings() []*LintFinding {
 	if x != nil {
-		return x.Content
+		return x.LintFindings
 	}
-	return ""
+	return nil
 }
 
-var File_eval_v1_eval_proto protoreflect.FileDescriptor
+type GetWorkspaceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_eval_v1_eval_proto_rawDesc = []byte{
-	0x0a, 0x12, 0x65, 0x76, 0x61, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
-	0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x49, 0x0a, 0x08, 0x56,
-	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x65, 0x76, 0x61, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x5c, 0x0a, 0x0d, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62,
-	0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x74, 0x65, 0x78, 0x74, 0x5f,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x74,
-	0x65, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x69, 0x6d, 0x61, 0x67,
-	0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52,
-	0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x22, 0x6c, 0x0a, 0x0b, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76,
-	0x69, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x5f,
-	0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x55,
-	0x72, 0x6c, 0x22, 0x51, 0x0a, 0x0e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x75, 0x0a, 0x0c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72,
-	0x6f, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x68, 0x6f,
-	0x75, 0x6c, 0x64, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0b, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x22, 0xdb, 0x01, 0x0a,
-	0x0c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
-	0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x08,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12,
-	0x37, 0x0a, 0x0c, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x40, 0x0a, 0x0f, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xce, 0x01, 0x0a, 0x11, 0x45,
-	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x09, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31,
-	0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x08, 0x74, 0x65, 0x73, 0x74, 0x43,
-	0x61, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x1c, 0x73, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x19, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x41, 0x0a, 0x12, 0x45,
-	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2b, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xb1,
-	0x02, 0x0a, 0x0f, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x76,
-	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x22, 0xc3, 0x01, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72,
-	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x64, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x10, 0x77, 0x6f, 0x72,
-	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f,
-	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0f, 0x77,
-	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x83,
-	0x07, 0x0a, 0x09, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x39,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x73, 0x18,
-	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74,
-	0x52, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x1d, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x1a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x11,
-	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x10, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20,
-	0x03, 0x28, 0x0d, 0x52, 0x14, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x46, 0x0a, 0x0e, 0x73, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d,
-	0x70, 0x74, 0x52, 0x0d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74,
-	0x73, 0x12, 0x4e, 0x0a, 0x24, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72,
-	0x6f, 0x6d, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x12, 0x18, 0x0a, 0x07, 0x58, 0x4d, 0x4c, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x58, 0x4d, 0x4c, 0x4d, 0x6f, 0x64, 0x65, 0x1a, 0xb5, 0x01, 0x0a, 0x06,
-	0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a,
-	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61,
-	0x62, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x76, 0x61,
-	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x09, 0x76,
-	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x1a, 0x4f, 0x0a, 0x0c, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f,
-	0x6d, 0x70, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x22, 0xba, 0x03, 0x0a, 0x08, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73,
-	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x49, 0x64, 0x12, 0x4e, 0x0a, 0x0f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
-	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e,
-	0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65,
-	0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x12, 0x68, 0x61, 0x73, 0x5f, 0x62, 0x65, 0x65,
-	0x6e, 0x5f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x10, 0x68, 0x61, 0x73, 0x42, 0x65, 0x65, 0x6e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61,
-	0x74, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39,
-	0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x1a, 0x59, 0x0a, 0x13, 0x56, 0x61, 0x72,
-	0x69, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x72, 0x69,
-	0x61, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0xba, 0x03, 0x0a, 0x0a, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x20, 0x0a, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65,
-	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32,
-	0x0a, 0x15, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x70,
-	0x72, 0x6f, 0x6d, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x40,
-	0x0a, 0x0f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x0e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x2e, 0x0a, 0x13, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x77,
-	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64,
-	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x46,
-	0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x25, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72,
-	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x48, 0x0a,
-	0x15, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
-	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
-	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x6d, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x57,
-	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x32, 0x0a, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x22, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
-	0x6d, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x6a, 0x0a, 0x14, 0x4c, 0x69,
-	0x73, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
-	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
-	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xa2, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x54,
-	0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x30, 0x0a, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x09, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73,
-	0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x76, 0x61, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0b,
-	0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x7a, 0x0a, 0x1a, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72, 0x6f,
-	0x6d, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
-	0x6f, 0x6d, 0x70, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x12, 0x25, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x4b, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x22, 0x48, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x09,
-	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0xf2,
-	0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x5b, 0x0a, 0x0f, 0x76,
-	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62,
-	0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x59, 0x0a, 0x13, 0x56, 0x61, 0x72, 0x69,
-	0x61, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61,
-	0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x48, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73,
-	0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a,
-	0x09, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43,
-	0x61, 0x73, 0x65, 0x52, 0x08, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x22, 0x27, 0x0a,
-	0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5b, 0x0a, 0x15, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4e,
-	0x61, 0x6d, 0x65, 0x22, 0x43, 0x0a, 0x16, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50,
-	0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a,
-	0x10, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x64, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x22, 0x19, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74,
-	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0xcb, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65,
-	0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x58, 0x0a, 0x0d, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x1a, 0x55, 0x0a, 0x11, 0x4d, 0x6f,
-	0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0x4f, 0x0a, 0x21, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53,
-	0x6d, 0x61, 0x6c, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4e, 0x61,
-	0x6d, 0x65, 0x22, 0x4f, 0x0a, 0x21, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x4c, 0x61, 0x72, 0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4e,
-	0x61, 0x6d, 0x65, 0x22, 0x7d, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
-	0x0c, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f,
-	0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4e, 0x61,
-	0x6d, 0x65, 0x22, 0xb8, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72,
-	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
-	0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64,
-	0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f,
-	0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6e, 0x65,
-	0x77, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x20, 0x0a,
-	0x09, 0x6e, 0x65, 0x77, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x00, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x42,
-	0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x65, 0x77, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0xc1, 0x01,
-	0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x6e, 0x65, 0x77,
-	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6e, 0x65, 0x77, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6d,
-	0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x22, 0xa0, 0x02, 0x0a, 0x17, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x65,
-	0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
-	0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64,
-	0x12, 0x25, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x5f,
-	0x63, 0x61, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x76,
-	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x09,
-	0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x5f, 0x63, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
-	0x09, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x6f, 0x74, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a,
-	0x0c, 0x6e, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x12,
-	0x24, 0x0a, 0x0b, 0x73, 0x65, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0a, 0x73, 0x65, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6d,
-	0x70, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x5f, 0x63, 0x6f, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x65, 0x65, 0x64, 0x5f, 0x70, 0x72,
-	0x6f, 0x6d, 0x70, 0x74, 0x22, 0x4c, 0x0a, 0x18, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x30, 0x0a, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x09, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73,
-	0x65, 0x73, 0x22, 0x71, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x49, 0x64, 0x22, 0x8c, 0x01, 0x0a, 0x1f, 0x53, 0x65, 0x74, 0x57, 0x6f, 0x72,
-	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72,
-	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13,
-	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x22, 0x7b, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76,
-	0x65, 0x22, 0x50, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x58, 0x4d, 0x4c, 0x4d, 0x6f, 0x64, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f,
-	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x58, 0x4d, 0x4c,
-	0x4d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x58, 0x4d, 0x4c, 0x4d,
-	0x6f, 0x64, 0x65, 0x22, 0x55, 0x0a, 0x15, 0x52, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e,
-	0x74, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0xd3, 0x01, 0x0a, 0x1a, 0x53,
-	0x79, 0x6e, 0x74, 0x68, 0x65, 0x74, 0x69, 0x63, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72,
-	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0d, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
-	0x3f, 0x0a, 0x1c, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74,
-	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f,
-	0x6d, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x2a, 0x23, 0x0a, 0x0c, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x08, 0x0a, 0x04, 0x54, 0x45, 0x58, 0x54, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4d,
-	0x41, 0x47, 0x45, 0x10, 0x01, 0x32, 0xfb, 0x0e, 0x0a, 0x11, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x45, 0x0a, 0x08, 0x45,
-	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76,
-	0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x59, 0x0a, 0x13, 0x53, 0x79, 0x6e, 0x74, 0x68, 0x65, 0x74, 0x69, 0x63, 0x47,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x65, 0x76, 0x61, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x74, 0x68, 0x65, 0x74, 0x69, 0x63, 0x47, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a,
-	0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x12, 0x1f, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x20, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1c, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0f, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1f, 0x2e, 0x65,
-	0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72,
-	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
-	0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f,
-	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x53, 0x0a, 0x0e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
-	0x6d, 0x70, 0x74, 0x12, 0x1e, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x1e, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x4c,
-	0x69, 0x73, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x65,
-	0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43,
-	0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x65, 0x76,
-	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61,
-	0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a,
-	0x10, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73,
-	0x65, 0x12, 0x20, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65,
-	0x72, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x1e, 0x2e, 0x65, 0x76, 0x61,
-	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43,
-	0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65,
-	0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x21, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d,
-	0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61,
-	0x75, 0x6c, 0x74, 0x53, 0x6d, 0x61, 0x6c, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x65, 0x76,
-	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57,
-	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4c, 0x61, 0x72, 0x67,
-	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x44, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x6d, 0x61, 0x6c, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2a, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x6d, 0x61, 0x6c, 0x6c, 0x4d,
-	0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x1a, 0x53,
-	0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4c, 0x61, 0x72, 0x67, 0x65, 0x4d, 0x6f,
-	0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2a, 0x2e, 0x65, 0x76, 0x61, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4c, 0x61,
-	0x72, 0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
-	0x68, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x25, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x26, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x15, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x25, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12,
-	0x28, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x57, 0x6f, 0x72,
-	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x20, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x58, 0x4d, 0x4c, 0x4d, 0x6f, 0x64,
-	0x65, 0x12, 0x1a, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x58,
-	0x4d, 0x4c, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0e, 0x52, 0x61, 0x74, 0x65, 0x54,
-	0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1e, 0x2e, 0x65, 0x76, 0x61, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x00, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x74, 0x69, 0x6e, 0x63, 0x61, 0x6e, 0x73, 0x2d, 0x61, 0x69, 0x2f, 0x65, 0x76, 0x61,
-	0x6c, 0x69, 0x74, 0x65, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x76, 0x61, 0x6c, 0x2f, 0x76, 0x31,
-	0x3b, 0x65, 0x76, 0x61, 0x6c, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Workspace *Workspace `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
 }
 
-var (
-	file_eval_v1_eval_proto_rawDescOnce sync.Once
-	file_eval_v1_eval_proto_rawDescData = file_eval_v1_eval_proto_rawDesc
-)
+func (x *GetWorkspaceResponse) Reset() {
+	*x = GetWorkspaceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_eval_v1_eval_proto_rawDescGZIP() []byte {
-	file_eval_v1_eval_proto_rawDescOnce.Do(func() {
-		file_eval_v1_eval_proto_rawDescData = protoimpl.X.CompressGZIP(file_eval_v1_eval_proto_rawDescData)
-	})
-	return file_eval_v1_eval_proto_rawDescData
+func (x *GetWorkspaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_eval_v1_eval_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_eval_v1_eval_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
-var file_eval_v1_eval_proto_goTypes = []any{
-	(VariableType)(0),                         // 0: eval.v1.VariableType
-	(*Variable)(nil),                          // 1: eval.v1.Variable
-	(*VariableValue)(nil),                     // 2: eval.v1.VariableValue
-	(*ModelConfig)(nil),                       // 3: eval.v1.ModelConfig
-	(*MessageOptions)(nil),                    // 4: eval.v1.MessageOptions
-	(*InferMessage)(nil),                      // 5: eval.v1.InferMessage
-	(*InferRequest)(nil),                      // 6: eval.v1.InferRequest
-	(*EvaluationRequest)(nil),                 // 7: eval.v1.EvaluationRequest
-	(*EvaluationResponse)(nil),                // 8: eval.v1.EvaluationResponse
-	(*WorkspaceConfig)(nil),                   // 9: eval.v1.WorkspaceConfig
-	(*CreateWorkspaceConfigRequest)(nil),      // 10: eval.v1.CreateWorkspaceConfigRequest
-	(*CreateWorkspaceConfigResponse)(nil),     // 11: eval.v1.CreateWorkspaceConfigResponse
-	(*Workspace)(nil),                         // 12: eval.v1.Workspace
-	(*TestCase)(nil),                          // 13: eval.v1.TestCase
-	(*TestResult)(nil),                        // 14: eval.v1.TestResult
-	(*CreateWorkspaceRequest)(nil),            // 15: eval.v1.CreateWorkspaceRequest
-	(*GetWorkspaceRequest)(nil),               // 16: eval.v1.GetWorkspaceRequest
-	(*ListWorkspacesRequest)(nil),             // 17: eval.v1.ListWorkspacesRequest
-	(*ListWorkspacesResponse)(nil),            // 18: eval.v1.ListWorkspacesResponse
-	(*GetPromptRequest)(nil),                  // 19: eval.v1.GetPromptRequest
-	(*ListTestCasesRequest)(nil),              // 20: eval.v1.ListTestCasesRequest
-	(*ListTestCasesResponse)(nil),             // 21: eval.v1.ListTestCasesResponse
-	(*CreatePromptVersionRequest)(nil),        // 22: eval.v1.CreatePromptVersionRequest
-	(*CreateWorkspaceResponse)(nil),           // 23: eval.v1.CreateWorkspaceResponse
-	(*GetWorkspaceResponse)(nil),              // 24: eval.v1.GetWorkspaceResponse
-	(*CreateTestCaseRequest)(nil),             // 25: eval.v1.CreateTestCaseRequest
-	(*CreateTestCaseResponse)(nil),            // 26: eval.v1.CreateTestCaseResponse
-	(*DeleteTestCaseRequest)(nil),             // 27: eval.v1.DeleteTestCaseRequest
-	(*GeneratePromptRequest)(nil),             // 28: eval.v1.GeneratePromptRequest
-	(*GeneratePromptResponse)(nil),            // 29: eval.v1.GeneratePromptResponse
-	(*ListModelConfigsRequest)(nil),           // 30: eval.v1.ListModelConfigsRequest
-	(*ListModelConfigsResponse)(nil),          // 31: eval.v1.ListModelConfigsResponse
-	(*SetDefaultSmallModelConfigRequest)(nil), // 32: eval.v1.SetDefaultSmallModelConfigRequest
-	(*SetDefaultLargeModelConfigRequest)(nil), // 33: eval.v1.SetDefaultLargeModelConfigRequest
-	(*GetModelConfigResponse)(nil),            // 34: eval.v1.GetModelConfigResponse
-	(*UpdateWorkspaceRequest)(nil),            // 35: eval.v1.UpdateWorkspaceRequest
-	(*UpdateWorkspaceResponse)(nil),           // 36: eval.v1.UpdateWorkspaceResponse
-	(*GenerateTestCaseRequest)(nil),           // 37: eval.v1.GenerateTestCaseRequest
-	(*GenerateTestCaseResponse)(nil),          // 38: eval.v1.GenerateTestCaseResponse
-	(*DeleteWorkspaceConfigRequest)(nil),      // 39: eval.v1.DeleteWorkspaceConfigRequest
-	(*SetWorkspaceConfigActiveRequest)(nil),   // 40: eval.v1.SetWorkspaceConfigActiveRequest
-	(*SetVersionActiveRequest)(nil),           // 41: eval.v1.SetVersionActiveRequest
-	(*SetXMLModeRequest)(nil),                 // 42: eval.v1.SetXMLModeRequest
-	(*RateTestResultRequest)(nil),             // 43: eval.v1.RateTestResultRequest
-	(*SyntheticGenerationRequest)(nil),        // 44: eval.v1.SyntheticGenerationRequest
-	(*Workspace_Prompt)(nil),                  // 45: eval.v1.Workspace.Prompt
-	(*Workspace_SystemPrompt)(nil),            // 46: eval.v1.Workspace.SystemPrompt
-	nil,                                       // 47: eval.v1.TestCase.VariableValuesEntry
-	nil,                                       // 48: eval.v1.CreateTestCaseRequest.VariableValuesEntry
-	nil,                                       // 49: eval.v1.ListModelConfigsResponse.ModelConfigsEntry
-	(*timestamppb.Timestamp)(nil),             // 50: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                     // 51: google.protobuf.Empty
+func (*GetWorkspaceResponse) ProtoMessage() {}
+
+func (x *GetWorkspaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_eval_v1_eval_proto_depIdxs = []int32{
-	0,  // 0: eval.v1.Variable.type:type_name -> eval.v1.VariableType
-	5,  // 1: eval.v1.InferRequest.messages:type_name -> eval.v1.InferMessage
-	3,  // 2: eval.v1.InferRequest.model_config:type_name -> eval.v1.ModelConfig
-	4,  // 3: eval.v1.InferRequest.message_options:type_name -> eval.v1.MessageOptions
-	13, // 4: eval.v1.EvaluationRequest.test_case:type_name -> eval.v1.TestCase
-	14, // 5: eval.v1.EvaluationResponse.result:type_name -> eval.v1.TestResult
-	4,  // 6: eval.v1.WorkspaceConfig.message_options:type_name -> eval.v1.MessageOptions
-	50, // 7: eval.v1.WorkspaceConfig.created_at:type_name -> google.protobuf.Timestamp
-	50, // 8: eval.v1.WorkspaceConfig.updated_at:type_name -> google.protobuf.Timestamp
-	4,  // 9: eval.v1.CreateWorkspaceConfigRequest.message_options:type_name -> eval.v1.MessageOptions
-	9,  // 10: eval.v1.CreateWorkspaceConfigResponse.workspace_config:type_name -> eval.v1.WorkspaceConfig
-	50, // 11: eval.v1.Workspace.created_at:type_name -> google.protobuf.Timestamp
-	50, // 12: eval.v1.Workspace.updated_at:type_name -> google.protobuf.Timestamp
-	45, // 13: eval.v1.Workspace.prompts:type_name -> eval.v1.Workspace.Prompt
-	9,  // 14: eval.v1.Workspace.workspace_configs:type_name -> eval.v1.WorkspaceConfig
-	46, // 15: eval.v1.Workspace.system_prompts:type_name -> eval.v1.Workspace.SystemPrompt
-	47, // 16: eval.v1.TestCase.variable_values:type_name -> eval.v1.TestCase.VariableValuesEntry
-	50, // 17: eval.v1.TestCase.created_at:type_name -> google.protobuf.Timestamp
-	50, // 18: eval.v1.TestCase.updated_at:type_name -> google.protobuf.Timestamp
-	4,  // 19: eval.v1.TestResult.message_options:type_name -> eval.v1.MessageOptions
-	50, // 20: eval.v1.TestResult.created_at:type_name -> google.protobuf.Timestamp
-	50, // 21: eval.v1.TestResult.updated_at:type_name -> google.protobuf.Timestamp
-	12, // 22: eval.v1.ListWorkspacesResponse.workspaces:type_name -> eval.v1.Workspace
-	13, // 23: eval.v1.ListTestCasesResponse.test_cases:type_name -> eval.v1.TestCase
-	14, // 24: eval.v1.ListTestCasesResponse.test_results:type_name -> eval.v1.TestResult
-	12, // 25: eval.v1.CreateWorkspaceResponse.workspace:type_name -> eval.v1.Workspace
-	12, // 26: eval.v1.GetWorkspaceResponse.workspace:type_name -> eval.v1.Workspace
-	48, // 27: eval.v1.CreateTestCaseRequest.variable_values:type_name -> eval.v1.CreateTestCaseRequest.VariableValuesEntry
-	13, // 28: eval.v1.CreateTestCaseResponse.test_case:type_name -> eval.v1.TestCase
-	49, // 29: eval.v1.ListModelConfigsResponse.model_configs:type_name -> eval.v1.ListModelConfigsResponse.ModelConfigsEntry
-	3,  // 30: eval.v1.GetModelConfigResponse.model_config:type_name -> eval.v1.ModelConfig
-	50, // 31: eval.v1.UpdateWorkspaceResponse.updated_at:type_name -> google.protobuf.Timestamp
-	13, // 32: eval.v1.GenerateTestCaseRequest.test_cases:type_name -> eval.v1.TestCase
-	13, // 33: eval.v1.GenerateTestCaseResponse.test_cases:type_name -> eval.v1.TestCase
-	1,  // 34: eval.v1.Workspace.Prompt.variables:type_name -> eval.v1.Variable
-	50, // 35: eval.v1.Workspace.Prompt.created_at:type_name -> google.protobuf.Timestamp
-	2,  // 36: eval.v1.TestCase.VariableValuesEntry.value:type_name -> eval.v1.VariableValue
-	2,  // 37: eval.v1.CreateTestCaseRequest.VariableValuesEntry.value:type_name -> eval.v1.VariableValue
-	3,  // 38: eval.v1.ListModelConfigsResponse.ModelConfigsEntry.value:type_name -> eval.v1.ModelConfig
-	7,  // 39: eval.v1.EvaluationService.Evaluate:input_type -> eval.v1.EvaluationRequest
-	44, // 40: eval.v1.EvaluationService.SyntheticGeneration:input_type -> eval.v1.SyntheticGenerationRequest
-	15, // 41: eval.v1.EvaluationService.CreateWorkspace:input_type -> eval.v1.CreateWorkspaceRequest
-	16, // 42: eval.v1.EvaluationService.GetWorkspace:input_type -> eval.v1.GetWorkspaceRequest
-	17, // 43: eval.v1.EvaluationService.ListWorkspaces:input_type -> eval.v1.ListWorkspacesRequest
-	35, // 44: eval.v1.EvaluationService.UpdateWorkspace:input_type -> eval.v1.UpdateWorkspaceRequest
-	28, // 45: eval.v1.EvaluationService.GeneratePrompt:input_type -> eval.v1.GeneratePromptRequest
-	25, // 46: eval.v1.EvaluationService.CreateTestCase:input_type -> eval.v1.CreateTestCaseRequest
-	20, // 47: eval.v1.EvaluationService.ListTestCases:input_type -> eval.v1.ListTestCasesRequest
-	37, // 48: eval.v1.EvaluationService.GenerateTestCase:input_type -> eval.v1.GenerateTestCaseRequest
-	27, // 49: eval.v1.EvaluationService.DeleteTestCase:input_type -> eval.v1.DeleteTestCaseRequest
-	51, // 50: eval.v1.EvaluationService.ListModelConfigs:input_type -> google.protobuf.Empty
-	51, // 51: eval.v1.EvaluationService.GetDefaultSmallModelConfig:input_type -> google.protobuf.Empty
-	51, // 52: eval.v1.EvaluationService.GetDefaultLargeModelConfig:input_type -> google.protobuf.Empty
-	32, // 53: eval.v1.EvaluationService.SetDefaultSmallModelConfig:input_type -> eval.v1.SetDefaultSmallModelConfigRequest
-	33, // 54: eval.v1.EvaluationService.SetDefaultLargeModelConfig:input_type -> eval.v1.SetDefaultLargeModelConfigRequest
-	10, // 55: eval.v1.EvaluationService.CreateWorkspaceConfig:input_type -> eval.v1.CreateWorkspaceConfigRequest
-	39, // 56: eval.v1.EvaluationService.DeleteWorkspaceConfig:input_type -> eval.v1.DeleteWorkspaceConfigRequest
-	40, // 57: eval.v1.EvaluationService.SetWorkspaceConfigActive:input_type -> eval.v1.SetWorkspaceConfigActiveRequest
-	41, // 58: eval.v1.EvaluationService.SetVersionActive:input_type -> eval.v1.SetVersionActiveRequest
-	42, // 59: eval.v1.EvaluationService.SetXMLMode:input_type -> eval.v1.SetXMLModeRequest
-	43, // 60: eval.v1.EvaluationService.RateTestResult:input_type -> eval.v1.RateTestResultRequest
-	8,  // 61: eval.v1.EvaluationService.Evaluate:output_type -> eval.v1.EvaluationResponse
-	8,  // 62: eval.v1.EvaluationService.SyntheticGeneration:output_type -> eval.v1.EvaluationResponse
-	23, // 63: eval.v1.EvaluationService.CreateWorkspace:output_type -> eval.v1.CreateWorkspaceResponse
-	24, // 64: eval.v1.EvaluationService.GetWorkspace:output_type -> eval.v1.GetWorkspaceResponse
-	18, // 65: eval.v1.EvaluationService.ListWorkspaces:output_type -> eval.v1.ListWorkspacesResponse
-	36, // 66: eval.v1.EvaluationService.UpdateWorkspace:output_type -> eval.v1.UpdateWorkspaceResponse
-	29, // 67: eval.v1.EvaluationService.GeneratePrompt:output_type -> eval.v1.GeneratePromptResponse
-	26, // 68: eval.v1.EvaluationService.CreateTestCase:output_type -> eval.v1.CreateTestCaseResponse
-	21, // 69: eval.v1.EvaluationService.ListTestCases:output_type -> eval.v1.ListTestCasesResponse
-	38, // 70: eval.v1.EvaluationService.GenerateTestCase:output_type -> eval.v1.GenerateTestCaseResponse
-	51, // 71: eval.v1.EvaluationService.DeleteTestCase:output_type -> google.protobuf.Empty
-	31, // 72: eval.v1.EvaluationService.ListModelConfigs:output_type -> eval.v1.ListModelConfigsResponse
-	34, // 73: eval.v1.EvaluationService.GetDefaultSmallModelConfig:output_type -> eval.v1.GetModelConfigResponse
-	34, // 74: eval.v1.EvaluationService.GetDefaultLargeModelConfig:output_type -> eval.v1.GetModelConfigResponse
-	51, // 75: eval.v1.EvaluationService.SetDefaultSmallModelConfig:output_type -> google.protobuf.Empty
-	51, // 76: eval.v1.EvaluationService.SetDefaultLargeModelConfig:output_type -> google.protobuf.Empty
-	11, // 77: eval.v1.EvaluationService.CreateWorkspaceConfig:output_type -> eval.v1.CreateWorkspaceConfigResponse
-	51, // 78: eval.v1.EvaluationService.DeleteWorkspaceConfig:output_type -> google.protobuf.Empty
-	51, // 79: eval.v1.EvaluationService.SetWorkspaceConfigActive:output_type -> google.protobuf.Empty
-	51, // 80: eval.v1.EvaluationService.SetVersionActive:output_type -> google.protobuf.Empty
-	51, // 81: eval.v1.EvaluationService.SetXMLMode:output_type -> google.protobuf.Empty
-	51, // 82: eval.v1.EvaluationService.RateTestResult:output_type -> google.protobuf.Empty
-	61, // [61:83] is the sub-list for method output_type
-	39, // [39:61] is the sub-list for method input_type
-	39, // [39:39] is the sub-list for extension type_name
-	39, // [39:39] is the sub-list for extension extendee
-	0,  // [0:39] is the sub-list for field type_name
+
+// Deprecated: Use GetWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{33}
 }
 
-func init() { file_eval_v1_eval_proto_init() }
-func file_eval_v1_eval_proto_init() {
-	if File_eval_v1_eval_proto != nil {
-		return
+func (x *GetWorkspaceResponse) GetWorkspace() *Workspace {
+	if x != nil {
+		return x.Workspace
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_eval_v1_eval_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*Variable); i {
+	return nil
+}
+
+type CreateTestCaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId    string                    `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	VariableValues map[string]*VariableValue `protobuf:"bytes,2,rep,name=variable_values,json=variableValues,proto3" json:"variable_values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// response is the reference output for the test case, if known
+	Response *string  `protobuf:"bytes,3,opt,name=response,proto3,oneof" json:"response,omitempty"`
+	Tags     []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Split    Split    `protobuf:"varint,5,opt,name=split,proto3,enum=eval.v1.Split" json:"split,omitempty"`
+}
+
+func (x *CreateTestCaseRequest) Reset() {
+	*x = CreateTestCaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTestCaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTestCaseRequest) ProtoMessage() {}
+
+func (x *CreateTestCaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTestCaseRequest.ProtoReflect.Descriptor instead.
+func (*CreateTestCaseRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CreateTestCaseRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *CreateTestCaseRequest) GetVariableValues() map[string]*VariableValue {
+	if x != nil {
+		return x.VariableValues
+	}
+	return nil
+}
+
+func (x *CreateTestCaseRequest) GetResponse() string {
+	if x != nil && x.Response != nil {
+		return *x.Response
+	}
+	return ""
+}
+
+func (x *CreateTestCaseRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateTestCaseRequest) GetSplit() Split {
+	if x != nil {
+		return x.Split
+	}
+	return Split_SPLIT_UNSPECIFIED
+}
+
+type CreateTestCaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TestCase *TestCase `protobuf:"bytes,1,opt,name=test_case,json=testCase,proto3" json:"test_case,omitempty"`
+}
+
+func (x *CreateTestCaseResponse) Reset() {
+	*x = CreateTestCaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTestCaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTestCaseResponse) ProtoMessage() {}
+
+func (x *CreateTestCaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTestCaseResponse.ProtoReflect.Descriptor instead.
+func (*CreateTestCaseResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreateTestCaseResponse) GetTestCase() *TestCase {
+	if x != nil {
+		return x.TestCase
+	}
+	return nil
+}
+
+type DeleteTestCaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteTestCaseRequest) Reset() {
+	*x = DeleteTestCaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteTestCaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTestCaseRequest) ProtoMessage() {}
+
+func (x *DeleteTestCaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTestCaseRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTestCaseRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DeleteTestCaseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DedupOptions controls how new test cases that are near-duplicates of existing
+// ones are handled. Similarity is the Jaccard similarity of the character shingles
+// of the normalized variable values.
+type DedupOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// threshold in (0, 1] above which cases are near-duplicates; 0.8 if unset
+	Threshold float64         `protobuf:"fixed64,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Action    DuplicateAction `protobuf:"varint,2,opt,name=action,proto3,enum=eval.v1.DuplicateAction" json:"action,omitempty"`
+}
+
+func (x *DedupOptions) Reset() {
+	*x = DedupOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DedupOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DedupOptions) ProtoMessage() {}
+
+func (x *DedupOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DedupOptions.ProtoReflect.Descriptor instead.
+func (*DedupOptions) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DedupOptions) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *DedupOptions) GetAction() DuplicateAction {
+	if x != nil {
+		return x.Action
+	}
+	return DuplicateAction_DUPLICATE_ACTION_SKIP
+}
+
+type NearDuplicate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// test_case_id is the new case, or empty if it was skipped
+	TestCaseId string `protobuf:"bytes,1,opt,name=test_case_id,json=testCaseId,proto3" json:"test_case_id,omitempty"`
+	// duplicate_of is the existing (or earlier new) case it resembles
+	DuplicateOf string  `protobuf:"bytes,2,opt,name=duplicate_of,json=duplicateOf,proto3" json:"duplicate_of,omitempty"`
+	Similarity  float64 `protobuf:"fixed64,3,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	Skipped     bool    `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// row is the imported row the case came from, for imports
+	Row uint32 `protobuf:"varint,5,opt,name=row,proto3" json:"row,omitempty"`
+}
+
+func (x *NearDuplicate) Reset() {
+	*x = NearDuplicate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NearDuplicate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearDuplicate) ProtoMessage() {}
+
+func (x *NearDuplicate) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearDuplicate.ProtoReflect.Descriptor instead.
+func (*NearDuplicate) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *NearDuplicate) GetTestCaseId() string {
+	if x != nil {
+		return x.TestCaseId
+	}
+	return ""
+}
+
+func (x *NearDuplicate) GetDuplicateOf() string {
+	if x != nil {
+		return x.DuplicateOf
+	}
+	return ""
+}
+
+func (x *NearDuplicate) GetSimilarity() float64 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+func (x *NearDuplicate) GetSkipped() bool {
+	if x != nil {
+		return x.Skipped
+	}
+	return false
+}
+
+func (x *NearDuplicate) GetRow() uint32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+type FindDuplicateTestCasesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	// threshold in (0, 1] above which cases are near-duplicates; 0.8 if unset
+	Threshold float64         `protobuf:"fixed64,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Filter    *TestCaseFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// soft-delete every case in a group except the oldest
+	Delete bool `protobuf:"varint,4,opt,name=delete,proto3" json:"delete,omitempty"`
+}
+
+func (x *FindDuplicateTestCasesRequest) Reset() {
+	*x = FindDuplicateTestCasesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindDuplicateTestCasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDuplicateTestCasesRequest) ProtoMessage() {}
+
+func (x *FindDuplicateTestCasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDuplicateTestCasesRequest.ProtoReflect.Descriptor instead.
+func (*FindDuplicateTestCasesRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *FindDuplicateTestCasesRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *FindDuplicateTestCasesRequest) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *FindDuplicateTestCasesRequest) GetFilter() *TestCaseFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *FindDuplicateTestCasesRequest) GetDelete() bool {
+	if x != nil {
+		return x.Delete
+	}
+	return false
+}
+
+// DuplicateGroup is a set of test cases linked by near-duplicate pairs.
+type DuplicateGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// test_case_ids are ordered oldest first
+	TestCaseIds []string `protobuf:"bytes,1,rep,name=test_case_ids,json=testCaseIds,proto3" json:"test_case_ids,omitempty"`
+	// min_similarity is the lowest similarity among the pairs linking the group
+	MinSimilarity float64 `protobuf:"fixed64,2,opt,name=min_similarity,json=minSimilarity,proto3" json:"min_similarity,omitempty"`
+}
+
+func (x *DuplicateGroup) Reset() {
+	*x = DuplicateGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DuplicateGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateGroup) ProtoMessage() {}
+
+func (x *DuplicateGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateGroup.ProtoReflect.Descriptor instead.
+func (*DuplicateGroup) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DuplicateGroup) GetTestCaseIds() []string {
+	if x != nil {
+		return x.TestCaseIds
+	}
+	return nil
+}
+
+func (x *DuplicateGroup) GetMinSimilarity() float64 {
+	if x != nil {
+		return x.MinSimilarity
+	}
+	return 0
+}
+
+type FindDuplicateTestCasesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groups   []*DuplicateGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	NDeleted uint32            `protobuf:"varint,2,opt,name=n_deleted,json=nDeleted,proto3" json:"n_deleted,omitempty"`
+}
+
+func (x *FindDuplicateTestCasesResponse) Reset() {
+	*x = FindDuplicateTestCasesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindDuplicateTestCasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDuplicateTestCasesResponse) ProtoMessage() {}
+
+func (x *FindDuplicateTestCasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindDuplicateTestCasesResponse.ProtoReflect.Descriptor instead.
+func (*FindDuplicateTestCasesResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *FindDuplicateTestCasesResponse) GetGroups() []*DuplicateGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *FindDuplicateTestCasesResponse) GetNDeleted() uint32 {
+	if x != nil {
+		return x.NDeleted
+	}
+	return 0
+}
+
+// ListDeletedTestCasesRequest lists the soft-deleted test cases of a workspace, most
+// recently deleted first.
+type ListDeletedTestCasesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Page        int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize    int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListDeletedTestCasesRequest) Reset() {
+	*x = ListDeletedTestCasesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeletedTestCasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedTestCasesRequest) ProtoMessage() {}
+
+func (x *ListDeletedTestCasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedTestCasesRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedTestCasesRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListDeletedTestCasesRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *ListDeletedTestCasesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListDeletedTestCasesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListDeletedTestCasesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TestCases  []*TestCase `protobuf:"bytes,1,rep,name=test_cases,json=testCases,proto3" json:"test_cases,omitempty"`
+	TotalCount int32       `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+}
+
+func (x *ListDeletedTestCasesResponse) Reset() {
+	*x = ListDeletedTestCasesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeletedTestCasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedTestCasesResponse) ProtoMessage() {}
+
+func (x *ListDeletedTestCasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedTestCasesResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedTestCasesResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListDeletedTestCasesResponse) GetTestCases() []*TestCase {
+	if x != nil {
+		return x.TestCases
+	}
+	return nil
+}
+
+func (x *ListDeletedTestCasesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type RestoreTestCaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RestoreTestCaseRequest) Reset() {
+	*x = RestoreTestCaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreTestCaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTestCaseRequest) ProtoMessage() {}
+
+func (x *RestoreTestCaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTestCaseRequest.ProtoReflect.Descriptor instead.
+func (*RestoreTestCaseRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RestoreTestCaseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RestoreTestCaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TestCase *TestCase `protobuf:"bytes,1,opt,name=test_case,json=testCase,proto3" json:"test_case,omitempty"`
+	// test results deleted with the test case and restored with it
+	NTestResults uint32 `protobuf:"varint,2,opt,name=n_test_results,json=nTestResults,proto3" json:"n_test_results,omitempty"`
+}
+
+func (x *RestoreTestCaseResponse) Reset() {
+	*x = RestoreTestCaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreTestCaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTestCaseResponse) ProtoMessage() {}
+
+func (x *RestoreTestCaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTestCaseResponse.ProtoReflect.Descriptor instead.
+func (*RestoreTestCaseResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RestoreTestCaseResponse) GetTestCase() *TestCase {
+	if x != nil {
+		return x.TestCase
+	}
+	return nil
+}
+
+func (x *RestoreTestCaseResponse) GetNTestResults() uint32 {
+	if x != nil {
+		return x.NTestResults
+	}
+	return 0
+}
+
+// PurgeTestCasesRequest permanently deletes soft-deleted test cases, along with their
+// test results and ratings. With no ids, every deleted test case of the workspace is
+// purged.
+type PurgeTestCasesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId string   `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Ids         []string `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"`
+	// only purge test cases deleted before this time
+	DeletedBefore *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=deleted_before,json=deletedBefore,proto3" json:"deleted_before,omitempty"`
+}
+
+func (x *PurgeTestCasesRequest) Reset() {
+	*x = PurgeTestCasesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeTestCasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTestCasesRequest) ProtoMessage() {}
+
+func (x *PurgeTestCasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTestCasesRequest.ProtoReflect.Descriptor instead.
+func (*PurgeTestCasesRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *PurgeTestCasesRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *PurgeTestCasesRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *PurgeTestCasesRequest) GetDeletedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedBefore
+	}
+	return nil
+}
+
+type PurgeTestCasesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NTestCases   uint32 `protobuf:"varint,1,opt,name=n_test_cases,json=nTestCases,proto3" json:"n_test_cases,omitempty"`
+	NTestResults uint32 `protobuf:"varint,2,opt,name=n_test_results,json=nTestResults,proto3" json:"n_test_results,omitempty"`
+	NRatings     uint32 `protobuf:"varint,3,opt,name=n_ratings,json=nRatings,proto3" json:"n_ratings,omitempty"`
+}
+
+func (x *PurgeTestCasesResponse) Reset() {
+	*x = PurgeTestCasesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeTestCasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTestCasesResponse) ProtoMessage() {}
+
+func (x *PurgeTestCasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTestCasesResponse.ProtoReflect.Descriptor instead.
+func (*PurgeTestCasesResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *PurgeTestCasesResponse) GetNTestCases() uint32 {
+	if x != nil {
+		return x.NTestCases
+	}
+	return 0
+}
+
+func (x *PurgeTestCasesResponse) GetNTestResults() uint32 {
+	if x != nil {
+		return x.NTestResults
+	}
+	return 0
+}
+
+func (x *PurgeTestCasesResponse) GetNRatings() uint32 {
+	if x != nil {
+		return x.NRatings
+	}
+	return 0
+}
+
+// UpdateTestCasesRequest changes the tags or split of several test cases at once.
+type UpdateTestCasesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// split is left unchanged if unset
+	Split      *Split   `protobuf:"varint,2,opt,name=split,proto3,enum=eval.v1.Split,oneof" json:"split,omitempty"`
+	AddTags    []string `protobuf:"bytes,3,rep,name=add_tags,json=addTags,proto3" json:"add_tags,omitempty"`
+	RemoveTags []string `protobuf:"bytes,4,rep,name=remove_tags,json=removeTags,proto3" json:"remove_tags,omitempty"`
+}
+
+func (x *UpdateTestCasesRequest) Reset() {
+	*x = UpdateTestCasesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateTestCasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTestCasesRequest) ProtoMessage() {}
+
+func (x *UpdateTestCasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTestCasesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTestCasesRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UpdateTestCasesRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *UpdateTestCasesRequest) GetSplit() Split {
+	if x != nil && x.Split != nil {
+		return *x.Split
+	}
+	return Split_SPLIT_UNSPECIFIED
+}
+
+func (x *UpdateTestCasesRequest) GetAddTags() []string {
+	if x != nil {
+		return x.AddTags
+	}
+	return nil
+}
+
+func (x *UpdateTestCasesRequest) GetRemoveTags() []string {
+	if x != nil {
+		return x.RemoveTags
+	}
+	return nil
+}
+
+type UpdateTestCasesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TestCases []*TestCase `protobuf:"bytes,1,rep,name=test_cases,json=testCases,proto3" json:"test_cases,omitempty"`
+}
+
+func (x *UpdateTestCasesResponse) Reset() {
+	*x = UpdateTestCasesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateTestCasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTestCasesResponse) ProtoMessage() {}
+
+func (x *UpdateTestCasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTestCasesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTestCasesResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UpdateTestCasesResponse) GetTestCases() []*TestCase {
+	if x != nil {
+		return x.TestCases
+	}
+	return nil
+}
+
+type ImportTestCasesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId string     `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Format      FileFormat `protobuf:"varint,2,opt,name=format,proto3,enum=eval.v1.FileFormat" json:"format,omitempty"`
+	Data        []byte     `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// column_mapping maps source columns to prompt variables. Columns named after a
+	// prompt variable are mapped to it without an entry here.
+	ColumnMapping map[string]string `protobuf:"bytes,4,rep,name=column_mapping,json=columnMapping,proto3" json:"column_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// reference_column holds the reference output for each case, if any
+	ReferenceColumn *string `protobuf:"bytes,5,opt,name=reference_column,json=referenceColumn,proto3,oneof" json:"reference_column,omitempty"`
+	// skip_invalid imports the valid rows even if other rows fail. Otherwise any
+	// row error aborts the whole import.
+	SkipInvalid bool `protobuf:"varint,6,opt,name=skip_invalid,json=skipInvalid,proto3" json:"skip_invalid,omitempty"`
+	// dry_run validates the rows without writing anything
+	DryRun bool `protobuf:"varint,7,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// tags and split are given to every imported case
+	Tags  []string      `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	Split Split         `protobuf:"varint,9,opt,name=split,proto3,enum=eval.v1.Split" json:"split,omitempty"`
+	Dedup *DedupOptions `protobuf:"bytes,10,opt,name=dedup,proto3" json:"dedup,omitempty"`
+	// if set, the cases are created in this dataset, which must be attached to the
+	// workspace. Variables are named as in the workspace and stored under the
+	// dataset's names.
+	DatasetId *string `protobuf:"bytes,11,opt,name=dataset_id,json=datasetId,proto3,oneof" json:"dataset_id,omitempty"`
+}
+
+func (x *ImportTestCasesRequest) Reset() {
+	*x = ImportTestCasesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportTestCasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTestCasesRequest) ProtoMessage() {}
+
+func (x *ImportTestCasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTestCasesRequest.ProtoReflect.Descriptor instead.
+func (*ImportTestCasesRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ImportTestCasesRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *ImportTestCasesRequest) GetFormat() FileFormat {
+	if x != nil {
+		return x.Format
+	}
+	return FileFormat_FILE_FORMAT_CSV
+}
+
+func (x *ImportTestCasesRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ImportTestCasesRequest) GetColumnMapping() map[string]string {
+	if x != nil {
+		return x.ColumnMapping
+	}
+	return nil
+}
+
+func (x *ImportTestCasesRequest) GetReferenceColumn() string {
+	if x != nil && x.ReferenceColumn != nil {
+		return *x.ReferenceColumn
+	}
+	return ""
+}
+
+func (x *ImportTestCasesRequest) GetSkipInvalid() bool {
+	if x != nil {
+		return x.SkipInvalid
+	}
+	return false
+}
+
+func (x *ImportTestCasesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportTestCasesRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ImportTestCasesRequest) GetSplit() Split {
+	if x != nil {
+		return x.Split
+	}
+	return Split_SPLIT_UNSPECIFIED
+}
+
+func (x *ImportTestCasesRequest) GetDedup() *DedupOptions {
+	if x != nil {
+		return x.Dedup
+	}
+	return nil
+}
+
+func (x *ImportTestCasesRequest) GetDatasetId() string {
+	if x != nil && x.DatasetId != nil {
+		return *x.DatasetId
+	}
+	return ""
+}
+
+type ImportRowError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// row is the line of the file the row starts on, starting at 1
+	Row   uint32 `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ImportRowError) Reset() {
+	*x = ImportRowError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowError) ProtoMessage() {}
+
+func (x *ImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowError.ProtoReflect.Descriptor instead.
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ImportRowError) GetRow() uint32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowError) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ImportTestCasesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TestCases []*TestCase `protobuf:"bytes,1,rep,name=test_cases,json=testCases,proto3" json:"test_cases,omitempty"`
+	// n_duplicates counts rows that matched an existing case or an earlier row
+	NDuplicates    uint32            `protobuf:"varint,2,opt,name=n_duplicates,json=nDuplicates,proto3" json:"n_duplicates,omitempty"`
+	Errors         []*ImportRowError `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	NearDuplicates []*NearDuplicate  `protobuf:"bytes,4,rep,name=near_duplicates,json=nearDuplicates,proto3" json:"near_duplicates,omitempty"`
+}
+
+func (x *ImportTestCasesResponse) Reset() {
+	*x = ImportTestCasesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportTestCasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTestCasesResponse) ProtoMessage() {}
+
+func (x *ImportTestCasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTestCasesResponse.ProtoReflect.Descriptor instead.
+func (*ImportTestCasesResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ImportTestCasesResponse) GetTestCases() []*TestCase {
+	if x != nil {
+		return x.TestCases
+	}
+	return nil
+}
+
+func (x *ImportTestCasesResponse) GetNDuplicates() uint32 {
+	if x != nil {
+		return x.NDuplicates
+	}
+	return 0
+}
+
+func (x *ImportTestCasesResponse) GetErrors() []*ImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportTestCasesResponse) GetNearDuplicates() []*NearDuplicate {
+	if x != nil {
+		return x.NearDuplicates
+	}
+	return nil
+}
+
+// GeneratePromptRequest uses LLM to generate a prompt
+type GeneratePromptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prompt          string `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	ModelConfigName string `protobuf:"bytes,2,opt,name=model_config_name,json=modelConfigName,proto3" json:"model_config_name,omitempty"`
+}
+
+func (x *GeneratePromptRequest) Reset() {
+	*x = GeneratePromptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeneratePromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneratePromptRequest) ProtoMessage() {}
+
+func (x *GeneratePromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneratePromptRequest.ProtoReflect.Descriptor instead.
+func (*GeneratePromptRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GeneratePromptRequest) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *GeneratePromptRequest) GetModelConfigName() string {
+	if x != nil {
+		return x.ModelConfigName
+	}
+	return ""
+}
+
+type GeneratePromptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GeneratedPrompt string `protobuf:"bytes,1,opt,name=generated_prompt,json=generatedPrompt,proto3" json:"generated_prompt,omitempty"`
+}
+
+func (x *GeneratePromptResponse) Reset() {
+	*x = GeneratePromptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeneratePromptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneratePromptResponse) ProtoMessage() {}
+
+func (x *GeneratePromptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneratePromptResponse.ProtoReflect.Descriptor instead.
+func (*GeneratePromptResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GeneratePromptResponse) GetGeneratedPrompt() string {
+	if x != nil {
+		return x.GeneratedPrompt
+	}
+	return ""
+}
+
+type ListModelConfigsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListModelConfigsRequest) Reset() {
+	*x = ListModelConfigsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListModelConfigsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModelConfigsRequest) ProtoMessage() {}
+
+func (x *ListModelConfigsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModelConfigsRequest.ProtoReflect.Descriptor instead.
+func (*ListModelConfigsRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{55}
+}
+
+type ListModelConfigsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModelConfigs map[string]*ModelConfig `protobuf:"bytes,1,rep,name=model_configs,json=modelConfigs,proto3" json:"model_configs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ListModelConfigsResponse) Reset() {
+	*x = ListModelConfigsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListModelConfigsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModelConfigsResponse) ProtoMessage() {}
+
+func (x *ListModelConfigsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModelConfigsResponse.ProtoReflect.Descriptor instead.
+func (*ListModelConfigsResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListModelConfigsResponse) GetModelConfigs() map[string]*ModelConfig {
+	if x != nil {
+		return x.ModelConfigs
+	}
+	return nil
+}
+
+type SetDefaultSmallModelConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModelConfigName string `protobuf:"bytes,1,opt,name=model_config_name,json=modelConfigName,proto3" json:"model_config_name,omitempty"`
+}
+
+func (x *SetDefaultSmallModelConfigRequest) Reset() {
+	*x = SetDefaultSmallModelConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDefaultSmallModelConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDefaultSmallModelConfigRequest) ProtoMessage() {}
+
+func (x *SetDefaultSmallModelConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDefaultSmallModelConfigRequest.ProtoReflect.Descriptor instead.
+func (*SetDefaultSmallModelConfigRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SetDefaultSmallModelConfigRequest) GetModelConfigName() string {
+	if x != nil {
+		return x.ModelConfigName
+	}
+	return ""
+}
+
+type SetDefaultLargeModelConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModelConfigName string `protobuf:"bytes,1,opt,name=model_config_name,json=modelConfigName,proto3" json:"model_config_name,omitempty"`
+}
+
+func (x *SetDefaultLargeModelConfigRequest) Reset() {
+	*x = SetDefaultLargeModelConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDefaultLargeModelConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDefaultLargeModelConfigRequest) ProtoMessage() {}
+
+func (x *SetDefaultLargeModelConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDefaultLargeModelConfigRequest.ProtoReflect.Descriptor instead.
+func (*SetDefaultLargeModelConfigRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SetDefaultLargeModelConfigRequest) GetModelConfigName() string {
+	if x != nil {
+		return x.ModelConfigName
+	}
+	return ""
+}
+
+type GetModelConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModelConfig     *ModelConfig `protobuf:"bytes,1,opt,name=model_config,json=modelConfig,proto3" json:"model_config,omitempty"`
+	ModelConfigName string       `protobuf:"bytes,2,opt,name=model_config_name,json=modelConfigName,proto3" json:"model_config_name,omitempty"`
+}
+
+func (x *GetModelConfigResponse) Reset() {
+	*x = GetModelConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetModelConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModelConfigResponse) ProtoMessage() {}
+
+func (x *GetModelConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModelConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetModelConfigResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetModelConfigResponse) GetModelConfig() *ModelConfig {
+	if x != nil {
+		return x.ModelConfig
+	}
+	return nil
+}
+
+func (x *GetModelConfigResponse) GetModelConfigName() string {
+	if x != nil {
+		return x.ModelConfigName
+	}
+	return ""
+}
+
+// UpdateWorkspaceRequest creates a new prompt version and a new system prompt version
+// when their content differs from the current versions.
+type UpdateWorkspaceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId     string  `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	NewContent      string  `protobuf:"bytes,2,opt,name=new_content,json=newContent,proto3" json:"new_content,omitempty"`
+	NewSystemPrompt string  `protobuf:"bytes,3,opt,name=new_system_prompt,json=newSystemPrompt,proto3" json:"new_system_prompt,omitempty"`
+	NewTitle        *string `protobuf:"bytes,4,opt,name=new_title,json=newTitle,proto3,oneof" json:"new_title,omitempty"`
+	// recorded on the new versions
+	CommitMessage string `protobuf:"bytes,5,opt,name=commit_message,json=commitMessage,proto3" json:"commit_message,omitempty"`
+	// the prompt version the new content was edited from; defaults to the current version
+	ParentVersionNumber *uint32 `protobuf:"varint,6,opt,name=parent_version_number,json=parentVersionNumber,proto3,oneof" json:"parent_version_number,omitempty"`
+}
+
+func (x *UpdateWorkspaceRequest) Reset() {
+	*x = UpdateWorkspaceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateWorkspaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkspaceRequest) ProtoMessage() {}
+
+func (x *UpdateWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UpdateWorkspaceRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *UpdateWorkspaceRequest) GetNewContent() string {
+	if x != nil {
+		return x.NewContent
+	}
+	return ""
+}
+
+func (x *UpdateWorkspaceRequest) GetNewSystemPrompt() string {
+	if x != nil {
+		return x.NewSystemPrompt
+	}
+	return ""
+}
+
+func (x *UpdateWorkspaceRequest) GetNewTitle() string {
+	if x != nil && x.NewTitle != nil {
+		return *x.NewTitle
+	}
+	return ""
+}
+
+func (x *UpdateWorkspaceRequest) GetCommitMessage() string {
+	if x != nil {
+		return x.CommitMessage
+	}
+	return ""
+}
+
+func (x *UpdateWorkspaceRequest) GetParentVersionNumber() uint32 {
+	if x != nil && x.ParentVersionNumber != nil {
+		return *x.ParentVersionNumber
+	}
+	return 0
+}
+
+type UpdateWorkspaceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the current prompt version, which is only new if prompt_changed
+	NewVersionNumber          uint32                 `protobuf:"varint,1,opt,name=new_version_number,json=newVersionNumber,proto3" json:"new_version_number,omitempty"`
+	Content                   string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	SystemPrompt              string                 `protobuf:"bytes,3,opt,name=system_prompt,json=systemPrompt,proto3" json:"system_prompt,omitempty"`
+	UpdatedAt                 *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	PromptChanged             bool                   `protobuf:"varint,5,opt,name=prompt_changed,json=promptChanged,proto3" json:"prompt_changed,omitempty"`
+	SystemPromptChanged       bool                   `protobuf:"varint,6,opt,name=system_prompt_changed,json=systemPromptChanged,proto3" json:"system_prompt_changed,omitempty"`
+	SystemPromptVersionNumber uint32                 `protobuf:"varint,7,opt,name=system_prompt_version_number,json=systemPromptVersionNumber,proto3" json:"system_prompt_version_number,omitempty"`
+	// lint findings for the current prompt and system prompt
+	LintFindings []*LintFinding `protobuf:"bytes,8,rep,name=lint_findings,json=lintFindings,proto3" json:"lint_findings,omitempty"`
+}
+
+func (x *UpdateWorkspaceResponse) Reset() {
+	*x = UpdateWorkspaceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateWorkspaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkspaceResponse) ProtoMessage() {}
+
+func (x *UpdateWorkspaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWorkspaceResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *UpdateWorkspaceResponse) GetNewVersionNumber() uint32 {
+	if x != nil {
+		return x.NewVersionNumber
+	}
+	return 0
+}
+
+func (x *UpdateWorkspaceResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *UpdateWorkspaceResponse) GetSystemPrompt() string {
+	if x != nil {
+		return x.SystemPrompt
+	}
+	return ""
+}
+
+func (x *UpdateWorkspaceResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *UpdateWorkspaceResponse) GetPromptChanged() bool {
+	if x != nil {
+		return x.PromptChanged
+	}
+	return false
+}
+
+func (x *UpdateWorkspaceResponse) GetSystemPromptChanged() bool {
+	if x != nil {
+		return x.SystemPromptChanged
+	}
+	return false
+}
+
+func (x *UpdateWorkspaceResponse) GetSystemPromptVersionNumber() uint32 {
+	if x != nil {
+		return x.SystemPromptVersionNumber
+	}
+	return 0
+}
+
+func (x *UpdateWorkspaceResponse) GetLintFindings() []*LintFinding {
+	if x != nil {
+		return x.LintFindings
+	}
+	return nil
+}
+
+type WordDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DIFF_OP_EQUAL, DIFF_OP_INSERT or DIFF_OP_DELETE
+	Op   DiffOp `protobuf:"varint,1,opt,name=op,proto3,enum=eval.v1.DiffOp" json:"op,omitempty"`
+	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *WordDiff) Reset() {
+	*x = WordDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WordDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WordDiff) ProtoMessage() {}
+
+func (x *WordDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WordDiff.ProtoReflect.Descriptor instead.
+func (*WordDiff) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *WordDiff) GetOp() DiffOp {
+	if x != nil {
+		return x.Op
+	}
+	return DiffOp_DIFF_OP_UNSPECIFIED
+}
+
+func (x *WordDiff) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type LineDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Op      DiffOp `protobuf:"varint,1,opt,name=op,proto3,enum=eval.v1.DiffOp" json:"op,omitempty"`
+	OldText string `protobuf:"bytes,2,opt,name=old_text,json=oldText,proto3" json:"old_text,omitempty"`
+	NewText string `protobuf:"bytes,3,opt,name=new_text,json=newText,proto3" json:"new_text,omitempty"`
+	// line numbers start at 1, and are 0 for a line that is not in that version
+	OldLine uint32 `protobuf:"varint,4,opt,name=old_line,json=oldLine,proto3" json:"old_line,omitempty"`
+	NewLine uint32 `protobuf:"varint,5,opt,name=new_line,json=newLine,proto3" json:"new_line,omitempty"`
+	// word-level diff of a DIFF_OP_REPLACE line
+	Words []*WordDiff `protobuf:"bytes,6,rep,name=words,proto3" json:"words,omitempty"`
+}
+
+func (x *LineDiff) Reset() {
+	*x = LineDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LineDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LineDiff) ProtoMessage() {}
+
+func (x *LineDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LineDiff.ProtoReflect.Descriptor instead.
+func (*LineDiff) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *LineDiff) GetOp() DiffOp {
+	if x != nil {
+		return x.Op
+	}
+	return DiffOp_DIFF_OP_UNSPECIFIED
+}
+
+func (x *LineDiff) GetOldText() string {
+	if x != nil {
+		return x.OldText
+	}
+	return ""
+}
+
+func (x *LineDiff) GetNewText() string {
+	if x != nil {
+		return x.NewText
+	}
+	return ""
+}
+
+func (x *LineDiff) GetOldLine() uint32 {
+	if x != nil {
+		return x.OldLine
+	}
+	return 0
+}
+
+func (x *LineDiff) GetNewLine() uint32 {
+	if x != nil {
+		return x.NewLine
+	}
+	return 0
+}
+
+func (x *LineDiff) GetWords() []*WordDiff {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+type TextDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lines     []*LineDiff `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	NInserted uint32      `protobuf:"varint,2,opt,name=n_inserted,json=nInserted,proto3" json:"n_inserted,omitempty"`
+	NDeleted  uint32      `protobuf:"varint,3,opt,name=n_deleted,json=nDeleted,proto3" json:"n_deleted,omitempty"`
+	NReplaced uint32      `protobuf:"varint,4,opt,name=n_replaced,json=nReplaced,proto3" json:"n_replaced,omitempty"`
+}
+
+func (x *TextDiff) Reset() {
+	*x = TextDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TextDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextDiff) ProtoMessage() {}
+
+func (x *TextDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextDiff.ProtoReflect.Descriptor instead.
+func (*TextDiff) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *TextDiff) GetLines() []*LineDiff {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *TextDiff) GetNInserted() uint32 {
+	if x != nil {
+		return x.NInserted
+	}
+	return 0
+}
+
+func (x *TextDiff) GetNDeleted() uint32 {
+	if x != nil {
+		return x.NDeleted
+	}
+	return 0
+}
+
+func (x *TextDiff) GetNReplaced() uint32 {
+	if x != nil {
+		return x.NReplaced
+	}
+	return 0
+}
+
+type DiffPromptVersionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId       string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	FromVersionNumber uint32 `protobuf:"varint,2,opt,name=from_version_number,json=fromVersionNumber,proto3" json:"from_version_number,omitempty"`
+	ToVersionNumber   uint32 `protobuf:"varint,3,opt,name=to_version_number,json=toVersionNumber,proto3" json:"to_version_number,omitempty"`
+	// system prompts are versioned separately, and are only diffed when both
+	// versions are given
+	FromSystemPromptVersionNumber *uint32 `protobuf:"varint,4,opt,name=from_system_prompt_version_number,json=fromSystemPromptVersionNumber,proto3,oneof" json:"from_system_prompt_version_number,omitempty"`
+	ToSystemPromptVersionNumber   *uint32 `protobuf:"varint,5,opt,name=to_system_prompt_version_number,json=toSystemPromptVersionNumber,proto3,oneof" json:"to_system_prompt_version_number,omitempty"`
+}
+
+func (x *DiffPromptVersionsRequest) Reset() {
+	*x = DiffPromptVersionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffPromptVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffPromptVersionsRequest) ProtoMessage() {}
+
+func (x *DiffPromptVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffPromptVersionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffPromptVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DiffPromptVersionsRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *DiffPromptVersionsRequest) GetFromVersionNumber() uint32 {
+	if x != nil {
+		return x.FromVersionNumber
+	}
+	return 0
+}
+
+func (x *DiffPromptVersionsRequest) GetToVersionNumber() uint32 {
+	if x != nil {
+		return x.ToVersionNumber
+	}
+	return 0
+}
+
+func (x *DiffPromptVersionsRequest) GetFromSystemPromptVersionNumber() uint32 {
+	if x != nil && x.FromSystemPromptVersionNumber != nil {
+		return *x.FromSystemPromptVersionNumber
+	}
+	return 0
+}
+
+func (x *DiffPromptVersionsRequest) GetToSystemPromptVersionNumber() uint32 {
+	if x != nil && x.ToSystemPromptVersionNumber != nil {
+		return *x.ToSystemPromptVersionNumber
+	}
+	return 0
+}
+
+type DiffPromptVersionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prompt       *TextDiff `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	SystemPrompt *TextDiff `protobuf:"bytes,2,opt,name=system_prompt,json=systemPrompt,proto3" json:"system_prompt,omitempty"`
+	// variables used by the "to" version but not the "from" version, and the reverse
+	AddedVariables   []string `protobuf:"bytes,3,rep,name=added_variables,json=addedVariables,proto3" json:"added_variables,omitempty"`
+	RemovedVariables []string `protobuf:"bytes,4,rep,name=removed_variables,json=removedVariables,proto3" json:"removed_variables,omitempty"`
+}
+
+func (x *DiffPromptVersionsResponse) Reset() {
+	*x = DiffPromptVersionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffPromptVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffPromptVersionsResponse) ProtoMessage() {}
+
+func (x *DiffPromptVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffPromptVersionsResponse.ProtoReflect.Descriptor instead.
+func (*DiffPromptVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DiffPromptVersionsResponse) GetPrompt() *TextDiff {
+	if x != nil {
+		return x.Prompt
+	}
+	return nil
+}
+
+func (x *DiffPromptVersionsResponse) GetSystemPrompt() *TextDiff {
+	if x != nil {
+		return x.SystemPrompt
+	}
+	return nil
+}
+
+func (x *DiffPromptVersionsResponse) GetAddedVariables() []string {
+	if x != nil {
+		return x.AddedVariables
+	}
+	return nil
+}
+
+func (x *DiffPromptVersionsResponse) GetRemovedVariables() []string {
+	if x != nil {
+		return x.RemovedVariables
+	}
+	return nil
+}
+
+// LintFinding is a warning about a prompt. Findings do not stop a prompt from
+// being saved or evaluated, except that CreateWorkspace and UpdateWorkspace
+// reject an invalid template with INVALID_ARGUMENT and its finding as an error
+// detail.
+type LintFinding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    LintCode `protobuf:"varint,1,opt,name=code,proto3,enum=eval.v1.LintCode" json:"code,omitempty"`
+	Message string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// line in the prompt, starting at 1, or 0 if the finding has no line
+	Line     uint32 `protobuf:"varint,3,opt,name=line,proto3" json:"line,omitempty"`
+	Variable string `protobuf:"bytes,4,opt,name=variable,proto3" json:"variable,omitempty"`
+	// the test case and workspace config of a context window finding
+	TestCaseId        string `protobuf:"bytes,5,opt,name=test_case_id,json=testCaseId,proto3" json:"test_case_id,omitempty"`
+	WorkspaceConfigId string `protobuf:"bytes,6,opt,name=workspace_config_id,json=workspaceConfigId,proto3" json:"workspace_config_id,omitempty"`
+}
+
+func (x *LintFinding) Reset() {
+	*x = LintFinding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LintFinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LintFinding) ProtoMessage() {}
+
+func (x *LintFinding) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LintFinding.ProtoReflect.Descriptor instead.
+func (*LintFinding) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *LintFinding) GetCode() LintCode {
+	if x != nil {
+		return x.Code
+	}
+	return LintCode_LINT_CODE_UNSPECIFIED
+}
+
+func (x *LintFinding) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LintFinding) GetLine() uint32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *LintFinding) GetVariable() string {
+	if x != nil {
+		return x.Variable
+	}
+	return ""
+}
+
+func (x *LintFinding) GetTestCaseId() string {
+	if x != nil {
+		return x.TestCaseId
+	}
+	return ""
+}
+
+func (x *LintFinding) GetWorkspaceConfigId() string {
+	if x != nil {
+		return x.WorkspaceConfigId
+	}
+	return ""
+}
+
+// LintPromptRequest lints a prompt against a workspace's test cases and configs.
+type LintPromptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	// lint this content instead of a saved version
+	Content *string `protobuf:"bytes,2,opt,name=content,proto3,oneof" json:"content,omitempty"`
+	// the version to lint; defaults to the current version
+	VersionNumber *uint32 `protobuf:"varint,3,opt,name=version_number,json=versionNumber,proto3,oneof" json:"version_number,omitempty"`
+	// defaults to the current system prompt
+	SystemPrompt *string `protobuf:"bytes,4,opt,name=system_prompt,json=systemPrompt,proto3,oneof" json:"system_prompt,omitempty"`
+}
+
+func (x *LintPromptRequest) Reset() {
+	*x = LintPromptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LintPromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LintPromptRequest) ProtoMessage() {}
+
+func (x *LintPromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LintPromptRequest.ProtoReflect.Descriptor instead.
+func (*LintPromptRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *LintPromptRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *LintPromptRequest) GetContent() string {
+	if x != nil && x.Content != nil {
+		return *x.Content
+	}
+	return ""
+}
+
+func (x *LintPromptRequest) GetVersionNumber() uint32 {
+	if x != nil && x.VersionNumber != nil {
+		return *x.VersionNumber
+	}
+	return 0
+}
+
+func (x *LintPromptRequest) GetSystemPrompt() string {
+	if x != nil && x.SystemPrompt != nil {
+		return *x.SystemPrompt
+	}
+	return ""
+}
+
+type LintPromptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Findings []*LintFinding `protobuf:"bytes,1,rep,name=findings,proto3" json:"findings,omitempty"`
+}
+
+func (x *LintPromptResponse) Reset() {
+	*x = LintPromptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LintPromptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LintPromptResponse) ProtoMessage() {}
+
+func (x *LintPromptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LintPromptResponse.ProtoReflect.Descriptor instead.
+func (*LintPromptResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *LintPromptResponse) GetFindings() []*LintFinding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+// PromptLabel is a movable name, like production or staging, for a prompt version
+// and a system prompt version of a workspace.
+type PromptLabel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId         string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Name                string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PromptVersionNumber uint32 `protobuf:"varint,3,opt,name=prompt_version_number,json=promptVersionNumber,proto3" json:"prompt_version_number,omitempty"`
+	// 0 for no system prompt
+	SystemPromptVersionNumber uint32                 `protobuf:"varint,4,opt,name=system_prompt_version_number,json=systemPromptVersionNumber,proto3" json:"system_prompt_version_number,omitempty"`
+	MovedBy                   string                 `protobuf:"bytes,5,opt,name=moved_by,json=movedBy,proto3" json:"moved_by,omitempty"`
+	UpdatedAt                 *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *PromptLabel) Reset() {
+	*x = PromptLabel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromptLabel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromptLabel) ProtoMessage() {}
+
+func (x *PromptLabel) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromptLabel.ProtoReflect.Descriptor instead.
+func (*PromptLabel) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *PromptLabel) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *PromptLabel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PromptLabel) GetPromptVersionNumber() uint32 {
+	if x != nil {
+		return x.PromptVersionNumber
+	}
+	return 0
+}
+
+func (x *PromptLabel) GetSystemPromptVersionNumber() uint32 {
+	if x != nil {
+		return x.SystemPromptVersionNumber
+	}
+	return 0
+}
+
+func (x *PromptLabel) GetMovedBy() string {
+	if x != nil {
+		return x.MovedBy
+	}
+	return ""
+}
+
+func (x *PromptLabel) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// PromptLabelEvent records a label being moved.
+type PromptLabelEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                        string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label                     string      `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Action                    LabelAction `protobuf:"varint,3,opt,name=action,proto3,enum=eval.v1.LabelAction" json:"action,omitempty"`
+	PromptVersionNumber       uint32      `protobuf:"varint,4,opt,name=prompt_version_number,json=promptVersionNumber,proto3" json:"prompt_version_number,omitempty"`
+	SystemPromptVersionNumber uint32      `protobuf:"varint,5,opt,name=system_prompt_version_number,json=systemPromptVersionNumber,proto3" json:"system_prompt_version_number,omitempty"`
+	// where the label pointed before; unset when the label was created
+	PreviousPromptVersionNumber       *uint32                `protobuf:"varint,6,opt,name=previous_prompt_version_number,json=previousPromptVersionNumber,proto3,oneof" json:"previous_prompt_version_number,omitempty"`
+	PreviousSystemPromptVersionNumber *uint32                `protobuf:"varint,7,opt,name=previous_system_prompt_version_number,json=previousSystemPromptVersionNumber,proto3,oneof" json:"previous_system_prompt_version_number,omitempty"`
+	MovedBy                           string                 `protobuf:"bytes,8,opt,name=moved_by,json=movedBy,proto3" json:"moved_by,omitempty"`
+	Reason                            string                 `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt                         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *PromptLabelEvent) Reset() {
+	*x = PromptLabelEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromptLabelEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromptLabelEvent) ProtoMessage() {}
+
+func (x *PromptLabelEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromptLabelEvent.ProtoReflect.Descriptor instead.
+func (*PromptLabelEvent) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *PromptLabelEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PromptLabelEvent) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *PromptLabelEvent) GetAction() LabelAction {
+	if x != nil {
+		return x.Action
+	}
+	return LabelAction_LABEL_ACTION_UNSPECIFIED
+}
+
+func (x *PromptLabelEvent) GetPromptVersionNumber() uint32 {
+	if x != nil {
+		return x.PromptVersionNumber
+	}
+	return 0
+}
+
+func (x *PromptLabelEvent) GetSystemPromptVersionNumber() uint32 {
+	if x != nil {
+		return x.SystemPromptVersionNumber
+	}
+	return 0
+}
+
+func (x *PromptLabelEvent) GetPreviousPromptVersionNumber() uint32 {
+	if x != nil && x.PreviousPromptVersionNumber != nil {
+		return *x.PreviousPromptVersionNumber
+	}
+	return 0
+}
+
+func (x *PromptLabelEvent) GetPreviousSystemPromptVersionNumber() uint32 {
+	if x != nil && x.PreviousSystemPromptVersionNumber != nil {
+		return *x.PreviousSystemPromptVersionNumber
+	}
+	return 0
+}
+
+func (x *PromptLabelEvent) GetMovedBy() string {
+	if x != nil {
+		return x.MovedBy
+	}
+	return ""
+}
+
+func (x *PromptLabelEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PromptLabelEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// CompleteRequest renders a prompt version with variable values and calls a
+// workspace config's model with it, so applications can serve prompts from
+// evalite instead of copying them.
+//
+// Without a label, the current prompt and current system prompt are served unless
+// version numbers are given. With a label, only the label's versions are served: a
+// label without a system prompt serves none, not the current one.
+type CompleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	// serve the versions a label points at; cannot be combined with version numbers
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// defaults to the current version when no label is given
+	VersionNumber uint32 `protobuf:"varint,3,opt,name=version_number,json=versionNumber,proto3" json:"version_number,omitempty"`
+	// defaults to the current system prompt version when no label is given
+	SystemPromptVersionNumber uint32 `protobuf:"varint,4,opt,name=system_prompt_version_number,json=systemPromptVersionNumber,proto3" json:"system_prompt_version_number,omitempty"`
+	// every variable the prompt uses is required, though it may be empty
+	Variables map[string]string `protobuf:"bytes,5,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// the ID or name of the workspace config to call; defaults to the only active one
+	WorkspaceConfig string `protobuf:"bytes,6,opt,name=workspace_config,json=workspaceConfig,proto3" json:"workspace_config,omitempty"`
+}
+
+func (x *CompleteRequest) Reset() {
+	*x = CompleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteRequest) ProtoMessage() {}
+
+func (x *CompleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteRequest.ProtoReflect.Descriptor instead.
+func (*CompleteRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CompleteRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *CompleteRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *CompleteRequest) GetVersionNumber() uint32 {
+	if x != nil {
+		return x.VersionNumber
+	}
+	return 0
+}
+
+func (x *CompleteRequest) GetSystemPromptVersionNumber() uint32 {
+	if x != nil {
+		return x.SystemPromptVersionNumber
+	}
+	return 0
+}
+
+func (x *CompleteRequest) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *CompleteRequest) GetWorkspaceConfig() string {
+	if x != nil {
+		return x.WorkspaceConfig
+	}
+	return ""
+}
+
+type CompleteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response string `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	// the trace the call was logged as; empty if logging failed
+	TraceId                   string `protobuf:"bytes,2,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	PromptVersionNumber       uint32 `protobuf:"varint,3,opt,name=prompt_version_number,json=promptVersionNumber,proto3" json:"prompt_version_number,omitempty"`
+	SystemPromptVersionNumber uint32 `protobuf:"varint,4,opt,name=system_prompt_version_number,json=systemPromptVersionNumber,proto3" json:"system_prompt_version_number,omitempty"`
+	WorkspaceConfigId         string `protobuf:"bytes,5,opt,name=workspace_config_id,json=workspaceConfigId,proto3" json:"workspace_config_id,omitempty"`
+	ModelConfigName           string `protobuf:"bytes,6,opt,name=model_config_name,json=modelConfigName,proto3" json:"model_config_name,omitempty"`
+}
+
+func (x *CompleteResponse) Reset() {
+	*x = CompleteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteResponse) ProtoMessage() {}
+
+func (x *CompleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteResponse.ProtoReflect.Descriptor instead.
+func (*CompleteResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CompleteResponse) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+func (x *CompleteResponse) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+func (x *CompleteResponse) GetPromptVersionNumber() uint32 {
+	if x != nil {
+		return x.PromptVersionNumber
+	}
+	return 0
+}
+
+func (x *CompleteResponse) GetSystemPromptVersionNumber() uint32 {
+	if x != nil {
+		return x.SystemPromptVersionNumber
+	}
+	return 0
+}
+
+func (x *CompleteResponse) GetWorkspaceConfigId() string {
+	if x != nil {
+		return x.WorkspaceConfigId
+	}
+	return ""
+}
+
+func (x *CompleteResponse) GetModelConfigName() string {
+	if x != nil {
+		return x.ModelConfigName
+	}
+	return ""
+}
+
+// Trace is a call served by Complete.
+type Trace struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                        string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WorkspaceId               string            `protobuf:"bytes,2,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Label                     string            `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	PromptVersionNumber       uint32            `protobuf:"varint,4,opt,name=prompt_version_number,json=promptVersionNumber,proto3" json:"prompt_version_number,omitempty"`
+	SystemPromptVersionNumber uint32            `protobuf:"varint,5,opt,name=system_prompt_version_number,json=systemPromptVersionNumber,proto3" json:"system_prompt_version_number,omitempty"`
+	WorkspaceConfigId         string            `protobuf:"bytes,6,opt,name=workspace_config_id,json=workspaceConfigId,proto3" json:"workspace_config_id,omitempty"`
+	ModelConfigName           string            `protobuf:"bytes,7,opt,name=model_config_name,json=modelConfigName,proto3" json:"model_config_name,omitempty"`
+	Variables                 map[string]string `protobuf:"bytes,8,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ExampleIds                []string          `protobuf:"bytes,9,rep,name=example_ids,json=exampleIds,proto3" json:"example_ids,omitempty"`
+	Response                  string            `protobuf:"bytes,10,opt,name=response,proto3" json:"response,omitempty"`
+	// set when the model call failed
+	Error     string                 `protobuf:"bytes,11,opt,name=error,proto3" json:"error,omitempty"`
+	LatencyMs uint32                 `protobuf:"varint,12,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *Trace) Reset() {
+	*x = Trace{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Trace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Trace) ProtoMessage() {}
+
+func (x *Trace) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Trace.ProtoReflect.Descriptor instead.
+func (*Trace) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *Trace) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Trace) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *Trace) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Trace) GetPromptVersionNumber() uint32 {
+	if x != nil {
+		return x.PromptVersionNumber
+	}
+	return 0
+}
+
+func (x *Trace) GetSystemPromptVersionNumber() uint32 {
+	if x != nil {
+		return x.SystemPromptVersionNumber
+	}
+	return 0
+}
+
+func (x *Trace) GetWorkspaceConfigId() string {
+	if x != nil {
+		return x.WorkspaceConfigId
+	}
+	return ""
+}
+
+func (x *Trace) GetModelConfigName() string {
+	if x != nil {
+		return x.ModelConfigName
+	}
+	return ""
+}
+
+func (x *Trace) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *Trace) GetExampleIds() []string {
+	if x != nil {
+		return x.ExampleIds
+	}
+	return nil
+}
+
+func (x *Trace) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+func (x *Trace) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Trace) GetLatencyMs() uint32 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *Trace) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListTracesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Page        int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize    int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// only list the traces served through this label
+	Label string `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	// only list failed calls
+	ErrorsOnly bool `protobuf:"varint,5,opt,name=errors_only,json=errorsOnly,proto3" json:"errors_only,omitempty"`
+}
+
+func (x *ListTracesRequest) Reset() {
+	*x = ListTracesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTracesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTracesRequest) ProtoMessage() {}
+
+func (x *ListTracesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTracesRequest.ProtoReflect.Descriptor instead.
+func (*ListTracesRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListTracesRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *ListTracesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTracesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTracesRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ListTracesRequest) GetErrorsOnly() bool {
+	if x != nil {
+		return x.ErrorsOnly
+	}
+	return false
+}
+
+type ListTracesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Traces     []*Trace `protobuf:"bytes,1,rep,name=traces,proto3" json:"traces,omitempty"`
+	TotalCount int32    `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+}
+
+func (x *ListTracesResponse) Reset() {
+	*x = ListTracesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTracesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTracesResponse) ProtoMessage() {}
+
+func (x *ListTracesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTracesResponse.ProtoReflect.Descriptor instead.
+func (*ListTracesResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListTracesResponse) GetTraces() []*Trace {
+	if x != nil {
+		return x.Traces
+	}
+	return nil
+}
+
+func (x *ListTracesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// PromoteVersionRequest points a label at prompt versions, creating the label if
+// needed. The versions are either given, or copied from from_label, e.g. to promote
+// staging to production.
+type PromoteVersionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId         string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Label               string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	PromptVersionNumber uint32 `protobuf:"varint,3,opt,name=prompt_version_number,json=promptVersionNumber,proto3" json:"prompt_version_number,omitempty"`
+	// defaults to the current system prompt version; 0 for no system prompt
+	SystemPromptVersionNumber *uint32 `protobuf:"varint,4,opt,name=system_prompt_version_number,json=systemPromptVersionNumber,proto3,oneof" json:"system_prompt_version_number,omitempty"`
+	FromLabel                 string  `protobuf:"bytes,5,opt,name=from_label,json=fromLabel,proto3" json:"from_label,omitempty"`
+	MovedBy                   string  `protobuf:"bytes,6,opt,name=moved_by,json=movedBy,proto3" json:"moved_by,omitempty"`
+	Reason                    string  `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *PromoteVersionRequest) Reset() {
+	*x = PromoteVersionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromoteVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteVersionRequest) ProtoMessage() {}
+
+func (x *PromoteVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteVersionRequest.ProtoReflect.Descriptor instead.
+func (*PromoteVersionRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *PromoteVersionRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *PromoteVersionRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *PromoteVersionRequest) GetPromptVersionNumber() uint32 {
+	if x != nil {
+		return x.PromptVersionNumber
+	}
+	return 0
+}
+
+func (x *PromoteVersionRequest) GetSystemPromptVersionNumber() uint32 {
+	if x != nil && x.SystemPromptVersionNumber != nil {
+		return *x.SystemPromptVersionNumber
+	}
+	return 0
+}
+
+func (x *PromoteVersionRequest) GetFromLabel() string {
+	if x != nil {
+		return x.FromLabel
+	}
+	return ""
+}
+
+func (x *PromoteVersionRequest) GetMovedBy() string {
+	if x != nil {
+		return x.MovedBy
+	}
+	return ""
+}
+
+func (x *PromoteVersionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type PromoteVersionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Label *PromptLabel `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	// unset if the label already pointed at the versions
+	Event *PromptLabelEvent `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *PromoteVersionResponse) Reset() {
+	*x = PromoteVersionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromoteVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteVersionResponse) ProtoMessage() {}
+
+func (x *PromoteVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteVersionResponse.ProtoReflect.Descriptor instead.
+func (*PromoteVersionResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *PromoteVersionResponse) GetLabel() *PromptLabel {
+	if x != nil {
+		return x.Label
+	}
+	return nil
+}
+
+func (x *PromoteVersionResponse) GetEvent() *PromptLabelEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type RollbackLabelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Label       string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// the history event whose versions to move back to; defaults to where the label
+	// pointed before its last promotion, skipping any rollbacks since
+	ToEventId *string `protobuf:"bytes,3,opt,name=to_event_id,json=toEventId,proto3,oneof" json:"to_event_id,omitempty"`
+	MovedBy   string  `protobuf:"bytes,4,opt,name=moved_by,json=movedBy,proto3" json:"moved_by,omitempty"`
+	Reason    string  `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *RollbackLabelRequest) Reset() {
+	*x = RollbackLabelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollbackLabelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackLabelRequest) ProtoMessage() {}
+
+func (x *RollbackLabelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackLabelRequest.ProtoReflect.Descriptor instead.
+func (*RollbackLabelRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *RollbackLabelRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *RollbackLabelRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *RollbackLabelRequest) GetToEventId() string {
+	if x != nil && x.ToEventId != nil {
+		return *x.ToEventId
+	}
+	return ""
+}
+
+func (x *RollbackLabelRequest) GetMovedBy() string {
+	if x != nil {
+		return x.MovedBy
+	}
+	return ""
+}
+
+func (x *RollbackLabelRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RollbackLabelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Label *PromptLabel      `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Event *PromptLabelEvent `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *RollbackLabelResponse) Reset() {
+	*x = RollbackLabelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollbackLabelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackLabelResponse) ProtoMessage() {}
+
+func (x *RollbackLabelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackLabelResponse.ProtoReflect.Descriptor instead.
+func (*RollbackLabelResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *RollbackLabelResponse) GetLabel() *PromptLabel {
+	if x != nil {
+		return x.Label
+	}
+	return nil
+}
+
+func (x *RollbackLabelResponse) GetEvent() *PromptLabelEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type ListLabelsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+}
+
+func (x *ListLabelsRequest) Reset() {
+	*x = ListLabelsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLabelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLabelsRequest) ProtoMessage() {}
+
+func (x *ListLabelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLabelsRequest.ProtoReflect.Descriptor instead.
+func (*ListLabelsRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ListLabelsRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+type ListLabelsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Labels []*PromptLabel `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty"`
+}
+
+func (x *ListLabelsResponse) Reset() {
+	*x = ListLabelsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLabelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLabelsResponse) ProtoMessage() {}
+
+func (x *ListLabelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLabelsResponse.ProtoReflect.Descriptor instead.
+func (*ListLabelsResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ListLabelsResponse) GetLabels() []*PromptLabel {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type ListLabelHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	// all labels if empty
+	Label    string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Page     int32  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListLabelHistoryRequest) Reset() {
+	*x = ListLabelHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLabelHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLabelHistoryRequest) ProtoMessage() {}
+
+func (x *ListLabelHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLabelHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListLabelHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListLabelHistoryRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *ListLabelHistoryRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ListLabelHistoryRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListLabelHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListLabelHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// newest first
+	Events     []*PromptLabelEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	TotalCount int32               `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+}
+
+func (x *ListLabelHistoryResponse) Reset() {
+	*x = ListLabelHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLabelHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLabelHistoryResponse) ProtoMessage() {}
+
+func (x *ListLabelHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLabelHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListLabelHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListLabelHistoryResponse) GetEvents() []*PromptLabelEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListLabelHistoryResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type GenerateTestCaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId   string        `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	VersionNumber uint32        `protobuf:"varint,2,opt,name=version_number,json=versionNumber,proto3" json:"version_number,omitempty"`
+	TestCases     []*TestCase   `protobuf:"bytes,3,rep,name=test_cases,json=testCases,proto3" json:"test_cases,omitempty"`
+	CustomCot     *string       `protobuf:"bytes,4,opt,name=custom_cot,json=customCot,proto3,oneof" json:"custom_cot,omitempty"`
+	NTestCases    uint32        `protobuf:"varint,5,opt,name=n_test_cases,json=nTestCases,proto3" json:"n_test_cases,omitempty"`
+	SeedPrompt    *string       `protobuf:"bytes,6,opt,name=seed_prompt,json=seedPrompt,proto3,oneof" json:"seed_prompt,omitempty"`
+	Dedup         *DedupOptions `protobuf:"bytes,7,opt,name=dedup,proto3" json:"dedup,omitempty"`
+	// model_config_name defaults to the default small model config
+	ModelConfigName *string `protobuf:"bytes,8,opt,name=model_config_name,json=modelConfigName,proto3,oneof" json:"model_config_name,omitempty"`
+	// temperature defaults to 1.2
+	Temperature *float32 `protobuf:"fixed32,9,opt,name=temperature,proto3,oneof" json:"temperature,omitempty"`
+	// max_tokens defaults to 4000
+	MaxTokens *int32 `protobuf:"varint,10,opt,name=max_tokens,json=maxTokens,proto3,oneof" json:"max_tokens,omitempty"`
+	// max_attempts bounds how often the model is re-prompted after an unparseable
+	// reply or rejected cases; defaults to 3
+	MaxAttempts uint32         `protobuf:"varint,11,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	Mode        GenerationMode `protobuf:"varint,12,opt,name=mode,proto3,enum=eval.v1.GenerationMode" json:"mode,omitempty"`
+	// attack_categories are the categories generated in adversarial mode, all of them
+	// if empty. n_test_cases are generated per category, and each case is tagged
+	// "adversarial" and "adversarial:<category>", e.g. "adversarial:jailbreak".
+	AttackCategories []AttackCategory `protobuf:"varint,13,rep,packed,name=attack_categories,json=attackCategories,proto3,enum=eval.v1.AttackCategory" json:"attack_categories,omitempty"`
+}
+
+func (x *GenerateTestCaseRequest) Reset() {
+	*x = GenerateTestCaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateTestCaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateTestCaseRequest) ProtoMessage() {}
+
+func (x *GenerateTestCaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateTestCaseRequest.ProtoReflect.Descriptor instead.
+func (*GenerateTestCaseRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GenerateTestCaseRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *GenerateTestCaseRequest) GetVersionNumber() uint32 {
+	if x != nil {
+		return x.VersionNumber
+	}
+	return 0
+}
+
+func (x *GenerateTestCaseRequest) GetTestCases() []*TestCase {
+	if x != nil {
+		return x.TestCases
+	}
+	return nil
+}
+
+func (x *GenerateTestCaseRequest) GetCustomCot() string {
+	if x != nil && x.CustomCot != nil {
+		return *x.CustomCot
+	}
+	return ""
+}
+
+func (x *GenerateTestCaseRequest) GetNTestCases() uint32 {
+	if x != nil {
+		return x.NTestCases
+	}
+	return 0
+}
+
+func (x *GenerateTestCaseRequest) GetSeedPrompt() string {
+	if x != nil && x.SeedPrompt != nil {
+		return *x.SeedPrompt
+	}
+	return ""
+}
+
+func (x *GenerateTestCaseRequest) GetDedup() *DedupOptions {
+	if x != nil {
+		return x.Dedup
+	}
+	return nil
+}
+
+func (x *GenerateTestCaseRequest) GetModelConfigName() string {
+	if x != nil && x.ModelConfigName != nil {
+		return *x.ModelConfigName
+	}
+	return ""
+}
+
+func (x *GenerateTestCaseRequest) GetTemperature() float32 {
+	if x != nil && x.Temperature != nil {
+		return *x.Temperature
+	}
+	return 0
+}
+
+func (x *GenerateTestCaseRequest) GetMaxTokens() int32 {
+	if x != nil && x.MaxTokens != nil {
+		return *x.MaxTokens
+	}
+	return 0
+}
+
+func (x *GenerateTestCaseRequest) GetMaxAttempts() uint32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *GenerateTestCaseRequest) GetMode() GenerationMode {
+	if x != nil {
+		return x.Mode
+	}
+	return GenerationMode_GENERATION_MODE_TYPICAL
+}
+
+func (x *GenerateTestCaseRequest) GetAttackCategories() []AttackCategory {
+	if x != nil {
+		return x.AttackCategories
+	}
+	return nil
+}
+
+// RejectedTestCase is a generated case that failed validation, or a reply that
+// could not be parsed, in which case variable_values is empty.
+type RejectedTestCase struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VariableValues map[string]string `protobuf:"bytes,1,rep,name=variable_values,json=variableValues,proto3" json:"variable_values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Reason         string            `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Attempt        uint32            `protobuf:"varint,3,opt,name=attempt,proto3" json:"attempt,omitempty"`
+}
+
+func (x *RejectedTestCase) Reset() {
+	*x = RejectedTestCase{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejectedTestCase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectedTestCase) ProtoMessage() {}
+
+func (x *RejectedTestCase) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectedTestCase.ProtoReflect.Descriptor instead.
+func (*RejectedTestCase) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *RejectedTestCase) GetVariableValues() map[string]string {
+	if x != nil {
+		return x.VariableValues
+	}
+	return nil
+}
+
+func (x *RejectedTestCase) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RejectedTestCase) GetAttempt() uint32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+type GenerateTestCaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TestCases      []*TestCase         `protobuf:"bytes,1,rep,name=test_cases,json=testCases,proto3" json:"test_cases,omitempty"`
+	NearDuplicates []*NearDuplicate    `protobuf:"bytes,2,rep,name=near_duplicates,json=nearDuplicates,proto3" json:"near_duplicates,omitempty"`
+	Rejected       []*RejectedTestCase `protobuf:"bytes,3,rep,name=rejected,proto3" json:"rejected,omitempty"`
+	NAttempts      uint32              `protobuf:"varint,4,opt,name=n_attempts,json=nAttempts,proto3" json:"n_attempts,omitempty"`
+}
+
+func (x *GenerateTestCaseResponse) Reset() {
+	*x = GenerateTestCaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateTestCaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateTestCaseResponse) ProtoMessage() {}
+
+func (x *GenerateTestCaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateTestCaseResponse.ProtoReflect.Descriptor instead.
+func (*GenerateTestCaseResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GenerateTestCaseResponse) GetTestCases() []*TestCase {
+	if x != nil {
+		return x.TestCases
+	}
+	return nil
+}
+
+func (x *GenerateTestCaseResponse) GetNearDuplicates() []*NearDuplicate {
+	if x != nil {
+		return x.NearDuplicates
+	}
+	return nil
+}
+
+func (x *GenerateTestCaseResponse) GetRejected() []*RejectedTestCase {
+	if x != nil {
+		return x.Rejected
+	}
+	return nil
+}
+
+func (x *GenerateTestCaseResponse) GetNAttempts() uint32 {
+	if x != nil {
+		return x.NAttempts
+	}
+	return 0
+}
+
+type DeleteWorkspaceConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId       string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	WorkspaceConfigId string `protobuf:"bytes,2,opt,name=workspace_config_id,json=workspaceConfigId,proto3" json:"workspace_config_id,omitempty"`
+}
+
+func (x *DeleteWorkspaceConfigRequest) Reset() {
+	*x = DeleteWorkspaceConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWorkspaceConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWorkspaceConfigRequest) ProtoMessage() {}
+
+func (x *DeleteWorkspaceConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWorkspaceConfigRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWorkspaceConfigRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *DeleteWorkspaceConfigRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *DeleteWorkspaceConfigRequest) GetWorkspaceConfigId() string {
+	if x != nil {
+		return x.WorkspaceConfigId
+	}
+	return ""
+}
+
+type SetWorkspaceConfigActiveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId       string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	WorkspaceConfigId string `protobuf:"bytes,2,opt,name=workspace_config_id,json=workspaceConfigId,proto3" json:"workspace_config_id,omitempty"`
+	Active            bool   `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (x *SetWorkspaceConfigActiveRequest) Reset() {
+	*x = SetWorkspaceConfigActiveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetWorkspaceConfigActiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWorkspaceConfigActiveRequest) ProtoMessage() {}
+
+func (x *SetWorkspaceConfigActiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWorkspaceConfigActiveRequest.ProtoReflect.Descriptor instead.
+func (*SetWorkspaceConfigActiveRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *SetWorkspaceConfigActiveRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *SetWorkspaceConfigActiveRequest) GetWorkspaceConfigId() string {
+	if x != nil {
+		return x.WorkspaceConfigId
+	}
+	return ""
+}
+
+func (x *SetWorkspaceConfigActiveRequest) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type SetVersionActiveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId   string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	VersionNumber uint32 `protobuf:"varint,2,opt,name=version_number,json=versionNumber,proto3" json:"version_number,omitempty"`
+	Active        bool   `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (x *SetVersionActiveRequest) Reset() {
+	*x = SetVersionActiveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetVersionActiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVersionActiveRequest) ProtoMessage() {}
+
+func (x *SetVersionActiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVersionActiveRequest.ProtoReflect.Descriptor instead.
+func (*SetVersionActiveRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *SetVersionActiveRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *SetVersionActiveRequest) GetVersionNumber() uint32 {
+	if x != nil {
+		return x.VersionNumber
+	}
+	return 0
+}
+
+func (x *SetVersionActiveRequest) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+// ExampleSettings control how many examples from the workspace's example bank are
+// inserted where a prompt uses {{EXAMPLES}}, and which.
+type ExampleSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// defaults to 3
+	Count    uint32          `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Strategy ExampleStrategy `protobuf:"varint,2,opt,name=strategy,proto3,enum=eval.v1.ExampleStrategy" json:"strategy,omitempty"`
+	Seed     int64           `protobuf:"varint,3,opt,name=seed,proto3" json:"seed,omitempty"`
+}
+
+func (x *ExampleSettings) Reset() {
+	*x = ExampleSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExampleSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExampleSettings) ProtoMessage() {}
+
+func (x *ExampleSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExampleSettings.ProtoReflect.Descriptor instead.
+func (*ExampleSettings) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ExampleSettings) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ExampleSettings) GetStrategy() ExampleStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return ExampleStrategy_EXAMPLE_STRATEGY_FIXED
+}
+
+func (x *ExampleSettings) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+// Example is a worked input and output in a workspace's example bank.
+type Example struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WorkspaceId    string                    `protobuf:"bytes,2,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	VariableValues map[string]*VariableValue `protobuf:"bytes,3,rep,name=variable_values,json=variableValues,proto3" json:"variable_values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Output         string                    `protobuf:"bytes,4,opt,name=output,proto3" json:"output,omitempty"`
+	// the rated result the example was taken from, if any
+	TestResultId *string                `protobuf:"bytes,5,opt,name=test_result_id,json=testResultId,proto3,oneof" json:"test_result_id,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *Example) Reset() {
+	*x = Example{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Example) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Example) ProtoMessage() {}
+
+func (x *Example) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Example.ProtoReflect.Descriptor instead.
+func (*Example) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *Example) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Example) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *Example) GetVariableValues() map[string]*VariableValue {
+	if x != nil {
+		return x.VariableValues
+	}
+	return nil
+}
+
+func (x *Example) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *Example) GetTestResultId() string {
+	if x != nil && x.TestResultId != nil {
+		return *x.TestResultId
+	}
+	return ""
+}
+
+func (x *Example) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateExampleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId    string                    `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	VariableValues map[string]*VariableValue `protobuf:"bytes,2,rep,name=variable_values,json=variableValues,proto3" json:"variable_values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Output         string                    `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
+}
+
+func (x *CreateExampleRequest) Reset() {
+	*x = CreateExampleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateExampleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExampleRequest) ProtoMessage() {}
+
+func (x *CreateExampleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExampleRequest.ProtoReflect.Descriptor instead.
+func (*CreateExampleRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *CreateExampleRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *CreateExampleRequest) GetVariableValues() map[string]*VariableValue {
+	if x != nil {
+		return x.VariableValues
+	}
+	return nil
+}
+
+func (x *CreateExampleRequest) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+type CreateExampleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Example *Example `protobuf:"bytes,1,opt,name=example,proto3" json:"example,omitempty"`
+}
+
+func (x *CreateExampleResponse) Reset() {
+	*x = CreateExampleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateExampleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExampleResponse) ProtoMessage() {}
+
+func (x *CreateExampleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExampleResponse.ProtoReflect.Descriptor instead.
+func (*CreateExampleResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *CreateExampleResponse) GetExample() *Example {
+	if x != nil {
+		return x.Example
+	}
+	return nil
+}
+
+type ListExamplesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+}
+
+func (x *ListExamplesRequest) Reset() {
+	*x = ListExamplesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExamplesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExamplesRequest) ProtoMessage() {}
+
+func (x *ListExamplesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExamplesRequest.ProtoReflect.Descriptor instead.
+func (*ListExamplesRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ListExamplesRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+type ListExamplesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Examples []*Example `protobuf:"bytes,1,rep,name=examples,proto3" json:"examples,omitempty"`
+}
+
+func (x *ListExamplesResponse) Reset() {
+	*x = ListExamplesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExamplesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExamplesResponse) ProtoMessage() {}
+
+func (x *ListExamplesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExamplesResponse.ProtoReflect.Descriptor instead.
+func (*ListExamplesResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ListExamplesResponse) GetExamples() []*Example {
+	if x != nil {
+		return x.Examples
+	}
+	return nil
+}
+
+type DeleteExampleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteExampleRequest) Reset() {
+	*x = DeleteExampleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteExampleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExampleRequest) ProtoMessage() {}
+
+func (x *DeleteExampleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExampleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteExampleRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *DeleteExampleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// AddExamplesFromResultsRequest copies rated results into the example bank. Without
+// test_result_ids it takes every thumbs-up result, or with rater and min_score every
+// result that rater scored at least min_score.
+type AddExamplesFromResultsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId   string   `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	TestResultIds []string `protobuf:"bytes,2,rep,name=test_result_ids,json=testResultIds,proto3" json:"test_result_ids,omitempty"`
+	Rater         string   `protobuf:"bytes,3,opt,name=rater,proto3" json:"rater,omitempty"`
+	MinScore      *float64 `protobuf:"fixed64,4,opt,name=min_score,json=minScore,proto3,oneof" json:"min_score,omitempty"`
+}
+
+func (x *AddExamplesFromResultsRequest) Reset() {
+	*x = AddExamplesFromResultsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddExamplesFromResultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddExamplesFromResultsRequest) ProtoMessage() {}
+
+func (x *AddExamplesFromResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddExamplesFromResultsRequest.ProtoReflect.Descriptor instead.
+func (*AddExamplesFromResultsRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *AddExamplesFromResultsRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *AddExamplesFromResultsRequest) GetTestResultIds() []string {
+	if x != nil {
+		return x.TestResultIds
+	}
+	return nil
+}
+
+func (x *AddExamplesFromResultsRequest) GetRater() string {
+	if x != nil {
+		return x.Rater
+	}
+	return ""
+}
+
+func (x *AddExamplesFromResultsRequest) GetMinScore() float64 {
+	if x != nil && x.MinScore != nil {
+		return *x.MinScore
+	}
+	return 0
+}
+
+type AddExamplesFromResultsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Examples []*Example `protobuf:"bytes,1,rep,name=examples,proto3" json:"examples,omitempty"`
+	// results that are already in the bank
+	NSkipped uint32 `protobuf:"varint,2,opt,name=n_skipped,json=nSkipped,proto3" json:"n_skipped,omitempty"`
+}
+
+func (x *AddExamplesFromResultsResponse) Reset() {
+	*x = AddExamplesFromResultsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddExamplesFromResultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddExamplesFromResultsResponse) ProtoMessage() {}
+
+func (x *AddExamplesFromResultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddExamplesFromResultsResponse.ProtoReflect.Descriptor instead.
+func (*AddExamplesFromResultsResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *AddExamplesFromResultsResponse) GetExamples() []*Example {
+	if x != nil {
+		return x.Examples
+	}
+	return nil
+}
+
+func (x *AddExamplesFromResultsResponse) GetNSkipped() uint32 {
+	if x != nil {
+		return x.NSkipped
+	}
+	return 0
+}
+
+type SetExampleSettingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId string           `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Settings    *ExampleSettings `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *SetExampleSettingsRequest) Reset() {
+	*x = SetExampleSettingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetExampleSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExampleSettingsRequest) ProtoMessage() {}
+
+func (x *SetExampleSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetExampleSettingsRequest.ProtoReflect.Descriptor instead.
+func (*SetExampleSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *SetExampleSettingsRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *SetExampleSettingsRequest) GetSettings() *ExampleSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type SetXMLModeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	XMLMode     bool   `protobuf:"varint,2,opt,name=XMLMode,proto3" json:"XMLMode,omitempty"`
+}
+
+func (x *SetXMLModeRequest) Reset() {
+	*x = SetXMLModeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetXMLModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetXMLModeRequest) ProtoMessage() {}
+
+func (x *SetXMLModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetXMLModeRequest.ProtoReflect.Descriptor instead.
+func (*SetXMLModeRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *SetXMLModeRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *SetXMLModeRequest) GetXMLMode() bool {
+	if x != nil {
+		return x.XMLMode
+	}
+	return false
+}
+
+type RateTestResultRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TestResultId string `protobuf:"bytes,1,opt,name=test_result_id,json=testResultId,proto3" json:"test_result_id,omitempty"`
+	Rating       int32  `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"`
+	// rater identifies who gave the rating. If empty, the rating is stored as the
+	// default rater and also becomes the test result's primary rating.
+	Rater     string   `protobuf:"bytes,3,opt,name=rater,proto3" json:"rater,omitempty"`
+	Automated bool     `protobuf:"varint,4,opt,name=automated,proto3" json:"automated,omitempty"`
+	Score     *float64 `protobuf:"fixed64,5,opt,name=score,proto3,oneof" json:"score,omitempty"`
+}
+
+func (x *RateTestResultRequest) Reset() {
+	*x = RateTestResultRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateTestResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateTestResultRequest) ProtoMessage() {}
+
+func (x *RateTestResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateTestResultRequest.ProtoReflect.Descriptor instead.
+func (*RateTestResultRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *RateTestResultRequest) GetTestResultId() string {
+	if x != nil {
+		return x.TestResultId
+	}
+	return ""
+}
+
+func (x *RateTestResultRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *RateTestResultRequest) GetRater() string {
+	if x != nil {
+		return x.Rater
+	}
+	return ""
+}
+
+func (x *RateTestResultRequest) GetAutomated() bool {
+	if x != nil {
+		return x.Automated
+	}
+	return false
+}
+
+func (x *RateTestResultRequest) GetScore() float64 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+type ComputeAgreementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId string      `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Scale       RatingScale `protobuf:"varint,2,opt,name=scale,proto3,enum=eval.v1.RatingScale" json:"scale,omitempty"`
+	// restrict to these raters; all raters in the workspace are used if empty
+	Raters           []string        `protobuf:"bytes,3,rep,name=raters,proto3" json:"raters,omitempty"`
+	MaxDisagreements uint32          `protobuf:"varint,4,opt,name=max_disagreements,json=maxDisagreements,proto3" json:"max_disagreements,omitempty"`
+	Filter           *TestCaseFilter `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *ComputeAgreementRequest) Reset() {
+	*x = ComputeAgreementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ComputeAgreementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComputeAgreementRequest) ProtoMessage() {}
+
+func (x *ComputeAgreementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComputeAgreementRequest.ProtoReflect.Descriptor instead.
+func (*ComputeAgreementRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *ComputeAgreementRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *ComputeAgreementRequest) GetScale() RatingScale {
+	if x != nil {
+		return x.Scale
+	}
+	return RatingScale_RATING_SCALE_THUMBS
+}
+
+func (x *ComputeAgreementRequest) GetRaters() []string {
+	if x != nil {
+		return x.Raters
+	}
+	return nil
+}
+
+func (x *ComputeAgreementRequest) GetMaxDisagreements() uint32 {
+	if x != nil {
+		return x.MaxDisagreements
+	}
+	return 0
+}
+
+func (x *ComputeAgreementRequest) GetFilter() *TestCaseFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type RaterPairAgreement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RaterA            string   `protobuf:"bytes,1,opt,name=rater_a,json=raterA,proto3" json:"rater_a,omitempty"`
+	RaterB            string   `protobuf:"bytes,2,opt,name=rater_b,json=raterB,proto3" json:"rater_b,omitempty"`
+	NItems            uint32   `protobuf:"varint,3,opt,name=n_items,json=nItems,proto3" json:"n_items,omitempty"`
+	PercentAgreement  *float64 `protobuf:"fixed64,4,opt,name=percent_agreement,json=percentAgreement,proto3,oneof" json:"percent_agreement,omitempty"`
+	CohensKappa       *float64 `protobuf:"fixed64,5,opt,name=cohens_kappa,json=cohensKappa,proto3,oneof" json:"cohens_kappa,omitempty"`
+	KrippendorffAlpha *float64 `protobuf:"fixed64,6,opt,name=krippendorff_alpha,json=krippendorffAlpha,proto3,oneof" json:"krippendorff_alpha,omitempty"`
+}
+
+func (x *RaterPairAgreement) Reset() {
+	*x = RaterPairAgreement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RaterPairAgreement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RaterPairAgreement) ProtoMessage() {}
+
+func (x *RaterPairAgreement) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RaterPairAgreement.ProtoReflect.Descriptor instead.
+func (*RaterPairAgreement) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *RaterPairAgreement) GetRaterA() string {
+	if x != nil {
+		return x.RaterA
+	}
+	return ""
+}
+
+func (x *RaterPairAgreement) GetRaterB() string {
+	if x != nil {
+		return x.RaterB
+	}
+	return ""
+}
+
+func (x *RaterPairAgreement) GetNItems() uint32 {
+	if x != nil {
+		return x.NItems
+	}
+	return 0
+}
+
+func (x *RaterPairAgreement) GetPercentAgreement() float64 {
+	if x != nil && x.PercentAgreement != nil {
+		return *x.PercentAgreement
+	}
+	return 0
+}
+
+func (x *RaterPairAgreement) GetCohensKappa() float64 {
+	if x != nil && x.CohensKappa != nil {
+		return *x.CohensKappa
+	}
+	return 0
+}
+
+func (x *RaterPairAgreement) GetKrippendorffAlpha() float64 {
+	if x != nil && x.KrippendorffAlpha != nil {
+		return *x.KrippendorffAlpha
+	}
+	return 0
+}
+
+type DisagreementItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TestResultId string             `protobuf:"bytes,1,opt,name=test_result_id,json=testResultId,proto3" json:"test_result_id,omitempty"`
+	TestCaseId   string             `protobuf:"bytes,2,opt,name=test_case_id,json=testCaseId,proto3" json:"test_case_id,omitempty"`
+	Values       map[string]float64 `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Spread       float64            `protobuf:"fixed64,4,opt,name=spread,proto3" json:"spread,omitempty"`
+}
+
+func (x *DisagreementItem) Reset() {
+	*x = DisagreementItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisagreementItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisagreementItem) ProtoMessage() {}
+
+func (x *DisagreementItem) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisagreementItem.ProtoReflect.Descriptor instead.
+func (*DisagreementItem) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *DisagreementItem) GetTestResultId() string {
+	if x != nil {
+		return x.TestResultId
+	}
+	return ""
+}
+
+func (x *DisagreementItem) GetTestCaseId() string {
+	if x != nil {
+		return x.TestCaseId
+	}
+	return ""
+}
+
+func (x *DisagreementItem) GetValues() map[string]float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *DisagreementItem) GetSpread() float64 {
+	if x != nil {
+		return x.Spread
+	}
+	return 0
+}
+
+type ComputeAgreementResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Raters []string `protobuf:"bytes,1,rep,name=raters,proto3" json:"raters,omitempty"`
+	NItems uint32   `protobuf:"varint,2,opt,name=n_items,json=nItems,proto3" json:"n_items,omitempty"`
+	// fleiss_kappa only uses items rated by every rater
+	NCompleteItems    uint32                `protobuf:"varint,3,opt,name=n_complete_items,json=nCompleteItems,proto3" json:"n_complete_items,omitempty"`
+	FleissKappa       *float64              `protobuf:"fixed64,4,opt,name=fleiss_kappa,json=fleissKappa,proto3,oneof" json:"fleiss_kappa,omitempty"`
+	KrippendorffAlpha *float64              `protobuf:"fixed64,5,opt,name=krippendorff_alpha,json=krippendorffAlpha,proto3,oneof" json:"krippendorff_alpha,omitempty"`
+	Pairs             []*RaterPairAgreement `protobuf:"bytes,6,rep,name=pairs,proto3" json:"pairs,omitempty"`
+	Disagreements     []*DisagreementItem   `protobuf:"bytes,7,rep,name=disagreements,proto3" json:"disagreements,omitempty"`
+}
+
+func (x *ComputeAgreementResponse) Reset() {
+	*x = ComputeAgreementResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ComputeAgreementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComputeAgreementResponse) ProtoMessage() {}
+
+func (x *ComputeAgreementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComputeAgreementResponse.ProtoReflect.Descriptor instead.
+func (*ComputeAgreementResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ComputeAgreementResponse) GetRaters() []string {
+	if x != nil {
+		return x.Raters
+	}
+	return nil
+}
+
+func (x *ComputeAgreementResponse) GetNItems() uint32 {
+	if x != nil {
+		return x.NItems
+	}
+	return 0
+}
+
+func (x *ComputeAgreementResponse) GetNCompleteItems() uint32 {
+	if x != nil {
+		return x.NCompleteItems
+	}
+	return 0
+}
+
+func (x *ComputeAgreementResponse) GetFleissKappa() float64 {
+	if x != nil && x.FleissKappa != nil {
+		return *x.FleissKappa
+	}
+	return 0
+}
+
+func (x *ComputeAgreementResponse) GetKrippendorffAlpha() float64 {
+	if x != nil && x.KrippendorffAlpha != nil {
+		return *x.KrippendorffAlpha
+	}
+	return 0
+}
+
+func (x *ComputeAgreementResponse) GetPairs() []*RaterPairAgreement {
+	if x != nil {
+		return x.Pairs
+	}
+	return nil
+}
+
+func (x *ComputeAgreementResponse) GetDisagreements() []*DisagreementItem {
+	if x != nil {
+		return x.Disagreements
+	}
+	return nil
+}
+
+// CompareVersionsRequest pairs the results of two prompt versions by test case.
+// If no configs are given, results are paired within the same workspace config.
+type CompareVersionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId        string  `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	VersionNumberA     uint32  `protobuf:"varint,2,opt,name=version_number_a,json=versionNumberA,proto3" json:"version_number_a,omitempty"`
+	VersionNumberB     uint32  `protobuf:"varint,3,opt,name=version_number_b,json=versionNumberB,proto3" json:"version_number_b,omitempty"`
+	WorkspaceConfigIdA *string `protobuf:"bytes,4,opt,name=workspace_config_id_a,json=workspaceConfigIdA,proto3,oneof" json:"workspace_config_id_a,omitempty"`
+	WorkspaceConfigIdB *string `protobuf:"bytes,5,opt,name=workspace_config_id_b,json=workspaceConfigIdB,proto3,oneof" json:"workspace_config_id_b,omitempty"`
+	// rater whose judgements are compared. If empty, the primary thumbs rating
+	// and the mean score across raters are used.
+	Rater  string          `protobuf:"bytes,6,opt,name=rater,proto3" json:"rater,omitempty"`
+	Filter *TestCaseFilter `protobuf:"bytes,7,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *CompareVersionsRequest) Reset() {
+	*x = CompareVersionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareVersionsRequest) ProtoMessage() {}
+
+func (x *CompareVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareVersionsRequest.ProtoReflect.Descriptor instead.
+func (*CompareVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *CompareVersionsRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *CompareVersionsRequest) GetVersionNumberA() uint32 {
+	if x != nil {
+		return x.VersionNumberA
+	}
+	return 0
+}
+
+func (x *CompareVersionsRequest) GetVersionNumberB() uint32 {
+	if x != nil {
+		return x.VersionNumberB
+	}
+	return 0
+}
+
+func (x *CompareVersionsRequest) GetWorkspaceConfigIdA() string {
+	if x != nil && x.WorkspaceConfigIdA != nil {
+		return *x.WorkspaceConfigIdA
+	}
+	return ""
+}
+
+func (x *CompareVersionsRequest) GetWorkspaceConfigIdB() string {
+	if x != nil && x.WorkspaceConfigIdB != nil {
+		return *x.WorkspaceConfigIdB
+	}
+	return ""
+}
+
+func (x *CompareVersionsRequest) GetRater() string {
+	if x != nil {
+		return x.Rater
+	}
+	return ""
+}
+
+func (x *CompareVersionsRequest) GetFilter() *TestCaseFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type FlippedCase struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TestCaseId        string `protobuf:"bytes,1,opt,name=test_case_id,json=testCaseId,proto3" json:"test_case_id,omitempty"`
+	TestResultIdA     string `protobuf:"bytes,2,opt,name=test_result_id_a,json=testResultIdA,proto3" json:"test_result_id_a,omitempty"`
+	TestResultIdB     string `protobuf:"bytes,3,opt,name=test_result_id_b,json=testResultIdB,proto3" json:"test_result_id_b,omitempty"`
+	WorkspaceConfigId string `protobuf:"bytes,4,opt,name=workspace_config_id,json=workspaceConfigId,proto3" json:"workspace_config_id,omitempty"`
+}
+
+func (x *FlippedCase) Reset() {
+	*x = FlippedCase{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlippedCase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlippedCase) ProtoMessage() {}
+
+func (x *FlippedCase) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlippedCase.ProtoReflect.Descriptor instead.
+func (*FlippedCase) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *FlippedCase) GetTestCaseId() string {
+	if x != nil {
+		return x.TestCaseId
+	}
+	return ""
+}
+
+func (x *FlippedCase) GetTestResultIdA() string {
+	if x != nil {
+		return x.TestResultIdA
+	}
+	return ""
+}
+
+func (x *FlippedCase) GetTestResultIdB() string {
+	if x != nil {
+		return x.TestResultIdB
+	}
+	return ""
+}
+
+func (x *FlippedCase) GetWorkspaceConfigId() string {
+	if x != nil {
+		return x.WorkspaceConfigId
+	}
+	return ""
+}
+
+// PassFailComparison is McNemar's test over thumbs ratings.
+type PassFailComparison struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NPairs   uint32 `protobuf:"varint,1,opt,name=n_pairs,json=nPairs,proto3" json:"n_pairs,omitempty"`
+	BothPass uint32 `protobuf:"varint,2,opt,name=both_pass,json=bothPass,proto3" json:"both_pass,omitempty"`
+	BothFail uint32 `protobuf:"varint,3,opt,name=both_fail,json=bothFail,proto3" json:"both_fail,omitempty"`
+	// improved went from fail in a to pass in b; regressed the other way around
+	Improved           uint32  `protobuf:"varint,4,opt,name=improved,proto3" json:"improved,omitempty"`
+	Regressed          uint32  `protobuf:"varint,5,opt,name=regressed,proto3" json:"regressed,omitempty"`
+	PassRateA          float64 `protobuf:"fixed64,6,opt,name=pass_rate_a,json=passRateA,proto3" json:"pass_rate_a,omitempty"`
+	PassRateB          float64 `protobuf:"fixed64,7,opt,name=pass_rate_b,json=passRateB,proto3" json:"pass_rate_b,omitempty"`
+	PassRateDifference float64 `protobuf:"fixed64,8,opt,name=pass_rate_difference,json=passRateDifference,proto3" json:"pass_rate_difference,omitempty"`
+	// odds_ratio is improved / regressed; above 1 means b is better
+	OddsRatio *float64 `protobuf:"fixed64,9,opt,name=odds_ratio,json=oddsRatio,proto3,oneof" json:"odds_ratio,omitempty"`
+	PValue    float64  `protobuf:"fixed64,10,opt,name=p_value,json=pValue,proto3" json:"p_value,omitempty"`
+}
+
+func (x *PassFailComparison) Reset() {
+	*x = PassFailComparison{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PassFailComparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PassFailComparison) ProtoMessage() {}
+
+func (x *PassFailComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PassFailComparison.ProtoReflect.Descriptor instead.
+func (*PassFailComparison) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *PassFailComparison) GetNPairs() uint32 {
+	if x != nil {
+		return x.NPairs
+	}
+	return 0
+}
+
+func (x *PassFailComparison) GetBothPass() uint32 {
+	if x != nil {
+		return x.BothPass
+	}
+	return 0
+}
+
+func (x *PassFailComparison) GetBothFail() uint32 {
+	if x != nil {
+		return x.BothFail
+	}
+	return 0
+}
+
+func (x *PassFailComparison) GetImproved() uint32 {
+	if x != nil {
+		return x.Improved
+	}
+	return 0
+}
+
+func (x *PassFailComparison) GetRegressed() uint32 {
+	if x != nil {
+		return x.Regressed
+	}
+	return 0
+}
+
+func (x *PassFailComparison) GetPassRateA() float64 {
+	if x != nil {
+		return x.PassRateA
+	}
+	return 0
+}
+
+func (x *PassFailComparison) GetPassRateB() float64 {
+	if x != nil {
+		return x.PassRateB
+	}
+	return 0
+}
+
+func (x *PassFailComparison) GetPassRateDifference() float64 {
+	if x != nil {
+		return x.PassRateDifference
+	}
+	return 0
+}
+
+func (x *PassFailComparison) GetOddsRatio() float64 {
+	if x != nil && x.OddsRatio != nil {
+		return *x.OddsRatio
+	}
+	return 0
+}
+
+func (x *PassFailComparison) GetPValue() float64 {
+	if x != nil {
+		return x.PValue
+	}
+	return 0
+}
+
+// ScoreComparison is the Wilcoxon signed-rank test over scale scores.
+type ScoreComparison struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NPairs         uint32  `protobuf:"varint,1,opt,name=n_pairs,json=nPairs,proto3" json:"n_pairs,omitempty"`
+	MeanA          float64 `protobuf:"fixed64,2,opt,name=mean_a,json=meanA,proto3" json:"mean_a,omitempty"`
+	MeanB          float64 `protobuf:"fixed64,3,opt,name=mean_b,json=meanB,proto3" json:"mean_b,omitempty"`
+	MeanDifference float64 `protobuf:"fixed64,4,opt,name=mean_difference,json=meanDifference,proto3" json:"mean_difference,omitempty"`
+	// rank_biserial is the matched-pairs rank-biserial correlation; positive means b scored higher
+	RankBiserial *float64 `protobuf:"fixed64,5,opt,name=rank_biserial,json=rankBiserial,proto3,oneof" json:"rank_biserial,omitempty"`
+	WPlus        float64  `protobuf:"fixed64,6,opt,name=w_plus,json=wPlus,proto3" json:"w_plus,omitempty"`
+	WMinus       float64  `protobuf:"fixed64,7,opt,name=w_minus,json=wMinus,proto3" json:"w_minus,omitempty"`
+	PValue       float64  `protobuf:"fixed64,8,opt,name=p_value,json=pValue,proto3" json:"p_value,omitempty"`
+}
+
+func (x *ScoreComparison) Reset() {
+	*x = ScoreComparison{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScoreComparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreComparison) ProtoMessage() {}
+
+func (x *ScoreComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreComparison.ProtoReflect.Descriptor instead.
+func (*ScoreComparison) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ScoreComparison) GetNPairs() uint32 {
+	if x != nil {
+		return x.NPairs
+	}
+	return 0
+}
+
+func (x *ScoreComparison) GetMeanA() float64 {
+	if x != nil {
+		return x.MeanA
+	}
+	return 0
+}
+
+func (x *ScoreComparison) GetMeanB() float64 {
+	if x != nil {
+		return x.MeanB
+	}
+	return 0
+}
+
+func (x *ScoreComparison) GetMeanDifference() float64 {
+	if x != nil {
+		return x.MeanDifference
+	}
+	return 0
+}
+
+func (x *ScoreComparison) GetRankBiserial() float64 {
+	if x != nil && x.RankBiserial != nil {
+		return *x.RankBiserial
+	}
+	return 0
+}
+
+func (x *ScoreComparison) GetWPlus() float64 {
+	if x != nil {
+		return x.WPlus
+	}
+	return 0
+}
+
+func (x *ScoreComparison) GetWMinus() float64 {
+	if x != nil {
+		return x.WMinus
+	}
+	return 0
+}
+
+func (x *ScoreComparison) GetPValue() float64 {
+	if x != nil {
+		return x.PValue
+	}
+	return 0
+}
+
+type CompareVersionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PassFail     *PassFailComparison `protobuf:"bytes,1,opt,name=pass_fail,json=passFail,proto3" json:"pass_fail,omitempty"`
+	Scores       *ScoreComparison    `protobuf:"bytes,2,opt,name=scores,proto3" json:"scores,omitempty"`
+	Regressions  []*FlippedCase      `protobuf:"bytes,3,rep,name=regressions,proto3" json:"regressions,omitempty"`
+	Improvements []*FlippedCase      `protobuf:"bytes,4,rep,name=improvements,proto3" json:"improvements,omitempty"`
+}
+
+func (x *CompareVersionsResponse) Reset() {
+	*x = CompareVersionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareVersionsResponse) ProtoMessage() {}
+
+func (x *CompareVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareVersionsResponse.ProtoReflect.Descriptor instead.
+func (*CompareVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *CompareVersionsResponse) GetPassFail() *PassFailComparison {
+	if x != nil {
+		return x.PassFail
+	}
+	return nil
+}
+
+func (x *CompareVersionsResponse) GetScores() *ScoreComparison {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *CompareVersionsResponse) GetRegressions() []*FlippedCase {
+	if x != nil {
+		return x.Regressions
+	}
+	return nil
+}
+
+func (x *CompareVersionsResponse) GetImprovements() []*FlippedCase {
+	if x != nil {
+		return x.Improvements
+	}
+	return nil
+}
+
+// Grader scores test results automatically. External graders run a local
+// executable that reads JSON on stdin and writes a JSON score/pass/reason on stdout.
+// Judge graders ask a model to score each result against a rubric.
+type Grader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WorkspaceId string     `protobuf:"bytes,2,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Name        string     `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type        GraderType `protobuf:"varint,4,opt,name=type,proto3,enum=eval.v1.GraderType" json:"type,omitempty"`
+	Command     string     `protobuf:"bytes,5,opt,name=command,proto3" json:"command,omitempty"`
+	Args        []string   `protobuf:"bytes,6,rep,name=args,proto3" json:"args,omitempty"`
+	// batch sends a JSON array of up to batch_size results per invocation
+	Batch          bool                   `protobuf:"varint,7,opt,name=batch,proto3" json:"batch,omitempty"`
+	BatchSize      uint32                 `protobuf:"varint,8,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	TimeoutSeconds uint32                 `protobuf:"varint,9,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	Concurrency    uint32                 `protobuf:"varint,10,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// judge graders only; model_config_name defaults to the default large model config
+	ModelConfigName string `protobuf:"bytes,13,opt,name=model_config_name,json=modelConfigName,proto3" json:"model_config_name,omitempty"`
+	Rubric          string `protobuf:"bytes,14,opt,name=rubric,proto3" json:"rubric,omitempty"`
+}
+
+func (x *Grader) Reset() {
+	*x = Grader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Grader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Grader) ProtoMessage() {}
+
+func (x *Grader) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Grader.ProtoReflect.Descriptor instead.
+func (*Grader) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *Grader) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Grader) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *Grader) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Grader) GetType() GraderType {
+	if x != nil {
+		return x.Type
+	}
+	return GraderType_GRADER_TYPE_UNSPECIFIED
+}
+
+func (x *Grader) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *Grader) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *Grader) GetBatch() bool {
+	if x != nil {
+		return x.Batch
+	}
+	return false
+}
+
+func (x *Grader) GetBatchSize() uint32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *Grader) GetTimeoutSeconds() uint32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *Grader) GetConcurrency() uint32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+func (x *Grader) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Grader) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Grader) GetModelConfigName() string {
+	if x != nil {
+		return x.ModelConfigName
+	}
+	return ""
+}
+
+func (x *Grader) GetRubric() string {
+	if x != nil {
+		return x.Rubric
+	}
+	return ""
+}
+
+type CreateGraderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId     string     `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Name            string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type            GraderType `protobuf:"varint,3,opt,name=type,proto3,enum=eval.v1.GraderType" json:"type,omitempty"`
+	Command         string     `protobuf:"bytes,4,opt,name=command,proto3" json:"command,omitempty"`
+	Args            []string   `protobuf:"bytes,5,rep,name=args,proto3" json:"args,omitempty"`
+	Batch           bool       `protobuf:"varint,6,opt,name=batch,proto3" json:"batch,omitempty"`
+	BatchSize       uint32     `protobuf:"varint,7,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	TimeoutSeconds  uint32     `protobuf:"varint,8,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	Concurrency     uint32     `protobuf:"varint,9,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	ModelConfigName string     `protobuf:"bytes,10,opt,name=model_config_name,json=modelConfigName,proto3" json:"model_config_name,omitempty"`
+	Rubric          string     `protobuf:"bytes,11,opt,name=rubric,proto3" json:"rubric,omitempty"`
+}
+
+func (x *CreateGraderRequest) Reset() {
+	*x = CreateGraderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGraderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGraderRequest) ProtoMessage() {}
+
+func (x *CreateGraderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGraderRequest.ProtoReflect.Descriptor instead.
+func (*CreateGraderRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *CreateGraderRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *CreateGraderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGraderRequest) GetType() GraderType {
+	if x != nil {
+		return x.Type
+	}
+	return GraderType_GRADER_TYPE_UNSPECIFIED
+}
+
+func (x *CreateGraderRequest) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *CreateGraderRequest) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *CreateGraderRequest) GetBatch() bool {
+	if x != nil {
+		return x.Batch
+	}
+	return false
+}
+
+func (x *CreateGraderRequest) GetBatchSize() uint32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *CreateGraderRequest) GetTimeoutSeconds() uint32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *CreateGraderRequest) GetConcurrency() uint32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+func (x *CreateGraderRequest) GetModelConfigName() string {
+	if x != nil {
+		return x.ModelConfigName
+	}
+	return ""
+}
+
+func (x *CreateGraderRequest) GetRubric() string {
+	if x != nil {
+		return x.Rubric
+	}
+	return ""
+}
+
+type CreateGraderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Grader *Grader `protobuf:"bytes,1,opt,name=grader,proto3" json:"grader,omitempty"`
+}
+
+func (x *CreateGraderResponse) Reset() {
+	*x = CreateGraderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGraderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGraderResponse) ProtoMessage() {}
+
+func (x *CreateGraderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGraderResponse.ProtoReflect.Descriptor instead.
+func (*CreateGraderResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *CreateGraderResponse) GetGrader() *Grader {
+	if x != nil {
+		return x.Grader
+	}
+	return nil
+}
+
+type ListGradersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+}
+
+func (x *ListGradersRequest) Reset() {
+	*x = ListGradersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGradersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGradersRequest) ProtoMessage() {}
+
+func (x *ListGradersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGradersRequest.ProtoReflect.Descriptor instead.
+func (*ListGradersRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ListGradersRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+type ListGradersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Graders []*Grader `protobuf:"bytes,1,rep,name=graders,proto3" json:"graders,omitempty"`
+}
+
+func (x *ListGradersResponse) Reset() {
+	*x = ListGradersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGradersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGradersResponse) ProtoMessage() {}
+
+func (x *ListGradersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGradersResponse.ProtoReflect.Descriptor instead.
+func (*ListGradersResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ListGradersResponse) GetGraders() []*Grader {
+	if x != nil {
+		return x.Graders
+	}
+	return nil
+}
+
+type DeleteGraderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteGraderRequest) Reset() {
+	*x = DeleteGraderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteGraderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGraderRequest) ProtoMessage() {}
+
+func (x *DeleteGraderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGraderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGraderRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *DeleteGraderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RunGraderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GraderId string `protobuf:"bytes,1,opt,name=grader_id,json=graderId,proto3" json:"grader_id,omitempty"`
+	// only grade results for this prompt version
+	VersionNumber *uint32 `protobuf:"varint,2,opt,name=version_number,json=versionNumber,proto3,oneof" json:"version_number,omitempty"`
+	// only grade these results; all results in the workspace if empty
+	TestResultIds []string `protobuf:"bytes,3,rep,name=test_result_ids,json=testResultIds,proto3" json:"test_result_ids,omitempty"`
+	// regrade results this grader has already scored
+	Regrade bool            `protobuf:"varint,4,opt,name=regrade,proto3" json:"regrade,omitempty"`
+	Filter  *TestCaseFilter `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *RunGraderRequest) Reset() {
+	*x = RunGraderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunGraderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunGraderRequest) ProtoMessage() {}
+
+func (x *RunGraderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunGraderRequest.ProtoReflect.Descriptor instead.
+func (*RunGraderRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *RunGraderRequest) GetGraderId() string {
+	if x != nil {
+		return x.GraderId
+	}
+	return ""
+}
+
+func (x *RunGraderRequest) GetVersionNumber() uint32 {
+	if x != nil && x.VersionNumber != nil {
+		return *x.VersionNumber
+	}
+	return 0
+}
+
+func (x *RunGraderRequest) GetTestResultIds() []string {
+	if x != nil {
+		return x.TestResultIds
+	}
+	return nil
+}
+
+func (x *RunGraderRequest) GetRegrade() bool {
+	if x != nil {
+		return x.Regrade
+	}
+	return false
+}
+
+func (x *RunGraderRequest) GetFilter() *TestCaseFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type GradeError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TestResultId string `protobuf:"bytes,1,opt,name=test_result_id,json=testResultId,proto3" json:"test_result_id,omitempty"`
+	Error        string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *GradeError) Reset() {
+	*x = GradeError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GradeError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeError) ProtoMessage() {}
+
+func (x *GradeError) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeError.ProtoReflect.Descriptor instead.
+func (*GradeError) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *GradeError) GetTestResultId() string {
+	if x != nil {
+		return x.TestResultId
+	}
+	return ""
+}
+
+func (x *GradeError) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type RunGraderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ratings  []*Rating     `protobuf:"bytes,1,rep,name=ratings,proto3" json:"ratings,omitempty"`
+	Errors   []*GradeError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	NSkipped uint32        `protobuf:"varint,3,opt,name=n_skipped,json=nSkipped,proto3" json:"n_skipped,omitempty"`
+}
+
+func (x *RunGraderResponse) Reset() {
+	*x = RunGraderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunGraderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunGraderResponse) ProtoMessage() {}
+
+func (x *RunGraderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunGraderResponse.ProtoReflect.Descriptor instead.
+func (*RunGraderResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *RunGraderResponse) GetRatings() []*Rating {
+	if x != nil {
+		return x.Ratings
+	}
+	return nil
+}
+
+func (x *RunGraderResponse) GetErrors() []*GradeError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *RunGraderResponse) GetNSkipped() uint32 {
+	if x != nil {
+		return x.NSkipped
+	}
+	return 0
+}
+
+type ExportWorkspaceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId string     `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	Format      FileFormat `protobuf:"varint,2,opt,name=format,proto3,enum=eval.v1.FileFormat" json:"format,omitempty"`
+	// only export results for this prompt version
+	VersionNumber *uint32         `protobuf:"varint,3,opt,name=version_number,json=versionNumber,proto3,oneof" json:"version_number,omitempty"`
+	Filter        *TestCaseFilter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *ExportWorkspaceRequest) Reset() {
+	*x = ExportWorkspaceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportWorkspaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportWorkspaceRequest) ProtoMessage() {}
+
+func (x *ExportWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*ExportWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *ExportWorkspaceRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *ExportWorkspaceRequest) GetFormat() FileFormat {
+	if x != nil {
+		return x.Format
+	}
+	return FileFormat_FILE_FORMAT_CSV
+}
+
+func (x *ExportWorkspaceRequest) GetVersionNumber() uint32 {
+	if x != nil && x.VersionNumber != nil {
+		return *x.VersionNumber
+	}
+	return 0
+}
+
+func (x *ExportWorkspaceRequest) GetFilter() *TestCaseFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// ExportWorkspaceResponse carries the next chunk of the exported file. Each test
+// result is one row; test cases without results get a row of their own.
+type ExportWorkspaceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chunk []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+}
+
+func (x *ExportWorkspaceResponse) Reset() {
+	*x = ExportWorkspaceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportWorkspaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportWorkspaceResponse) ProtoMessage() {}
+
+func (x *ExportWorkspaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*ExportWorkspaceResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *ExportWorkspaceResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// generate all results for a workspace and model config
+type SyntheticGenerationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId               string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	ModelConfigName           string `protobuf:"bytes,2,opt,name=model_config_name,json=modelConfigName,proto3" json:"model_config_name,omitempty"`
+	VersionNumber             uint32 `protobuf:"varint,3,opt,name=version_number,json=versionNumber,proto3" json:"version_number,omitempty"`
+	SystemPromptVersionNumber uint32 `protobuf:"varint,4,opt,name=system_prompt_version_number,json=systemPromptVersionNumber,proto3" json:"system_prompt_version_number,omitempty"`
+	// only run the test cases matching the filter
+	Filter *TestCaseFilter `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	// run the versions a label points at instead of version numbers
+	Label string `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (x *SyntheticGenerationRequest) Reset() {
+	*x = SyntheticGenerationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyntheticGenerationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyntheticGenerationRequest) ProtoMessage() {}
+
+func (x *SyntheticGenerationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyntheticGenerationRequest.ProtoReflect.Descriptor instead.
+func (*SyntheticGenerationRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *SyntheticGenerationRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *SyntheticGenerationRequest) GetModelConfigName() string {
+	if x != nil {
+		return x.ModelConfigName
+	}
+	return ""
+}
+
+func (x *SyntheticGenerationRequest) GetVersionNumber() uint32 {
+	if x != nil {
+		return x.VersionNumber
+	}
+	return 0
+}
+
+func (x *SyntheticGenerationRequest) GetSystemPromptVersionNumber() uint32 {
+	if x != nil {
+		return x.SystemPromptVersionNumber
+	}
+	return 0
+}
+
+func (x *SyntheticGenerationRequest) GetFilter() *TestCaseFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *SyntheticGenerationRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+// Extended EvaluationService
+// RunPerturbationsRequest creates perturbed variants of test cases as child test
+// cases, evaluates originals and variants with the same configs, and measures how
+// often the result or grade changes.
+type RunPerturbationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	// test cases to perturb; all test cases matching filter if empty
+	TestCaseIds []string        `protobuf:"bytes,2,rep,name=test_case_ids,json=testCaseIds,proto3" json:"test_case_ids,omitempty"`
+	Filter      *TestCaseFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// all kinds if empty
+	Kinds []PerturbationKind `protobuf:"varint,4,rep,packed,name=kinds,proto3,enum=eval.v1.PerturbationKind" json:"kinds,omitempty"`
+	// variants per test case and kind; defaults to 1
+	NVariants uint32 `protobuf:"varint,5,opt,name=n_variants,json=nVariants,proto3" json:"n_variants,omitempty"`
+	// paraphrase_model_config_name defaults to the default small model config
+	ParaphraseModelConfigName *string `protobuf:"bytes,6,opt,name=paraphrase_model_config_name,json=paraphraseModelConfigName,proto3,oneof" json:"paraphrase_model_config_name,omitempty"`
+	// seed makes the perturbations reproducible; random if 0
+	Seed int64 `protobuf:"varint,7,opt,name=seed,proto3" json:"seed,omitempty"`
+	// version_number defaults to the current prompt version
+	VersionNumber *uint32 `protobuf:"varint,8,opt,name=version_number,json=versionNumber,proto3,oneof" json:"version_number,omitempty"`
+	// configs to evaluate with; the active configs if empty
+	WorkspaceConfigIds []string `protobuf:"bytes,9,rep,name=workspace_config_ids,json=workspaceConfigIds,proto3" json:"workspace_config_ids,omitempty"`
+	// if set, originals and variants are graded and grades are compared too
+	GraderId *string `protobuf:"bytes,10,opt,name=grader_id,json=graderId,proto3,oneof" json:"grader_id,omitempty"`
+	// run the versions a label points at instead of version_number and the current
+	// system prompt
+	Label string `protobuf:"bytes,11,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (x *RunPerturbationsRequest) Reset() {
+	*x = RunPerturbationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunPerturbationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunPerturbationsRequest) ProtoMessage() {}
+
+func (x *RunPerturbationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunPerturbationsRequest.ProtoReflect.Descriptor instead.
+func (*RunPerturbationsRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *RunPerturbationsRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *RunPerturbationsRequest) GetTestCaseIds() []string {
+	if x != nil {
+		return x.TestCaseIds
+	}
+	return nil
+}
+
+func (x *RunPerturbationsRequest) GetFilter() *TestCaseFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *RunPerturbationsRequest) GetKinds() []PerturbationKind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+func (x *RunPerturbationsRequest) GetNVariants() uint32 {
+	if x != nil {
+		return x.NVariants
+	}
+	return 0
+}
+
+func (x *RunPerturbationsRequest) GetParaphraseModelConfigName() string {
+	if x != nil && x.ParaphraseModelConfigName != nil {
+		return *x.ParaphraseModelConfigName
+	}
+	return ""
+}
+
+func (x *RunPerturbationsRequest) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *RunPerturbationsRequest) GetVersionNumber() uint32 {
+	if x != nil && x.VersionNumber != nil {
+		return *x.VersionNumber
+	}
+	return 0
+}
+
+func (x *RunPerturbationsRequest) GetWorkspaceConfigIds() []string {
+	if x != nil {
+		return x.WorkspaceConfigIds
+	}
+	return nil
+}
+
+func (x *RunPerturbationsRequest) GetGraderId() string {
+	if x != nil && x.GraderId != nil {
+		return *x.GraderId
+	}
+	return ""
+}
+
+func (x *RunPerturbationsRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+// PerturbationStability compares the results of originals and their variants for one
+// config, over all perturbation kinds if kind is unspecified. A result changes if its
+// XML fields differ, or its text does when it has none, ignoring whitespace.
+type PerturbationStability struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceConfigId string           `protobuf:"bytes,1,opt,name=workspace_config_id,json=workspaceConfigId,proto3" json:"workspace_config_id,omitempty"`
+	ModelConfigName   string           `protobuf:"bytes,2,opt,name=model_config_name,json=modelConfigName,proto3" json:"model_config_name,omitempty"`
+	Kind              PerturbationKind `protobuf:"varint,3,opt,name=kind,proto3,enum=eval.v1.PerturbationKind" json:"kind,omitempty"`
+	NPairs            uint32           `protobuf:"varint,4,opt,name=n_pairs,json=nPairs,proto3" json:"n_pairs,omitempty"`
+	NResultChanged    uint32           `protobuf:"varint,5,opt,name=n_result_changed,json=nResultChanged,proto3" json:"n_result_changed,omitempty"`
+	// pairs where both results have a grade from the grader
+	NGradedPairs  uint32 `protobuf:"varint,6,opt,name=n_graded_pairs,json=nGradedPairs,proto3" json:"n_graded_pairs,omitempty"`
+	NGradeChanged uint32 `protobuf:"varint,7,opt,name=n_grade_changed,json=nGradeChanged,proto3" json:"n_grade_changed,omitempty"`
+	// stability is the fraction of pairs where neither the result nor the grade changed
+	Stability float64 `protobuf:"fixed64,8,opt,name=stability,proto3" json:"stability,omitempty"`
+}
+
+func (x *PerturbationStability) Reset() {
+	*x = PerturbationStability{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PerturbationStability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PerturbationStability) ProtoMessage() {}
+
+func (x *PerturbationStability) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerturbationStability.ProtoReflect.Descriptor instead.
+func (*PerturbationStability) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *PerturbationStability) GetWorkspaceConfigId() string {
+	if x != nil {
+		return x.WorkspaceConfigId
+	}
+	return ""
+}
+
+func (x *PerturbationStability) GetModelConfigName() string {
+	if x != nil {
+		return x.ModelConfigName
+	}
+	return ""
+}
+
+func (x *PerturbationStability) GetKind() PerturbationKind {
+	if x != nil {
+		return x.Kind
+	}
+	return PerturbationKind_PERTURBATION_KIND_UNSPECIFIED
+}
+
+func (x *PerturbationStability) GetNPairs() uint32 {
+	if x != nil {
+		return x.NPairs
+	}
+	return 0
+}
+
+func (x *PerturbationStability) GetNResultChanged() uint32 {
+	if x != nil {
+		return x.NResultChanged
+	}
+	return 0
+}
+
+func (x *PerturbationStability) GetNGradedPairs() uint32 {
+	if x != nil {
+		return x.NGradedPairs
+	}
+	return 0
+}
+
+func (x *PerturbationStability) GetNGradeChanged() uint32 {
+	if x != nil {
+		return x.NGradeChanged
+	}
+	return 0
+}
+
+func (x *PerturbationStability) GetStability() float64 {
+	if x != nil {
+		return x.Stability
+	}
+	return 0
+}
+
+type RunPerturbationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Variants  []*TestCase              `protobuf:"bytes,1,rep,name=variants,proto3" json:"variants,omitempty"`
+	Results   []*TestResult            `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	Stability []*PerturbationStability `protobuf:"bytes,3,rep,name=stability,proto3" json:"stability,omitempty"`
+	// perturbations, evaluations or grades that failed
+	Errors []string `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *RunPerturbationsResponse) Reset() {
+	*x = RunPerturbationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunPerturbationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunPerturbationsResponse) ProtoMessage() {}
+
+func (x *RunPerturbationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunPerturbationsResponse.ProtoReflect.Descriptor instead.
+func (*RunPerturbationsResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *RunPerturbationsResponse) GetVariants() []*TestCase {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *RunPerturbationsResponse) GetResults() []*TestResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *RunPerturbationsResponse) GetStability() []*PerturbationStability {
+	if x != nil {
+		return x.Stability
+	}
+	return nil
+}
+
+func (x *RunPerturbationsResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// OptimizePromptRequest starts from a prompt version and, each round, asks a model
+// to revise the best version so far using its failing cases. Every candidate is saved
+// as a new prompt version and scored with the grader on the dev split. The current
+// version of the workspace is left as it is.
+type OptimizePromptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	// version_number defaults to the current prompt version
+	VersionNumber *uint32 `protobuf:"varint,2,opt,name=version_number,json=versionNumber,proto3,oneof" json:"version_number,omitempty"`
+	// grader_id scores every version; use an external grader for assertions or a
+	// judge grader for judge scores
+	GraderId string              `protobuf:"bytes,3,opt,name=grader_id,json=graderId,proto3" json:"grader_id,omitempty"`
+	Scoring  OptimizationScoring `protobuf:"varint,4,opt,name=scoring,proto3,enum=eval.v1.OptimizationScoring" json:"scoring,omitempty"`
+	// model config that proposes revisions; defaults to the default large model config
+	ModelConfigName *string `protobuf:"bytes,5,opt,name=model_config_name,json=modelConfigName,proto3,oneof" json:"model_config_name,omitempty"`
+	// defaults to 3
+	MaxRounds uint32 `protobuf:"varint,6,opt,name=max_rounds,json=maxRounds,proto3" json:"max_rounds,omitempty"`
+	// candidates per round; defaults to 3
+	NCandidates uint32 `protobuf:"varint,7,opt,name=n_candidates,json=nCandidates,proto3" json:"n_candidates,omitempty"`
+	// stop once a round improves the best score by less than this; defaults to 0.01
+	MinImprovement *float64 `protobuf:"fixed64,8,opt,name=min_improvement,json=minImprovement,proto3,oneof" json:"min_improvement,omitempty"`
+	// grades without a pass count as failing below this score; defaults to 0.5
+	PassThreshold *float64 `protobuf:"fixed64,9,opt,name=pass_threshold,json=passThreshold,proto3,oneof" json:"pass_threshold,omitempty"`
+	// configs to evaluate with; the active configs if empty
+	WorkspaceConfigIds []string `protobuf:"bytes,10,rep,name=workspace_config_ids,json=workspaceConfigIds,proto3" json:"workspace_config_ids,omitempty"`
+}
+
+func (x *OptimizePromptRequest) Reset() {
+	*x = OptimizePromptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptimizePromptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptimizePromptRequest) ProtoMessage() {}
+
+func (x *OptimizePromptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptimizePromptRequest.ProtoReflect.Descriptor instead.
+func (*OptimizePromptRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *OptimizePromptRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *OptimizePromptRequest) GetVersionNumber() uint32 {
+	if x != nil && x.VersionNumber != nil {
+		return *x.VersionNumber
+	}
+	return 0
+}
+
+func (x *OptimizePromptRequest) GetGraderId() string {
+	if x != nil {
+		return x.GraderId
+	}
+	return ""
+}
+
+func (x *OptimizePromptRequest) GetScoring() OptimizationScoring {
+	if x != nil {
+		return x.Scoring
+	}
+	return OptimizationScoring_OPTIMIZATION_SCORING_GRADER
+}
+
+func (x *OptimizePromptRequest) GetModelConfigName() string {
+	if x != nil && x.ModelConfigName != nil {
+		return *x.ModelConfigName
+	}
+	return ""
+}
+
+func (x *OptimizePromptRequest) GetMaxRounds() uint32 {
+	if x != nil {
+		return x.MaxRounds
+	}
+	return 0
+}
+
+func (x *OptimizePromptRequest) GetNCandidates() uint32 {
+	if x != nil {
+		return x.NCandidates
+	}
+	return 0
+}
+
+func (x *OptimizePromptRequest) GetMinImprovement() float64 {
+	if x != nil && x.MinImprovement != nil {
+		return *x.MinImprovement
+	}
+	return 0
+}
+
+func (x *OptimizePromptRequest) GetPassThreshold() float64 {
+	if x != nil && x.PassThreshold != nil {
+		return *x.PassThreshold
+	}
+	return 0
+}
+
+func (x *OptimizePromptRequest) GetWorkspaceConfigIds() []string {
+	if x != nil {
+		return x.WorkspaceConfigIds
+	}
+	return nil
+}
+
+type OptimizationCandidate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Round               uint32 `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	PromptVersionNumber uint32 `protobuf:"varint,2,opt,name=prompt_version_number,json=promptVersionNumber,proto3" json:"prompt_version_number,omitempty"`
+	ParentVersionNumber uint32 `protobuf:"varint,3,opt,name=parent_version_number,json=parentVersionNumber,proto3" json:"parent_version_number,omitempty"`
+	// score is the mean grade of the version's results on the dev split
+	Score   float64 `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`
+	NScored uint32  `protobuf:"varint,5,opt,name=n_scored,json=nScored,proto3" json:"n_scored,omitempty"`
+	NFailed uint32  `protobuf:"varint,6,opt,name=n_failed,json=nFailed,proto3" json:"n_failed,omitempty"`
+}
+
+func (x *OptimizationCandidate) Reset() {
+	*x = OptimizationCandidate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptimizationCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptimizationCandidate) ProtoMessage() {}
+
+func (x *OptimizationCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptimizationCandidate.ProtoReflect.Descriptor instead.
+func (*OptimizationCandidate) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *OptimizationCandidate) GetRound() uint32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *OptimizationCandidate) GetPromptVersionNumber() uint32 {
+	if x != nil {
+		return x.PromptVersionNumber
+	}
+	return 0
+}
+
+func (x *OptimizationCandidate) GetParentVersionNumber() uint32 {
+	if x != nil {
+		return x.ParentVersionNumber
+	}
+	return 0
+}
+
+func (x *OptimizationCandidate) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *OptimizationCandidate) GetNScored() uint32 {
+	if x != nil {
+		return x.NScored
+	}
+	return 0
+}
+
+func (x *OptimizationCandidate) GetNFailed() uint32 {
+	if x != nil {
+		return x.NFailed
+	}
+	return 0
+}
+
+type OptimizationRun struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                 string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WorkspaceId        string                   `protobuf:"bytes,2,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	GraderId           string                   `protobuf:"bytes,3,opt,name=grader_id,json=graderId,proto3" json:"grader_id,omitempty"`
+	Scoring            OptimizationScoring      `protobuf:"varint,4,opt,name=scoring,proto3,enum=eval.v1.OptimizationScoring" json:"scoring,omitempty"`
+	ModelConfigName    string                   `protobuf:"bytes,5,opt,name=model_config_name,json=modelConfigName,proto3" json:"model_config_name,omitempty"`
+	StartVersionNumber uint32                   `protobuf:"varint,6,opt,name=start_version_number,json=startVersionNumber,proto3" json:"start_version_number,omitempty"`
+	StartScore         float64                  `protobuf:"fixed64,7,opt,name=start_score,json=startScore,proto3" json:"start_score,omitempty"`
+	BestVersionNumber  uint32                   `protobuf:"varint,8,opt,name=best_version_number,json=bestVersionNumber,proto3" json:"best_version_number,omitempty"`
+	BestScore          float64                  `protobuf:"fixed64,9,opt,name=best_score,json=bestScore,proto3" json:"best_score,omitempty"`
+	NRounds            uint32                   `protobuf:"varint,10,opt,name=n_rounds,json=nRounds,proto3" json:"n_rounds,omitempty"`
+	StopReason         OptimizationStopReason   `protobuf:"varint,11,opt,name=stop_reason,json=stopReason,proto3,enum=eval.v1.OptimizationStopReason" json:"stop_reason,omitempty"`
+	Candidates         []*OptimizationCandidate `protobuf:"bytes,12,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	Errors             []string                 `protobuf:"bytes,13,rep,name=errors,proto3" json:"errors,omitempty"`
+	CreatedAt          *timestamppb.Timestamp   `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *OptimizationRun) Reset() {
+	*x = OptimizationRun{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptimizationRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptimizationRun) ProtoMessage() {}
+
+func (x *OptimizationRun) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptimizationRun.ProtoReflect.Descriptor instead.
+func (*OptimizationRun) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *OptimizationRun) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OptimizationRun) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *OptimizationRun) GetGraderId() string {
+	if x != nil {
+		return x.GraderId
+	}
+	return ""
+}
+
+func (x *OptimizationRun) GetScoring() OptimizationScoring {
+	if x != nil {
+		return x.Scoring
+	}
+	return OptimizationScoring_OPTIMIZATION_SCORING_GRADER
+}
+
+func (x *OptimizationRun) GetModelConfigName() string {
+	if x != nil {
+		return x.ModelConfigName
+	}
+	return ""
+}
+
+func (x *OptimizationRun) GetStartVersionNumber() uint32 {
+	if x != nil {
+		return x.StartVersionNumber
+	}
+	return 0
+}
+
+func (x *OptimizationRun) GetStartScore() float64 {
+	if x != nil {
+		return x.StartScore
+	}
+	return 0
+}
+
+func (x *OptimizationRun) GetBestVersionNumber() uint32 {
+	if x != nil {
+		return x.BestVersionNumber
+	}
+	return 0
+}
+
+func (x *OptimizationRun) GetBestScore() float64 {
+	if x != nil {
+		return x.BestScore
+	}
+	return 0
+}
+
+func (x *OptimizationRun) GetNRounds() uint32 {
+	if x != nil {
+		return x.NRounds
+	}
+	return 0
+}
+
+func (x *OptimizationRun) GetStopReason() OptimizationStopReason {
+	if x != nil {
+		return x.StopReason
+	}
+	return OptimizationStopReason_OPTIMIZATION_STOP_REASON_UNSPECIFIED
+}
+
+func (x *OptimizationRun) GetCandidates() []*OptimizationCandidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+func (x *OptimizationRun) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *OptimizationRun) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type OptimizePromptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Run *OptimizationRun `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"`
+}
+
+func (x *OptimizePromptResponse) Reset() {
+	*x = OptimizePromptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptimizePromptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptimizePromptResponse) ProtoMessage() {}
+
+func (x *OptimizePromptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptimizePromptResponse.ProtoReflect.Descriptor instead.
+func (*OptimizePromptResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *OptimizePromptResponse) GetRun() *OptimizationRun {
+	if x != nil {
+		return x.Run
+	}
+	return nil
+}
+
+type ListOptimizationRunsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+}
+
+func (x *ListOptimizationRunsRequest) Reset() {
+	*x = ListOptimizationRunsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOptimizationRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOptimizationRunsRequest) ProtoMessage() {}
+
+func (x *ListOptimizationRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOptimizationRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListOptimizationRunsRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *ListOptimizationRunsRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+type ListOptimizationRunsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Runs []*OptimizationRun `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+}
+
+func (x *ListOptimizationRunsResponse) Reset() {
+	*x = ListOptimizationRunsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOptimizationRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOptimizationRunsResponse) ProtoMessage() {}
+
+func (x *ListOptimizationRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOptimizationRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListOptimizationRunsResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *ListOptimizationRunsResponse) GetRuns() []*OptimizationRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+// Dataset is a set of test cases that can be attached to several workspaces. Each
+// workspace keeps its own results for the dataset's test cases.
+type Dataset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	NTestCases  uint32                 `protobuf:"varint,4,opt,name=n_test_cases,json=nTestCases,proto3" json:"n_test_cases,omitempty"`
+	Attachments []*DatasetAttachment   `protobuf:"bytes,5,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *Dataset) Reset() {
+	*x = Dataset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Dataset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Dataset) ProtoMessage() {}
+
+func (x *Dataset) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Dataset.ProtoReflect.Descriptor instead.
+func (*Dataset) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *Dataset) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Dataset) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Dataset) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Dataset) GetNTestCases() uint32 {
+	if x != nil {
+		return x.NTestCases
+	}
+	return 0
+}
+
+func (x *Dataset) GetAttachments() []*DatasetAttachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *Dataset) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Dataset) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type DatasetAttachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	DatasetId   string `protobuf:"bytes,2,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
+	// variable_mapping maps dataset variable names to the workspace's variable names.
+	// Variables without an entry keep their name.
+	VariableMapping map[string]string      `protobuf:"bytes,3,rep,name=variable_mapping,json=variableMapping,proto3" json:"variable_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *DatasetAttachment) Reset() {
+	*x = DatasetAttachment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DatasetAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatasetAttachment) ProtoMessage() {}
+
+func (x *DatasetAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatasetAttachment.ProtoReflect.Descriptor instead.
+func (*DatasetAttachment) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *DatasetAttachment) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *DatasetAttachment) GetDatasetId() string {
+	if x != nil {
+		return x.DatasetId
+	}
+	return ""
+}
+
+func (x *DatasetAttachment) GetVariableMapping() map[string]string {
+	if x != nil {
+		return x.VariableMapping
+	}
+	return nil
+}
+
+func (x *DatasetAttachment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateDatasetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// if set, the workspace's own test cases matching filter are moved into the new
+	// dataset along with their results, and the dataset is attached to the workspace
+	SourceWorkspaceId *string         `protobuf:"bytes,3,opt,name=source_workspace_id,json=sourceWorkspaceId,proto3,oneof" json:"source_workspace_id,omitempty"`
+	Filter            *TestCaseFilter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *CreateDatasetRequest) Reset() {
+	*x = CreateDatasetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateDatasetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDatasetRequest) ProtoMessage() {}
+
+func (x *CreateDatasetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDatasetRequest.ProtoReflect.Descriptor instead.
+func (*CreateDatasetRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *CreateDatasetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateDatasetRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateDatasetRequest) GetSourceWorkspaceId() string {
+	if x != nil && x.SourceWorkspaceId != nil {
+		return *x.SourceWorkspaceId
+	}
+	return ""
+}
+
+func (x *CreateDatasetRequest) GetFilter() *TestCaseFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type CreateDatasetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Dataset *Dataset `protobuf:"bytes,1,opt,name=dataset,proto3" json:"dataset,omitempty"`
+	NMoved  uint32   `protobuf:"varint,2,opt,name=n_moved,json=nMoved,proto3" json:"n_moved,omitempty"`
+}
+
+func (x *CreateDatasetResponse) Reset() {
+	*x = CreateDatasetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateDatasetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDatasetResponse) ProtoMessage() {}
+
+func (x *CreateDatasetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDatasetResponse.ProtoReflect.Descriptor instead.
+func (*CreateDatasetResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *CreateDatasetResponse) GetDataset() *Dataset {
+	if x != nil {
+		return x.Dataset
+	}
+	return nil
+}
+
+func (x *CreateDatasetResponse) GetNMoved() uint32 {
+	if x != nil {
+		return x.NMoved
+	}
+	return 0
+}
+
+type ListDatasetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// only list datasets attached to this workspace
+	WorkspaceId *string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3,oneof" json:"workspace_id,omitempty"`
+}
+
+func (x *ListDatasetsRequest) Reset() {
+	*x = ListDatasetsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDatasetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDatasetsRequest) ProtoMessage() {}
+
+func (x *ListDatasetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDatasetsRequest.ProtoReflect.Descriptor instead.
+func (*ListDatasetsRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *ListDatasetsRequest) GetWorkspaceId() string {
+	if x != nil && x.WorkspaceId != nil {
+		return *x.WorkspaceId
+	}
+	return ""
+}
+
+type ListDatasetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Datasets []*Dataset `protobuf:"bytes,1,rep,name=datasets,proto3" json:"datasets,omitempty"`
+}
+
+func (x *ListDatasetsResponse) Reset() {
+	*x = ListDatasetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDatasetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDatasetsResponse) ProtoMessage() {}
+
+func (x *ListDatasetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDatasetsResponse.ProtoReflect.Descriptor instead.
+func (*ListDatasetsResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *ListDatasetsResponse) GetDatasets() []*Dataset {
+	if x != nil {
+		return x.Datasets
+	}
+	return nil
+}
+
+// DeleteDatasetRequest permanently deletes a dataset with its test cases and their
+// results. The dataset must be detached from every workspace first.
+type DeleteDatasetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteDatasetRequest) Reset() {
+	*x = DeleteDatasetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDatasetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDatasetRequest) ProtoMessage() {}
+
+func (x *DeleteDatasetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDatasetRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDatasetRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *DeleteDatasetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// AttachDatasetRequest attaches a dataset to a workspace, or updates the variable
+// mapping if it is already attached.
+type AttachDatasetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId     string            `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	DatasetId       string            `protobuf:"bytes,2,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
+	VariableMapping map[string]string `protobuf:"bytes,3,rep,name=variable_mapping,json=variableMapping,proto3" json:"variable_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *AttachDatasetRequest) Reset() {
+	*x = AttachDatasetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttachDatasetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachDatasetRequest) ProtoMessage() {}
+
+func (x *AttachDatasetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachDatasetRequest.ProtoReflect.Descriptor instead.
+func (*AttachDatasetRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *AttachDatasetRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *AttachDatasetRequest) GetDatasetId() string {
+	if x != nil {
+		return x.DatasetId
+	}
+	return ""
+}
+
+func (x *AttachDatasetRequest) GetVariableMapping() map[string]string {
+	if x != nil {
+		return x.VariableMapping
+	}
+	return nil
+}
+
+type AttachDatasetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attachment *DatasetAttachment `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+}
+
+func (x *AttachDatasetResponse) Reset() {
+	*x = AttachDatasetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttachDatasetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachDatasetResponse) ProtoMessage() {}
+
+func (x *AttachDatasetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachDatasetResponse.ProtoReflect.Descriptor instead.
+func (*AttachDatasetResponse) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *AttachDatasetResponse) GetAttachment() *DatasetAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+// DetachDatasetRequest detaches a dataset from a workspace. The workspace's results
+// for the dataset's test cases are kept, and show up again if it is re-attached.
+type DetachDatasetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId string `protobuf:"bytes,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	DatasetId   string `protobuf:"bytes,2,opt,name=dataset_id,json=datasetId,proto3" json:"dataset_id,omitempty"`
+}
+
+func (x *DetachDatasetRequest) Reset() {
+	*x = DetachDatasetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DetachDatasetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetachDatasetRequest) ProtoMessage() {}
+
+func (x *DetachDatasetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetachDatasetRequest.ProtoReflect.Descriptor instead.
+func (*DetachDatasetRequest) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *DetachDatasetRequest) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *DetachDatasetRequest) GetDatasetId() string {
+	if x != nil {
+		return x.DatasetId
+	}
+	return ""
+}
+
+type Workspace_Prompt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VersionNumber uint32                 `protobuf:"varint,1,opt,name=version_number,json=versionNumber,proto3" json:"version_number,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Variables     []*Variable            `protobuf:"bytes,3,rep,name=variables,proto3" json:"variables,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// the version this one was edited from; unset for the first version
+	ParentVersionNumber *uint32 `protobuf:"varint,5,opt,name=parent_version_number,json=parentVersionNumber,proto3,oneof" json:"parent_version_number,omitempty"`
+	CommitMessage       string  `protobuf:"bytes,6,opt,name=commit_message,json=commitMessage,proto3" json:"commit_message,omitempty"`
+}
+
+func (x *Workspace_Prompt) Reset() {
+	*x = Workspace_Prompt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Workspace_Prompt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Workspace_Prompt) ProtoMessage() {}
+
+func (x *Workspace_Prompt) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Workspace_Prompt.ProtoReflect.Descriptor instead.
+func (*Workspace_Prompt) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *Workspace_Prompt) GetVersionNumber() uint32 {
+	if x != nil {
+		return x.VersionNumber
+	}
+	return 0
+}
+
+func (x *Workspace_Prompt) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Workspace_Prompt) GetVariables() []*Variable {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *Workspace_Prompt) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Workspace_Prompt) GetParentVersionNumber() uint32 {
+	if x != nil && x.ParentVersionNumber != nil {
+		return *x.ParentVersionNumber
+	}
+	return 0
+}
+
+func (x *Workspace_Prompt) GetCommitMessage() string {
+	if x != nil {
+		return x.CommitMessage
+	}
+	return ""
+}
+
+type Workspace_SystemPrompt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VersionNumber       uint32  `protobuf:"varint,1,opt,name=version_number,json=versionNumber,proto3" json:"version_number,omitempty"`
+	Content             string  `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	ParentVersionNumber *uint32 `protobuf:"varint,3,opt,name=parent_version_number,json=parentVersionNumber,proto3,oneof" json:"parent_version_number,omitempty"`
+	CommitMessage       string  `protobuf:"bytes,4,opt,name=commit_message,json=commitMessage,proto3" json:"commit_message,omitempty"`
+}
+
+func (x *Workspace_SystemPrompt) Reset() {
+	*x = Workspace_SystemPrompt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_eval_v1_eval_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Workspace_SystemPrompt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Workspace_SystemPrompt) ProtoMessage() {}
+
+func (x *Workspace_SystemPrompt) ProtoReflect() protoreflect.Message {
+	mi := &file_eval_v1_eval_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Workspace_SystemPrompt.ProtoReflect.Descriptor instead.
+func (*Workspace_SystemPrompt) Descriptor() ([]byte, []int) {
+	return file_eval_v1_eval_proto_rawDescGZIP(), []int{11, 1}
+}
+
+func (x *Workspace_SystemPrompt) GetVersionNumber() uint32 {
+	if x != nil {
+		return x.VersionNumber
+	}
+	return 0
+}
+
+func (x *Workspace_SystemPrompt) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Workspace_SystemPrompt) GetParentVersionNumber() uint32 {
+	if x != nil && x.ParentVersionNumber != nil {
+		return *x.ParentVersionNumber
+	}
+	return 0
+}
+
+func (x *Workspace_SystemPrompt) GetCommitMessage() string {
+	if x != nil {
+		return x.CommitMessage
+	}
+	return ""
+}
+
+var File_eval_v1_eval_proto protoreflect.FileDescriptor
+
+var file_eval_v1_eval_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x65, 0x76, 0x61, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x49, 0x0a, 0x08, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x65, 0x76, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x5c, 0x0a, 0x0d, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x74, 0x65, 0x78, 0x74, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x74,
+	0x65, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52,
+	0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x6c, 0x0a, 0x0b, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x55,
+	0x72, 0x6c, 0x22, 0x51, 0x0a, 0x0e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x75, 0x0a, 0x0c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x68, 0x6f,
+	0x75, 0x6c, 0x64, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x43, 0x61, 0x63, 0x68, 0x65, 0x22, 0xdb, 0x01, 0x0a,
+	0x0c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x08,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12,
+	0x37, 0x0a, 0x0c, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x40, 0x0a, 0x0f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xe4, 0x01, 0x0a, 0x11, 0x45,
+	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x09, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x08, 0x74, 0x65, 0x73, 0x74, 0x43,
+	0x61, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x1c, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x19, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x22, 0x41, 0x0a, 0x12, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x22, 0xb1, 0x02, 0x0a, 0x0f, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0xc3, 0x01, 0x0a, 0x1c, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x64,
+	0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x43, 0x0a, 0x10, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x76, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x0f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x22, 0xc7, 0x0a, 0x0a, 0x09, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x10, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x70, 0x72,
+	0x6f, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x76,
+	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e,
+	0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x73, 0x12,
+	0x41, 0x0a, 0x1d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70,
+	0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50,
+	0x72, 0x6f, 0x6d, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x45, 0x0a, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x10, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x14, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12,
+	0x46, 0x0a, 0x0e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52, 0x0d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x4e, 0x0a, 0x24, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x58, 0x4d, 0x4c, 0x4d, 0x6f,
+	0x64, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x58, 0x4d, 0x4c, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x43, 0x0a, 0x10, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x76,
+	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x0f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x33, 0x0a, 0x13, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x57, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0b, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x1a, 0xaf, 0x02, 0x0a, 0x06, 0x50, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x09, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x37, 0x0a, 0x15, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x00, 0x52, 0x13, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x42, 0x18, 0x0a, 0x16, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x1a, 0xc9, 0x01, 0x0a, 0x0c, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x15,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x13, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x18, 0x0a, 0x16,
+	0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x22, 0xed,
+	0x05, 0x0a, 0x08, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x77,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x4e,
+	0x0a, 0x0f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e,
+	0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x1f,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x2c, 0x0a, 0x12, 0x68, 0x61, 0x73, 0x5f, 0x62, 0x65, 0x65, 0x6e, 0x5f, 0x65, 0x76, 0x61, 0x6c,
+	0x75, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x68, 0x61, 0x73,
+	0x42, 0x65, 0x65, 0x6e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x64, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x39, 0x0a,
+	0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x32, 0x0a, 0x13, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x10, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x54,
+	0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x0c,
+	0x70, 0x65, 0x72, 0x74, 0x75, 0x72, 0x62, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72,
+	0x74, 0x75, 0x72, 0x62, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x0c, 0x70,
+	0x65, 0x72, 0x74, 0x75, 0x72, 0x62, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0a, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x02, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x1a,
+	0x59, 0x0a, 0x13, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x6f,
+	0x0a, 0x0e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
+	0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x54, 0x61, 0x67, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x70, 0x6c, 0x69, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x52, 0x06, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x73, 0x22,
+	0x86, 0x04, 0x0a, 0x0a, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20,
+	0x0a, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x15,
+	0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x70, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e,
+	0x0a, 0x13, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x29, 0x0a, 0x07,
+	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07,
+	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x22, 0xbd, 0x02, 0x0a, 0x06, 0x52, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x74,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x74, 0x65, 0x72, 0x12,
+	0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74,
+	0x68, 0x75, 0x6d, 0x62, 0x73, 0x12, 0x19, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x46, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x22, 0xf2, 0x01, 0x0a, 0x15, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x63, 0x6f, 0x70, 0x79, 0x5f, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x63, 0x6f, 0x70, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x6f,
+	0x70, 0x79, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x6f, 0x70, 0x79, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73,
+	0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x70, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x6f, 0x70, 0x79, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x4a, 0x0a, 0x16, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x30, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x22, 0x98, 0x01, 0x0a, 0x1c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x5f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61,
+	0x73, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x37, 0x0a, 0x1d,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x42,
+	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x62,
+	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x22, 0x59, 0x0a, 0x1c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64,
+	0x22, 0xeb, 0x02, 0x0a, 0x1d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x10, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x73, 0x5f, 0x61,
+	0x64, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6e, 0x50, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x73, 0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x16, 0x6e, 0x5f, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x73, 0x5f, 0x61, 0x64,
+	0x64, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x6e, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x73, 0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x39,
+	0x0a, 0x19, 0x6e, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x16, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x73, 0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x12, 0x6e, 0x5f, 0x74,
+	0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65,
+	0x73, 0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0d, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x41, 0x64, 0x64, 0x65, 0x64, 0x22, 0x25,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x73, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x22, 0x3b, 0x0a, 0x16, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x22, 0x58, 0x0a, 0x17, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x22, 0x6d, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x77,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x22, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x92, 0x04, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x73,
+	0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x2f, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74,
+	0x43, 0x61, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x46, 0x0a, 0x11, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x65,
+	0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x10, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x73, 0x12, 0x37, 0x0a, 0x15, 0x70,
+	0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x13, 0x70, 0x72,
+	0x6f, 0x6d, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x88, 0x01,
+	0x01, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x29, 0x0a, 0x04, 0x73, 0x6f, 0x72,
+	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x04,
+	0x73, 0x6f, 0x72, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0xca, 0x01, 0x0a, 0x15, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x09, 0x74, 0x65, 0x73, 0x74,
+	0x43, 0x61, 0x73, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65,
+	0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x0b, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x7a, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x22, 0x86, 0x01, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30,
+	0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x39, 0x0a, 0x0d, 0x6c, 0x69, 0x6e, 0x74, 0x5f, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x74, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x6c,
+	0x69, 0x6e, 0x74, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x48, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0xda, 0x02, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x5b, 0x0a, 0x0f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x65, 0x76,
+	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74,
+	0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12,
+	0x1f, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70,
+	0x6c, 0x69, 0x74, 0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x1a, 0x59, 0x0a, 0x13, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x48, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74,
+	0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x09,
+	0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61,
+	0x73, 0x65, 0x52, 0x08, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x22, 0x27, 0x0a, 0x15,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5e, 0x0a, 0x0c, 0x44, 0x65, 0x64, 0x75, 0x70, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa0, 0x01, 0x0a, 0x0d, 0x4e, 0x65, 0x61, 0x72, 0x44, 0x75,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x5f,
+	0x63, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74,
+	0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x75, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x12, 0x1e, 0x0a, 0x0a,
+	0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0a, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73,
+	0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x22, 0xa9, 0x01, 0x0a, 0x1d, 0x46, 0x69, 0x6e,
+	0x64, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61,
+	0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x76,
+	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x22, 0x5b, 0x0a, 0x0e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63,
+	0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x74,
+	0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x49, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x69,
+	0x6e, 0x5f, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74,
+	0x79, 0x22, 0x6e, 0x0a, 0x1e, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x22, 0x71, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x71, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x09, 0x74, 0x65, 0x73,
+	0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x28, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x6f, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x65, 0x73, 0x74,
+	0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x09,
+	0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61,
+	0x73, 0x65, 0x52, 0x08, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0e,
+	0x6e, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x15, 0x50, 0x75, 0x72, 0x67, 0x65, 0x54, 0x65, 0x73, 0x74,
+	0x43, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64,
+	0x73, 0x12, 0x41, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x22, 0x7d, 0x0a, 0x16, 0x50, 0x75, 0x72, 0x67, 0x65, 0x54, 0x65, 0x73,
+	0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20,
+	0x0a, 0x0c, 0x6e, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73,
+	0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6e, 0x54, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6e, 0x52, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65,
+	0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73,
+	0x12, 0x29, 0x0a, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0e, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x48,
+	0x00, 0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x08, 0x61,
+	0x64, 0x64, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x54, 0x61, 0x67, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x54, 0x61, 0x67, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x70, 0x6c, 0x69,
+	0x74, 0x22, 0x4b, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43,
+	0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x0a,
+	0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43,
+	0x61, 0x73, 0x65, 0x52, 0x09, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x22, 0xb4,
+	0x04, 0x0a, 0x16, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x65,
+	0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x59, 0x0a,
+	0x0e, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x10, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x0f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x43,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6b, 0x69, 0x70,
+	0x5f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x73, 0x6b, 0x69, 0x70, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64,
+	0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72,
+	0x79, 0x52, 0x75, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x73, 0x70, 0x6c, 0x69,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x2b,
+	0x0a, 0x05, 0x64, 0x65, 0x64, 0x75, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x64, 0x75, 0x70, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x64, 0x65, 0x64, 0x75, 0x70, 0x12, 0x22, 0x0a, 0x0a, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x1a,
+	0x40, 0x0a, 0x12, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f,
+	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x38, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x6f, 0x77, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0xe0, 0x01, 0x0a, 0x17, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61,
+	0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x74,
+	0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61,
+	0x73, 0x65, 0x52, 0x09, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x6e, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73,
+	0x12, 0x2f, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x6f, 0x77, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x12, 0x3f, 0x0a, 0x0f, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x76, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x61, 0x72, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x52, 0x0e, 0x6e, 0x65, 0x61, 0x72, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x73, 0x22, 0x5b, 0x0a, 0x15, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x43, 0x0a, 0x16, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x70,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x50, 0x72,
+	0x6f, 0x6d, 0x70, 0x74, 0x22, 0x19, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0xcb, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0d,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x1a, 0x55, 0x0a, 0x11, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65,
+	0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4f, 0x0a,
+	0x21, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x6d, 0x61, 0x6c, 0x6c,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x4f,
+	0x0a, 0x21, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4c, 0x61, 0x72, 0x67,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x7d, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xb2,
+	0x02, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6e, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a,
+	0x11, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6d,
+	0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6e, 0x65, 0x77, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x20, 0x0a, 0x09, 0x6e, 0x65, 0x77,
+	0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08,
+	0x6e, 0x65, 0x77, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x37, 0x0a, 0x15, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x48, 0x01, 0x52, 0x13, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x6e, 0x65, 0x77, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x22, 0x98, 0x03, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2c, 0x0a, 0x12, 0x6e, 0x65, 0x77, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6e, 0x65, 0x77,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x6d, 0x70,
+	0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0d, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x32,
+	0x0a, 0x15, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x12, 0x3f, 0x0a, 0x1c, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x70, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0d, 0x6c, 0x69, 0x6e, 0x74, 0x5f, 0x66, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x74, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x0c, 0x6c, 0x69, 0x6e, 0x74, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x3f,
+	0x0a, 0x08, 0x57, 0x6f, 0x72, 0x64, 0x44, 0x69, 0x66, 0x66, 0x12, 0x1f, 0x0a, 0x02, 0x6f, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x69, 0x66, 0x66, 0x4f, 0x70, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22,
+	0xc0, 0x01, 0x0a, 0x08, 0x4c, 0x69, 0x6e, 0x65, 0x44, 0x69, 0x66, 0x66, 0x12, 0x1f, 0x0a, 0x02,
+	0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x4f, 0x70, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x19, 0x0a,
+	0x08, 0x6f, 0x6c, 0x64, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6f, 0x6c, 0x64, 0x54, 0x65, 0x78, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x54,
+	0x65, 0x78, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6f, 0x6c, 0x64, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x6e, 0x65, 0x77, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x77, 0x6f, 0x72,
+	0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x44, 0x69, 0x66, 0x66, 0x52, 0x05, 0x77, 0x6f, 0x72,
+	0x64, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x08, 0x54, 0x65, 0x78, 0x74, 0x44, 0x69, 0x66, 0x66, 0x12,
+	0x27, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x65, 0x44, 0x69, 0x66,
+	0x66, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x5f, 0x69, 0x6e,
+	0x73, 0x65, 0x72, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x49,
+	0x6e, 0x73, 0x65, 0x72, 0x74, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x5f, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x64, 0x22, 0xfe, 0x02, 0x0a, 0x19, 0x44, 0x69, 0x66, 0x66, 0x50, 0x72, 0x6f, 0x6d,
+	0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x11, 0x66, 0x72, 0x6f, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x6f, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0f, 0x74, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x4d, 0x0a, 0x21, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f,
+	0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x1d, 0x66,
+	0x72, 0x6f, 0x6d, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12,
+	0x49, 0x0a, 0x1f, 0x74, 0x6f, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x70, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x1b, 0x74, 0x6f, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x24, 0x0a, 0x22, 0x5f, 0x66,
+	0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70,
+	0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x42, 0x22, 0x0a, 0x20, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x70,
+	0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x22, 0xd5, 0x01, 0x0a, 0x1a, 0x44, 0x69, 0x66, 0x66, 0x50, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65,
+	0x78, 0x74, 0x44, 0x69, 0x66, 0x66, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x36,
+	0x0a, 0x0d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x65, 0x78, 0x74, 0x44, 0x69, 0x66, 0x66, 0x52, 0x0c, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f,
+	0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0e, 0x61, 0x64, 0x64, 0x65, 0x64, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12,
+	0x2b, 0x0a, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61,
+	0x62, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x22, 0xd0, 0x01, 0x0a,
+	0x0b, 0x4c, 0x69, 0x6e, 0x74, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x65, 0x76, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x20, 0x0a,
+	0x0c, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12,
+	0x2e, 0x0a, 0x13, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x77, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x22,
+	0xdc, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x01, 0x52, 0x0d, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x70, 0x72,
+	0x6f, 0x6d, 0x70, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0c, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a,
+	0x08, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x10, 0x0a, 0x0e,
+	0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x22, 0x46,
+	0x0a, 0x12, 0x4c, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x6e, 0x74, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x66, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x8f, 0x02, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x6d, 0x70,
+	0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a,
+	0x15, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x70, 0x72,
+	0x6f, 0x6d, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x3f, 0x0a, 0x1c, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6d,
+	0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50,
+	0x72, 0x6f, 0x6d, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x42, 0x79, 0x12, 0x39, 0x0a,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xb7, 0x04, 0x0a, 0x10, 0x50, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x12, 0x2c, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x13, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x1c, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f,
+	0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x1e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00,
+	0x52, 0x1b, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x88, 0x01, 0x01,
+	0x12, 0x55, 0x0a, 0x25, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x01, 0x52, 0x21, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x5f, 0x62, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x42, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x21, 0x0a, 0x1f, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x28, 0x0a, 0x26, 0x5f, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x70, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x22, 0xe2, 0x02, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12,
+	0x25, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x1c, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61,
+	0x62, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x65, 0x76, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x29,
+	0x0a, 0x10, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x3c, 0x0a, 0x0e, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9a, 0x02, 0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x1c, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x13, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0xc9, 0x04, 0x0a, 0x05, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x72, 0x6f, 0x6d, 0x70,
+	0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x1c, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x19, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x13,
+	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x76,
+	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x2e, 0x56, 0x61, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6c, 0x61,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x1a, 0x3c, 0x0a, 0x0e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x9e, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x4f, 0x6e, 0x6c,
+	0x79, 0x22, 0x5d, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0xbd, 0x02, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x1c, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52,
+	0x19, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a,
+	0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x19, 0x0a, 0x08,
+	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x42, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42,
+	0x1f, 0x0a, 0x1d, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70,
+	0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x22, 0x75, 0x0a, 0x16, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x2f, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xb7, 0x01, 0x0a, 0x14, 0x52, 0x6f, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x23, 0x0a, 0x0b, 0x74, 0x6f, 0x5f,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x09, 0x74, 0x6f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x19,
+	0x0a, 0x08, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x42, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x6f, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x22, 0x74, 0x0a, 0x15, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x2f, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x36, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x22,
+	0x42, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x06, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x6e, 0x0a, 0x18, 0x4c, 0x69, 0x73,
+	0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x94, 0x05, 0x0a, 0x17, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0d, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x30, 0x0a, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65,
+	0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x09, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65,
+	0x73, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x63, 0x6f, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43,
+	0x6f, 0x74, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0c, 0x6e, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x5f,
+	0x63, 0x61, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6e, 0x54, 0x65,
+	0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x65, 0x65, 0x64, 0x5f,
+	0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0a,
+	0x73, 0x65, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a,
+	0x05, 0x64, 0x65, 0x64, 0x75, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65,
+	0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x64, 0x75, 0x70, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x05, 0x64, 0x65, 0x64, 0x75, 0x70, 0x12, 0x2f, 0x0a, 0x11, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x74,
+	0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02,
+	0x48, 0x03, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x48, 0x04, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61,
+	0x78, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65,
+	0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x44, 0x0a, 0x11, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b,
+	0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x17, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x6b, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x10, 0x61, 0x74, 0x74, 0x61,
+	0x63, 0x6b, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x63, 0x6f, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x73, 0x65, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x42, 0x14, 0x0a, 0x12, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x22, 0xdf, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x65, 0x73,
+	0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d,
+	0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x1a,
+	0x41, 0x0a, 0x13, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xe3, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54,
+	0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x30, 0x0a, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65,
+	0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x09, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65,
+	0x73, 0x12, 0x3f, 0x0a, 0x0f, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x76, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x61, 0x72, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x52, 0x0e, 0x6e, 0x65, 0x61, 0x72, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52,
+	0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x5f, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e,
+	0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x22, 0x71, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x77,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x22, 0x8c, 0x01, 0x0a, 0x1f,
+	0x53, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x7b, 0x0a, 0x17, 0x53, 0x65,
+	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0d, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x71, 0x0a, 0x0f, 0x45, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x34, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x08, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x22, 0xf7, 0x02, 0x0a, 0x07, 0x45,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x4d, 0x0a, 0x0f, 0x76, 0x61, 0x72,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x12, 0x29, 0x0a, 0x0e, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x1a, 0x59, 0x0a, 0x13, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x5f, 0x69, 0x64, 0x22, 0x88, 0x02, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x5a, 0x0a, 0x0f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x65, 0x76, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x76, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x1a, 0x59, 0x0a, 0x13, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65,
+	0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x43, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x65, 0x76, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x07, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x22, 0x38, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x22, 0x44,
+	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x08, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x73, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xb0, 0x01, 0x0a,
+	0x1d, 0x41, 0x64, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x46, 0x72, 0x6f, 0x6d,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49,
+	0x64, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x49, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x74,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x74, 0x65, 0x72, 0x12,
+	0x20, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x88, 0x01,
+	0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22,
+	0x6b, 0x0a, 0x1e, 0x41, 0x64, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x46, 0x72,
+	0x6f, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2c, 0x0a, 0x08, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x08, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x6e, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x6e, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x22, 0x74, 0x0a, 0x19,
+	0x53, 0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x08,
+	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x22, 0x50, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x58, 0x4d, 0x4c, 0x4d, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x58, 0x4d,
+	0x4c, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x58, 0x4d, 0x4c,
+	0x4d, 0x6f, 0x64, 0x65, 0x22, 0xae, 0x01, 0x0a, 0x15, 0x52, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24,
+	0x0a, 0x0e, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05,
+	0x72, 0x61, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x74,
+	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x19, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48,
+	0x00, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0xde, 0x01, 0x0a, 0x17, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74,
+	0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x61, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f,
+	0x64, 0x69, 0x73, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x44, 0x69, 0x73, 0x61, 0x67, 0x72, 0x65, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xab, 0x02, 0x0a, 0x12, 0x52, 0x61, 0x74, 0x65, 0x72,
+	0x50, 0x61, 0x69, 0x72, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x72, 0x61, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x61, 0x74, 0x65, 0x72, 0x41, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x61, 0x74, 0x65, 0x72, 0x5f,
+	0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x74, 0x65, 0x72, 0x42, 0x12,
+	0x17, 0x0a, 0x07, 0x6e, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x30, 0x0a, 0x11, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x5f, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x10, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x41, 0x67,
+	0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6f,
+	0x68, 0x65, 0x6e, 0x73, 0x5f, 0x6b, 0x61, 0x70, 0x70, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x48, 0x01, 0x52, 0x0b, 0x63, 0x6f, 0x68, 0x65, 0x6e, 0x73, 0x4b, 0x61, 0x70, 0x70, 0x61, 0x88,
+	0x01, 0x01, 0x12, 0x32, 0x0a, 0x12, 0x6b, 0x72, 0x69, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x72,
+	0x66, 0x66, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x02,
+	0x52, 0x11, 0x6b, 0x72, 0x69, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x66, 0x66, 0x41, 0x6c,
+	0x70, 0x68, 0x61, 0x88, 0x01, 0x01, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x5f, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x0f, 0x0a, 0x0d,
+	0x5f, 0x63, 0x6f, 0x68, 0x65, 0x6e, 0x73, 0x5f, 0x6b, 0x61, 0x70, 0x70, 0x61, 0x42, 0x15, 0x0a,
+	0x13, 0x5f, 0x6b, 0x72, 0x69, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x66, 0x66, 0x5f, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x22, 0xec, 0x01, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x61, 0x67, 0x72, 0x65,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x65, 0x73,
+	0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x49, 0x64, 0x12,
+	0x20, 0x0a, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x49,
+	0x64, 0x12, 0x3d, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x61,
+	0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x2e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x06, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x1a, 0x39, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xed, 0x02, 0x0a, 0x18, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x41,
+	0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x61, 0x74, 0x65, 0x72, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x5f, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x49, 0x74, 0x65, 0x6d,
+	0x73, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x5f,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6e, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x26, 0x0a, 0x0c, 0x66,
+	0x6c, 0x65, 0x69, 0x73, 0x73, 0x5f, 0x6b, 0x61, 0x70, 0x70, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x48, 0x00, 0x52, 0x0b, 0x66, 0x6c, 0x65, 0x69, 0x73, 0x73, 0x4b, 0x61, 0x70, 0x70, 0x61,
+	0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x12, 0x6b, 0x72, 0x69, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x6f,
+	0x72, 0x66, 0x66, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48,
+	0x01, 0x52, 0x11, 0x6b, 0x72, 0x69, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x66, 0x66, 0x41,
+	0x6c, 0x70, 0x68, 0x61, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x61, 0x74, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x12, 0x3f, 0x0a, 0x0d, 0x64, 0x69,
+	0x73, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x61,
+	0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0d, 0x64, 0x69,
+	0x73, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x66, 0x6c, 0x65, 0x69, 0x73, 0x73, 0x5f, 0x6b, 0x61, 0x70, 0x70, 0x61, 0x42, 0x15, 0x0a, 0x13,
+	0x5f, 0x6b, 0x72, 0x69, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x66, 0x66, 0x5f, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x22, 0xfa, 0x02, 0x0a, 0x16, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49,
+	0x64, 0x12, 0x28, 0x0a, 0x10, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x5f, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x41, 0x12, 0x28, 0x0a, 0x10, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x62, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x42, 0x12, 0x36, 0x0a, 0x15, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x69, 0x64, 0x5f, 0x61, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x12, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x41, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a,
+	0x15, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x5f, 0x69, 0x64, 0x5f, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x12,
+	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49,
+	0x64, 0x42, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x74, 0x65, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x74, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x76,
+	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x18, 0x0a, 0x16,
+	0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x5f, 0x69, 0x64, 0x5f, 0x61, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x69, 0x64, 0x5f, 0x62,
+	0x22, 0xb1, 0x01, 0x0a, 0x0b, 0x46, 0x6c, 0x69, 0x70, 0x70, 0x65, 0x64, 0x43, 0x61, 0x73, 0x65,
+	0x12, 0x20, 0x0a, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65,
+	0x49, 0x64, 0x12, 0x27, 0x0a, 0x10, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x5f, 0x69, 0x64, 0x5f, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x49, 0x64, 0x41, 0x12, 0x27, 0x0a, 0x10, 0x74,
+	0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x69, 0x64, 0x5f, 0x62, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x49, 0x64, 0x42, 0x12, 0x2e, 0x0a, 0x13, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x49, 0x64, 0x22, 0xdf, 0x02, 0x0a, 0x12, 0x50, 0x61, 0x73, 0x73, 0x46, 0x61, 0x69,
+	0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6e,
+	0x5f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x50,
+	0x61, 0x69, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x6f, 0x74, 0x68, 0x5f, 0x70, 0x61, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x62, 0x6f, 0x74, 0x68, 0x50, 0x61, 0x73,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x6f, 0x74, 0x68, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x62, 0x6f, 0x74, 0x68, 0x46, 0x61, 0x69, 0x6c, 0x12, 0x1a,
+	0x0a, 0x08, 0x69, 0x6d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x69, 0x6d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72,
+	0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x70, 0x61, 0x73, 0x73,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x70,
+	0x61, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65, 0x41, 0x12, 0x1e, 0x0a, 0x0b, 0x70, 0x61, 0x73, 0x73,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x70,
+	0x61, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65, 0x42, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x61, 0x73, 0x73,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x70, 0x61, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65,
+	0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0a, 0x6f, 0x64,
+	0x64, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00,
+	0x52, 0x09, 0x6f, 0x64, 0x64, 0x73, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x17,
+	0x0a, 0x07, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6f, 0x64, 0x64, 0x73,
+	0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x22, 0x86, 0x02, 0x0a, 0x0f, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x5f,
+	0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x50, 0x61,
+	0x69, 0x72, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x6d, 0x65, 0x61, 0x6e, 0x41, 0x12, 0x15, 0x0a, 0x06, 0x6d, 0x65,
+	0x61, 0x6e, 0x5f, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6d, 0x65, 0x61, 0x6e,
+	0x42, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x6d, 0x65, 0x61, 0x6e,
+	0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0d, 0x72, 0x61,
+	0x6e, 0x6b, 0x5f, 0x62, 0x69, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x61, 0x6e, 0x6b, 0x42, 0x69, 0x73, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x06, 0x77, 0x5f, 0x70, 0x6c, 0x75, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x77, 0x50, 0x6c, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x77,
+	0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x4d,
+	0x69, 0x6e, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x10, 0x0a,
+	0x0e, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x5f, 0x62, 0x69, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x22,
+	0xf7, 0x01, 0x0a, 0x17, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x70,
+	0x61, 0x73, 0x73, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x46, 0x61, 0x69,
+	0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x46, 0x61, 0x69, 0x6c, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x52,
+	0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x0b, 0x72, 0x65, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65,
+	0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x69, 0x70, 0x70, 0x65, 0x64, 0x43, 0x61,
+	0x73, 0x65, 0x52, 0x0b, 0x72, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x38, 0x0a, 0x0c, 0x69, 0x6d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x6c, 0x69, 0x70, 0x70, 0x65, 0x64, 0x43, 0x61, 0x73, 0x65, 0x52, 0x0c, 0x69, 0x6d, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xe0, 0x03, 0x0a, 0x06, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x65, 0x76, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72,
+	0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x22, 0xe7, 0x02, 0x0a,
+	0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x65, 0x76, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72,
+	0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x75, 0x62, 0x72, 0x69, 0x63, 0x22, 0x3f, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27,
+	0x0a, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x52,
+	0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x22, 0x37, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x47,
+	0x72, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64,
+	0x22, 0x40, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x52, 0x07, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x22, 0x25, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xe1, 0x01, 0x0a, 0x10, 0x52, 0x75,
+	0x6e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x0e, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0d, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x65, 0x73, 0x74, 0x5f,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0d, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x49, 0x64, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x72, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x76, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x48, 0x0a,
+	0x0a, 0x47, 0x72, 0x61, 0x64, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x0e, 0x74,
+	0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x88, 0x01, 0x0a, 0x11, 0x52, 0x75, 0x6e, 0x47,
+	0x72, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a,
+	0x07, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x07, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x70,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6e, 0x53, 0x6b, 0x69, 0x70, 0x70,
+	0x65, 0x64, 0x22, 0xd8, 0x01, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x2b, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x13, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x2a, 0x0a,
+	0x0e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0d, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x76, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x2f, 0x0a,
+	0x17, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x9a,
+	0x02, 0x0a, 0x1a, 0x53, 0x79, 0x6e, 0x74, 0x68, 0x65, 0x74, 0x69, 0x63, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x1c, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x70, 0x72,
+	0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x93, 0x04, 0x0a, 0x17,
+	0x52, 0x75, 0x6e, 0x50, 0x65, 0x72, 0x74, 0x75, 0x72, 0x62, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x65,
+	0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0b, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x49, 0x64, 0x73, 0x12, 0x2f,
+	0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73,
+	0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x2f, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x19,
+	0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x74, 0x75, 0x72, 0x62,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x12,
+	0x44, 0x0a, 0x1c, 0x70, 0x61, 0x72, 0x61, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x5f, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x19, 0x70, 0x61, 0x72, 0x61, 0x70, 0x68, 0x72,
+	0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4e, 0x61,
+	0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x0e, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0d, 0x48, 0x01, 0x52, 0x0d, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x14, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x12, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x08, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x42,
+	0x1f, 0x0a, 0x1d, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x22, 0xd1, 0x02, 0x0a, 0x15, 0x50, 0x65, 0x72, 0x74, 0x75, 0x72, 0x62, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x77,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x65, 0x72, 0x74, 0x75, 0x72, 0x62, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64,
+	0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x5f, 0x70, 0x61, 0x69, 0x72,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12,
+	0x28, 0x0a, 0x10, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x5f, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x6e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x64, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x6e, 0x5f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6e, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x73, 0x74, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0xce, 0x01, 0x0a, 0x18, 0x52, 0x75, 0x6e, 0x50, 0x65, 0x72,
+	0x74, 0x75, 0x72, 0x62, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74,
+	0x73, 0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x12, 0x3c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65,
+	0x72, 0x74, 0x75, 0x72, 0x62, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x52, 0x09, 0x73, 0x74, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x8a, 0x04, 0x0a, 0x15, 0x4f, 0x70, 0x74, 0x69, 0x6d,
+	0x69, 0x7a, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0d, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12,
+	0x1b, 0x0a, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x07,
+	0x73, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e,
+	0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x63, 0x6f,
+	0x72, 0x69, 0x6e, 0x67, 0x12, 0x2f, 0x0a, 0x11, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x0f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4e, 0x61,
+	0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x5f, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6e, 0x43, 0x61, 0x6e,
+	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x69,
+	0x6d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01,
+	0x48, 0x02, 0x52, 0x0e, 0x6d, 0x69, 0x6e, 0x49, 0x6d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x70, 0x61, 0x73, 0x73, 0x5f, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52,
+	0x0d, 0x70, 0x61, 0x73, 0x73, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x30, 0x0a, 0x14, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x12, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x49, 0x64, 0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x12, 0x0a, 0x10,
+	0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x6d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x22, 0xe1, 0x01, 0x0a, 0x15, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x6e, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x6e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22, 0xd7, 0x04, 0x0a, 0x0f, 0x4f, 0x70, 0x74, 0x69,
+	0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x77,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x07, 0x73,
+	0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x65,
+	0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x63, 0x6f, 0x72,
+	0x69, 0x6e, 0x67, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x30, 0x0a, 0x14, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x11, 0x62, 0x65, 0x73, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x62, 0x65, 0x73, 0x74, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x40, 0x0a, 0x0b,
+	0x73, 0x74, 0x6f, 0x70, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1f, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x69,
+	0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x3e,
+	0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x74,
+	0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x44, 0x0a, 0x16, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x50, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x03, 0x72,
+	0x75, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x75, 0x6e, 0x52, 0x03, 0x72, 0x75, 0x6e, 0x22, 0x40, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x1c, 0x4c, 0x69, 0x73,
+	0x74, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x72, 0x75, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75,
+	0x6e, 0x52, 0x04, 0x72, 0x75, 0x6e, 0x73, 0x22, 0xa5, 0x02, 0x0a, 0x07, 0x44, 0x61, 0x74, 0x61,
+	0x73, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0c, 0x6e, 0x5f, 0x74,
+	0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x6e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x0b, 0x61,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73,
+	0x65, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0xb0, 0x02, 0x0a, 0x11, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x5a, 0x0a, 0x10, 0x76, 0x61, 0x72, 0x69, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2f, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x73, 0x65, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x1a, 0x42,
+	0x0a, 0x14, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xca, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x33, 0x0a, 0x13, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x22,
+	0x5c, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x65, 0x76, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x07, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x65, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x4d, 0x6f, 0x76, 0x65, 0x64, 0x22, 0x4e, 0x0a,
+	0x13, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x77, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d,
+	0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x44, 0x0a,
+	0x14, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x65, 0x74, 0x73, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xfb, 0x01, 0x0a, 0x14,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x5d, 0x0a, 0x10, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x32, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x1a, 0x42, 0x0a, 0x14, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x53, 0x0a, 0x15, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x58,
+	0x0a, 0x14, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x49, 0x64, 0x2a, 0x23, 0x0a, 0x0c, 0x56, 0x61, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x45, 0x58, 0x54,
+	0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x10, 0x01, 0x2a, 0x50, 0x0a,
+	0x05, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a,
+	0x09, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x5f, 0x44, 0x45, 0x56, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a,
+	0x53, 0x50, 0x4c, 0x49, 0x54, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d,
+	0x53, 0x50, 0x4c, 0x49, 0x54, 0x5f, 0x48, 0x4f, 0x4c, 0x44, 0x4f, 0x55, 0x54, 0x10, 0x03, 0x2a,
+	0xd8, 0x01, 0x0a, 0x10, 0x50, 0x65, 0x72, 0x74, 0x75, 0x72, 0x62, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4b, 0x69, 0x6e, 0x64, 0x12, 0x21, 0x0a, 0x1d, 0x50, 0x45, 0x52, 0x54, 0x55, 0x52, 0x42, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x45, 0x52, 0x54, 0x55,
+	0x52, 0x42, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x54, 0x59, 0x50,
+	0x4f, 0x53, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x45, 0x52, 0x54, 0x55, 0x52, 0x42, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x43, 0x41, 0x53, 0x49, 0x4e, 0x47,
+	0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x45, 0x52, 0x54, 0x55, 0x52, 0x42, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x57, 0x48, 0x49, 0x54, 0x45, 0x53, 0x50, 0x41,
+	0x43, 0x45, 0x10, 0x03, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x45, 0x52, 0x54, 0x55, 0x52, 0x42, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x50, 0x48,
+	0x52, 0x41, 0x53, 0x45, 0x10, 0x04, 0x12, 0x22, 0x0a, 0x1e, 0x50, 0x45, 0x52, 0x54, 0x55, 0x52,
+	0x42, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x4f, 0x52,
+	0x44, 0x45, 0x52, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x10, 0x05, 0x2a, 0x7b, 0x0a, 0x10, 0x45, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21,
+	0x0a, 0x1d, 0x45, 0x56, 0x41, 0x4c, 0x55, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x56, 0x41, 0x4c, 0x55, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x56, 0x41, 0x4c, 0x55, 0x41, 0x54, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x45, 0x56, 0x41, 0x4c, 0x55, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x56, 0x41, 0x4c,
+	0x55, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x6c, 0x0a, 0x0c, 0x54, 0x65, 0x73, 0x74, 0x43,
+	0x61, 0x73, 0x65, 0x53, 0x6f, 0x72, 0x74, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x45, 0x53, 0x54, 0x5f,
+	0x43, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x45, 0x53, 0x54, 0x5f,
+	0x43, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45,
+	0x44, 0x5f, 0x41, 0x54, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x43,
+	0x41, 0x53, 0x45, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44,
+	0x5f, 0x41, 0x54, 0x10, 0x02, 0x2a, 0x63, 0x0a, 0x0f, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x55, 0x50, 0x4c,
+	0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4b, 0x49,
+	0x50, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x4c, 0x41, 0x47, 0x10, 0x01, 0x12, 0x1a,
+	0x0a, 0x16, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x10, 0x02, 0x2a, 0x4e, 0x0a, 0x0a, 0x46, 0x69,
+	0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x49, 0x4c, 0x45,
+	0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43, 0x53, 0x56, 0x10, 0x00, 0x12, 0x15, 0x0a,
+	0x11, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4a, 0x53, 0x4f,
+	0x4e, 0x4c, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x46, 0x4f, 0x52,
+	0x4d, 0x41, 0x54, 0x5f, 0x59, 0x41, 0x4d, 0x4c, 0x10, 0x02, 0x2a, 0x71, 0x0a, 0x06, 0x44, 0x69,
+	0x66, 0x66, 0x4f, 0x70, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x49, 0x46, 0x46, 0x5f, 0x4f, 0x50, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a,
+	0x0d, 0x44, 0x49, 0x46, 0x46, 0x5f, 0x4f, 0x50, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x10, 0x01,
+	0x12, 0x12, 0x0a, 0x0e, 0x44, 0x49, 0x46, 0x46, 0x5f, 0x4f, 0x50, 0x5f, 0x49, 0x4e, 0x53, 0x45,
+	0x52, 0x54, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x49, 0x46, 0x46, 0x5f, 0x4f, 0x50, 0x5f,
+	0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x49, 0x46, 0x46,
+	0x5f, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x10, 0x04, 0x2a, 0x90, 0x02,
+	0x0a, 0x08, 0x4c, 0x69, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x4c, 0x49,
+	0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x4c, 0x49, 0x4e, 0x54, 0x5f, 0x43, 0x4f,
+	0x44, 0x45, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x4c, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x4c, 0x49, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x44, 0x45,
+	0x5f, 0x55, 0x4e, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x4c, 0x49, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f,
+	0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x56, 0x41, 0x52, 0x49, 0x41, 0x42, 0x4c, 0x45,
+	0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19, 0x4c, 0x49, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f,
+	0x55, 0x4e, 0x55, 0x53, 0x45, 0x44, 0x5f, 0x56, 0x41, 0x52, 0x49, 0x41, 0x42, 0x4c, 0x45, 0x10,
+	0x04, 0x12, 0x20, 0x0a, 0x1c, 0x4c, 0x49, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4c,
+	0x4f, 0x57, 0x45, 0x52, 0x43, 0x41, 0x53, 0x45, 0x5f, 0x56, 0x41, 0x52, 0x49, 0x41, 0x42, 0x4c,
+	0x45, 0x10, 0x05, 0x12, 0x24, 0x0a, 0x20, 0x4c, 0x49, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x44, 0x45,
+	0x5f, 0x58, 0x4d, 0x4c, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x4f, 0x55,
+	0x54, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x59, 0x10, 0x06, 0x12, 0x25, 0x0a, 0x21, 0x4c, 0x49, 0x4e,
+	0x54, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x58, 0x54, 0x5f, 0x57,
+	0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x07,
+	0x2a, 0x60, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1c, 0x0a, 0x18, 0x4c, 0x41, 0x42, 0x45, 0x4c, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a,
+	0x14, 0x4c, 0x41, 0x42, 0x45, 0x4c, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52,
+	0x4f, 0x4d, 0x4f, 0x54, 0x45, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x4c, 0x41, 0x42, 0x45, 0x4c,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b,
+	0x10, 0x02, 0x2a, 0x4e, 0x0a, 0x0e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x49, 0x43, 0x41, 0x4c, 0x10,
+	0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x44, 0x56, 0x45, 0x52, 0x53, 0x41, 0x52, 0x49, 0x41, 0x4c,
+	0x10, 0x01, 0x2a, 0xe9, 0x01, 0x0a, 0x0e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x54, 0x54, 0x41, 0x43, 0x4b, 0x5f,
+	0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x41, 0x54, 0x54, 0x41, 0x43, 0x4b,
+	0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x50, 0x52, 0x4f, 0x4d, 0x50, 0x54,
+	0x5f, 0x49, 0x4e, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19,
+	0x41, 0x54, 0x54, 0x41, 0x43, 0x4b, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f,
+	0x4a, 0x41, 0x49, 0x4c, 0x42, 0x52, 0x45, 0x41, 0x4b, 0x10, 0x02, 0x12, 0x24, 0x0a, 0x20, 0x41,
+	0x54, 0x54, 0x41, 0x43, 0x4b, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x45,
+	0x44, 0x47, 0x45, 0x5f, 0x43, 0x41, 0x53, 0x45, 0x5f, 0x4c, 0x45, 0x4e, 0x47, 0x54, 0x48, 0x10,
+	0x03, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x54, 0x54, 0x41, 0x43, 0x4b, 0x5f, 0x43, 0x41, 0x54, 0x45,
+	0x47, 0x4f, 0x52, 0x59, 0x5f, 0x55, 0x4e, 0x49, 0x43, 0x4f, 0x44, 0x45, 0x10, 0x04, 0x12, 0x2e,
+	0x0a, 0x2a, 0x41, 0x54, 0x54, 0x41, 0x43, 0x4b, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52,
+	0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x41, 0x44, 0x49, 0x43, 0x54, 0x4f, 0x52, 0x59, 0x5f,
+	0x49, 0x4e, 0x53, 0x54, 0x52, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x05, 0x2a, 0x68,
+	0x0a, 0x0f, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x58, 0x41, 0x4d, 0x50, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x52,
+	0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x46, 0x49, 0x58, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a,
+	0x17, 0x45, 0x58, 0x41, 0x4d, 0x50, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47,
+	0x59, 0x5f, 0x52, 0x41, 0x4e, 0x44, 0x4f, 0x4d, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x58,
+	0x41, 0x4d, 0x50, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x53,
+	0x49, 0x4d, 0x49, 0x4c, 0x41, 0x52, 0x10, 0x02, 0x2a, 0x3e, 0x0a, 0x0b, 0x52, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x41, 0x54, 0x49, 0x4e,
+	0x47, 0x5f, 0x53, 0x43, 0x41, 0x4c, 0x45, 0x5f, 0x54, 0x48, 0x55, 0x4d, 0x42, 0x53, 0x10, 0x00,
+	0x12, 0x16, 0x0a, 0x12, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x43, 0x41, 0x4c, 0x45,
+	0x5f, 0x53, 0x43, 0x4f, 0x52, 0x45, 0x10, 0x01, 0x2a, 0x5a, 0x0a, 0x0a, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x52, 0x41, 0x44, 0x45, 0x52,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x52, 0x41, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x45, 0x58, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x15, 0x0a,
+	0x11, 0x47, 0x52, 0x41, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4a, 0x55, 0x44,
+	0x47, 0x45, 0x10, 0x02, 0x2a, 0x58, 0x0a, 0x13, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x1b, 0x4f,
+	0x50, 0x54, 0x49, 0x4d, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x43, 0x4f, 0x52,
+	0x49, 0x4e, 0x47, 0x5f, 0x47, 0x52, 0x41, 0x44, 0x45, 0x52, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c,
+	0x4f, 0x50, 0x54, 0x49, 0x4d, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x43, 0x4f,
+	0x52, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x53, 0x10, 0x01, 0x2a, 0xe7,
+	0x01, 0x0a, 0x16, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x6f, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x24, 0x4f, 0x50, 0x54,
+	0x49, 0x4d, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x27, 0x0a, 0x23, 0x4f, 0x50, 0x54, 0x49, 0x4d, 0x49, 0x5a, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x4d, 0x41, 0x58, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x53, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20,
+	0x4f, 0x50, 0x54, 0x49, 0x4d, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x4f,
+	0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x50, 0x4c, 0x41, 0x54, 0x45, 0x41, 0x55,
+	0x10, 0x02, 0x12, 0x28, 0x0a, 0x24, 0x4f, 0x50, 0x54, 0x49, 0x4d, 0x49, 0x5a, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4e,
+	0x4f, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x53, 0x10, 0x03, 0x12, 0x2a, 0x0a, 0x26,
+	0x4f, 0x50, 0x54, 0x49, 0x4d, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x4f,
+	0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x5f, 0x43, 0x41, 0x4e, 0x44,
+	0x49, 0x44, 0x41, 0x54, 0x45, 0x53, 0x10, 0x04, 0x32, 0xf4, 0x28, 0x0a, 0x11, 0x45, 0x76, 0x61,
+	0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x45,
+	0x0a, 0x08, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x2e, 0x65, 0x76, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x13, 0x53, 0x79, 0x6e, 0x74, 0x68, 0x65, 0x74,
+	0x69, 0x63, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x65,
+	0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x74, 0x68, 0x65, 0x74, 0x69, 0x63,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c,
+	0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x56, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x12, 0x1f, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x57,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1c, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x57,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x65, 0x76, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x76, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0f,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x1f, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0f, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1f, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x53,
+	0x0a, 0x0e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x1e, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1f, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x4e, 0x0a, 0x10, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x20, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x68, 0x0a, 0x15, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x25, 0x2e, 0x65, 0x76, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x15, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x42, 0x75,
+	0x6e, 0x64, 0x6c, 0x65, 0x12, 0x25, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x42, 0x75,
+	0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x65, 0x76,
+	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x1e, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x12, 0x44, 0x69,
+	0x66, 0x66, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x22, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x50,
+	0x72, 0x6f, 0x6d, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x69, 0x66, 0x66, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0a, 0x4c,
+	0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x1a, 0x2e, 0x65, 0x76, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65,
+	0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x1e, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x14, 0x4c, 0x69, 0x73,
+	0x74, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6e,
+	0x73, 0x12, 0x24, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x53, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61,
+	0x73, 0x65, 0x12, 0x1e, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x73,
+	0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x10, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x20, 0x2e, 0x65, 0x76,
+	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x65,
+	0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74,
+	0x43, 0x61, 0x73, 0x65, 0x12, 0x1e, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x56,
+	0x0a, 0x0f, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65,
+	0x73, 0x12, 0x1f, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x65, 0x76, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61,
+	0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x65, 0x76, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43,
+	0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b,
+	0x0a, 0x16, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x54,
+	0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x12, 0x26, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x44,
+	0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x14, 0x4c,
+	0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61,
+	0x73, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x65, 0x76, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x54,
+	0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x56, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x65, 0x73,
+	0x74, 0x43, 0x61, 0x73, 0x65, 0x12, 0x1f, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x50, 0x75,
+	0x72, 0x67, 0x65, 0x54, 0x65, 0x73, 0x74, 0x43, 0x61, 0x73, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x65,
+	0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x54, 0x65, 0x73, 0x74,
+	0x43, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65,
+	0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x54, 0x65, 0x73, 0x74,
+	0x43, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4f, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x21, 0x2e, 0x65, 0x76,
+	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x57, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x6d,
+	0x61, 0x6c, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x1a, 0x47, 0x65, 0x74,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4c, 0x61, 0x72, 0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x1f, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x62, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x53, 0x6d, 0x61, 0x6c, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x2a, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x6d, 0x61, 0x6c, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x4c, 0x61, 0x72, 0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x2a, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4c, 0x61, 0x72, 0x67, 0x65, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x15, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x25, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x65, 0x76, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x25, 0x2e,
+	0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5e,
+	0x0a, 0x18, 0x53, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x28, 0x2e, 0x65, 0x76, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4e,
+	0x0a, 0x10, 0x53, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x12, 0x20, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x42,
+	0x0a, 0x0a, 0x53, 0x65, 0x74, 0x58, 0x4d, 0x4c, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x2e, 0x65,
+	0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x58, 0x4d, 0x4c, 0x4d, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0e, 0x52, 0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x1e, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x61, 0x74, 0x65, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x59,
+	0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x20, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x75, 0x74, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0f, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x72, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x65,
+	0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x1c, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4a, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12,
+	0x1b, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x65,
+	0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0c,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x65,
+	0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x52, 0x75, 0x6e, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x19, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x47,
+	0x72, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x65,
+	0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x1d, 0x2e, 0x65, 0x76,
+	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x65, 0x76, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c,
+	0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x65,
+	0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x76, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0d, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x1d, 0x2e, 0x65,
+	0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x44,
+	0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x1d, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0d, 0x44, 0x65, 0x74, 0x61, 0x63,
+	0x68, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x1d, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x53, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1d, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x1a, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x59, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x20, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x08,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x47, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x63, 0x65, 0x73, 0x12, 0x1a, 0x2e,
+	0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x65, 0x76, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x10, 0x52, 0x75, 0x6e, 0x50,
+	0x65, 0x72, 0x74, 0x75, 0x72, 0x62, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x65,
+	0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x50, 0x65, 0x72, 0x74, 0x75, 0x72,
+	0x62, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x50, 0x65, 0x72, 0x74,
+	0x75, 0x72, 0x62, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x12, 0x1d, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x1d, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x6b,
+	0x0a, 0x16, 0x41, 0x64, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x46, 0x72, 0x6f,
+	0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x26, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x46, 0x72,
+	0x6f, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x45, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x12, 0x53,
+	0x65, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x22, 0x2e, 0x65, 0x76, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x45,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x42,
+	0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x69,
+	0x6e, 0x63, 0x61, 0x6e, 0x73, 0x2d, 0x61, 0x69, 0x2f, 0x65, 0x76, 0x61, 0x6c, 0x69, 0x74, 0x65,
+	0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x76, 0x61, 0x6c, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x76, 0x61,
+	0x6c, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_eval_v1_eval_proto_rawDescOnce sync.Once
+	file_eval_v1_eval_proto_rawDescData = file_eval_v1_eval_proto_rawDesc
+)
+
+func file_eval_v1_eval_proto_rawDescGZIP() []byte {
+	file_eval_v1_eval_proto_rawDescOnce.Do(func() {
+		file_eval_v1_eval_proto_rawDescData = protoimpl.X.CompressGZIP(file_eval_v1_eval_proto_rawDescData)
+	})
+	return file_eval_v1_eval_proto_rawDescData
+}
+
+var file_eval_v1_eval_proto_enumTypes = make([]protoimpl.EnumInfo, 17)
+var file_eval_v1_eval_proto_msgTypes = make([]protoimpl.MessageInfo, 157)
+var file_eval_v1_eval_proto_goTypes = []any{
+	(VariableType)(0),                         // 0: eval.v1.VariableType
+	(Split)(0),                                // 1: eval.v1.Split
+	(PerturbationKind)(0),                     // 2: eval.v1.PerturbationKind
+	(EvaluationStatus)(0),                     // 3: eval.v1.EvaluationStatus
+	(TestCaseSort)(0),                         // 4: eval.v1.TestCaseSort
+	(DuplicateAction)(0),                      // 5: eval.v1.DuplicateAction
+	(FileFormat)(0),                           // 6: eval.v1.FileFormat
+	(DiffOp)(0),                               // 7: eval.v1.DiffOp
+	(LintCode)(0),                             // 8: eval.v1.LintCode
+	(LabelAction)(0),                          // 9: eval.v1.LabelAction
+	(GenerationMode)(0),                       // 10: eval.v1.GenerationMode
+	(AttackCategory)(0),                       // 11: eval.v1.AttackCategory
+	(ExampleStrategy)(0),                      // 12: eval.v1.ExampleStrategy
+	(RatingScale)(0),                          // 13: eval.v1.RatingScale
+	(GraderType)(0),                           // 14: eval.v1.GraderType
+	(OptimizationScoring)(0),                  // 15: eval.v1.OptimizationScoring
+	(OptimizationStopReason)(0),               // 16: eval.v1.OptimizationStopReason
+	(*Variable)(nil),                          // 17: eval.v1.Variable
+	(*VariableValue)(nil),                     // 18: eval.v1.VariableValue
+	(*ModelConfig)(nil),                       // 19: eval.v1.ModelConfig
+	(*MessageOptions)(nil),                    // 20: eval.v1.MessageOptions
+	(*InferMessage)(nil),                      // 21: eval.v1.InferMessage
+	(*InferRequest)(nil),                      // 22: eval.v1.InferRequest
+	(*EvaluationRequest)(nil),                 // 23: eval.v1.EvaluationRequest
+	(*EvaluationResponse)(nil),                // 24: eval.v1.EvaluationResponse
+	(*WorkspaceConfig)(nil),                   // 25: eval.v1.WorkspaceConfig
+	(*CreateWorkspaceConfigRequest)(nil),      // 26: eval.v1.CreateWorkspaceConfigRequest
+	(*CreateWorkspaceConfigResponse)(nil),     // 27: eval.v1.CreateWorkspaceConfigResponse
+	(*Workspace)(nil),                         // 28: eval.v1.Workspace
+	(*TestCase)(nil),                          // 29: eval.v1.TestCase
+	(*TestCaseFilter)(nil),                    // 30: eval.v1.TestCaseFilter
+	(*TestResult)(nil),                        // 31: eval.v1.TestResult
+	(*Rating)(nil),                            // 32: eval.v1.Rating
+	(*CreateWorkspaceRequest)(nil),            // 33: eval.v1.CreateWorkspaceRequest
+	(*CloneWorkspaceRequest)(nil),             // 34: eval.v1.CloneWorkspaceRequest
+	(*CloneWorkspaceResponse)(nil),            // 35: eval.v1.CloneWorkspaceResponse
+	(*ExportWorkspaceBundleRequest)(nil),      // 36: eval.v1.ExportWorkspaceBundleRequest
+	(*ExportWorkspaceBundleResponse)(nil),     // 37: eval.v1.ExportWorkspaceBundleResponse
+	(*ImportWorkspaceBundleRequest)(nil),      // 38: eval.v1.ImportWorkspaceBundleRequest
+	(*ImportWorkspaceBundleResponse)(nil),     // 39: eval.v1.ImportWorkspaceBundleResponse
+	(*GetWorkspaceRequest)(nil),               // 40: eval.v1.GetWorkspaceRequest
+	(*ListWorkspacesRequest)(nil),             // 41: eval.v1.ListWorkspacesRequest
+	(*DeleteWorkspaceRequest)(nil),            // 42: eval.v1.DeleteWorkspaceRequest
+	(*ArchiveWorkspaceRequest)(nil),           // 43: eval.v1.ArchiveWorkspaceRequest
+	(*ListWorkspacesResponse)(nil),            // 44: eval.v1.ListWorkspacesResponse
+	(*GetPromptRequest)(nil),                  // 45: eval.v1.GetPromptRequest
+	(*ListTestCasesRequest)(nil),              // 46: eval.v1.ListTestCasesRequest
+	(*ListTestCasesResponse)(nil),             // 47: eval.v1.ListTestCasesResponse
+	(*CreatePromptVersionRequest)(nil),        // 48: eval.v1.CreatePromptVersionRequest
+	(*CreateWorkspaceResponse)(nil),           // 49: eval.v1.CreateWorkspaceResponse
+	(*GetWorkspaceResponse)(nil),              // 50: eval.v1.GetWorkspaceResponse
+	(*CreateTestCaseRequest)(nil),             // 51: eval.v1.CreateTestCaseRequest
+	(*CreateTestCaseResponse)(nil),            // 52: eval.v1.CreateTestCaseResponse
+	(*DeleteTestCaseRequest)(nil),             // 53: eval.v1.DeleteTestCaseRequest
+	(*DedupOptions)(nil),                      // 54: eval.v1.DedupOptions
+	(*NearDuplicate)(nil),                     // 55: eval.v1.NearDuplicate
+	(*FindDuplicateTestCasesRequest)(nil),     // 56: eval.v1.FindDuplicateTestCasesRequest
+	(*DuplicateGroup)(nil),                    // 57: eval.v1.DuplicateGroup
+	(*FindDuplicateTestCasesResponse)(nil),    // 58: eval.v1.FindDuplicateTestCasesResponse
+	(*ListDeletedTestCasesRequest)(nil),       // 59: eval.v1.ListDeletedTestCasesRequest
+	(*ListDeletedTestCasesResponse)(nil),      // 60: eval.v1.ListDeletedTestCasesResponse
+	(*RestoreTestCaseRequest)(nil),            // 61: eval.v1.RestoreTestCaseRequest
+	(*RestoreTestCaseResponse)(nil),           // 62: eval.v1.RestoreTestCaseResponse
+	(*PurgeTestCasesRequest)(nil),             // 63: eval.v1.PurgeTestCasesRequest
+	(*PurgeTestCasesResponse)(nil),            // 64: eval.v1.PurgeTestCasesResponse
+	(*UpdateTestCasesRequest)(nil),            // 65: eval.v1.UpdateTestCasesRequest
+	(*UpdateTestCasesResponse)(nil),           // 66: eval.v1.UpdateTestCasesResponse
+	(*ImportTestCasesRequest)(nil),            // 67: eval.v1.ImportTestCasesRequest
+	(*ImportRowError)(nil),                    // 68: eval.v1.ImportRowError
+	(*ImportTestCasesResponse)(nil),           // 69: eval.v1.ImportTestCasesResponse
+	(*GeneratePromptRequest)(nil),             // 70: eval.v1.GeneratePromptRequest
+	(*GeneratePromptResponse)(nil),            // 71: eval.v1.GeneratePromptResponse
+	(*ListModelConfigsRequest)(nil),           // 72: eval.v1.ListModelConfigsRequest
+	(*ListModelConfigsResponse)(nil),          // 73: eval.v1.ListModelConfigsResponse
+	(*SetDefaultSmallModelConfigRequest)(nil), // 74: eval.v1.SetDefaultSmallModelConfigRequest
+	(*SetDefaultLargeModelConfigRequest)(nil), // 75: eval.v1.SetDefaultLargeModelConfigRequest
+	(*GetModelConfigResponse)(nil),            // 76: eval.v1.GetModelConfigResponse
+	(*UpdateWorkspaceRequest)(nil),            // 77: eval.v1.UpdateWorkspaceRequest
+	(*UpdateWorkspaceResponse)(nil),           // 78: eval.v1.UpdateWorkspaceResponse
+	(*WordDiff)(nil),                          // 79: eval.v1.WordDiff
+	(*LineDiff)(nil),                          // 80: eval.v1.LineDiff
+	(*TextDiff)(nil),                          // 81: eval.v1.TextDiff
+	(*DiffPromptVersionsRequest)(nil),         // 82: eval.v1.DiffPromptVersionsRequest
+	(*DiffPromptVersionsResponse)(nil),        // 83: eval.v1.DiffPromptVersionsResponse
+	(*LintFinding)(nil),                       // 84: eval.v1.LintFinding
+	(*LintPromptRequest)(nil),                 // 85: eval.v1.LintPromptRequest
+	(*LintPromptResponse)(nil),                // 86: eval.v1.LintPromptResponse
+	(*PromptLabel)(nil),                       // 87: eval.v1.PromptLabel
+	(*PromptLabelEvent)(nil),                  // 88: eval.v1.PromptLabelEvent
+	(*CompleteRequest)(nil),                   // 89: eval.v1.CompleteRequest
+	(*CompleteResponse)(nil),                  // 90: eval.v1.CompleteResponse
+	(*Trace)(nil),                             // 91: eval.v1.Trace
+	(*ListTracesRequest)(nil),                 // 92: eval.v1.ListTracesRequest
+	(*ListTracesResponse)(nil),                // 93: eval.v1.ListTracesResponse
+	(*PromoteVersionRequest)(nil),             // 94: eval.v1.PromoteVersionRequest
+	(*PromoteVersionResponse)(nil),            // 95: eval.v1.PromoteVersionResponse
+	(*RollbackLabelRequest)(nil),              // 96: eval.v1.RollbackLabelRequest
+	(*RollbackLabelResponse)(nil),             // 97: eval.v1.RollbackLabelResponse
+	(*ListLabelsRequest)(nil),                 // 98: eval.v1.ListLabelsRequest
+	(*ListLabelsResponse)(nil),                // 99: eval.v1.ListLabelsResponse
+	(*ListLabelHistoryRequest)(nil),           // 100: eval.v1.ListLabelHistoryRequest
+	(*ListLabelHistoryResponse)(nil),          // 101: eval.v1.ListLabelHistoryResponse
+	(*GenerateTestCaseRequest)(nil),           // 102: eval.v1.GenerateTestCaseRequest
+	(*RejectedTestCase)(nil),                  // 103: eval.v1.RejectedTestCase
+	(*GenerateTestCaseResponse)(nil),          // 104: eval.v1.GenerateTestCaseResponse
+	(*DeleteWorkspaceConfigRequest)(nil),      // 105: eval.v1.DeleteWorkspaceConfigRequest
+	(*SetWorkspaceConfigActiveRequest)(nil),   // 106: eval.v1.SetWorkspaceConfigActiveRequest
+	(*SetVersionActiveRequest)(nil),           // 107: eval.v1.SetVersionActiveRequest
+	(*ExampleSettings)(nil),                   // 108: eval.v1.ExampleSettings
+	(*Example)(nil),                           // 109: eval.v1.Example
+	(*CreateExampleRequest)(nil),              // 110: eval.v1.CreateExampleRequest
+	(*CreateExampleResponse)(nil),             // 111: eval.v1.CreateExampleResponse
+	(*ListExamplesRequest)(nil),               // 112: eval.v1.ListExamplesRequest
+	(*ListExamplesResponse)(nil),              // 113: eval.v1.ListExamplesResponse
+	(*DeleteExampleRequest)(nil),              // 114: eval.v1.DeleteExampleRequest
+	(*AddExamplesFromResultsRequest)(nil),     // 115: eval.v1.AddExamplesFromResultsRequest
+	(*AddExamplesFromResultsResponse)(nil),    // 116: eval.v1.AddExamplesFromResultsResponse
+	(*SetExampleSettingsRequest)(nil),         // 117: eval.v1.SetExampleSettingsRequest
+	(*SetXMLModeRequest)(nil),                 // 118: eval.v1.SetXMLModeRequest
+	(*RateTestResultRequest)(nil),             // 119: eval.v1.RateTestResultRequest
+	(*ComputeAgreementRequest)(nil),           // 120: eval.v1.ComputeAgreementRequest
+	(*RaterPairAgreement)(nil),                // 121: eval.v1.RaterPairAgreement
+	(*DisagreementItem)(nil),                  // 122: eval.v1.DisagreementItem
+	(*ComputeAgreementResponse)(nil),          // 123: eval.v1.ComputeAgreementResponse
+	(*CompareVersionsRequest)(nil),            // 124: eval.v1.CompareVersionsRequest
+	(*FlippedCase)(nil),                       // 125: eval.v1.FlippedCase
+	(*PassFailComparison)(nil),                // 126: eval.v1.PassFailComparison
+	(*ScoreComparison)(nil),                   // 127: eval.v1.ScoreComparison
+	(*CompareVersionsResponse)(nil),           // 128: eval.v1.CompareVersionsResponse
+	(*Grader)(nil),                            // 129: eval.v1.Grader
+	(*CreateGraderRequest)(nil),               // 130: eval.v1.CreateGraderRequest
+	(*CreateGraderResponse)(nil),              // 131: eval.v1.CreateGraderResponse
+	(*ListGradersRequest)(nil),                // 132: eval.v1.ListGradersRequest
+	(*ListGradersResponse)(nil),               // 133: eval.v1.ListGradersResponse
+	(*DeleteGraderRequest)(nil),               // 134: eval.v1.DeleteGraderRequest
+	(*RunGraderRequest)(nil),                  // 135: eval.v1.RunGraderRequest
+	(*GradeError)(nil),                        // 136: eval.v1.GradeError
+	(*RunGraderResponse)(nil),                 // 137: eval.v1.RunGraderResponse
+	(*ExportWorkspaceRequest)(nil),            // 138: eval.v1.ExportWorkspaceRequest
+	(*ExportWorkspaceResponse)(nil),           // 139: eval.v1.ExportWorkspaceResponse
+	(*SyntheticGenerationRequest)(nil),        // 140: eval.v1.SyntheticGenerationRequest
+	(*RunPerturbationsRequest)(nil),           // 141: eval.v1.RunPerturbationsRequest
+	(*PerturbationStability)(nil),             // 142: eval.v1.PerturbationStability
+	(*RunPerturbationsResponse)(nil),          // 143: eval.v1.RunPerturbationsResponse
+	(*OptimizePromptRequest)(nil),             // 144: eval.v1.OptimizePromptRequest
+	(*OptimizationCandidate)(nil),             // 145: eval.v1.OptimizationCandidate
+	(*OptimizationRun)(nil),                   // 146: eval.v1.OptimizationRun
+	(*OptimizePromptResponse)(nil),            // 147: eval.v1.OptimizePromptResponse
+	(*ListOptimizationRunsRequest)(nil),       // 148: eval.v1.ListOptimizationRunsRequest
+	(*ListOptimizationRunsResponse)(nil),      // 149: eval.v1.ListOptimizationRunsResponse
+	(*Dataset)(nil),                           // 150: eval.v1.Dataset
+	(*DatasetAttachment)(nil),                 // 151: eval.v1.DatasetAttachment
+	(*CreateDatasetRequest)(nil),              // 152: eval.v1.CreateDatasetRequest
+	(*CreateDatasetResponse)(nil),             // 153: eval.v1.CreateDatasetResponse
+	(*ListDatasetsRequest)(nil),               // 154: eval.v1.ListDatasetsRequest
+	(*ListDatasetsResponse)(nil),              // 155: eval.v1.ListDatasetsResponse
+	(*DeleteDatasetRequest)(nil),              // 156: eval.v1.DeleteDatasetRequest
+	(*AttachDatasetRequest)(nil),              // 157: eval.v1.AttachDatasetRequest
+	(*AttachDatasetResponse)(nil),             // 158: eval.v1.AttachDatasetResponse
+	(*DetachDatasetRequest)(nil),              // 159: eval.v1.DetachDatasetRequest
+	(*Workspace_Prompt)(nil),                  // 160: eval.v1.Workspace.Prompt
+	(*Workspace_SystemPrompt)(nil),            // 161: eval.v1.Workspace.SystemPrompt
+	nil,                                       // 162: eval.v1.TestCase.VariableValuesEntry
+	nil,                                       // 163: eval.v1.CreateTestCaseRequest.VariableValuesEntry
+	nil,                                       // 164: eval.v1.ImportTestCasesRequest.ColumnMappingEntry
+	nil,                                       // 165: eval.v1.ListModelConfigsResponse.ModelConfigsEntry
+	nil,                                       // 166: eval.v1.CompleteRequest.VariablesEntry
+	nil,                                       // 167: eval.v1.Trace.VariablesEntry
+	nil,                                       // 168: eval.v1.RejectedTestCase.VariableValuesEntry
+	nil,                                       // 169: eval.v1.Example.VariableValuesEntry
+	nil,                                       // 170: eval.v1.CreateExampleRequest.VariableValuesEntry
+	nil,                                       // 171: eval.v1.DisagreementItem.ValuesEntry
+	nil,                                       // 172: eval.v1.DatasetAttachment.VariableMappingEntry
+	nil,                                       // 173: eval.v1.AttachDatasetRequest.VariableMappingEntry
+	(*timestamppb.Timestamp)(nil),             // 174: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                     // 175: google.protobuf.Empty
+}
+var file_eval_v1_eval_proto_depIdxs = []int32{
+	0,   // 0: eval.v1.Variable.type:type_name -> eval.v1.VariableType
+	21,  // 1: eval.v1.InferRequest.messages:type_name -> eval.v1.InferMessage
+	19,  // 2: eval.v1.InferRequest.model_config:type_name -> eval.v1.ModelConfig
+	20,  // 3: eval.v1.InferRequest.message_options:type_name -> eval.v1.MessageOptions
+	29,  // 4: eval.v1.EvaluationRequest.test_case:type_name -> eval.v1.TestCase
+	31,  // 5: eval.v1.EvaluationResponse.result:type_name -> eval.v1.TestResult
+	20,  // 6: eval.v1.WorkspaceConfig.message_options:type_name -> eval.v1.MessageOptions
+	174, // 7: eval.v1.WorkspaceConfig.created_at:type_name -> google.protobuf.Timestamp
+	174, // 8: eval.v1.WorkspaceConfig.updated_at:type_name -> google.protobuf.Timestamp
+	20,  // 9: eval.v1.CreateWorkspaceConfigRequest.message_options:type_name -> eval.v1.MessageOptions
+	25,  // 10: eval.v1.CreateWorkspaceConfigResponse.workspace_config:type_name -> eval.v1.WorkspaceConfig
+	174, // 11: eval.v1.Workspace.created_at:type_name -> google.protobuf.Timestamp
+	174, // 12: eval.v1.Workspace.updated_at:type_name -> google.protobuf.Timestamp
+	160, // 13: eval.v1.Workspace.prompts:type_name -> eval.v1.Workspace.Prompt
+	25,  // 14: eval.v1.Workspace.workspace_configs:type_name -> eval.v1.WorkspaceConfig
+	161, // 15: eval.v1.Workspace.system_prompts:type_name -> eval.v1.Workspace.SystemPrompt
+	108, // 16: eval.v1.Workspace.example_settings:type_name -> eval.v1.ExampleSettings
+	174, // 17: eval.v1.Workspace.archived_at:type_name -> google.protobuf.Timestamp
+	162, // 18: eval.v1.TestCase.variable_values:type_name -> eval.v1.TestCase.VariableValuesEntry
+	174, // 19: eval.v1.TestCase.created_at:type_name -> google.protobuf.Timestamp
+	174, // 20: eval.v1.TestCase.updated_at:type_name -> google.protobuf.Timestamp
+	1,   // 21: eval.v1.TestCase.split:type_name -> eval.v1.Split
+	174, // 22: eval.v1.TestCase.deleted_at:type_name -> google.protobuf.Timestamp
+	2,   // 23: eval.v1.TestCase.perturbation:type_name -> eval.v1.PerturbationKind
+	1,   // 24: eval.v1.TestCaseFilter.splits:type_name -> eval.v1.Split
+	20,  // 25: eval.v1.TestResult.message_options:type_name -> eval.v1.MessageOptions
+	174, // 26: eval.v1.TestResult.created_at:type_name -> google.protobuf.Timestamp
+	174, // 27: eval.v1.TestResult.updated_at:type_name -> google.protobuf.Timestamp
+	32,  // 28: eval.v1.TestResult.ratings:type_name -> eval.v1.Rating
+	174, // 29: eval.v1.Rating.created_at:type_name -> google.protobuf.Timestamp
+	174, // 30: eval.v1.Rating.updated_at:type_name -> google.protobuf.Timestamp
+	28,  // 31: eval.v1.CloneWorkspaceResponse.workspace:type_name -> eval.v1.Workspace
+	28,  // 32: eval.v1.ImportWorkspaceBundleResponse.workspace:type_name -> eval.v1.Workspace
+	28,  // 33: eval.v1.ListWorkspacesResponse.workspaces:type_name -> eval.v1.Workspace
+	30,  // 34: eval.v1.ListTestCasesRequest.filter:type_name -> eval.v1.TestCaseFilter
+	3,   // 35: eval.v1.ListTestCasesRequest.evaluation_status:type_name -> eval.v1.EvaluationStatus
+	4,   // 36: eval.v1.ListTestCasesRequest.sort:type_name -> eval.v1.TestCaseSort
+	29,  // 37: eval.v1.ListTestCasesResponse.test_cases:type_name -> eval.v1.TestCase
+	31,  // 38: eval.v1.ListTestCasesResponse.test_results:type_name -> eval.v1.TestResult
+	28,  // 39: eval.v1.CreateWorkspaceResponse.workspace:type_name -> eval.v1.Workspace
+	84,  // 40: eval.v1.CreateWorkspaceResponse.lint_findings:type_name -> eval.v1.LintFinding
+	28,  // 41: eval.v1.GetWorkspaceResponse.workspace:type_name -> eval.v1.Workspace
+	163, // 42: eval.v1.CreateTestCaseRequest.variable_values:type_name -> eval.v1.CreateTestCaseRequest.VariableValuesEntry
+	1,   // 43: eval.v1.CreateTestCaseRequest.split:type_name -> eval.v1.Split
+	29,  // 44: eval.v1.CreateTestCaseResponse.test_case:type_name -> eval.v1.TestCase
+	5,   // 45: eval.v1.DedupOptions.action:type_name -> eval.v1.DuplicateAction
+	30,  // 46: eval.v1.FindDuplicateTestCasesRequest.filter:type_name -> eval.v1.TestCaseFilter
+	57,  // 47: eval.v1.FindDuplicateTestCasesResponse.groups:type_name -> eval.v1.DuplicateGroup
+	29,  // 48: eval.v1.ListDeletedTestCasesResponse.test_cases:type_name -> eval.v1.TestCase
+	29,  // 49: eval.v1.RestoreTestCaseResponse.test_case:type_name -> eval.v1.TestCase
+	174, // 50: eval.v1.PurgeTestCasesRequest.deleted_before:type_name -> google.protobuf.Timestamp
+	1,   // 51: eval.v1.UpdateTestCasesRequest.split:type_name -> eval.v1.Split
+	29,  // 52: eval.v1.UpdateTestCasesResponse.test_cases:type_name -> eval.v1.TestCase
+	6,   // 53: eval.v1.ImportTestCasesRequest.format:type_name -> eval.v1.FileFormat
+	164, // 54: eval.v1.ImportTestCasesRequest.column_mapping:type_name -> eval.v1.ImportTestCasesRequest.ColumnMappingEntry
+	1,   // 55: eval.v1.ImportTestCasesRequest.split:type_name -> eval.v1.Split
+	54,  // 56: eval.v1.ImportTestCasesRequest.dedup:type_name -> eval.v1.DedupOptions
+	29,  // 57: eval.v1.ImportTestCasesResponse.test_cases:type_name -> eval.v1.TestCase
+	68,  // 58: eval.v1.ImportTestCasesResponse.errors:type_name -> eval.v1.ImportRowError
+	55,  // 59: eval.v1.ImportTestCasesResponse.near_duplicates:type_name -> eval.v1.NearDuplicate
+	165, // 60: eval.v1.ListModelConfigsResponse.model_configs:type_name -> eval.v1.ListModelConfigsResponse.ModelConfigsEntry
+	19,  // 61: eval.v1.GetModelConfigResponse.model_config:type_name -> eval.v1.ModelConfig
+	174, // 62: eval.v1.UpdateWorkspaceResponse.updated_at:type_name -> google.protobuf.Timestamp
+	84,  // 63: eval.v1.UpdateWorkspaceResponse.lint_findings:type_name -> eval.v1.LintFinding
+	7,   // 64: eval.v1.WordDiff.op:type_name -> eval.v1.DiffOp
+	7,   // 65: eval.v1.LineDiff.op:type_name -> eval.v1.DiffOp
+	79,  // 66: eval.v1.LineDiff.words:type_name -> eval.v1.WordDiff
+	80,  // 67: eval.v1.TextDiff.lines:type_name -> eval.v1.LineDiff
+	81,  // 68: eval.v1.DiffPromptVersionsResponse.prompt:type_name -> eval.v1.TextDiff
+	81,  // 69: eval.v1.DiffPromptVersionsResponse.system_prompt:type_name -> eval.v1.TextDiff
+	8,   // 70: eval.v1.LintFinding.code:type_name -> eval.v1.LintCode
+	84,  // 71: eval.v1.LintPromptResponse.findings:type_name -> eval.v1.LintFinding
+	174, // 72: eval.v1.PromptLabel.updated_at:type_name -> google.protobuf.Timestamp
+	9,   // 73: eval.v1.PromptLabelEvent.action:type_name -> eval.v1.LabelAction
+	174, // 74: eval.v1.PromptLabelEvent.created_at:type_name -> google.protobuf.Timestamp
+	166, // 75: eval.v1.CompleteRequest.variables:type_name -> eval.v1.CompleteRequest.VariablesEntry
+	167, // 76: eval.v1.Trace.variables:type_name -> eval.v1.Trace.VariablesEntry
+	174, // 77: eval.v1.Trace.created_at:type_name -> google.protobuf.Timestamp
+	91,  // 78: eval.v1.ListTracesResponse.traces:type_name -> eval.v1.Trace
+	87,  // 79: eval.v1.PromoteVersionResponse.label:type_name -> eval.v1.PromptLabel
+	88,  // 80: eval.v1.PromoteVersionResponse.event:type_name -> eval.v1.PromptLabelEvent
+	87,  // 81: eval.v1.RollbackLabelResponse.label:type_name -> eval.v1.PromptLabel
+	88,  // 82: eval.v1.RollbackLabelResponse.event:type_name -> eval.v1.PromptLabelEvent
+	87,  // 83: eval.v1.ListLabelsResponse.labels:type_name -> eval.v1.PromptLabel
+	88,  // 84: eval.v1.ListLabelHistoryResponse.events:type_name -> eval.v1.PromptLabelEvent
+	29,  // 85: eval.v1.GenerateTestCaseRequest.test_cases:type_name -> eval.v1.TestCase
+	54,  // 86: eval.v1.GenerateTestCaseRequest.dedup:type_name -> eval.v1.DedupOptions
+	10,  // 87: eval.v1.GenerateTestCaseRequest.mode:type_name -> eval.v1.GenerationMode
+	11,  // 88: eval.v1.GenerateTestCaseRequest.attack_categories:type_name -> eval.v1.AttackCategory
+	168, // 89: eval.v1.RejectedTestCase.variable_values:type_name -> eval.v1.RejectedTestCase.VariableValuesEntry
+	29,  // 90: eval.v1.GenerateTestCaseResponse.test_cases:type_name -> eval.v1.TestCase
+	55,  // 91: eval.v1.GenerateTestCaseResponse.near_duplicates:type_name -> eval.v1.NearDuplicate
+	103, // 92: eval.v1.GenerateTestCaseResponse.rejected:type_name -> eval.v1.RejectedTestCase
+	12,  // 93: eval.v1.ExampleSettings.strategy:type_name -> eval.v1.ExampleStrategy
+	169, // 94: eval.v1.Example.variable_values:type_name -> eval.v1.Example.VariableValuesEntry
+	174, // 95: eval.v1.Example.created_at:type_name -> google.protobuf.Timestamp
+	170, // 96: eval.v1.CreateExampleRequest.variable_values:type_name -> eval.v1.CreateExampleRequest.VariableValuesEntry
+	109, // 97: eval.v1.CreateExampleResponse.example:type_name -> eval.v1.Example
+	109, // 98: eval.v1.ListExamplesResponse.examples:type_name -> eval.v1.Example
+	109, // 99: eval.v1.AddExamplesFromResultsResponse.examples:type_name -> eval.v1.Example
+	108, // 100: eval.v1.SetExampleSettingsRequest.settings:type_name -> eval.v1.ExampleSettings
+	13,  // 101: eval.v1.ComputeAgreementRequest.scale:type_name -> eval.v1.RatingScale
+	30,  // 102: eval.v1.ComputeAgreementRequest.filter:type_name -> eval.v1.TestCaseFilter
+	171, // 103: eval.v1.DisagreementItem.values:type_name -> eval.v1.DisagreementItem.ValuesEntry
+	121, // 104: eval.v1.ComputeAgreementResponse.pairs:type_name -> eval.v1.RaterPairAgreement
+	122, // 105: eval.v1.ComputeAgreementResponse.disagreements:type_name -> eval.v1.DisagreementItem
+	30,  // 106: eval.v1.CompareVersionsRequest.filter:type_name -> eval.v1.TestCaseFilter
+	126, // 107: eval.v1.CompareVersionsResponse.pass_fail:type_name -> eval.v1.PassFailComparison
+	127, // 108: eval.v1.CompareVersionsResponse.scores:type_name -> eval.v1.ScoreComparison
+	125, // 109: eval.v1.CompareVersionsResponse.regressions:type_name -> eval.v1.FlippedCase
+	125, // 110: eval.v1.CompareVersionsResponse.improvements:type_name -> eval.v1.FlippedCase
+	14,  // 111: eval.v1.Grader.type:type_name -> eval.v1.GraderType
+	174, // 112: eval.v1.Grader.created_at:type_name -> google.protobuf.Timestamp
+	174, // 113: eval.v1.Grader.updated_at:type_name -> google.protobuf.Timestamp
+	14,  // 114: eval.v1.CreateGraderRequest.type:type_name -> eval.v1.GraderType
+	129, // 115: eval.v1.CreateGraderResponse.grader:type_name -> eval.v1.Grader
+	129, // 116: eval.v1.ListGradersResponse.graders:type_name -> eval.v1.Grader
+	30,  // 117: eval.v1.RunGraderRequest.filter:type_name -> eval.v1.TestCaseFilter
+	32,  // 118: eval.v1.RunGraderResponse.ratings:type_name -> eval.v1.Rating
+	136, // 119: eval.v1.RunGraderResponse.errors:type_name -> eval.v1.GradeError
+	6,   // 120: eval.v1.ExportWorkspaceRequest.format:type_name -> eval.v1.FileFormat
+	30,  // 121: eval.v1.ExportWorkspaceRequest.filter:type_name -> eval.v1.TestCaseFilter
+	30,  // 122: eval.v1.SyntheticGenerationRequest.filter:type_name -> eval.v1.TestCaseFilter
+	30,  // 123: eval.v1.RunPerturbationsRequest.filter:type_name -> eval.v1.TestCaseFilter
+	2,   // 124: eval.v1.RunPerturbationsRequest.kinds:type_name -> eval.v1.PerturbationKind
+	2,   // 125: eval.v1.PerturbationStability.kind:type_name -> eval.v1.PerturbationKind
+	29,  // 126: eval.v1.RunPerturbationsResponse.variants:type_name -> eval.v1.TestCase
+	31,  // 127: eval.v1.RunPerturbationsResponse.results:type_name -> eval.v1.TestResult
+	142, // 128: eval.v1.RunPerturbationsResponse.stability:type_name -> eval.v1.PerturbationStability
+	15,  // 129: eval.v1.OptimizePromptRequest.scoring:type_name -> eval.v1.OptimizationScoring
+	15,  // 130: eval.v1.OptimizationRun.scoring:type_name -> eval.v1.OptimizationScoring
+	16,  // 131: eval.v1.OptimizationRun.stop_reason:type_name -> eval.v1.OptimizationStopReason
+	145, // 132: eval.v1.OptimizationRun.candidates:type_name -> eval.v1.OptimizationCandidate
+	174, // 133: eval.v1.OptimizationRun.created_at:type_name -> google.protobuf.Timestamp
+	146, // 134: eval.v1.OptimizePromptResponse.run:type_name -> eval.v1.OptimizationRun
+	146, // 135: eval.v1.ListOptimizationRunsResponse.runs:type_name -> eval.v1.OptimizationRun
+	151, // 136: eval.v1.Dataset.attachments:type_name -> eval.v1.DatasetAttachment
+	174, // 137: eval.v1.Dataset.created_at:type_name -> google.protobuf.Timestamp
+	174, // 138: eval.v1.Dataset.updated_at:type_name -> google.protobuf.Timestamp
+	172, // 139: eval.v1.DatasetAttachment.variable_mapping:type_name -> eval.v1.DatasetAttachment.VariableMappingEntry
+	174, // 140: eval.v1.DatasetAttachment.created_at:type_name -> google.protobuf.Timestamp
+	30,  // 141: eval.v1.CreateDatasetRequest.filter:type_name -> eval.v1.TestCaseFilter
+	150, // 142: eval.v1.CreateDatasetResponse.dataset:type_name -> eval.v1.Dataset
+	150, // 143: eval.v1.ListDatasetsResponse.datasets:type_name -> eval.v1.Dataset
+	173, // 144: eval.v1.AttachDatasetRequest.variable_mapping:type_name -> eval.v1.AttachDatasetRequest.VariableMappingEntry
+	151, // 145: eval.v1.AttachDatasetResponse.attachment:type_name -> eval.v1.DatasetAttachment
+	17,  // 146: eval.v1.Workspace.Prompt.variables:type_name -> eval.v1.Variable
+	174, // 147: eval.v1.Workspace.Prompt.created_at:type_name -> google.protobuf.Timestamp
+	18,  // 148: eval.v1.TestCase.VariableValuesEntry.value:type_name -> eval.v1.VariableValue
+	18,  // 149: eval.v1.CreateTestCaseRequest.VariableValuesEntry.value:type_name -> eval.v1.VariableValue
+	19,  // 150: eval.v1.ListModelConfigsResponse.ModelConfigsEntry.value:type_name -> eval.v1.ModelConfig
+	18,  // 151: eval.v1.Example.VariableValuesEntry.value:type_name -> eval.v1.VariableValue
+	18,  // 152: eval.v1.CreateExampleRequest.VariableValuesEntry.value:type_name -> eval.v1.VariableValue
+	23,  // 153: eval.v1.EvaluationService.Evaluate:input_type -> eval.v1.EvaluationRequest
+	140, // 154: eval.v1.EvaluationService.SyntheticGeneration:input_type -> eval.v1.SyntheticGenerationRequest
+	33,  // 155: eval.v1.EvaluationService.CreateWorkspace:input_type -> eval.v1.CreateWorkspaceRequest
+	40,  // 156: eval.v1.EvaluationService.GetWorkspace:input_type -> eval.v1.GetWorkspaceRequest
+	41,  // 157: eval.v1.EvaluationService.ListWorkspaces:input_type -> eval.v1.ListWorkspacesRequest
+	77,  // 158: eval.v1.EvaluationService.UpdateWorkspace:input_type -> eval.v1.UpdateWorkspaceRequest
+	138, // 159: eval.v1.EvaluationService.ExportWorkspace:input_type -> eval.v1.ExportWorkspaceRequest
+	34,  // 160: eval.v1.EvaluationService.CloneWorkspace:input_type -> eval.v1.CloneWorkspaceRequest
+	42,  // 161: eval.v1.EvaluationService.DeleteWorkspace:input_type -> eval.v1.DeleteWorkspaceRequest
+	43,  // 162: eval.v1.EvaluationService.ArchiveWorkspace:input_type -> eval.v1.ArchiveWorkspaceRequest
+	36,  // 163: eval.v1.EvaluationService.ExportWorkspaceBundle:input_type -> eval.v1.ExportWorkspaceBundleRequest
+	38,  // 164: eval.v1.EvaluationService.ImportWorkspaceBundle:input_type -> eval.v1.ImportWorkspaceBundleRequest
+	70,  // 165: eval.v1.EvaluationService.GeneratePrompt:input_type -> eval.v1.GeneratePromptRequest
+	82,  // 166: eval.v1.EvaluationService.DiffPromptVersions:input_type -> eval.v1.DiffPromptVersionsRequest
+	85,  // 167: eval.v1.EvaluationService.LintPrompt:input_type -> eval.v1.LintPromptRequest
+	144, // 168: eval.v1.EvaluationService.OptimizePrompt:input_type -> eval.v1.OptimizePromptRequest
+	148, // 169: eval.v1.EvaluationService.ListOptimizationRuns:input_type -> eval.v1.ListOptimizationRunsRequest
+	51,  // 170: eval.v1.EvaluationService.CreateTestCase:input_type -> eval.v1.CreateTestCaseRequest
+	46,  // 171: eval.v1.EvaluationService.ListTestCases:input_type -> eval.v1.ListTestCasesRequest
+	102, // 172: eval.v1.EvaluationService.GenerateTestCase:input_type -> eval.v1.GenerateTestCaseRequest
+	53,  // 173: eval.v1.EvaluationService.DeleteTestCase:input_type -> eval.v1.DeleteTestCaseRequest
+	67,  // 174: eval.v1.EvaluationService.ImportTestCases:input_type -> eval.v1.ImportTestCasesRequest
+	65,  // 175: eval.v1.EvaluationService.UpdateTestCases:input_type -> eval.v1.UpdateTestCasesRequest
+	56,  // 176: eval.v1.EvaluationService.FindDuplicateTestCases:input_type -> eval.v1.FindDuplicateTestCasesRequest
+	59,  // 177: eval.v1.EvaluationService.ListDeletedTestCases:input_type -> eval.v1.ListDeletedTestCasesRequest
+	61,  // 178: eval.v1.EvaluationService.RestoreTestCase:input_type -> eval.v1.RestoreTestCaseRequest
+	63,  // 179: eval.v1.EvaluationService.PurgeTestCases:input_type -> eval.v1.PurgeTestCasesRequest
+	175, // 180: eval.v1.EvaluationService.ListModelConfigs:input_type -> google.protobuf.Empty
+	175, // 181: eval.v1.EvaluationService.GetDefaultSmallModelConfig:input_type -> google.protobuf.Empty
+	175, // 182: eval.v1.EvaluationService.GetDefaultLargeModelConfig:input_type -> google.protobuf.Empty
+	74,  // 183: eval.v1.EvaluationService.SetDefaultSmallModelConfig:input_type -> eval.v1.SetDefaultSmallModelConfigRequest
+	75,  // 184: eval.v1.EvaluationService.SetDefaultLargeModelConfig:input_type -> eval.v1.SetDefaultLargeModelConfigRequest
+	26,  // 185: eval.v1.EvaluationService.CreateWorkspaceConfig:input_type -> eval.v1.CreateWorkspaceConfigRequest
+	105, // 186: eval.v1.EvaluationService.DeleteWorkspaceConfig:input_type -> eval.v1.DeleteWorkspaceConfigRequest
+	106, // 187: eval.v1.EvaluationService.SetWorkspaceConfigActive:input_type -> eval.v1.SetWorkspaceConfigActiveRequest
+	107, // 188: eval.v1.EvaluationService.SetVersionActive:input_type -> eval.v1.SetVersionActiveRequest
+	118, // 189: eval.v1.EvaluationService.SetXMLMode:input_type -> eval.v1.SetXMLModeRequest
+	119, // 190: eval.v1.EvaluationService.RateTestResult:input_type -> eval.v1.RateTestResultRequest
+	120, // 191: eval.v1.EvaluationService.ComputeAgreement:input_type -> eval.v1.ComputeAgreementRequest
+	124, // 192: eval.v1.EvaluationService.CompareVersions:input_type -> eval.v1.CompareVersionsRequest
+	130, // 193: eval.v1.EvaluationService.CreateGrader:input_type -> eval.v1.CreateGraderRequest
+	132, // 194: eval.v1.EvaluationService.ListGraders:input_type -> eval.v1.ListGradersRequest
+	134, // 195: eval.v1.EvaluationService.DeleteGrader:input_type -> eval.v1.DeleteGraderRequest
+	135, // 196: eval.v1.EvaluationService.RunGrader:input_type -> eval.v1.RunGraderRequest
+	152, // 197: eval.v1.EvaluationService.CreateDataset:input_type -> eval.v1.CreateDatasetRequest
+	154, // 198: eval.v1.EvaluationService.ListDatasets:input_type -> eval.v1.ListDatasetsRequest
+	156, // 199: eval.v1.EvaluationService.DeleteDataset:input_type -> eval.v1.DeleteDatasetRequest
+	157, // 200: eval.v1.EvaluationService.AttachDataset:input_type -> eval.v1.AttachDatasetRequest
+	159, // 201: eval.v1.EvaluationService.DetachDataset:input_type -> eval.v1.DetachDatasetRequest
+	94,  // 202: eval.v1.EvaluationService.PromoteVersion:input_type -> eval.v1.PromoteVersionRequest
+	96,  // 203: eval.v1.EvaluationService.RollbackLabel:input_type -> eval.v1.RollbackLabelRequest
+	98,  // 204: eval.v1.EvaluationService.ListLabels:input_type -> eval.v1.ListLabelsRequest
+	100, // 205: eval.v1.EvaluationService.ListLabelHistory:input_type -> eval.v1.ListLabelHistoryRequest
+	89,  // 206: eval.v1.EvaluationService.Complete:input_type -> eval.v1.CompleteRequest
+	92,  // 207: eval.v1.EvaluationService.ListTraces:input_type -> eval.v1.ListTracesRequest
+	141, // 208: eval.v1.EvaluationService.RunPerturbations:input_type -> eval.v1.RunPerturbationsRequest
+	110, // 209: eval.v1.EvaluationService.CreateExample:input_type -> eval.v1.CreateExampleRequest
+	112, // 210: eval.v1.EvaluationService.ListExamples:input_type -> eval.v1.ListExamplesRequest
+	114, // 211: eval.v1.EvaluationService.DeleteExample:input_type -> eval.v1.DeleteExampleRequest
+	115, // 212: eval.v1.EvaluationService.AddExamplesFromResults:input_type -> eval.v1.AddExamplesFromResultsRequest
+	117, // 213: eval.v1.EvaluationService.SetExampleSettings:input_type -> eval.v1.SetExampleSettingsRequest
+	24,  // 214: eval.v1.EvaluationService.Evaluate:output_type -> eval.v1.EvaluationResponse
+	24,  // 215: eval.v1.EvaluationService.SyntheticGeneration:output_type -> eval.v1.EvaluationResponse
+	49,  // 216: eval.v1.EvaluationService.CreateWorkspace:output_type -> eval.v1.CreateWorkspaceResponse
+	50,  // 217: eval.v1.EvaluationService.GetWorkspace:output_type -> eval.v1.GetWorkspaceResponse
+	44,  // 218: eval.v1.EvaluationService.ListWorkspaces:output_type -> eval.v1.ListWorkspacesResponse
+	78,  // 219: eval.v1.EvaluationService.UpdateWorkspace:output_type -> eval.v1.UpdateWorkspaceResponse
+	139, // 220: eval.v1.EvaluationService.ExportWorkspace:output_type -> eval.v1.ExportWorkspaceResponse
+	35,  // 221: eval.v1.EvaluationService.CloneWorkspace:output_type -> eval.v1.CloneWorkspaceResponse
+	175, // 222: eval.v1.EvaluationService.DeleteWorkspace:output_type -> google.protobuf.Empty
+	175, // 223: eval.v1.EvaluationService.ArchiveWorkspace:output_type -> google.protobuf.Empty
+	37,  // 224: eval.v1.EvaluationService.ExportWorkspaceBundle:output_type -> eval.v1.ExportWorkspaceBundleResponse
+	39,  // 225: eval.v1.EvaluationService.ImportWorkspaceBundle:output_type -> eval.v1.ImportWorkspaceBundleResponse
+	71,  // 226: eval.v1.EvaluationService.GeneratePrompt:output_type -> eval.v1.GeneratePromptResponse
+	83,  // 227: eval.v1.EvaluationService.DiffPromptVersions:output_type -> eval.v1.DiffPromptVersionsResponse
+	86,  // 228: eval.v1.EvaluationService.LintPrompt:output_type -> eval.v1.LintPromptResponse
+	147, // 229: eval.v1.EvaluationService.OptimizePrompt:output_type -> eval.v1.OptimizePromptResponse
+	149, // 230: eval.v1.EvaluationService.ListOptimizationRuns:output_type -> eval.v1.ListOptimizationRunsResponse
+	52,  // 231: eval.v1.EvaluationService.CreateTestCase:output_type -> eval.v1.CreateTestCaseResponse
+	47,  // 232: eval.v1.EvaluationService.ListTestCases:output_type -> eval.v1.ListTestCasesResponse
+	104, // 233: eval.v1.EvaluationService.GenerateTestCase:output_type -> eval.v1.GenerateTestCaseResponse
+	175, // 234: eval.v1.EvaluationService.DeleteTestCase:output_type -> google.protobuf.Empty
+	69,  // 235: eval.v1.EvaluationService.ImportTestCases:output_type -> eval.v1.ImportTestCasesResponse
+	66,  // 236: eval.v1.EvaluationService.UpdateTestCases:output_type -> eval.v1.UpdateTestCasesResponse
+	58,  // 237: eval.v1.EvaluationService.FindDuplicateTestCases:output_type -> eval.v1.FindDuplicateTestCasesResponse
+	60,  // 238: eval.v1.EvaluationService.ListDeletedTestCases:output_type -> eval.v1.ListDeletedTestCasesResponse
+	62,  // 239: eval.v1.EvaluationService.RestoreTestCase:output_type -> eval.v1.RestoreTestCaseResponse
+	64,  // 240: eval.v1.EvaluationService.PurgeTestCases:output_type -> eval.v1.PurgeTestCasesResponse
+	73,  // 241: eval.v1.EvaluationService.ListModelConfigs:output_type -> eval.v1.ListModelConfigsResponse
+	76,  // 242: eval.v1.EvaluationService.GetDefaultSmallModelConfig:output_type -> eval.v1.GetModelConfigResponse
+	76,  // 243: eval.v1.EvaluationService.GetDefaultLargeModelConfig:output_type -> eval.v1.GetModelConfigResponse
+	175, // 244: eval.v1.EvaluationService.SetDefaultSmallModelConfig:output_type -> google.protobuf.Empty
+	175, // 245: eval.v1.EvaluationService.SetDefaultLargeModelConfig:output_type -> google.protobuf.Empty
+	27,  // 246: eval.v1.EvaluationService.CreateWorkspaceConfig:output_type -> eval.v1.CreateWorkspaceConfigResponse
+	175, // 247: eval.v1.EvaluationService.DeleteWorkspaceConfig:output_type -> google.protobuf.Empty
+	175, // 248: eval.v1.EvaluationService.SetWorkspaceConfigActive:output_type -> google.protobuf.Empty
+	175, // 249: eval.v1.EvaluationService.SetVersionActive:output_type -> google.protobuf.Empty
+	175, // 250: eval.v1.EvaluationService.SetXMLMode:output_type -> google.protobuf.Empty
+	175, // 251: eval.v1.EvaluationService.RateTestResult:output_type -> google.protobuf.Empty
+	123, // 252: eval.v1.EvaluationService.ComputeAgreement:output_type -> eval.v1.ComputeAgreementResponse
+	128, // 253: eval.v1.EvaluationService.CompareVersions:output_type -> eval.v1.CompareVersionsResponse
+	131, // 254: eval.v1.EvaluationService.CreateGrader:output_type -> eval.v1.CreateGraderResponse
+	133, // 255: eval.v1.EvaluationService.ListGraders:output_type -> eval.v1.ListGradersResponse
+	175, // 256: eval.v1.EvaluationService.DeleteGrader:output_type -> google.protobuf.Empty
+	137, // 257: eval.v1.EvaluationService.RunGrader:output_type -> eval.v1.RunGraderResponse
+	153, // 258: eval.v1.EvaluationService.CreateDataset:output_type -> eval.v1.CreateDatasetResponse
+	155, // 259: eval.v1.EvaluationService.ListDatasets:output_type -> eval.v1.ListDatasetsResponse
+	175, // 260: eval.v1.EvaluationService.DeleteDataset:output_type -> google.protobuf.Empty
+	158, // 261: eval.v1.EvaluationService.AttachDataset:output_type -> eval.v1.AttachDatasetResponse
+	175, // 262: eval.v1.EvaluationService.DetachDataset:output_type -> google.protobuf.Empty
+	95,  // 263: eval.v1.EvaluationService.PromoteVersion:output_type -> eval.v1.PromoteVersionResponse
+	97,  // 264: eval.v1.EvaluationService.RollbackLabel:output_type -> eval.v1.RollbackLabelResponse
+	99,  // 265: eval.v1.EvaluationService.ListLabels:output_type -> eval.v1.ListLabelsResponse
+	101, // 266: eval.v1.EvaluationService.ListLabelHistory:output_type -> eval.v1.ListLabelHistoryResponse
+	90,  // 267: eval.v1.EvaluationService.Complete:output_type -> eval.v1.CompleteResponse
+	93,  // 268: eval.v1.EvaluationService.ListTraces:output_type -> eval.v1.ListTracesResponse
+	143, // 269: eval.v1.EvaluationService.RunPerturbations:output_type -> eval.v1.RunPerturbationsResponse
+	111, // 270: eval.v1.EvaluationService.CreateExample:output_type -> eval.v1.CreateExampleResponse
+	113, // 271: eval.v1.EvaluationService.ListExamples:output_type -> eval.v1.ListExamplesResponse
+	175, // 272: eval.v1.EvaluationService.DeleteExample:output_type -> google.protobuf.Empty
+	116, // 273: eval.v1.EvaluationService.AddExamplesFromResults:output_type -> eval.v1.AddExamplesFromResultsResponse
+	175, // 274: eval.v1.EvaluationService.SetExampleSettings:output_type -> google.protobuf.Empty
+	214, // [214:275] is the sub-list for method output_type
+	153, // [153:214] is the sub-list for method input_type
+	153, // [153:153] is the sub-list for extension type_name
+	153, // [153:153] is the sub-list for extension extendee
+	0,   // [0:153] is the sub-list for field type_name
+}
+
+func init() { file_eval_v1_eval_proto_init() }
+func file_eval_v1_eval_proto_init() {
+	if File_eval_v1_eval_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_eval_v1_eval_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Variable); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*VariableValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*ModelConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*MessageOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*InferMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*InferRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*EvaluationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*EvaluationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*WorkspaceConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateWorkspaceConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateWorkspaceConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*Workspace); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*TestCase); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*TestCaseFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*TestResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*Rating); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateWorkspaceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*CloneWorkspaceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*CloneWorkspaceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportWorkspaceBundleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportWorkspaceBundleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportWorkspaceBundleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportWorkspaceBundleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*GetWorkspaceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*ListWorkspacesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteWorkspaceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*ArchiveWorkspaceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*ListWorkspacesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPromptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*ListTestCasesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*ListTestCasesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*CreatePromptVersionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateWorkspaceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*GetWorkspaceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateTestCaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateTestCaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteTestCaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*DedupOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*NearDuplicate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*FindDuplicateTestCasesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*DuplicateGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*FindDuplicateTestCasesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*ListDeletedTestCasesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*ListDeletedTestCasesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*RestoreTestCaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*RestoreTestCaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*PurgeTestCasesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*PurgeTestCasesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateTestCasesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateTestCasesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportTestCasesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportRowError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportTestCasesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*GeneratePromptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*GeneratePromptResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[55].Exporter = func(v any, i int) any {
+			switch v := v.(*ListModelConfigsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*ListModelConfigsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[57].Exporter = func(v any, i int) any {
+			switch v := v.(*SetDefaultSmallModelConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[58].Exporter = func(v any, i int) any {
+			switch v := v.(*SetDefaultLargeModelConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[59].Exporter = func(v any, i int) any {
+			switch v := v.(*GetModelConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[60].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateWorkspaceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[61].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateWorkspaceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[62].Exporter = func(v any, i int) any {
+			switch v := v.(*WordDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[63].Exporter = func(v any, i int) any {
+			switch v := v.(*LineDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[64].Exporter = func(v any, i int) any {
+			switch v := v.(*TextDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[65].Exporter = func(v any, i int) any {
+			switch v := v.(*DiffPromptVersionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[66].Exporter = func(v any, i int) any {
+			switch v := v.(*DiffPromptVersionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[67].Exporter = func(v any, i int) any {
+			switch v := v.(*LintFinding); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[68].Exporter = func(v any, i int) any {
+			switch v := v.(*LintPromptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[69].Exporter = func(v any, i int) any {
+			switch v := v.(*LintPromptResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[70].Exporter = func(v any, i int) any {
+			switch v := v.(*PromptLabel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[71].Exporter = func(v any, i int) any {
+			switch v := v.(*PromptLabelEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[72].Exporter = func(v any, i int) any {
+			switch v := v.(*CompleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[73].Exporter = func(v any, i int) any {
+			switch v := v.(*CompleteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[74].Exporter = func(v any, i int) any {
+			switch v := v.(*Trace); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[75].Exporter = func(v any, i int) any {
+			switch v := v.(*ListTracesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[76].Exporter = func(v any, i int) any {
+			switch v := v.(*ListTracesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[77].Exporter = func(v any, i int) any {
+			switch v := v.(*PromoteVersionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[78].Exporter = func(v any, i int) any {
+			switch v := v.(*PromoteVersionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[79].Exporter = func(v any, i int) any {
+			switch v := v.(*RollbackLabelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[80].Exporter = func(v any, i int) any {
+			switch v := v.(*RollbackLabelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[81].Exporter = func(v any, i int) any {
+			switch v := v.(*ListLabelsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[82].Exporter = func(v any, i int) any {
+			switch v := v.(*ListLabelsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[83].Exporter = func(v any, i int) any {
+			switch v := v.(*ListLabelHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3679,8 +15218,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*VariableValue); i {
+		file_eval_v1_eval_proto_msgTypes[84].Exporter = func(v any, i int) any {
+			switch v := v.(*ListLabelHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3691,8 +15230,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*ModelConfig); i {
+		file_eval_v1_eval_proto_msgTypes[85].Exporter = func(v any, i int) any {
+			switch v := v.(*GenerateTestCaseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3703,8 +15242,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*MessageOptions); i {
+		file_eval_v1_eval_proto_msgTypes[86].Exporter = func(v any, i int) any {
+			switch v := v.(*RejectedTestCase); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3715,8 +15254,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*InferMessage); i {
+		file_eval_v1_eval_proto_msgTypes[87].Exporter = func(v any, i int) any {
+			switch v := v.(*GenerateTestCaseResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3727,8 +15266,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*InferRequest); i {
+		file_eval_v1_eval_proto_msgTypes[88].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteWorkspaceConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3739,8 +15278,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*EvaluationRequest); i {
+		file_eval_v1_eval_proto_msgTypes[89].Exporter = func(v any, i int) any {
+			switch v := v.(*SetWorkspaceConfigActiveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3751,8 +15290,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*EvaluationResponse); i {
+		file_eval_v1_eval_proto_msgTypes[90].Exporter = func(v any, i int) any {
+			switch v := v.(*SetVersionActiveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3763,8 +15302,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*WorkspaceConfig); i {
+		file_eval_v1_eval_proto_msgTypes[91].Exporter = func(v any, i int) any {
+			switch v := v.(*ExampleSettings); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3775,8 +15314,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateWorkspaceConfigRequest); i {
+		file_eval_v1_eval_proto_msgTypes[92].Exporter = func(v any, i int) any {
+			switch v := v.(*Example); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3787,8 +15326,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateWorkspaceConfigResponse); i {
+		file_eval_v1_eval_proto_msgTypes[93].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateExampleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3799,8 +15338,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*Workspace); i {
+		file_eval_v1_eval_proto_msgTypes[94].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateExampleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3811,8 +15350,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*TestCase); i {
+		file_eval_v1_eval_proto_msgTypes[95].Exporter = func(v any, i int) any {
+			switch v := v.(*ListExamplesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3823,8 +15362,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*TestResult); i {
+		file_eval_v1_eval_proto_msgTypes[96].Exporter = func(v any, i int) any {
+			switch v := v.(*ListExamplesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3835,8 +15374,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateWorkspaceRequest); i {
+		file_eval_v1_eval_proto_msgTypes[97].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteExampleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3847,8 +15386,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*GetWorkspaceRequest); i {
+		file_eval_v1_eval_proto_msgTypes[98].Exporter = func(v any, i int) any {
+			switch v := v.(*AddExamplesFromResultsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3859,8 +15398,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*ListWorkspacesRequest); i {
+		file_eval_v1_eval_proto_msgTypes[99].Exporter = func(v any, i int) any {
+			switch v := v.(*AddExamplesFromResultsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3871,8 +15410,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*ListWorkspacesResponse); i {
+		file_eval_v1_eval_proto_msgTypes[100].Exporter = func(v any, i int) any {
+			switch v := v.(*SetExampleSettingsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3883,8 +15422,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*GetPromptRequest); i {
+		file_eval_v1_eval_proto_msgTypes[101].Exporter = func(v any, i int) any {
+			switch v := v.(*SetXMLModeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3895,8 +15434,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*ListTestCasesRequest); i {
+		file_eval_v1_eval_proto_msgTypes[102].Exporter = func(v any, i int) any {
+			switch v := v.(*RateTestResultRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3907,8 +15446,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[20].Exporter = func(v any, i int) any {
-			switch v := v.(*ListTestCasesResponse); i {
+		file_eval_v1_eval_proto_msgTypes[103].Exporter = func(v any, i int) any {
+			switch v := v.(*ComputeAgreementRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3919,8 +15458,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[21].Exporter = func(v any, i int) any {
-			switch v := v.(*CreatePromptVersionRequest); i {
+		file_eval_v1_eval_proto_msgTypes[104].Exporter = func(v any, i int) any {
+			switch v := v.(*RaterPairAgreement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3931,8 +15470,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[22].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateWorkspaceResponse); i {
+		file_eval_v1_eval_proto_msgTypes[105].Exporter = func(v any, i int) any {
+			switch v := v.(*DisagreementItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3943,8 +15482,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[23].Exporter = func(v any, i int) any {
-			switch v := v.(*GetWorkspaceResponse); i {
+		file_eval_v1_eval_proto_msgTypes[106].Exporter = func(v any, i int) any {
+			switch v := v.(*ComputeAgreementResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3955,8 +15494,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[24].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateTestCaseRequest); i {
+		file_eval_v1_eval_proto_msgTypes[107].Exporter = func(v any, i int) any {
+			switch v := v.(*CompareVersionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3967,8 +15506,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[25].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateTestCaseResponse); i {
+		file_eval_v1_eval_proto_msgTypes[108].Exporter = func(v any, i int) any {
+			switch v := v.(*FlippedCase); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3979,8 +15518,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[26].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteTestCaseRequest); i {
+		file_eval_v1_eval_proto_msgTypes[109].Exporter = func(v any, i int) any {
+			switch v := v.(*PassFailComparison); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3991,8 +15530,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[27].Exporter = func(v any, i int) any {
-			switch v := v.(*GeneratePromptRequest); i {
+		file_eval_v1_eval_proto_msgTypes[110].Exporter = func(v any, i int) any {
+			switch v := v.(*ScoreComparison); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4003,8 +15542,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[28].Exporter = func(v any, i int) any {
-			switch v := v.(*GeneratePromptResponse); i {
+		file_eval_v1_eval_proto_msgTypes[111].Exporter = func(v any, i int) any {
+			switch v := v.(*CompareVersionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4015,8 +15554,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[29].Exporter = func(v any, i int) any {
-			switch v := v.(*ListModelConfigsRequest); i {
+		file_eval_v1_eval_proto_msgTypes[112].Exporter = func(v any, i int) any {
+			switch v := v.(*Grader); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4027,8 +15566,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[30].Exporter = func(v any, i int) any {
-			switch v := v.(*ListModelConfigsResponse); i {
+		file_eval_v1_eval_proto_msgTypes[113].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateGraderRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4039,8 +15578,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[31].Exporter = func(v any, i int) any {
-			switch v := v.(*SetDefaultSmallModelConfigRequest); i {
+		file_eval_v1_eval_proto_msgTypes[114].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateGraderResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4051,8 +15590,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[32].Exporter = func(v any, i int) any {
-			switch v := v.(*SetDefaultLargeModelConfigRequest); i {
+		file_eval_v1_eval_proto_msgTypes[115].Exporter = func(v any, i int) any {
+			switch v := v.(*ListGradersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4063,8 +15602,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[33].Exporter = func(v any, i int) any {
-			switch v := v.(*GetModelConfigResponse); i {
+		file_eval_v1_eval_proto_msgTypes[116].Exporter = func(v any, i int) any {
+			switch v := v.(*ListGradersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4075,8 +15614,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[34].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateWorkspaceRequest); i {
+		file_eval_v1_eval_proto_msgTypes[117].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteGraderRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4087,8 +15626,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[35].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateWorkspaceResponse); i {
+		file_eval_v1_eval_proto_msgTypes[118].Exporter = func(v any, i int) any {
+			switch v := v.(*RunGraderRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4099,8 +15638,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[36].Exporter = func(v any, i int) any {
-			switch v := v.(*GenerateTestCaseRequest); i {
+		file_eval_v1_eval_proto_msgTypes[119].Exporter = func(v any, i int) any {
+			switch v := v.(*GradeError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4111,8 +15650,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[37].Exporter = func(v any, i int) any {
-			switch v := v.(*GenerateTestCaseResponse); i {
+		file_eval_v1_eval_proto_msgTypes[120].Exporter = func(v any, i int) any {
+			switch v := v.(*RunGraderResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4123,8 +15662,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[38].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteWorkspaceConfigRequest); i {
+		file_eval_v1_eval_proto_msgTypes[121].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportWorkspaceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4135,8 +15674,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[39].Exporter = func(v any, i int) any {
-			switch v := v.(*SetWorkspaceConfigActiveRequest); i {
+		file_eval_v1_eval_proto_msgTypes[122].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportWorkspaceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4147,8 +15686,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[40].Exporter = func(v any, i int) any {
-			switch v := v.(*SetVersionActiveRequest); i {
+		file_eval_v1_eval_proto_msgTypes[123].Exporter = func(v any, i int) any {
+			switch v := v.(*SyntheticGenerationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4159,8 +15698,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[41].Exporter = func(v any, i int) any {
-			switch v := v.(*SetXMLModeRequest); i {
+		file_eval_v1_eval_proto_msgTypes[124].Exporter = func(v any, i int) any {
+			switch v := v.(*RunPerturbationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4171,8 +15710,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[42].Exporter = func(v any, i int) any {
-			switch v := v.(*RateTestResultRequest); i {
+		file_eval_v1_eval_proto_msgTypes[125].Exporter = func(v any, i int) any {
+			switch v := v.(*PerturbationStability); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4183,8 +15722,8 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[43].Exporter = func(v any, i int) any {
-			switch v := v.(*SyntheticGenerationRequest); i {
+		file_eval_v1_eval_proto_msgTypes[126].Exporter = func(v any, i int) any {
+			switch v := v.(*RunPerturbationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4195,7 +15734,199 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[44].Exporter = func(v any, i int) any {
+		file_eval_v1_eval_proto_msgTypes[127].Exporter = func(v any, i int) any {
+			switch v := v.(*OptimizePromptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[128].Exporter = func(v any, i int) any {
+			switch v := v.(*OptimizationCandidate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[129].Exporter = func(v any, i int) any {
+			switch v := v.(*OptimizationRun); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[130].Exporter = func(v any, i int) any {
+			switch v := v.(*OptimizePromptResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[131].Exporter = func(v any, i int) any {
+			switch v := v.(*ListOptimizationRunsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[132].Exporter = func(v any, i int) any {
+			switch v := v.(*ListOptimizationRunsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[133].Exporter = func(v any, i int) any {
+			switch v := v.(*Dataset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[134].Exporter = func(v any, i int) any {
+			switch v := v.(*DatasetAttachment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[135].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateDatasetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[136].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateDatasetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[137].Exporter = func(v any, i int) any {
+			switch v := v.(*ListDatasetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[138].Exporter = func(v any, i int) any {
+			switch v := v.(*ListDatasetsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[139].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteDatasetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[140].Exporter = func(v any, i int) any {
+			switch v := v.(*AttachDatasetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[141].Exporter = func(v any, i int) any {
+			switch v := v.(*AttachDatasetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[142].Exporter = func(v any, i int) any {
+			switch v := v.(*DetachDatasetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_eval_v1_eval_proto_msgTypes[143].Exporter = func(v any, i int) any {
 			switch v := v.(*Workspace_Prompt); i {
 			case 0:
 				return &v.state
@@ -4207,7 +15938,7 @@ func file_eval_v1_eval_proto_init() {
 				return nil
 			}
 		}
-		file_eval_v1_eval_proto_msgTypes[45].Exporter = func(v any, i int) any {
+		file_eval_v1_eval_proto_msgTypes[144].Exporter = func(v any, i int) any {
 			switch v := v.(*Workspace_SystemPrompt); i {
 			case 0:
 				return &v.state
@@ -4224,16 +15955,43 @@ func file_eval_v1_eval_proto_init() {
 		(*VariableValue_TextValue)(nil),
 		(*VariableValue_ImageValue)(nil),
 	}
+	file_eval_v1_eval_proto_msgTypes[11].OneofWrappers = []any{}
 	file_eval_v1_eval_proto_msgTypes[12].OneofWrappers = []any{}
+	file_eval_v1_eval_proto_msgTypes[15].OneofWrappers = []any{}
+	file_eval_v1_eval_proto_msgTypes[29].OneofWrappers = []any{}
 	file_eval_v1_eval_proto_msgTypes[34].OneofWrappers = []any{}
-	file_eval_v1_eval_proto_msgTypes[36].OneofWrappers = []any{}
+	file_eval_v1_eval_proto_msgTypes[48].OneofWrappers = []any{}
+	file_eval_v1_eval_proto_msgTypes[50].OneofWrappers = []any{}
+	file_eval_v1_eval_proto_msgTypes[60].OneofWrappers = []any{}
+	file_eval_v1_eval_proto_msgTypes[65].OneofWrappers = []any{}
+	file_eval_v1_eval_proto_msgTypes[68].OneofWrappers = []any{}
+	file_eval_v1_eval_proto_msgTypes[71].OneofWrappers = []any{}
+	file_eval_v1_eval_proto_msgTypes[77].OneofWrappers = []any{}
+	file_eval_v1_eval_proto_msgTypes[79].OneofWrappers = []any{}
+	file_eval_v1_eval_proto_msgTypes[85].OneofWrappers = []any{}
+	file_eval_v1_eval_proto_msgTypes[92].OneofWrappers = []any{}
+	file_eval_v1_eval_proto_msgTypes[98].OneofWrappers = []any{}
+	file_eval_v1_eval_proto_msgTypes[102].OneofWrappers = []any{}
+	file_eval_v1_eval_proto_msgTypes[104].OneofWrappers = []any{}
+	file_eval_v1_eval_proto_msgTypes[106].OneofWrappers = []any{}
+	file_eval_v1_eval_proto_msgTypes[107].OneofWrappers = []any{}
+	file_eval_v1_eval_proto_msgTypes[109].OneofWrappers = []any{}
+	file_eval_v1_eval_proto_msgTypes[110].OneofWrappers = []any{}
+	file_eval_v1_eval_proto_msgTypes[118].OneofWrappers = []any{}
+	file_eval_v1_eval_proto_msgTypes[121].OneofWrappers = []any{}
+	file_eval_v1_eval_proto_msgTypes[124].OneofWrappers = []any{}
+	file_eval_v1_eval_proto_msgTypes[127].OneofWrappers = []any{}
+	file_eval_v1_eval_proto_msgTypes[135].OneofWrappers = []any{}
+	file_eval_v1_eval_proto_msgTypes[137].OneofWrappers = []any{}
+	file_eval_v1_eval_proto_msgTypes[143].OneofWrappers = []any{}
+	file_eval_v1_eval_proto_msgTypes[144].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_eval_v1_eval_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   49,
+			NumEnums:      17,
+			NumMessages:   157,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -52,9 +52,39 @@ const (
 	// EvaluationServiceUpdateWorkspaceProcedure is the fully-qualified name of the EvaluationService's
 	// UpdateWorkspace RPC.
 	EvaluationServiceUpdateWorkspaceProcedure = "/eval.v1.EvaluationService/UpdateWorkspace"
+	// EvaluationServiceExportWorkspaceProcedure is the fully-qualified name of the EvaluationService's
+	// ExportWorkspace RPC.
+	EvaluationServiceExportWorkspaceProcedure = "/eval.v1.EvaluationService/ExportWorkspace"
+	// EvaluationServiceCloneWorkspaceProcedure is the fully-qualified name of the EvaluationService's
+	// CloneWorkspace RPC.
+	EvaluationServiceCloneWorkspaceProcedure = "/eval.v1.EvaluationService/CloneWorkspace"
+	// EvaluationServiceDeleteWorkspaceProcedure is the fully-qualified name of the EvaluationService's
+	// DeleteWorkspace RPC.
+	EvaluationServiceDeleteWorkspaceProcedure = "/eval.v1.EvaluationService/DeleteWorkspace"
+	// EvaluationServiceArchiveWorkspaceProcedure is the fully-qualified name of the EvaluationService's
+	// ArchiveWorkspace RPC.
+	EvaluationServiceArchiveWorkspaceProcedure = "/eval.v1.EvaluationService/ArchiveWorkspace"
+	// EvaluationServiceExportWorkspaceBundleProcedure is the fully-qualified name of the
+	// EvaluationService's ExportWorkspaceBundle RPC.
+	EvaluationServiceExportWorkspaceBundleProcedure = "/eval.v1.EvaluationService/ExportWorkspaceBundle"
+	// EvaluationServiceImportWorkspaceBundleProcedure is the fully-qualified name of the
+	// EvaluationService's ImportWorkspaceBundle RPC.
+	EvaluationServiceImportWorkspaceBundleProcedure = "/eval.v1.EvaluationService/ImportWorkspaceBundle"
 	// EvaluationServiceGeneratePromptProcedure is the fully-qualified name of the EvaluationService's
 	// GeneratePrompt RPC.
 	EvaluationServiceGeneratePromptProcedure = "/eval.v1.EvaluationService/GeneratePrompt"
+	// EvaluationServiceDiffPromptVersionsProcedure is the fully-qualified name of the
+	// EvaluationService's DiffPromptVersions RPC.
+	EvaluationServiceDiffPromptVersionsProcedure = "/eval.v1.EvaluationService/DiffPromptVersions"
+	// EvaluationServiceLintPromptProcedure is the fully-qualified name of the EvaluationService's
+	// LintPrompt RPC.
+	EvaluationServiceLintPromptProcedure = "/eval.v1.EvaluationService/LintPrompt"
+	// EvaluationServiceOptimizePromptProcedure is the fully-qualified name of the EvaluationService's
+	// OptimizePrompt RPC.
+	EvaluationServiceOptimizePromptProcedure = "/eval.v1.EvaluationService/OptimizePrompt"
+	// EvaluationServiceListOptimizationRunsProcedure is the fully-qualified name of the
+	// EvaluationService's ListOptimizationRuns RPC.
+	EvaluationServiceListOptimizationRunsProcedure = "/eval.v1.EvaluationService/ListOptimizationRuns"
 	// EvaluationServiceCreateTestCaseProcedure is the fully-qualified name of the EvaluationService's
 	// CreateTestCase RPC.
 	EvaluationServiceCreateTestCaseProcedure = "/eval.v1.EvaluationService/CreateTestCase"
@@ -67,6 +97,24 @@ const (
 	// EvaluationServiceDeleteTestCaseProcedure is the fully-qualified name of the EvaluationService's
 	// DeleteTestCase RPC.
 	EvaluationServiceDeleteTestCaseProcedure = "/eval.v1.EvaluationService/DeleteTestCase"
+	// EvaluationServiceImportTestCasesProcedure is the fully-qualified name of the EvaluationService's
+	// ImportTestCases RPC.
+	EvaluationServiceImportTestCasesProcedure = "/eval.v1.EvaluationService/ImportTestCases"
+	// EvaluationServiceUpdateTestCasesProcedure is the fully-qualified name of the EvaluationService's
+	// UpdateTestCases RPC.
+	EvaluationServiceUpdateTestCasesProcedure = "/eval.v1.EvaluationService/UpdateTestCases"
+	// EvaluationServiceFindDuplicateTestCasesProcedure is the fully-qualified name of the
+	// EvaluationService's FindDuplicateTestCases RPC.
+	EvaluationServiceFindDuplicateTestCasesProcedure = "/eval.v1.EvaluationService/FindDuplicateTestCases"
+	// EvaluationServiceListDeletedTestCasesProcedure is the fully-qualified name of the
+	// EvaluationService's ListDeletedTestCases RPC.
+	EvaluationServiceListDeletedTestCasesProcedure = "/eval.v1.EvaluationService/ListDeletedTestCases"
+	// EvaluationServiceRestoreTestCaseProcedure is the fully-qualified name of the EvaluationService's
+	// RestoreTestCase RPC.
+	EvaluationServiceRestoreTestCaseProcedure = "/eval.v1.EvaluationService/RestoreTestCase"
+	// EvaluationServicePurgeTestCasesProcedure is the fully-qualified name of the EvaluationService's
+	// PurgeTestCases RPC.
+	EvaluationServicePurgeTestCasesProcedure = "/eval.v1.EvaluationService/PurgeTestCases"
 	// EvaluationServiceListModelConfigsProcedure is the fully-qualified name of the EvaluationService's
 	// ListModelConfigs RPC.
 	EvaluationServiceListModelConfigsProcedure = "/eval.v1.EvaluationService/ListModelConfigs"
@@ -100,6 +148,75 @@ const (
 	// EvaluationServiceRateTestResultProcedure is the fully-qualified name of the EvaluationService's
 	// RateTestResult RPC.
 	EvaluationServiceRateTestResultProcedure = "/eval.v1.EvaluationService/RateTestResult"
+	// EvaluationServiceComputeAgreementProcedure is the fully-qualified name of the EvaluationService's
+	// ComputeAgreement RPC.
+	EvaluationServiceComputeAgreementProcedure = "/eval.v1.EvaluationService/ComputeAgreement"
+	// EvaluationServiceCompareVersionsProcedure is the fully-qualified name of the EvaluationService's
+	// CompareVersions RPC.
+	EvaluationServiceCompareVersionsProcedure = "/eval.v1.EvaluationService/CompareVersions"
+	// EvaluationServiceCreateGraderProcedure is the fully-qualified name of the EvaluationService's
+	// CreateGrader RPC.
+	EvaluationServiceCreateGraderProcedure = "/eval.v1.EvaluationService/CreateGrader"
+	// EvaluationServiceListGradersProcedure is the fully-qualified name of the EvaluationService's
+	// ListGraders RPC.
+	EvaluationServiceListGradersProcedure = "/eval.v1.EvaluationService/ListGraders"
+	// EvaluationServiceDeleteGraderProcedure is the fully-qualified name of the EvaluationService's
+	// DeleteGrader RPC.
+	EvaluationServiceDeleteGraderProcedure = "/eval.v1.EvaluationService/DeleteGrader"
+	// EvaluationServiceRunGraderProcedure is the fully-qualified name of the EvaluationService's
+	// RunGrader RPC.
+	EvaluationServiceRunGraderProcedure = "/eval.v1.EvaluationService/RunGrader"
+	// EvaluationServiceCreateDatasetProcedure is the fully-qualified name of the EvaluationService's
+	// CreateDataset RPC.
+	EvaluationServiceCreateDatasetProcedure = "/eval.v1.EvaluationService/CreateDataset"
+	// EvaluationServiceListDatasetsProcedure is the fully-qualified name of the EvaluationService's
+	// ListDatasets RPC.
+	EvaluationServiceListDatasetsProcedure = "/eval.v1.EvaluationService/ListDatasets"
+	// EvaluationServiceDeleteDatasetProcedure is the fully-qualified name of the EvaluationService's
+	// DeleteDataset RPC.
+	EvaluationServiceDeleteDatasetProcedure = "/eval.v1.EvaluationService/DeleteDataset"
+	// EvaluationServiceAttachDatasetProcedure is the fully-qualified name of the EvaluationService's
+	// AttachDataset RPC.
+	EvaluationServiceAttachDatasetProcedure = "/eval.v1.EvaluationService/AttachDataset"
+	// EvaluationServiceDetachDatasetProcedure is the fully-qualified name of the EvaluationService's
+	// DetachDataset RPC.
+	EvaluationServiceDetachDatasetProcedure = "/eval.v1.EvaluationService/DetachDataset"
+	// EvaluationServicePromoteVersionProcedure is the fully-qualified name of the EvaluationService's
+	// PromoteVersion RPC.
+	EvaluationServicePromoteVersionProcedure = "/eval.v1.EvaluationService/PromoteVersion"
+	// EvaluationServiceRollbackLabelProcedure is the fully-qualified name of the EvaluationService's
+	// RollbackLabel RPC.
+	EvaluationServiceRollbackLabelProcedure = "/eval.v1.EvaluationService/RollbackLabel"
+	// EvaluationServiceListLabelsProcedure is the fully-qualified name of the EvaluationService's
+	// ListLabels RPC.
+	EvaluationServiceListLabelsProcedure = "/eval.v1.EvaluationService/ListLabels"
+	// EvaluationServiceListLabelHistoryProcedure is the fully-qualified name of the EvaluationService's
+	// ListLabelHistory RPC.
+	EvaluationServiceListLabelHistoryProcedure = "/eval.v1.EvaluationService/ListLabelHistory"
+	// EvaluationServiceCompleteProcedure is the fully-qualified name of the EvaluationService's
+	// Complete RPC.
+	EvaluationServiceCompleteProcedure = "/eval.v1.EvaluationService/Complete"
+	// EvaluationServiceListTracesProcedure is the fully-qualified name of the EvaluationService's
+	// ListTraces RPC.
+	EvaluationServiceListTracesProcedure = "/eval.v1.EvaluationService/ListTraces"
+	// EvaluationServiceRunPerturbationsProcedure is the fully-qualified name of the EvaluationService's
+	// RunPerturbations RPC.
+	EvaluationServiceRunPerturbationsProcedure = "/eval.v1.EvaluationService/RunPerturbations"
+	// EvaluationServiceCreateExampleProcedure is the fully-qualified name of the EvaluationService's
+	// CreateExample RPC.
+	EvaluationServiceCreateExampleProcedure = "/eval.v1.EvaluationService/CreateExample"
+	// EvaluationServiceListExamplesProcedure is the fully-qualified name of the EvaluationService's
+	// ListExamples RPC.
+	EvaluationServiceListExamplesProcedure = "/eval.v1.EvaluationService/ListExamples"
+	// EvaluationServiceDeleteExampleProcedure is the fully-qualified name of the EvaluationService's
+	// DeleteExample RPC.
+	EvaluationServiceDeleteExampleProcedure = "/eval.v1.EvaluationService/DeleteExample"
+	// EvaluationServiceAddExamplesFromResultsProcedure is the fully-qualified name of the
+	// EvaluationService's AddExamplesFromResults RPC.
+	EvaluationServiceAddExamplesFromResultsProcedure = "/eval.v1.EvaluationService/AddExamplesFromResults"
+	// EvaluationServiceSetExampleSettingsProcedure is the fully-qualified name of the
+	// EvaluationService's SetExampleSettings RPC.
+	EvaluationServiceSetExampleSettingsProcedure = "/eval.v1.EvaluationService/SetExampleSettings"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
@@ -111,11 +228,27 @@ var (
 	evaluationServiceGetWorkspaceMethodDescriptor               = evaluationServiceServiceDescriptor.Methods().ByName("GetWorkspace")
 	evaluationServiceListWorkspacesMethodDescriptor             = evaluationServiceServiceDescriptor.Methods().ByName("ListWorkspaces")
 	evaluationServiceUpdateWorkspaceMethodDescriptor            = evaluationServiceServiceDescriptor.Methods().ByName("UpdateWorkspace")
+	evaluationServiceExportWorkspaceMethodDescriptor            = evaluationServiceServiceDescriptor.Methods().ByName("ExportWorkspace")
+	evaluationServiceCloneWorkspaceMethodDescriptor             = evaluationServiceServiceDescriptor.Methods().ByName("CloneWorkspace")
+	evaluationServiceDeleteWorkspaceMethodDescriptor            = evaluationServiceServiceDescriptor.Methods().ByName("DeleteWorkspace")
+	evaluationServiceArchiveWorkspaceMethodDescriptor           = evaluationServiceServiceDescriptor.Methods().ByName("ArchiveWorkspace")
+	evaluationServiceExportWorkspaceBundleMethodDescriptor      = evaluationServiceServiceDescriptor.Methods().ByName("ExportWorkspaceBundle")
+	evaluationServiceImportWorkspaceBundleMethodDescriptor      = evaluationServiceServiceDescriptor.Methods().ByName("ImportWorkspaceBundle")
 	evaluationServiceGeneratePromptMethodDescriptor             = evaluationServiceServiceDescriptor.Methods().ByName("GeneratePrompt")
+	evaluationServiceDiffPromptVersionsMethodDescriptor         = evaluationServiceServiceDescriptor.Methods().ByName("DiffPromptVersions")
+	evaluationServiceLintPromptMethodDescriptor                 = evaluationServiceServiceDescriptor.Methods().ByName("LintPrompt")
+	evaluationServiceOptimizePromptMethodDescriptor             = evaluationServiceServiceDescriptor.Methods().ByName("OptimizePrompt")
+	evaluationServiceListOptimizationRunsMethodDescriptor       = evaluationServiceServiceDescriptor.Methods().ByName("ListOptimizationRuns")
 	evaluationServiceCreateTestCaseMethodDescriptor             = evaluationServiceServiceDescriptor.Methods().ByName("CreateTestCase")
 	evaluationServiceListTestCasesMethodDescriptor              = evaluationServiceServiceDescriptor.Methods().ByName("ListTestCases")
 	evaluationServiceGenerateTestCaseMethodDescriptor           = evaluationServiceServiceDescriptor.Methods().ByName("GenerateTestCase")
 	evaluationServiceDeleteTestCaseMethodDescriptor             = evaluationServiceServiceDescriptor.Methods().ByName("DeleteTestCase")
+	evaluationServiceImportTestCasesMethodDescriptor            = evaluationServiceServiceDescriptor.Methods().ByName("ImportTestCases")
+	evaluationServiceUpdateTestCasesMethodDescriptor            = evaluationServiceServiceDescriptor.Methods().ByName("UpdateTestCases")
+	evaluationServiceFindDuplicateTestCasesMethodDescriptor     = evaluationServiceServiceDescriptor.Methods().ByName("FindDuplicateTestCases")
+	evaluationServiceListDeletedTestCasesMethodDescriptor       = evaluationServiceServiceDescriptor.Methods().ByName("ListDeletedTestCases")
+	evaluationServiceRestoreTestCaseMethodDescriptor            = evaluationServiceServiceDescriptor.Methods().ByName("RestoreTestCase")
+	evaluationServicePurgeTestCasesMethodDescriptor             = evaluationServiceServiceDescriptor.Methods().ByName("PurgeTestCases")
 	evaluationServiceListModelConfigsMethodDescriptor           = evaluationServiceServiceDescriptor.Methods().ByName("ListModelConfigs")
 	evaluationServiceGetDefaultSmallModelConfigMethodDescriptor = evaluationServiceServiceDescriptor.Methods().ByName("GetDefaultSmallModelConfig")
 	evaluationServiceGetDefaultLargeModelConfigMethodDescriptor = evaluationServiceServiceDescriptor.Methods().ByName("GetDefaultLargeModelConfig")
@@ -127,6 +260,29 @@ var (
 	evaluationServiceSetVersionActiveMethodDescriptor           = evaluationServiceServiceDescriptor.Methods().ByName("SetVersionActive")
 	evaluationServiceSetXMLModeMethodDescriptor                 = evaluationServiceServiceDescriptor.Methods().ByName("SetXMLMode")
 	evaluationServiceRateTestResultMethodDescriptor             = evaluationServiceServiceDescriptor.Methods().ByName("RateTestResult")
+	evaluationServiceComputeAgreementMethodDescriptor           = evaluationServiceServiceDescriptor.Methods().ByName("ComputeAgreement")
+	evaluationServiceCompareVersionsMethodDescriptor            = evaluationServiceServiceDescriptor.Methods().ByName("CompareVersions")
+	evaluationServiceCreateGraderMethodDescriptor               = evaluationServiceServiceDescriptor.Methods().ByName("CreateGrader")
+	evaluationServiceListGradersMethodDescriptor                = evaluationServiceServiceDescriptor.Methods().ByName("ListGraders")
+	evaluationServiceDeleteGraderMethodDescriptor               = evaluationServiceServiceDescriptor.Methods().ByName("DeleteGrader")
+	evaluationServiceRunGraderMethodDescriptor                  = evaluationServiceServiceDescriptor.Methods().ByName("RunGrader")
+	evaluationServiceCreateDatasetMethodDescriptor              = evaluationServiceServiceDescriptor.Methods().ByName("CreateDataset")
+	evaluationServiceListDatasetsMethodDescriptor               = evaluationServiceServiceDescriptor.Methods().ByName("ListDatasets")
+	evaluationServiceDeleteDatasetMethodDescriptor              = evaluationServiceServiceDescriptor.Methods().ByName("DeleteDataset")
+	evaluationServiceAttachDatasetMethodDescriptor              = evaluationServiceServiceDescriptor.Methods().ByName("AttachDataset")
+	evaluationServiceDetachDatasetMethodDescriptor              = evaluationServiceServiceDescriptor.Methods().ByName("DetachDataset")
+	evaluationServicePromoteVersionMethodDescriptor             = evaluationServiceServiceDescriptor.Methods().ByName("PromoteVersion")
+	evaluationServiceRollbackLabelMethodDescriptor              = evaluationServiceServiceDescriptor.Methods().ByName("RollbackLabel")
+	evaluationServiceListLabelsMethodDescriptor                 = evaluationServiceServiceDescriptor.Methods().ByName("ListLabels")
+	evaluationServiceListLabelHistoryMethodDescriptor           = evaluationServiceServiceDescriptor.Methods().ByName("ListLabelHistory")
+	evaluationServiceCompleteMethodDescriptor                   = evaluationServiceServiceDescriptor.Methods().ByName("Complete")
+	evaluationServiceListTracesMethodDescriptor                 = evaluationServiceServiceDescriptor.Methods().ByName("ListTraces")
+	evaluationServiceRunPerturbationsMethodDescriptor           = evaluationServiceServiceDescriptor.Methods().ByName("RunPerturbations")
+	evaluationServiceCreateExampleMethodDescriptor              = evaluationServiceServiceDescriptor.Methods().ByName("CreateExample")
+	evaluationServiceListExamplesMethodDescriptor               = evaluationServiceServiceDescriptor.Methods().ByName("ListExamples")
+	evaluationServiceDeleteExampleMethodDescriptor              = evaluationServiceServiceDescriptor.Methods().ByName("DeleteExample")
+	evaluationServiceAddExamplesFromResultsMethodDescriptor     = evaluationServiceServiceDescriptor.Methods().ByName("AddExamplesFromResults")
+	evaluationServiceSetExampleSettingsMethodDescriptor         = evaluationServiceServiceDescriptor.Methods().ByName("SetExampleSettings")
 )
 
 // EvaluationServiceClient is a client for the eval.v1.EvaluationService service.
@@ -138,13 +294,29 @@ type EvaluationServiceClient interface {
 	GetWorkspace(context.Context, *connect.Request[v1.GetWorkspaceRequest]) (*connect.Response[v1.GetWorkspaceResponse], error)
 	ListWorkspaces(context.Context, *connect.Request[v1.ListWorkspacesRequest]) (*connect.Response[v1.ListWorkspacesResponse], error)
 	UpdateWorkspace(context.Context, *connect.Request[v1.UpdateWorkspaceRequest]) (*connect.Response[v1.UpdateWorkspaceResponse], error)
+	ExportWorkspace(context.Context, *connect.Request[v1.ExportWorkspaceRequest]) (*connect.ServerStreamForClient[v1.ExportWorkspaceResponse], error)
+	CloneWorkspace(context.Context, *connect.Request[v1.CloneWorkspaceRequest]) (*connect.Response[v1.CloneWorkspaceResponse], error)
+	DeleteWorkspace(context.Context, *connect.Request[v1.DeleteWorkspaceRequest]) (*connect.Response[emptypb.Empty], error)
+	ArchiveWorkspace(context.Context, *connect.Request[v1.ArchiveWorkspaceRequest]) (*connect.Response[emptypb.Empty], error)
+	ExportWorkspaceBundle(context.Context, *connect.Request[v1.ExportWorkspaceBundleRequest]) (*connect.Response[v1.ExportWorkspaceBundleResponse], error)
+	ImportWorkspaceBundle(context.Context, *connect.Request[v1.ImportWorkspaceBundleRequest]) (*connect.Response[v1.ImportWorkspaceBundleResponse], error)
 	// Prompt operations
 	GeneratePrompt(context.Context, *connect.Request[v1.GeneratePromptRequest]) (*connect.Response[v1.GeneratePromptResponse], error)
+	DiffPromptVersions(context.Context, *connect.Request[v1.DiffPromptVersionsRequest]) (*connect.Response[v1.DiffPromptVersionsResponse], error)
+	LintPrompt(context.Context, *connect.Request[v1.LintPromptRequest]) (*connect.Response[v1.LintPromptResponse], error)
+	OptimizePrompt(context.Context, *connect.Request[v1.OptimizePromptRequest]) (*connect.Response[v1.OptimizePromptResponse], error)
+	ListOptimizationRuns(context.Context, *connect.Request[v1.ListOptimizationRunsRequest]) (*connect.Response[v1.ListOptimizationRunsResponse], error)
 	// TestCase operations
 	CreateTestCase(context.Context, *connect.Request[v1.CreateTestCaseRequest]) (*connect.Response[v1.CreateTestCaseResponse], error)
 	ListTestCases(context.Context, *connect.Request[v1.ListTestCasesRequest]) (*connect.Response[v1.ListTestCasesResponse], error)
 	GenerateTestCase(context.Context, *connect.Request[v1.GenerateTestCaseRequest]) (*connect.Response[v1.GenerateTestCaseResponse], error)
 	DeleteTestCase(context.Context, *connect.Request[v1.DeleteTestCaseRequest]) (*connect.Response[emptypb.Empty], error)
+	ImportTestCases(context.Context, *connect.Request[v1.ImportTestCasesRequest]) (*connect.Response[v1.ImportTestCasesResponse], error)
+	UpdateTestCases(context.Context, *connect.Request[v1.UpdateTestCasesRequest]) (*connect.Response[v1.UpdateTestCasesResponse], error)
+	FindDuplicateTestCases(context.Context, *connect.Request[v1.FindDuplicateTestCasesRequest]) (*connect.Response[v1.FindDuplicateTestCasesResponse], error)
+	ListDeletedTestCases(context.Context, *connect.Request[v1.ListDeletedTestCasesRequest]) (*connect.Response[v1.ListDeletedTestCasesResponse], error)
+	RestoreTestCase(context.Context, *connect.Request[v1.RestoreTestCaseRequest]) (*connect.Response[v1.RestoreTestCaseResponse], error)
+	PurgeTestCases(context.Context, *connect.Request[v1.PurgeTestCasesRequest]) (*connect.Response[v1.PurgeTestCasesResponse], error)
 	// ModelConfig operations
 	ListModelConfigs(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListModelConfigsResponse], error)
 	GetDefaultSmallModelConfig(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetModelConfigResponse], error)
@@ -157,6 +329,36 @@ type EvaluationServiceClient interface {
 	SetVersionActive(context.Context, *connect.Request[v1.SetVersionActiveRequest]) (*connect.Response[emptypb.Empty], error)
 	SetXMLMode(context.Context, *connect.Request[v1.SetXMLModeRequest]) (*connect.Response[emptypb.Empty], error)
 	RateTestResult(context.Context, *connect.Request[v1.RateTestResultRequest]) (*connect.Response[emptypb.Empty], error)
+	// Rating operations
+	ComputeAgreement(context.Context, *connect.Request[v1.ComputeAgreementRequest]) (*connect.Response[v1.ComputeAgreementResponse], error)
+	CompareVersions(context.Context, *connect.Request[v1.CompareVersionsRequest]) (*connect.Response[v1.CompareVersionsResponse], error)
+	// Grader operations
+	CreateGrader(context.Context, *connect.Request[v1.CreateGraderRequest]) (*connect.Response[v1.CreateGraderResponse], error)
+	ListGraders(context.Context, *connect.Request[v1.ListGradersRequest]) (*connect.Response[v1.ListGradersResponse], error)
+	DeleteGrader(context.Context, *connect.Request[v1.DeleteGraderRequest]) (*connect.Response[emptypb.Empty], error)
+	RunGrader(context.Context, *connect.Request[v1.RunGraderRequest]) (*connect.Response[v1.RunGraderResponse], error)
+	// Dataset operations
+	CreateDataset(context.Context, *connect.Request[v1.CreateDatasetRequest]) (*connect.Response[v1.CreateDatasetResponse], error)
+	ListDatasets(context.Context, *connect.Request[v1.ListDatasetsRequest]) (*connect.Response[v1.ListDatasetsResponse], error)
+	DeleteDataset(context.Context, *connect.Request[v1.DeleteDatasetRequest]) (*connect.Response[emptypb.Empty], error)
+	AttachDataset(context.Context, *connect.Request[v1.AttachDatasetRequest]) (*connect.Response[v1.AttachDatasetResponse], error)
+	DetachDataset(context.Context, *connect.Request[v1.DetachDatasetRequest]) (*connect.Response[emptypb.Empty], error)
+	// Label operations
+	PromoteVersion(context.Context, *connect.Request[v1.PromoteVersionRequest]) (*connect.Response[v1.PromoteVersionResponse], error)
+	RollbackLabel(context.Context, *connect.Request[v1.RollbackLabelRequest]) (*connect.Response[v1.RollbackLabelResponse], error)
+	ListLabels(context.Context, *connect.Request[v1.ListLabelsRequest]) (*connect.Response[v1.ListLabelsResponse], error)
+	ListLabelHistory(context.Context, *connect.Request[v1.ListLabelHistoryRequest]) (*connect.Response[v1.ListLabelHistoryResponse], error)
+	// Serving operations
+	Complete(context.Context, *connect.Request[v1.CompleteRequest]) (*connect.Response[v1.CompleteResponse], error)
+	ListTraces(context.Context, *connect.Request[v1.ListTracesRequest]) (*connect.Response[v1.ListTracesResponse], error)
+	// Robustness operations
+	RunPerturbations(context.Context, *connect.Request[v1.RunPerturbationsRequest]) (*connect.Response[v1.RunPerturbationsResponse], error)
+	// Example operations
+	CreateExample(context.Context, *connect.Request[v1.CreateExampleRequest]) (*connect.Response[v1.CreateExampleResponse], error)
+	ListExamples(context.Context, *connect.Request[v1.ListExamplesRequest]) (*connect.Response[v1.ListExamplesResponse], error)
+	DeleteExample(context.Context, *connect.Request[v1.DeleteExampleRequest]) (*connect.Response[emptypb.Empty], error)
+	AddExamplesFromResults(context.Context, *connect.Request[v1.AddExamplesFromResultsRequest]) (*connect.Response[v1.AddExamplesFromResultsResponse], error)
+	SetExampleSettings(context.Context, *connect.Request[v1.SetExampleSettingsRequest]) (*connect.Response[emptypb.Empty], error)
 }
 
 // NewEvaluationServiceClient constructs a client for the eval.v1.EvaluationService service. By
@@ -205,12 +407,72 @@ func NewEvaluationServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(evaluationServiceUpdateWorkspaceMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		exportWorkspace: connect.NewClient[v1.ExportWorkspaceRequest, v1.ExportWorkspaceResponse](
+			httpClient,
+			baseURL+EvaluationServiceExportWorkspaceProcedure,
+			connect.WithSchema(evaluationServiceExportWorkspaceMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		cloneWorkspace: connect.NewClient[v1.CloneWorkspaceRequest, v1.CloneWorkspaceResponse](
+			httpClient,
+			baseURL+EvaluationServiceCloneWorkspaceProcedure,
+			connect.WithSchema(evaluationServiceCloneWorkspaceMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteWorkspace: connect.NewClient[v1.DeleteWorkspaceRequest, emptypb.Empty](
+			httpClient,
+			baseURL+EvaluationServiceDeleteWorkspaceProcedure,
+			connect.WithSchema(evaluationServiceDeleteWorkspaceMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		archiveWorkspace: connect.NewClient[v1.ArchiveWorkspaceRequest, emptypb.Empty](
+			httpClient,
+			baseURL+EvaluationServiceArchiveWorkspaceProcedure,
+			connect.WithSchema(evaluationServiceArchiveWorkspaceMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		exportWorkspaceBundle: connect.NewClient[v1.ExportWorkspaceBundleRequest, v1.ExportWorkspaceBundleResponse](
+			httpClient,
+			baseURL+EvaluationServiceExportWorkspaceBundleProcedure,
+			connect.WithSchema(evaluationServiceExportWorkspaceBundleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		importWorkspaceBundle: connect.NewClient[v1.ImportWorkspaceBundleRequest, v1.ImportWorkspaceBundleResponse](
+			httpClient,
+			baseURL+EvaluationServiceImportWorkspaceBundleProcedure,
+			connect.WithSchema(evaluationServiceImportWorkspaceBundleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		generatePrompt: connect.NewClient[v1.GeneratePromptRequest, v1.GeneratePromptResponse](
 			httpClient,
 			baseURL+EvaluationServiceGeneratePromptProcedure,
 			connect.WithSchema(evaluationServiceGeneratePromptMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		diffPromptVersions: connect.NewClient[v1.DiffPromptVersionsRequest, v1.DiffPromptVersionsResponse](
+			httpClient,
+			baseURL+EvaluationServiceDiffPromptVersionsProcedure,
+			connect.WithSchema(evaluationServiceDiffPromptVersionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		lintPrompt: connect.NewClient[v1.LintPromptRequest, v1.LintPromptResponse](
+			httpClient,
+			baseURL+EvaluationServiceLintPromptProcedure,
+			connect.WithSchema(evaluationServiceLintPromptMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		optimizePrompt: connect.NewClient[v1.OptimizePromptRequest, v1.OptimizePromptResponse](
+			httpClient,
+			baseURL+EvaluationServiceOptimizePromptProcedure,
+			connect.WithSchema(evaluationServiceOptimizePromptMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listOptimizationRuns: connect.NewClient[v1.ListOptimizationRunsRequest, v1.ListOptimizationRunsResponse](
+			httpClient,
+			baseURL+EvaluationServiceListOptimizationRunsProcedure,
+			connect.WithSchema(evaluationServiceListOptimizationRunsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		createTestCase: connect.NewClient[v1.CreateTestCaseRequest, v1.CreateTestCaseResponse](
 			httpClient,
 			baseURL+EvaluationServiceCreateTestCaseProcedure,
@@ -235,6 +497,42 @@ func NewEvaluationServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(evaluationServiceDeleteTestCaseMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		importTestCases: connect.NewClient[v1.ImportTestCasesRequest, v1.ImportTestCasesResponse](
+			httpClient,
+			baseURL+EvaluationServiceImportTestCasesProcedure,
+			connect.WithSchema(evaluationServiceImportTestCasesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateTestCases: connect.NewClient[v1.UpdateTestCasesRequest, v1.UpdateTestCasesResponse](
+			httpClient,
+			baseURL+EvaluationServiceUpdateTestCasesProcedure,
+			connect.WithSchema(evaluationServiceUpdateTestCasesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		findDuplicateTestCases: connect.NewClient[v1.FindDuplicateTestCasesRequest, v1.FindDuplicateTestCasesResponse](
+			httpClient,
+			baseURL+EvaluationServiceFindDuplicateTestCasesProcedure,
+			connect.WithSchema(evaluationServiceFindDuplicateTestCasesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listDeletedTestCases: connect.NewClient[v1.ListDeletedTestCasesRequest, v1.ListDeletedTestCasesResponse](
+			httpClient,
+			baseURL+EvaluationServiceListDeletedTestCasesProcedure,
+			connect.WithSchema(evaluationServiceListDeletedTestCasesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		restoreTestCase: connect.NewClient[v1.RestoreTestCaseRequest, v1.RestoreTestCaseResponse](
+			httpClient,
+			baseURL+EvaluationServiceRestoreTestCaseProcedure,
+			connect.WithSchema(evaluationServiceRestoreTestCaseMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		purgeTestCases: connect.NewClient[v1.PurgeTestCasesRequest, v1.PurgeTestCasesResponse](
+			httpClient,
+			baseURL+EvaluationServicePurgeTestCasesProcedure,
+			connect.WithSchema(evaluationServicePurgeTestCasesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		listModelConfigs: connect.NewClient[emptypb.Empty, v1.ListModelConfigsResponse](
 			httpClient,
 			baseURL+EvaluationServiceListModelConfigsProcedure,
@@ -301,6 +599,144 @@ func NewEvaluationServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(evaluationServiceRateTestResultMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		computeAgreement: connect.NewClient[v1.ComputeAgreementRequest, v1.ComputeAgreementResponse](
+			httpClient,
+			baseURL+EvaluationServiceComputeAgreementProcedure,
+			connect.WithSchema(evaluationServiceComputeAgreementMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		compareVersions: connect.NewClient[v1.CompareVersionsRequest, v1.CompareVersionsResponse](
+			httpClient,
+			baseURL+EvaluationServiceCompareVersionsProcedure,
+			connect.WithSchema(evaluationServiceCompareVersionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createGrader: connect.NewClient[v1.CreateGraderRequest, v1.CreateGraderResponse](
+			httpClient,
+			baseURL+EvaluationServiceCreateGraderProcedure,
+			connect.WithSchema(evaluationServiceCreateGraderMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listGraders: connect.NewClient[v1.ListGradersRequest, v1.ListGradersResponse](
+			httpClient,
+			baseURL+EvaluationServiceListGradersProcedure,
+			connect.WithSchema(evaluationServiceListGradersMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteGrader: connect.NewClient[v1.DeleteGraderRequest, emptypb.Empty](
+			httpClient,
+			baseURL+EvaluationServiceDeleteGraderProcedure,
+			connect.WithSchema(evaluationServiceDeleteGraderMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		runGrader: connect.NewClient[v1.RunGraderRequest, v1.RunGraderResponse](
+			httpClient,
+			baseURL+EvaluationServiceRunGraderProcedure,
+			connect.WithSchema(evaluationServiceRunGraderMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createDataset: connect.NewClient[v1.CreateDatasetRequest, v1.CreateDatasetResponse](
+			httpClient,
+			baseURL+EvaluationServiceCreateDatasetProcedure,
+			connect.WithSchema(evaluationServiceCreateDatasetMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listDatasets: connect.NewClient[v1.ListDatasetsRequest, v1.ListDatasetsResponse](
+			httpClient,
+			baseURL+EvaluationServiceListDatasetsProcedure,
+			connect.WithSchema(evaluationServiceListDatasetsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteDataset: connect.NewClient[v1.DeleteDatasetRequest, emptypb.Empty](
+			httpClient,
+			baseURL+EvaluationServiceDeleteDatasetProcedure,
+			connect.WithSchema(evaluationServiceDeleteDatasetMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		attachDataset: connect.NewClient[v1.AttachDatasetRequest, v1.AttachDatasetResponse](
+			httpClient,
+			baseURL+EvaluationServiceAttachDatasetProcedure,
+			connect.WithSchema(evaluationServiceAttachDatasetMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		detachDataset: connect.NewClient[v1.DetachDatasetRequest, emptypb.Empty](
+			httpClient,
+			baseURL+EvaluationServiceDetachDatasetProcedure,
+			connect.WithSchema(evaluationServiceDetachDatasetMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		promoteVersion: connect.NewClient[v1.PromoteVersionRequest, v1.PromoteVersionResponse](
+			httpClient,
+			baseURL+EvaluationServicePromoteVersionProcedure,
+			connect.WithSchema(evaluationServicePromoteVersionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		rollbackLabel: connect.NewClient[v1.RollbackLabelRequest, v1.RollbackLabelResponse](
+			httpClient,
+			baseURL+EvaluationServiceRollbackLabelProcedure,
+			connect.WithSchema(evaluationServiceRollbackLabelMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listLabels: connect.NewClient[v1.ListLabelsRequest, v1.ListLabelsResponse](
+			httpClient,
+			baseURL+EvaluationServiceListLabelsProcedure,
+			connect.WithSchema(evaluationServiceListLabelsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listLabelHistory: connect.NewClient[v1.ListLabelHistoryRequest, v1.ListLabelHistoryResponse](
+			httpClient,
+			baseURL+EvaluationServiceListLabelHistoryProcedure,
+			connect.WithSchema(evaluationServiceListLabelHistoryMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		complete: connect.NewClient[v1.CompleteRequest, v1.CompleteResponse](
+			httpClient,
+			baseURL+EvaluationServiceCompleteProcedure,
+			connect.WithSchema(evaluationServiceCompleteMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listTraces: connect.NewClient[v1.ListTracesRequest, v1.ListTracesResponse](
+			httpClient,
+			baseURL+EvaluationServiceListTracesProcedure,
+			connect.WithSchema(evaluationServiceListTracesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		runPerturbations: connect.NewClient[v1.RunPerturbationsRequest, v1.RunPerturbationsResponse](
+			httpClient,
+			baseURL+EvaluationServiceRunPerturbationsProcedure,
+			connect.WithSchema(evaluationServiceRunPerturbationsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createExample: connect.NewClient[v1.CreateExampleRequest, v1.CreateExampleResponse](
+			httpClient,
+			baseURL+EvaluationServiceCreateExampleProcedure,
+			connect.WithSchema(evaluationServiceCreateExampleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listExamples: connect.NewClient[v1.ListExamplesRequest, v1.ListExamplesResponse](
+			httpClient,
+			baseURL+EvaluationServiceListExamplesProcedure,
+			connect.WithSchema(evaluationServiceListExamplesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteExample: connect.NewClient[v1.DeleteExampleRequest, emptypb.Empty](
+			httpClient,
+			baseURL+EvaluationServiceDeleteExampleProcedure,
+			connect.WithSchema(evaluationServiceDeleteExampleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		addExamplesFromResults: connect.NewClient[v1.AddExamplesFromResultsRequest, v1.AddExamplesFromResultsResponse](
+			httpClient,
+			baseURL+EvaluationServiceAddExamplesFromResultsProcedure,
+			connect.WithSchema(evaluationServiceAddExamplesFromResultsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setExampleSettings: connect.NewClient[v1.SetExampleSettingsRequest, emptypb.Empty](
+			httpClient,
+			baseURL+EvaluationServiceSetExampleSettingsProcedure,
+			connect.WithSchema(evaluationServiceSetExampleSettingsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -312,11 +748,27 @@ type evaluationServiceClient struct {
 	getWorkspace               *connect.Client[v1.GetWorkspaceRequest, v1.GetWorkspaceResponse]
 	listWorkspaces             *connect.Client[v1.ListWorkspacesRequest, v1.ListWorkspacesResponse]
 	updateWorkspace            *connect.Client[v1.UpdateWorkspaceRequest, v1.UpdateWorkspaceResponse]
+	exportWorkspace            *connect.Client[v1.ExportWorkspaceRequest, v1.ExportWorkspaceResponse]
+	cloneWorkspace             *connect.Client[v1.CloneWorkspaceRequest, v1.CloneWorkspaceResponse]
+	deleteWorkspace            *connect.Client[v1.DeleteWorkspaceRequest, emptypb.Empty]
+	archiveWorkspace           *connect.Client[v1.ArchiveWorkspaceRequest, emptypb.Empty]
+	exportWorkspaceBundle      *connect.Client[v1.ExportWorkspaceBundleRequest, v1.ExportWorkspaceBundleResponse]
+	importWorkspaceBundle      *connect.Client[v1.ImportWorkspaceBundleRequest, v1.ImportWorkspaceBundleResponse]
 	generatePrompt             *connect.Client[v1.GeneratePromptRequest, v1.GeneratePromptResponse]
+	diffPromptVersions         *connect.Client[v1.DiffPromptVersionsRequest, v1.DiffPromptVersionsResponse]
+	lintPrompt                 *connect.Client[v1.LintPromptRequest, v1.LintPromptResponse]
+	optimizePrompt             *connect.Client[v1.OptimizePromptRequest, v1.OptimizePromptResponse]
+	listOptimizationRuns       *connect.Client[v1.ListOptimizationRunsRequest, v1.ListOptimizationRunsResponse]
 	createTestCase             *connect.Client[v1.CreateTestCaseRequest, v1.CreateTestCaseResponse]
 	listTestCases              *connect.Client[v1.ListTestCasesRequest, v1.ListTestCasesResponse]
 	generateTestCase           *connect.Client[v1.GenerateTestCaseRequest, v1.GenerateTestCaseResponse]
 	deleteTestCase             *connect.Client[v1.DeleteTestCaseRequest, emptypb.Empty]
+	importTestCases            *connect.Client[v1.ImportTestCasesRequest, v1.ImportTestCasesResponse]
+	updateTestCases            *connect.Client[v1.UpdateTestCasesRequest, v1.UpdateTestCasesResponse]
+	findDuplicateTestCases     *connect.Client[v1.FindDuplicateTestCasesRequest, v1.FindDuplicateTestCasesResponse]
+	listDeletedTestCases       *connect.Client[v1.ListDeletedTestCasesRequest, v1.ListDeletedTestCasesResponse]
+	restoreTestCase            *connect.Client[v1.RestoreTestCaseRequest, v1.RestoreTestCaseResponse]
+	purgeTestCases             *connect.Client[v1.PurgeTestCasesRequest, v1.PurgeTestCasesResponse]
 	listModelConfigs           *connect.Client[emptypb.Empty, v1.ListModelConfigsResponse]
 	getDefaultSmallModelConfig *connect.Client[emptypb.Empty, v1.GetModelConfigResponse]
 	getDefaultLargeModelConfig *connect.Client[emptypb.Empty, v1.GetModelConfigResponse]
@@ -328,6 +780,29 @@ type evaluationServiceClient struct {
 	setVersionActive           *connect.Client[v1.SetVersionActiveRequest, emptypb.Empty]
 	setXMLMode                 *connect.Client[v1.SetXMLModeRequest, emptypb.Empty]
 	rateTestResult             *connect.Client[v1.RateTestResultRequest, emptypb.Empty]
+	computeAgreement           *connect.Client[v1.ComputeAgreementRequest, v1.ComputeAgreementResponse]
+	compareVersions            *connect.Client[v1.CompareVersionsRequest, v1.CompareVersionsResponse]
+	createGrader               *connect.Client[v1.CreateGraderRequest, v1.CreateGraderResponse]
+	listGraders                *connect.Client[v1.ListGradersRequest, v1.ListGradersResponse]
+	deleteGrader               *connect.Client[v1.DeleteGraderRequest, emptypb.Empty]
+	runGrader                  *connect.Client[v1.RunGraderRequest, v1.RunGraderResponse]
+	createDataset              *connect.Client[v1.CreateDatasetRequest, v1.CreateDatasetResponse]
+	listDatasets               *connect.Client[v1.ListDatasetsRequest, v1.ListDatasetsResponse]
+	deleteDataset              *connect.Client[v1.DeleteDatasetRequest, emptypb.Empty]
+	attachDataset              *connect.Client[v1.AttachDatasetRequest, v1.AttachDatasetResponse]
+	detachDataset              *connect.Client[v1.DetachDatasetRequest, emptypb.Empty]
+	promoteVersion             *connect.Client[v1.PromoteVersionRequest, v1.PromoteVersionResponse]
+	rollbackLabel              *connect.Client[v1.RollbackLabelRequest, v1.RollbackLabelResponse]
+	listLabels                 *connect.Client[v1.ListLabelsRequest, v1.ListLabelsResponse]
+	listLabelHistory           *connect.Client[v1.ListLabelHistoryRequest, v1.ListLabelHistoryResponse]
+	complete                   *connect.Client[v1.CompleteRequest, v1.CompleteResponse]
+	listTraces                 *connect.Client[v1.ListTracesRequest, v1.ListTracesResponse]
+	runPerturbations           *connect.Client[v1.RunPerturbationsRequest, v1.RunPerturbationsResponse]
+	createExample              *connect.Client[v1.CreateExampleRequest, v1.CreateExampleResponse]
+	listExamples               *connect.Client[v1.ListExamplesRequest, v1.ListExamplesResponse]
+	deleteExample              *connect.Client[v1.DeleteExampleRequest, emptypb.Empty]
+	addExamplesFromResults     *connect.Client[v1.AddExamplesFromResultsRequest, v1.AddExamplesFromResultsResponse]
+	setExampleSettings         *connect.Client[v1.SetExampleSettingsRequest, emptypb.Empty]
 }
 
 // Evaluate calls eval.v1.EvaluationService.Evaluate.
@@ -360,11 +835,61 @@ func (c *evaluationServiceClient) UpdateWorkspace(ctx context.Context, req *conn
 	return c.updateWorkspace.CallUnary(ctx, req)
 }
 
+// ExportWorkspace calls eval.v1.EvaluationService.ExportWorkspace.
+func (c *evaluationServiceClient) ExportWorkspace(ctx context.Context, req *connect.Request[v1.ExportWorkspaceRequest]) (*connect.ServerStreamForClient[v1.ExportWorkspaceResponse], error) {
+	return c.exportWorkspace.CallServerStream(ctx, req)
+}
+
+// CloneWorkspace calls eval.v1.EvaluationService.CloneWorkspace.
+func (c *evaluationServiceClient) CloneWorkspace(ctx context.Context, req *connect.Request[v1.CloneWorkspaceRequest]) (*connect.Response[v1.CloneWorkspaceResponse], error) {
+	return c.cloneWorkspace.CallUnary(ctx, req)
+}
+
+// DeleteWorkspace calls eval.v1.EvaluationService.DeleteWorkspace.
+func (c *evaluationServiceClient) DeleteWorkspace(ctx context.Context, req *connect.Request[v1.DeleteWorkspaceRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteWorkspace.CallUnary(ctx, req)
+}
+
+// ArchiveWorkspace calls eval.v1.EvaluationService.ArchiveWorkspace.
+func (c *evaluationServiceClient) ArchiveWorkspace(ctx context.Context, req *connect.Request[v1.ArchiveWorkspaceRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.archiveWorkspace.CallUnary(ctx, req)
+}
+
+// ExportWorkspaceBundle calls eval.v1.EvaluationService.ExportWorkspaceBundle.
+func (c *evaluationServiceClient) ExportWorkspaceBundle(ctx context.Context, req *connect.Request[v1.ExportWorkspaceBundleRequest]) (*connect.Response[v1.ExportWorkspaceBundleResponse], error) {
+	return c.exportWorkspaceBundle.CallUnary(ctx, req)
+}
+
+// ImportWorkspaceBundle calls eval.v1.EvaluationService.ImportWorkspaceBundle.
+func (c *evaluationServiceClient) ImportWorkspaceBundle(ctx context.Context, req *connect.Request[v1.ImportWorkspaceBundleRequest]) (*connect.Response[v1.ImportWorkspaceBundleResponse], error) {
+	return c.importWorkspaceBundle.CallUnary(ctx, req)
+}
+
 // GeneratePrompt calls eval.v1.EvaluationService.GeneratePrompt.
 func (c *evaluationServiceClient) GeneratePrompt(ctx context.Context, req *connect.Request[v1.GeneratePromptRequest]) (*connect.Response[v1.GeneratePromptResponse], error) {
 	return c.generatePrompt.CallUnary(ctx, req)
 }
 
+// DiffPromptVersions calls eval.v1.EvaluationService.DiffPromptVersions.
+func (c *evaluationServiceClient) DiffPromptVersions(ctx context.Context, req *connect.Request[v1.DiffPromptVersionsRequest]) (*connect.Response[v1.DiffPromptVersionsResponse], error) {
+	return c.diffPromptVersions.CallUnary(ctx, req)
+}
+
+// LintPrompt calls eval.v1.EvaluationService.LintPrompt.
+func (c *evaluationServiceClient) LintPrompt(ctx context.Context, req *connect.Request[v1.LintPromptRequest]) (*connect.Response[v1.LintPromptResponse], error) {
+	return c.lintPrompt.CallUnary(ctx, req)
+}
+
+// OptimizePrompt calls eval.v1.EvaluationService.OptimizePrompt.
+func (c *evaluationServiceClient) OptimizePrompt(ctx context.Context, req *connect.Request[v1.OptimizePromptRequest]) (*connect.Response[v1.OptimizePromptResponse], error) {
+	return c.optimizePrompt.CallUnary(ctx, req)
+}
+
+// ListOptimizationRuns calls eval.v1.EvaluationService.ListOptimizationRuns.
+func (c *evaluationServiceClient) ListOptimizationRuns(ctx context.Context, req *connect.Request[v1.ListOptimizationRunsRequest]) (*connect.Response[v1.ListOptimizationRunsResponse], error) {
+	return c.listOptimizationRuns.CallUnary(ctx, req)
+}
+
 // CreateTestCase calls eval.v1.EvaluationService.CreateTestCase.
 func (c *evaluationServiceClient) CreateTestCase(ctx context.Context, req *connect.Request[v1.CreateTestCaseRequest]) (*connect.Response[v1.CreateTestCaseResponse], error) {
 	return c.createTestCase.CallUnary(ctx, req)
@@ -385,6 +910,36 @@ func (c *evaluationServiceClient) DeleteTestCase(ctx context.Context, req *conne
 	return c.deleteTestCase.CallUnary(ctx, req)
 }
 
+// ImportTestCases calls eval.v1.EvaluationService.ImportTestCases.
+func (c *evaluationServiceClient) ImportTestCases(ctx context.Context, req *connect.Request[v1.ImportTestCasesRequest]) (*connect.Response[v1.ImportTestCasesResponse], error) {
+	return c.importTestCases.CallUnary(ctx, req)
+}
+
+// UpdateTestCases calls eval.v1.EvaluationService.UpdateTestCases.
+func (c *evaluationServiceClient) UpdateTestCases(ctx context.Context, req *connect.Request[v1.UpdateTestCasesRequest]) (*connect.Response[v1.UpdateTestCasesResponse], error) {
+	return c.updateTestCases.CallUnary(ctx, req)
+}
+
+// FindDuplicateTestCases calls eval.v1.EvaluationService.FindDuplicateTestCases.
+func (c *evaluationServiceClient) FindDuplicateTestCases(ctx context.Context, req *connect.Request[v1.FindDuplicateTestCasesRequest]) (*connect.Response[v1.FindDuplicateTestCasesResponse], error) {
+	return c.findDuplicateTestCases.CallUnary(ctx, req)
+}
+
+// ListDeletedTestCases calls eval.v1.EvaluationService.ListDeletedTestCases.
+func (c *evaluationServiceClient) ListDeletedTestCases(ctx context.Context, req *connect.Request[v1.ListDeletedTestCasesRequest]) (*connect.Response[v1.ListDeletedTestCasesResponse], error) {
+	return c.listDeletedTestCases.CallUnary(ctx, req)
+}
+
+// RestoreTestCase calls eval.v1.EvaluationService.RestoreTestCase.
+func (c *evaluationServiceClient) RestoreTestCase(ctx context.Context, req *connect.Request[v1.RestoreTestCaseRequest]) (*connect.Response[v1.RestoreTestCaseResponse], error) {
+	return c.restoreTestCase.CallUnary(ctx, req)
+}
+
+// PurgeTestCases calls eval.v1.EvaluationService.PurgeTestCases.
+func (c *evaluationServiceClient) PurgeTestCases(ctx context.Context, req *connect.Request[v1.PurgeTestCasesRequest]) (*connect.Response[v1.PurgeTestCasesResponse], error) {
+	return c.purgeTestCases.CallUnary(ctx, req)
+}
+
 // ListModelConfigs calls eval.v1.EvaluationService.ListModelConfigs.
 func (c *evaluationServiceClient) ListModelConfigs(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListModelConfigsResponse], error) {
 	return c.listModelConfigs.CallUnary(ctx, req)
@@ -440,6 +995,121 @@ func (c *evaluationServiceClient) RateTestResult(ctx context.Context, req *conne
 	return c.rateTestResult.CallUnary(ctx, req)
 }
 
+// ComputeAgreement calls eval.v1.EvaluationService.ComputeAgreement.
+func (c *evaluationServiceClient) ComputeAgreement(ctx context.Context, req *connect.Request[v1.ComputeAgreementRequest]) (*connect.Response[v1.ComputeAgreementResponse], error) {
+	return c.computeAgreement.CallUnary(ctx, req)
+}
+
+// CompareVersions calls eval.v1.EvaluationService.CompareVersions.
+func (c *evaluationServiceClient) CompareVersions(ctx context.Context, req *connect.Request[v1.CompareVersionsRequest]) (*connect.Response[v1.CompareVersionsResponse], error) {
+	return c.compareVersions.CallUnary(ctx, req)
+}
+
+// CreateGrader calls eval.v1.EvaluationService.CreateGrader.
+func (c *evaluationServiceClient) CreateGrader(ctx context.Context, req *connect.Request[v1.CreateGraderRequest]) (*connect.Response[v1.CreateGraderResponse], error) {
+	return c.createGrader.CallUnary(ctx, req)
+}
+
+// ListGraders calls eval.v1.EvaluationService.ListGraders.
+func (c *evaluationServiceClient) ListGraders(ctx context.Context, req *connect.Request[v1.ListGradersRequest]) (*connect.Response[v1.ListGradersResponse], error) {
+	return c.listGraders.CallUnary(ctx, req)
+}
+
+// DeleteGrader calls eval.v1.EvaluationService.DeleteGrader.
+func (c *evaluationServiceClient) DeleteGrader(ctx context.Context, req *connect.Request[v1.DeleteGraderRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteGrader.CallUnary(ctx, req)
+}
+
+// RunGrader calls eval.v1.EvaluationService.RunGrader.
+func (c *evaluationServiceClient) RunGrader(ctx context.Context, req *connect.Request[v1.RunGraderRequest]) (*connect.Response[v1.RunGraderResponse], error) {
+	return c.runGrader.CallUnary(ctx, req)
+}
+
+// CreateDataset calls eval.v1.EvaluationService.CreateDataset.
+func (c *evaluationServiceClient) CreateDataset(ctx context.Context, req *connect.Request[v1.CreateDatasetRequest]) (*connect.Response[v1.CreateDatasetResponse], error) {
+	return c.createDataset.CallUnary(ctx, req)
+}
+
+// ListDatasets calls eval.v1.EvaluationService.ListDatasets.
+func (c *evaluationServiceClient) ListDatasets(ctx context.Context, req *connect.Request[v1.ListDatasetsRequest]) (*connect.Response[v1.ListDatasetsResponse], error) {
+	return c.listDatasets.CallUnary(ctx, req)
+}
+
+// DeleteDataset calls eval.v1.EvaluationService.DeleteDataset.
+func (c *evaluationServiceClient) DeleteDataset(ctx context.Context, req *connect.Request[v1.DeleteDatasetRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteDataset.CallUnary(ctx, req)
+}
+
+// AttachDataset calls eval.v1.EvaluationService.AttachDataset.
+func (c *evaluationServiceClient) AttachDataset(ctx context.Context, req *connect.Request[v1.AttachDatasetRequest]) (*connect.Response[v1.AttachDatasetResponse], error) {
+	return c.attachDataset.CallUnary(ctx, req)
+}
+
+// DetachDataset calls eval.v1.EvaluationService.DetachDataset.
+func (c *evaluationServiceClient) DetachDataset(ctx context.Context, req *connect.Request[v1.DetachDatasetRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.detachDataset.CallUnary(ctx, req)
+}
+
+// PromoteVersion calls eval.v1.EvaluationService.PromoteVersion.
+func (c *evaluationServiceClient) PromoteVersion(ctx context.Context, req *connect.Request[v1.PromoteVersionRequest]) (*connect.Response[v1.PromoteVersionResponse], error) {
+	return c.promoteVersion.CallUnary(ctx, req)
+}
+
+// RollbackLabel calls eval.v1.EvaluationService.RollbackLabel.
+func (c *evaluationServiceClient) RollbackLabel(ctx context.Context, req *connect.Request[v1.RollbackLabelRequest]) (*connect.Response[v1.RollbackLabelResponse], error) {
+	return c.rollbackLabel.CallUnary(ctx, req)
+}
+
+// ListLabels calls eval.v1.EvaluationService.ListLabels.
+func (c *evaluationServiceClient) ListLabels(ctx context.Context, req *connect.Request[v1.ListLabelsRequest]) (*connect.Response[v1.ListLabelsResponse], error) {
+	return c.listLabels.CallUnary(ctx, req)
+}
+
+// ListLabelHistory calls eval.v1.EvaluationService.ListLabelHistory.
+func (c *evaluationServiceClient) ListLabelHistory(ctx context.Context, req *connect.Request[v1.ListLabelHistoryRequest]) (*connect.Response[v1.ListLabelHistoryResponse], error) {
+	return c.listLabelHistory.CallUnary(ctx, req)
+}
+
+// Complete calls eval.v1.EvaluationService.Complete.
+func (c *evaluationServiceClient) Complete(ctx context.Context, req *connect.Request[v1.CompleteRequest]) (*connect.Response[v1.CompleteResponse], error) {
+	return c.complete.CallUnary(ctx, req)
+}
+
+// ListTraces calls eval.v1.EvaluationService.ListTraces.
+func (c *evaluationServiceClient) ListTraces(ctx context.Context, req *connect.Request[v1.ListTracesRequest]) (*connect.Response[v1.ListTracesResponse], error) {
+	return c.listTraces.CallUnary(ctx, req)
+}
+
+// RunPerturbations calls eval.v1.EvaluationService.RunPerturbations.
+func (c *evaluationServiceClient) RunPerturbations(ctx context.Context, req *connect.Request[v1.RunPerturbationsRequest]) (*connect.Response[v1.RunPerturbationsResponse], error) {
+	return c.runPerturbations.CallUnary(ctx, req)
+}
+
+// CreateExample calls eval.v1.EvaluationService.CreateExample.
+func (c *evaluationServiceClient) CreateExample(ctx context.Context, req *connect.Request[v1.CreateExampleRequest]) (*connect.Response[v1.CreateExampleResponse], error) {
+	return c.createExample.CallUnary(ctx, req)
+}
+
+// ListExamples calls eval.v1.EvaluationService.ListExamples.
+func (c *evaluationServiceClient) ListExamples(ctx context.Context, req *connect.Request[v1.ListExamplesRequest]) (*connect.Response[v1.ListExamplesResponse], error) {
+	return c.listExamples.CallUnary(ctx, req)
+}
+
+// DeleteExample calls eval.v1.EvaluationService.DeleteExample.
+func (c *evaluationServiceClient) DeleteExample(ctx context.Context, req *connect.Request[v1.DeleteExampleRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteExample.CallUnary(ctx, req)
+}
+
+// AddExamplesFromResults calls eval.v1.EvaluationService.AddExamplesFromResults.
+func (c *evaluationServiceClient) AddExamplesFromResults(ctx context.Context, req *connect.Request[v1.AddExamplesFromResultsRequest]) (*connect.Response[v1.AddExamplesFromResultsResponse], error) {
+	return c.addExamplesFromResults.CallUnary(ctx, req)
+}
+
+// SetExampleSettings calls eval.v1.EvaluationService.SetExampleSettings.
+func (c *evaluationServiceClient) SetExampleSettings(ctx context.Context, req *connect.Request[v1.SetExampleSettingsRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.setExampleSettings.CallUnary(ctx, req)
+}
+
 // EvaluationServiceHandler is an implementation of the eval.v1.EvaluationService service.
 type EvaluationServiceHandler interface {
 	Evaluate(context.Context, *connect.Request[v1.EvaluationRequest]) (*connect.Response[v1.EvaluationResponse], error)
@@ -449,13 +1119,29 @@ type EvaluationServiceHandler interface {
 	GetWorkspace(context.Context, *connect.Request[v1.GetWorkspaceRequest]) (*connect.Response[v1.GetWorkspaceResponse], error)
 	ListWorkspaces(context.Context, *connect.Request[v1.ListWorkspacesRequest]) (*connect.Response[v1.ListWorkspacesResponse], error)
 	UpdateWorkspace(context.Context, *connect.Request[v1.UpdateWorkspaceRequest]) (*connect.Response[v1.UpdateWorkspaceResponse], error)
+	ExportWorkspace(context.Context, *connect.Request[v1.ExportWorkspaceRequest], *connect.ServerStream[v1.ExportWorkspaceResponse]) error
+	CloneWorkspace(context.Context, *connect.Request[v1.CloneWorkspaceRequest]) (*connect.Response[v1.CloneWorkspaceResponse], error)
+	DeleteWorkspace(context.Context, *connect.Request[v1.DeleteWorkspaceRequest]) (*connect.Response[emptypb.Empty], error)
+	ArchiveWorkspace(context.Context, *connect.Request[v1.ArchiveWorkspaceRequest]) (*connect.Response[emptypb.Empty], error)
+	ExportWorkspaceBundle(context.Context, *connect.Request[v1.ExportWorkspaceBundleRequest]) (*connect.Response[v1.ExportWorkspaceBundleResponse], error)
+	ImportWorkspaceBundle(context.Context, *connect.Request[v1.ImportWorkspaceBundleRequest]) (*connect.Response[v1.ImportWorkspaceBundleResponse], error)
 	// Prompt operations
 	GeneratePrompt(context.Context, *connect.Request[v1.GeneratePromptRequest]) (*connect.Response[v1.GeneratePromptResponse], error)
+	DiffPromptVersions(context.Context, *connect.Request[v1.DiffPromptVersionsRequest]) (*connect.Response[v1.DiffPromptVersionsResponse], error)
+	LintPrompt(context.Context, *connect.Request[v1.LintPromptRequest]) (*connect.Response[v1.LintPromptResponse], error)
+	OptimizePrompt(context.Context, *connect.Request[v1.OptimizePromptRequest]) (*connect.Response[v1.OptimizePromptResponse], error)
+	ListOptimizationRuns(context.Context, *connect.Request[v1.ListOptimizationRunsRequest]) (*connect.Response[v1.ListOptimizationRunsResponse], error)
 	// TestCase operations
 	CreateTestCase(context.Context, *connect.Request[v1.CreateTestCaseRequest]) (*connect.Response[v1.CreateTestCaseResponse], error)
 	ListTestCases(context.Context, *connect.Request[v1.ListTestCasesRequest]) (*connect.Response[v1.ListTestCasesResponse], error)
 	GenerateTestCase(context.Context, *connect.Request[v1.GenerateTestCaseRequest]) (*connect.Response[v1.GenerateTestCaseResponse], error)
 	DeleteTestCase(context.Context, *connect.Request[v1.DeleteTestCaseRequest]) (*connect.Response[emptypb.Empty], error)
+	ImportTestCases(context.Context, *connect.Request[v1.ImportTestCasesRequest]) (*connect.Response[v1.ImportTestCasesResponse], error)
+	UpdateTestCases(context.Context, *connect.Request[v1.UpdateTestCasesRequest]) (*connect.Response[v1.UpdateTestCasesResponse], error)
+	FindDuplicateTestCases(context.Context, *connect.Request[v1.FindDuplicateTestCasesRequest]) (*connect.Response[v1.FindDuplicateTestCasesResponse], error)
+	ListDeletedTestCases(context.Context, *connect.Request[v1.ListDeletedTestCasesRequest]) (*connect.Response[v1.ListDeletedTestCasesResponse], error)
+	RestoreTestCase(context.Context, *connect.Request[v1.RestoreTestCaseRequest]) (*connect.Response[v1.RestoreTestCaseResponse], error)
+	PurgeTestCases(context.Context, *connect.Request[v1.PurgeTestCasesRequest]) (*connect.Response[v1.PurgeTestCasesResponse], error)
 	// ModelConfig operations
 	ListModelConfigs(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListModelConfigsResponse], error)
 	GetDefaultSmallModelConfig(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetModelConfigResponse], error)
@@ -468,6 +1154,36 @@ type EvaluationServiceHandler interface {
 	SetVersionActive(context.Context, *connect.Request[v1.SetVersionActiveRequest]) (*connect.Response[emptypb.Empty], error)
 	SetXMLMode(context.Context, *connect.Request[v1.SetXMLModeRequest]) (*connect.Response[emptypb.Empty], error)
 	RateTestResult(context.Context, *connect.Request[v1.RateTestResultRequest]) (*connect.Response[emptypb.Empty], error)
+	// Rating operations
+	ComputeAgreement(context.Context, *connect.Request[v1.ComputeAgreementRequest]) (*connect.Response[v1.ComputeAgreementResponse], error)
+	CompareVersions(context.Context, *connect.Request[v1.CompareVersionsRequest]) (*connect.Response[v1.CompareVersionsResponse], error)
+	// Grader operations
+	CreateGrader(context.Context, *connect.Request[v1.CreateGraderRequest]) (*connect.Response[v1.CreateGraderResponse], error)
+	ListGraders(context.Context, *connect.Request[v1.ListGradersRequest]) (*connect.Response[v1.ListGradersResponse], error)
+	DeleteGrader(context.Context, *connect.Request[v1.DeleteGraderRequest]) (*connect.Response[emptypb.Empty], error)
+	RunGrader(context.Context, *connect.Request[v1.RunGraderRequest]) (*connect.Response[v1.RunGraderResponse], error)
+	// Dataset operations
+	CreateDataset(context.Context, *connect.Request[v1.CreateDatasetRequest]) (*connect.Response[v1.CreateDatasetResponse], error)
+	ListDatasets(context.Context, *connect.Request[v1.ListDatasetsRequest]) (*connect.Response[v1.ListDatasetsResponse], error)
+	DeleteDataset(context.Context, *connect.Request[v1.DeleteDatasetRequest]) (*connect.Response[emptypb.Empty], error)
+	AttachDataset(context.Context, *connect.Request[v1.AttachDatasetRequest]) (*connect.Response[v1.AttachDatasetResponse], error)
+	DetachDataset(context.Context, *connect.Request[v1.DetachDatasetRequest]) (*connect.Response[emptypb.Empty], error)
+	// Label operations
+	PromoteVersion(context.Context, *connect.Request[v1.PromoteVersionRequest]) (*connect.Response[v1.PromoteVersionResponse], error)
+	RollbackLabel(context.Context, *connect.Request[v1.RollbackLabelRequest]) (*connect.Response[v1.RollbackLabelResponse], error)
+	ListLabels(context.Context, *connect.Request[v1.ListLabelsRequest]) (*connect.Response[v1.ListLabelsResponse], error)
+	ListLabelHistory(context.Context, *connect.Request[v1.ListLabelHistoryRequest]) (*connect.Response[v1.ListLabelHistoryResponse], error)
+	// Serving operations
+	Complete(context.Context, *connect.Request[v1.CompleteRequest]) (*connect.Response[v1.CompleteResponse], error)
+	ListTraces(context.Context, *connect.Request[v1.ListTracesRequest]) (*connect.Response[v1.ListTracesResponse], error)
+	// Robustness operations
+	RunPerturbations(context.Context, *connect.Request[v1.RunPerturbationsRequest]) (*connect.Response[v1.RunPerturbationsResponse], error)
+	// Example operations
+	CreateExample(context.Context, *connect.Request[v1.CreateExampleRequest]) (*connect.Response[v1.CreateExampleResponse], error)
+	ListExamples(context.Context, *connect.Request[v1.ListExamplesRequest]) (*connect.Response[v1.ListExamplesResponse], error)
+	DeleteExample(context.Context, *connect.Request[v1.DeleteExampleRequest]) (*connect.Response[emptypb.Empty], error)
+	AddExamplesFromResults(context.Context, *connect.Request[v1.AddExamplesFromResultsRequest]) (*connect.Response[v1.AddExamplesFromResultsResponse], error)
+	SetExampleSettings(context.Context, *connect.Request[v1.SetExampleSettingsRequest]) (*connect.Response[emptypb.Empty], error)
 }
 
 // NewEvaluationServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -512,12 +1228,72 @@ func NewEvaluationServiceHandler(svc EvaluationServiceHandler, opts ...connect.H
 		connect.WithSchema(evaluationServiceUpdateWorkspaceMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	evaluationServiceExportWorkspaceHandler := connect.NewServerStreamHandler(
+		EvaluationServiceExportWorkspaceProcedure,
+		svc.ExportWorkspace,
+		connect.WithSchema(evaluationServiceExportWorkspaceMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationServiceCloneWorkspaceHandler := connect.NewUnaryHandler(
+		EvaluationServiceCloneWorkspaceProcedure,
+		svc.CloneWorkspace,
+		connect.WithSchema(evaluationServiceCloneWorkspaceMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationServiceDeleteWorkspaceHandler := connect.NewUnaryHandler(
+		EvaluationServiceDeleteWorkspaceProcedure,
+		svc.DeleteWorkspace,
+		connect.WithSchema(evaluationServiceDeleteWorkspaceMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationServiceArchiveWorkspaceHandler := connect.NewUnaryHandler(
+		EvaluationServiceArchiveWorkspaceProcedure,
+		svc.ArchiveWorkspace,
+		connect.WithSchema(evaluationServiceArchiveWorkspaceMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationServiceExportWorkspaceBundleHandler := connect.NewUnaryHandler(
+		EvaluationServiceExportWorkspaceBundleProcedure,
+		svc.ExportWorkspaceBundle,
+		connect.WithSchema(evaluationServiceExportWorkspaceBundleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationServiceImportWorkspaceBundleHandler := connect.NewUnaryHandler(
+		EvaluationServiceImportWorkspaceBundleProcedure,
+		svc.ImportWorkspaceBundle,
+		connect.WithSchema(evaluationServiceImportWorkspaceBundleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	evaluationServiceGeneratePromptHandler := connect.NewUnaryHandler(
 		EvaluationServiceGeneratePromptProcedure,
 		svc.GeneratePrompt,
 		connect.WithSchema(evaluationServiceGeneratePromptMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	evaluationServiceDiffPromptVersionsHandler := connect.NewUnaryHandler(
+		EvaluationServiceDiffPromptVersionsProcedure,
+		svc.DiffPromptVersions,
+		connect.WithSchema(evaluationServiceDiffPromptVersionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationServiceLintPromptHandler := connect.NewUnaryHandler(
+		EvaluationServiceLintPromptProcedure,
+		svc.LintPrompt,
+		connect.WithSchema(evaluationServiceLintPromptMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationServiceOptimizePromptHandler := connect.NewUnaryHandler(
+		EvaluationServiceOptimizePromptProcedure,
+		svc.OptimizePrompt,
+		connect.WithSchema(evaluationServiceOptimizePromptMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationServiceListOptimizationRunsHandler := connect.NewUnaryHandler(
+		EvaluationServiceListOptimizationRunsProcedure,
+		svc.ListOptimizationRuns,
+		connect.WithSchema(evaluationServiceListOptimizationRunsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	evaluationServiceCreateTestCaseHandler := connect.NewUnaryHandler(
 		EvaluationServiceCreateTestCaseProcedure,
 		svc.CreateTestCase,
@@ -542,6 +1318,42 @@ func NewEvaluationServiceHandler(svc EvaluationServiceHandler, opts ...connect.H
 		connect.WithSchema(evaluationServiceDeleteTestCaseMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	evaluationServiceImportTestCasesHandler := connect.NewUnaryHandler(
+		EvaluationServiceImportTestCasesProcedure,
+		svc.ImportTestCases,
+		connect.WithSchema(evaluationServiceImportTestCasesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationServiceUpdateTestCasesHandler := connect.NewUnaryHandler(
+		EvaluationServiceUpdateTestCasesProcedure,
+		svc.UpdateTestCases,
+		connect.WithSchema(evaluationServiceUpdateTestCasesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationServiceFindDuplicateTestCasesHandler := connect.NewUnaryHandler(
+		EvaluationServiceFindDuplicateTestCasesProcedure,
+		svc.FindDuplicateTestCases,
+		connect.WithSchema(evaluationServiceFindDuplicateTestCasesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationServiceListDeletedTestCasesHandler := connect.NewUnaryHandler(
+		EvaluationServiceListDeletedTestCasesProcedure,
+		svc.ListDeletedTestCases,
+		connect.WithSchema(evaluationServiceListDeletedTestCasesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationServiceRestoreTestCaseHandler := connect.NewUnaryHandler(
+		EvaluationServiceRestoreTestCaseProcedure,
+		svc.RestoreTestCase,
+		connect.WithSchema(evaluationServiceRestoreTestCaseMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationServicePurgeTestCasesHandler := connect.NewUnaryHandler(
+		EvaluationServicePurgeTestCasesProcedure,
+		svc.PurgeTestCases,
+		connect.WithSchema(evaluationServicePurgeTestCasesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	evaluationServiceListModelConfigsHandler := connect.NewUnaryHandler(
 		EvaluationServiceListModelConfigsProcedure,
 		svc.ListModelConfigs,
@@ -608,6 +1420,144 @@ func NewEvaluationServiceHandler(svc EvaluationServiceHandler, opts ...connect.H
 		connect.WithSchema(evaluationServiceRateTestResultMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	evaluationServiceComputeAgreementHandler := connect.NewUnaryHandler(
+		EvaluationServiceComputeAgreementProcedure,
+		svc.ComputeAgreement,
+		connect.WithSchema(evaluationServiceComputeAgreementMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationServiceCompareVersionsHandler := connect.NewUnaryHandler(
+		EvaluationServiceCompareVersionsProcedure,
+		svc.CompareVersions,
+		connect.WithSchema(evaluationServiceCompareVersionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationServiceCreateGraderHandler := connect.NewUnaryHandler(
+		EvaluationServiceCreateGraderProcedure,
+		svc.CreateGrader,
+		connect.WithSchema(evaluationServiceCreateGraderMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationServiceListGradersHandler := connect.NewUnaryHandler(
+		EvaluationServiceListGradersProcedure,
+		svc.ListGraders,
+		connect.WithSchema(evaluationServiceListGradersMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationServiceDeleteGraderHandler := connect.NewUnaryHandler(
+		EvaluationServiceDeleteGraderProcedure,
+		svc.DeleteGrader,
+		connect.WithSchema(evaluationServiceDeleteGraderMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationServiceRunGraderHandler := connect.NewUnaryHandler(
+		EvaluationServiceRunGraderProcedure,
+		svc.RunGrader,
+		connect.WithSchema(evaluationServiceRunGraderMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationServiceCreateDatasetHandler := connect.NewUnaryHandler(
+		EvaluationServiceCreateDatasetProcedure,
+		svc.CreateDataset,
+		connect.WithSchema(evaluationServiceCreateDatasetMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationServiceListDatasetsHandler := connect.NewUnaryHandler(
+		EvaluationServiceListDatasetsProcedure,
+		svc.ListDatasets,
+		connect.WithSchema(evaluationServiceListDatasetsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationServiceDeleteDatasetHandler := connect.NewUnaryHandler(
+		EvaluationServiceDeleteDatasetProcedure,
+		svc.DeleteDataset,
+		connect.WithSchema(evaluationServiceDeleteDatasetMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationServiceAttachDatasetHandler := connect.NewUnaryHandler(
+		EvaluationServiceAttachDatasetProcedure,
+		svc.AttachDataset,
+		connect.WithSchema(evaluationServiceAttachDatasetMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationServiceDetachDatasetHandler := connect.NewUnaryHandler(
+		EvaluationServiceDetachDatasetProcedure,
+		svc.DetachDataset,
+		connect.WithSchema(evaluationServiceDetachDatasetMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationServicePromoteVersionHandler := connect.NewUnaryHandler(
+		EvaluationServicePromoteVersionProcedure,
+		svc.PromoteVersion,
+		connect.WithSchema(evaluationServicePromoteVersionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationServiceRollbackLabelHandler := connect.NewUnaryHandler(
+		EvaluationServiceRollbackLabelProcedure,
+		svc.RollbackLabel,
+		connect.WithSchema(evaluationServiceRollbackLabelMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationServiceListLabelsHandler := connect.NewUnaryHandler(
+		EvaluationServiceListLabelsProcedure,
+		svc.ListLabels,
+		connect.WithSchema(evaluationServiceListLabelsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationServiceListLabelHistoryHandler := connect.NewUnaryHandler(
+		EvaluationServiceListLabelHistoryProcedure,
+		svc.ListLabelHistory,
+		connect.WithSchema(evaluationServiceListLabelHistoryMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationServiceCompleteHandler := connect.NewUnaryHandler(
+		EvaluationServiceCompleteProcedure,
+		svc.Complete,
+		connect.WithSchema(evaluationServiceCompleteMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationServiceListTracesHandler := connect.NewUnaryHandler(
+		EvaluationServiceListTracesProcedure,
+		svc.ListTraces,
+		connect.WithSchema(evaluationServiceListTracesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationServiceRunPerturbationsHandler := connect.NewUnaryHandler(
+		EvaluationServiceRunPerturbationsProcedure,
+		svc.RunPerturbations,
+		connect.WithSchema(evaluationServiceRunPerturbationsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationServiceCreateExampleHandler := connect.NewUnaryHandler(
+		EvaluationServiceCreateExampleProcedure,
+		svc.CreateExample,
+		connect.WithSchema(evaluationServiceCreateExampleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationServiceListExamplesHandler := connect.NewUnaryHandler(
+		EvaluationServiceListExamplesProcedure,
+		svc.ListExamples,
+		connect.WithSchema(evaluationServiceListExamplesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationServiceDeleteExampleHandler := connect.NewUnaryHandler(
+		EvaluationServiceDeleteExampleProcedure,
+		svc.DeleteExample,
+		connect.WithSchema(evaluationServiceDeleteExampleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationServiceAddExamplesFromResultsHandler := connect.NewUnaryHandler(
+		EvaluationServiceAddExamplesFromResultsProcedure,
+		svc.AddExamplesFromResults,
+		connect.WithSchema(evaluationServiceAddExamplesFromResultsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	evaluationServiceSetExampleSettingsHandler := connect.NewUnaryHandler(
+		EvaluationServiceSetExampleSettingsProcedure,
+		svc.SetExampleSettings,
+		connect.WithSchema(evaluationServiceSetExampleSettingsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/eval.v1.EvaluationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case EvaluationServiceEvaluateProcedure:
@@ -622,8 +1572,28 @@ func NewEvaluationServiceHandler(svc EvaluationServiceHandler, opts ...connect.H
 			evaluationServiceListWorkspacesHandler.ServeHTTP(w, r)
 		case EvaluationServiceUpdateWorkspaceProcedure:
 			evaluationServiceUpdateWorkspaceHandler.ServeHTTP(w, r)
+		case EvaluationServiceExportWorkspaceProcedure:
+			evaluationServiceExportWorkspaceHandler.ServeHTTP(w, r)
+		case EvaluationServiceCloneWorkspaceProcedure:
+			evaluationServiceCloneWorkspaceHandler.ServeHTTP(w, r)
+		case EvaluationServiceDeleteWorkspaceProcedure:
+			evaluationServiceDeleteWorkspaceHandler.ServeHTTP(w, r)
+		case EvaluationServiceArchiveWorkspaceProcedure:
+			evaluationServiceArchiveWorkspaceHandler.ServeHTTP(w, r)
+		case EvaluationServiceExportWorkspaceBundleProcedure:
+			evaluationServiceExportWorkspaceBundleHandler.ServeHTTP(w, r)
+		case EvaluationServiceImportWorkspaceBundleProcedure:
+			evaluationServiceImportWorkspaceBundleHandler.ServeHTTP(w, r)
 		case EvaluationServiceGeneratePromptProcedure:
 			evaluationServiceGeneratePromptHandler.ServeHTTP(w, r)
+		case EvaluationServiceDiffPromptVersionsProcedure:
+			evaluationServiceDiffPromptVersionsHandler.ServeHTTP(w, r)
+		case EvaluationServiceLintPromptProcedure:
+			evaluationServiceLintPromptHandler.ServeHTTP(w, r)
+		case EvaluationServiceOptimizePromptProcedure:
+			evaluationServiceOptimizePromptHandler.ServeHTTP(w, r)
+		case EvaluationServiceListOptimizationRunsProcedure:
+			evaluationServiceListOptimizationRunsHandler.ServeHTTP(w, r)
 		case EvaluationServiceCreateTestCaseProcedure:
 			evaluationServiceCreateTestCaseHandler.ServeHTTP(w, r)
 		case EvaluationServiceListTestCasesProcedure:
@@ -632,6 +1602,18 @@ func NewEvaluationServiceHandler(svc EvaluationServiceHandler, opts ...connect.H
 			evaluationServiceGenerateTestCaseHandler.ServeHTTP(w, r)
 		case EvaluationServiceDeleteTestCaseProcedure:
 			evaluationServiceDeleteTestCaseHandler.ServeHTTP(w, r)
+		case EvaluationServiceImportTestCasesProcedure:
+			evaluationServiceImportTestCasesHandler.ServeHTTP(w, r)
+		case EvaluationServiceUpdateTestCasesProcedure:
+			evaluationServiceUpdateTestCasesHandler.ServeHTTP(w, r)
+		case EvaluationServiceFindDuplicateTestCasesProcedure:
+			evaluationServiceFindDuplicateTestCasesHandler.ServeHTTP(w, r)
+		case EvaluationServiceListDeletedTestCasesProcedure:
+			evaluationServiceListDeletedTestCasesHandler.ServeHTTP(w, r)
+		case EvaluationServiceRestoreTestCaseProcedure:
+			evaluationServiceRestoreTestCaseHandler.ServeHTTP(w, r)
+		case EvaluationServicePurgeTestCasesProcedure:
+			evaluationServicePurgeTestCasesHandler.ServeHTTP(w, r)
 		case EvaluationServiceListModelConfigsProcedure:
 			evaluationServiceListModelConfigsHandler.ServeHTTP(w, r)
 		case EvaluationServiceGetDefaultSmallModelConfigProcedure:
@@ -654,6 +1636,52 @@ func NewEvaluationServiceHandler(svc EvaluationServiceHandler, opts ...connect.H
 			evaluationServiceSetXMLModeHandler.ServeHTTP(w, r)
 		case EvaluationServiceRateTestResultProcedure:
 			evaluationServiceRateTestResultHandler.ServeHTTP(w, r)
+		case EvaluationServiceComputeAgreementProcedure:
+			evaluationServiceComputeAgreementHandler.ServeHTTP(w, r)
+		case EvaluationServiceCompareVersionsProcedure:
+			evaluationServiceCompareVersionsHandler.ServeHTTP(w, r)
+		case EvaluationServiceCreateGraderProcedure:
+			evaluationServiceCreateGraderHandler.ServeHTTP(w, r)
+		case EvaluationServiceListGradersProcedure:
+			evaluationServiceListGradersHandler.ServeHTTP(w, r)
+		case EvaluationServiceDeleteGraderProcedure:
+			evaluationServiceDeleteGraderHandler.ServeHTTP(w, r)
+		case EvaluationServiceRunGraderProcedure:
+			evaluationServiceRunGraderHandler.ServeHTTP(w, r)
+		case EvaluationServiceCreateDatasetProcedure:
+			evaluationServiceCreateDatasetHandler.ServeHTTP(w, r)
+		case EvaluationServiceListDatasetsProcedure:
+			evaluationServiceListDatasetsHandler.ServeHTTP(w, r)
+		case EvaluationServiceDeleteDatasetProcedure:
+			evaluationServiceDeleteDatasetHandler.ServeHTTP(w, r)
+		case EvaluationServiceAttachDatasetProcedure:
+			evaluationServiceAttachDatasetHandler.ServeHTTP(w, r)
+		case EvaluationServiceDetachDatasetProcedure:
+			evaluationServiceDetachDatasetHandler.ServeHTTP(w, r)
+		case EvaluationServicePromoteVersionProcedure:
+			evaluationServicePromoteVersionHandler.ServeHTTP(w, r)
+		case EvaluationServiceRollbackLabelProcedure:
+			evaluationServiceRollbackLabelHandler.ServeHTTP(w, r)
+		case EvaluationServiceListLabelsProcedure:
+			evaluationServiceListLabelsHandler.ServeHTTP(w, r)
+		case EvaluationServiceListLabelHistoryProcedure:
+			evaluationServiceListLabelHistoryHandler.ServeHTTP(w, r)
+		case EvaluationServiceCompleteProcedure:
+			evaluationServiceCompleteHandler.ServeHTTP(w, r)
+		case EvaluationServiceListTracesProcedure:
+			evaluationServiceListTracesHandler.ServeHTTP(w, r)
+		case EvaluationServiceRunPerturbationsProcedure:
+			evaluationServiceRunPerturbationsHandler.ServeHTTP(w, r)
+		case EvaluationServiceCreateExampleProcedure:
+			evaluationServiceCreateExampleHandler.ServeHTTP(w, r)
+		case EvaluationServiceListExamplesProcedure:
+			evaluationServiceListExamplesHandler.ServeHTTP(w, r)
+		case EvaluationServiceDeleteExampleProcedure:
+			evaluationServiceDeleteExampleHandler.ServeHTTP(w, r)
+		case EvaluationServiceAddExamplesFromResultsProcedure:
+			evaluationServiceAddExamplesFromResultsHandler.ServeHTTP(w, r)
+		case EvaluationServiceSetExampleSettingsProcedure:
+			evaluationServiceSetExampleSettingsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -687,10 +1715,50 @@ func (UnimplementedEvaluationServiceHandler) UpdateWorkspace(context.Context, *c
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.UpdateWorkspace is not implemented"))
 }
 
+func (UnimplementedEvaluationServiceHandler) ExportWorkspace(context.Context, *connect.Request[v1.ExportWorkspaceRequest], *connect.ServerStream[v1.ExportWorkspaceResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.ExportWorkspace is not implemented"))
+}
+
+func (UnimplementedEvaluationServiceHandler) CloneWorkspace(context.Context, *connect.Request[v1.CloneWorkspaceRequest]) (*connect.Response[v1.CloneWorkspaceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.CloneWorkspace is not implemented"))
+}
+
+func (UnimplementedEvaluationServiceHandler) DeleteWorkspace(context.Context, *connect.Request[v1.DeleteWorkspaceRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.DeleteWorkspace is not implemented"))
+}
+
+func (UnimplementedEvaluationServiceHandler) ArchiveWorkspace(context.Context, *connect.Request[v1.ArchiveWorkspaceRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.ArchiveWorkspace is not implemented"))
+}
+
+func (UnimplementedEvaluationServiceHandler) ExportWorkspaceBundle(context.Context, *connect.Request[v1.ExportWorkspaceBundleRequest]) (*connect.Response[v1.ExportWorkspaceBundleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.ExportWorkspaceBundle is not implemented"))
+}
+
+func (UnimplementedEvaluationServiceHandler) ImportWorkspaceBundle(context.Context, *connect.Request[v1.ImportWorkspaceBundleRequest]) (*connect.Response[v1.ImportWorkspaceBundleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.ImportWorkspaceBundle is not implemented"))
+}
+
 func (UnimplementedEvaluationServiceHandler) GeneratePrompt(context.Context, *connect.Request[v1.GeneratePromptRequest]) (*connect.Response[v1.GeneratePromptResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.GeneratePrompt is not implemented"))
 }
 
+func (UnimplementedEvaluationServiceHandler) DiffPromptVersions(context.Context, *connect.Request[v1.DiffPromptVersionsRequest]) (*connect.Response[v1.DiffPromptVersionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.DiffPromptVersions is not implemented"))
+}
+
+func (UnimplementedEvaluationServiceHandler) LintPrompt(context.Context, *connect.Request[v1.LintPromptRequest]) (*connect.Response[v1.LintPromptResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.LintPrompt is not implemented"))
+}
+
+func (UnimplementedEvaluationServiceHandler) OptimizePrompt(context.Context, *connect.Request[v1.OptimizePromptRequest]) (*connect.Response[v1.OptimizePromptResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.OptimizePrompt is not implemented"))
+}
+
+func (UnimplementedEvaluationServiceHandler) ListOptimizationRuns(context.Context, *connect.Request[v1.ListOptimizationRunsRequest]) (*connect.Response[v1.ListOptimizationRunsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.ListOptimizationRuns is not implemented"))
+}
+
 func (UnimplementedEvaluationServiceHandler) CreateTestCase(context.Context, *connect.Request[v1.CreateTestCaseRequest]) (*connect.Response[v1.CreateTestCaseResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.CreateTestCase is not implemented"))
 }
@@ -707,6 +1775,30 @@ func (UnimplementedEvaluationServiceHandler) DeleteTestCase(context.Context, *co
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.DeleteTestCase is not implemented"))
 }
 
+func (UnimplementedEvaluationServiceHandler) ImportTestCases(context.Context, *connect.Request[v1.ImportTestCasesRequest]) (*connect.Response[v1.ImportTestCasesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.ImportTestCases is not implemented"))
+}
+
+func (UnimplementedEvaluationServiceHandler) UpdateTestCases(context.Context, *connect.Request[v1.UpdateTestCasesRequest]) (*connect.Response[v1.UpdateTestCasesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.UpdateTestCases is not implemented"))
+}
+
+func (UnimplementedEvaluationServiceHandler) FindDuplicateTestCases(context.Context, *connect.Request[v1.FindDuplicateTestCasesRequest]) (*connect.Response[v1.FindDuplicateTestCasesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.FindDuplicateTestCases is not implemented"))
+}
+
+func (UnimplementedEvaluationServiceHandler) ListDeletedTestCases(context.Context, *connect.Request[v1.ListDeletedTestCasesRequest]) (*connect.Response[v1.ListDeletedTestCasesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.ListDeletedTestCases is not implemented"))
+}
+
+func (UnimplementedEvaluationServiceHandler) RestoreTestCase(context.Context, *connect.Request[v1.RestoreTestCaseRequest]) (*connect.Response[v1.RestoreTestCaseResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.RestoreTestCase is not implemented"))
+}
+
+func (UnimplementedEvaluationServiceHandler) PurgeTestCases(context.Context, *connect.Request[v1.PurgeTestCasesRequest]) (*connect.Response[v1.PurgeTestCasesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.PurgeTestCases is not implemented"))
+}
+
 func (UnimplementedEvaluationServiceHandler) ListModelConfigs(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListModelConfigsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.ListModelConfigs is not implemented"))
 }
@@ -750,3 +1842,95 @@ func (UnimplementedEvaluationServiceHandler) SetXMLMode(context.Context, *connec
 func (UnimplementedEvaluationServiceHandler) RateTestResult(context.Context, *connect.Request[v1.RateTestResultRequest]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.RateTestResult is not implemented"))
 }
+
+func (UnimplementedEvaluationServiceHandler) ComputeAgreement(context.Context, *connect.Request[v1.ComputeAgreementRequest]) (*connect.Response[v1.ComputeAgreementResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.ComputeAgreement is not implemented"))
+}
+
+func (UnimplementedEvaluationServiceHandler) CompareVersions(context.Context, *connect.Request[v1.CompareVersionsRequest]) (*connect.Response[v1.CompareVersionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.CompareVersions is not implemented"))
+}
+
+func (UnimplementedEvaluationServiceHandler) CreateGrader(context.Context, *connect.Request[v1.CreateGraderRequest]) (*connect.Response[v1.CreateGraderResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.CreateGrader is not implemented"))
+}
+
+func (UnimplementedEvaluationServiceHandler) ListGraders(context.Context, *connect.Request[v1.ListGradersRequest]) (*connect.Response[v1.ListGradersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.ListGraders is not implemented"))
+}
+
+func (UnimplementedEvaluationServiceHandler) DeleteGrader(context.Context, *connect.Request[v1.DeleteGraderRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.DeleteGrader is not implemented"))
+}
+
+func (UnimplementedEvaluationServiceHandler) RunGrader(context.Context, *connect.Request[v1.RunGraderRequest]) (*connect.Response[v1.RunGraderResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.RunGrader is not implemented"))
+}
+
+func (UnimplementedEvaluationServiceHandler) CreateDataset(context.Context, *connect.Request[v1.CreateDatasetRequest]) (*connect.Response[v1.CreateDatasetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.CreateDataset is not implemented"))
+}
+
+func (UnimplementedEvaluationServiceHandler) ListDatasets(context.Context, *connect.Request[v1.ListDatasetsRequest]) (*connect.Response[v1.ListDatasetsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.ListDatasets is not implemented"))
+}
+
+func (UnimplementedEvaluationServiceHandler) DeleteDataset(context.Context, *connect.Request[v1.DeleteDatasetRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.DeleteDataset is not implemented"))
+}
+
+func (UnimplementedEvaluationServiceHandler) AttachDataset(context.Context, *connect.Request[v1.AttachDatasetRequest]) (*connect.Response[v1.AttachDatasetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.AttachDataset is not implemented"))
+}
+
+func (UnimplementedEvaluationServiceHandler) DetachDataset(context.Context, *connect.Request[v1.DetachDatasetRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.DetachDataset is not implemented"))
+}
+
+func (UnimplementedEvaluationServiceHandler) PromoteVersion(context.Context, *connect.Request[v1.PromoteVersionRequest]) (*connect.Response[v1.PromoteVersionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.PromoteVersion is not implemented"))
+}
+
+func (UnimplementedEvaluationServiceHandler) RollbackLabel(context.Context, *connect.Request[v1.RollbackLabelRequest]) (*connect.Response[v1.RollbackLabelResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.RollbackLabel is not implemented"))
+}
+
+func (UnimplementedEvaluationServiceHandler) ListLabels(context.Context, *connect.Request[v1.ListLabelsRequest]) (*connect.Response[v1.ListLabelsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.ListLabels is not implemented"))
+}
+
+func (UnimplementedEvaluationServiceHandler) ListLabelHistory(context.Context, *connect.Request[v1.ListLabelHistoryRequest]) (*connect.Response[v1.ListLabelHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.ListLabelHistory is not implemented"))
+}
+
+func (UnimplementedEvaluationServiceHandler) Complete(context.Context, *connect.Request[v1.CompleteRequest]) (*connect.Response[v1.CompleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.Complete is not implemented"))
+}
+
+func (UnimplementedEvaluationServiceHandler) ListTraces(context.Context, *connect.Request[v1.ListTracesRequest]) (*connect.Response[v1.ListTracesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.ListTraces is not implemented"))
+}
+
+func (UnimplementedEvaluationServiceHandler) RunPerturbations(context.Context, *connect.Request[v1.RunPerturbationsRequest]) (*connect.Response[v1.RunPerturbationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.RunPerturbations is not implemented"))
+}
+
+func (UnimplementedEvaluationServiceHandler) CreateExample(context.Context, *connect.Request[v1.CreateExampleRequest]) (*connect.Response[v1.CreateExampleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.CreateExample is not implemented"))
+}
+
+func (UnimplementedEvaluationServiceHandler) ListExamples(context.Context, *connect.Request[v1.ListExamplesRequest]) (*connect.Response[v1.ListExamplesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.ListExamples is not implemented"))
+}
+
+func (UnimplementedEvaluationServiceHandler) DeleteExample(context.Context, *connect.Request[v1.DeleteExampleRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.DeleteExample is not implemented"))
+}
+
+func (UnimplementedEvaluationServiceHandler) AddExamplesFromResults(context.Context, *connect.Request[v1.AddExamplesFromResultsRequest]) (*connect.Response[v1.AddExamplesFromResultsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.AddExamplesFromResults is not implemented"))
+}
+
+func (UnimplementedEvaluationServiceHandler) SetExampleSettings(context.Context, *connect.Request[v1.SetExampleSettingsRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("eval.v1.EvaluationService.SetExampleSettings is not implemented"))
+}
@@ -12,6 +12,7 @@ require (
 	github.com/stillmatic/gollum v0.0.0-20240901180452-8d6e66d55ab2
 	golang.org/x/net v0.28.0
 	google.golang.org/protobuf v1.34.2
+	gopkg.in/yaml.v3 v3.0.1
 	gorm.io/datatypes v1.2.1
 	gorm.io/driver/sqlite v1.5.6
 	gorm.io/gorm v1.25.11
@@ -0,0 +1,219 @@
+// Package dedup finds near-duplicate texts with MinHash and locality-sensitive
+// hashing over character shingles.
+package dedup
+
+import (
+	"hash/fnv"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	// shingleSize is the length in runes of the character shingles texts are split into.
+	shingleSize = 5
+	numHashes   = 128
+	// numBands * rowsPerBand must equal numHashes. With 32 bands of 4 rows, pairs
+	// with a Jaccard similarity of about 0.5 or more almost always share a band.
+	numBands    = 32
+	rowsPerBand = numHashes / numBands
+)
+
+// Normalize lowercases text, drops punctuation and collapses whitespace, so
+// trivial formatting differences do not count against similarity.
+func Normalize(text string) string {
+	var sb strings.Builder
+	space := false
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsNumber(r):
+			if space && sb.Len() > 0 {
+				sb.WriteByte(' ')
+			}
+			space = false
+			sb.WriteRune(r)
+		case unicode.IsSpace(r) || unicode.IsPunct(r) || unicode.IsSymbol(r):
+			space = true
+		}
+	}
+	return sb.String()
+}
+
+// shingles returns the hashed character shingles of the normalized text. Texts
+// shorter than a shingle are a single shingle.
+func shingles(text string) map[uint64]struct{} {
+	runes := []rune(Normalize(text))
+	out := make(map[uint64]struct{})
+	if len(runes) == 0 {
+		return out
+	}
+	if len(runes) < shingleSize {
+		out[hashString(string(runes))] = struct{}{}
+		return out
+	}
+	for i := 0; i+shingleSize <= len(runes); i++ {
+		out[hashString(string(runes[i:i+shingleSize]))] = struct{}{}
+	}
+	return out
+}
+
+func hashString(s string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(s))
+	return h.Sum64()
+}
+
+// mix is the splitmix64 finalizer, used to derive independent hash functions
+// from one shingle hash.
+func mix(x uint64) uint64 {
+	x += 0x9e3779b97f4a7c15
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+	return x ^ (x >> 31)
+}
+
+// signature is the MinHash signature of a shingle set.
+func signature(set map[uint64]struct{}) []uint64 {
+	sig := make([]uint64, numHashes)
+	for i := range sig {
+		sig[i] = ^uint64(0)
+	}
+	for sh := range set {
+		for i := range sig {
+			if v := mix(sh ^ uint64(i)*0x9e3779b97f4a7c15); v < sig[i] {
+				sig[i] = v
+			}
+		}
+	}
+	return sig
+}
+
+// Jaccard returns the Jaccard similarity of the shingle sets of two texts.
+func Jaccard(a, b string) float64 {
+	return jaccard(shingles(a), shingles(b))
+}
+
+func jaccard(a, b map[uint64]struct{}) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 0
+	}
+	inter := 0
+	for sh := range a {
+		if _, ok := b[sh]; ok {
+			inter++
+		}
+	}
+	return float64(inter) / float64(len(a)+len(b)-inter)
+}
+
+type entry struct {
+	id       string
+	shingles map[uint64]struct{}
+	keys     []uint64
+}
+
+// Index holds texts and finds the ones similar to a query. MinHash banding picks
+// candidates, and their exact shingle Jaccard similarity is then compared to the
+// threshold. An Index is not safe for concurrent use.
+type Index struct {
+	entries []entry
+	byID    map[string]int
+	buckets []map[uint64][]int
+}
+
+func NewIndex() *Index {
+	buckets := make([]map[uint64][]int, numBands)
+	for i := range buckets {
+		buckets[i] = make(map[uint64][]int)
+	}
+	return &Index{byID: make(map[string]int), buckets: buckets}
+}
+
+// Match is an indexed text similar to a query.
+type Match struct {
+	ID         string
+	Similarity float64
+}
+
+// Add indexes text under id. If id is already indexed, its first text is kept.
+// Texts that are empty once normalized are not indexed, since they are not
+// similar to anything.
+func (ix *Index) Add(id, text string) {
+	if _, ok := ix.byID[id]; ok {
+		return
+	}
+	set := shingles(text)
+	if len(set) == 0 {
+		return
+	}
+	keys := bandKeys(signature(set))
+	n := len(ix.entries)
+	ix.entries = append(ix.entries, entry{id: id, shingles: set, keys: keys})
+	ix.byID[id] = n
+	for band, key := range keys {
+		ix.buckets[band][key] = append(ix.buckets[band][key], n)
+	}
+}
+
+// Query returns the indexed texts whose similarity to text is at least threshold,
+// most similar first. An empty text matches nothing.
+func (ix *Index) Query(text string, threshold float64) []Match {
+	set := shingles(text)
+	if len(set) == 0 {
+		return make([]Match, 0)
+	}
+	return ix.query(set, bandKeys(signature(set)), threshold, -1)
+}
+
+func (ix *Index) query(set map[uint64]struct{}, keys []uint64, threshold float64, self int) []Match {
+	seen := make(map[int]bool)
+	matches := make([]Match, 0)
+	for band, key := range keys {
+		for _, n := range ix.buckets[band][key] {
+			if n == self || seen[n] {
+				continue
+			}
+			seen[n] = true
+			if sim := jaccard(set, ix.entries[n].shingles); sim >= threshold {
+				matches = append(matches, Match{ID: ix.entries[n].id, Similarity: sim})
+			}
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Similarity > matches[j].Similarity
+	})
+	return matches
+}
+
+// Pair is two indexed texts whose similarity is at least the requested threshold.
+// A was added before B.
+type Pair struct {
+	A, B       string
+	Similarity float64
+}
+
+// Pairs returns every pair of indexed texts with a similarity of at least threshold.
+func (ix *Index) Pairs(threshold float64) []Pair {
+	pairs := make([]Pair, 0)
+	for n, e := range ix.entries {
+		for _, m := range ix.query(e.shingles, e.keys, threshold, n) {
+			if ix.byID[m.ID] < n {
+				pairs = append(pairs, Pair{A: m.ID, B: e.id, Similarity: m.Similarity})
+			}
+		}
+	}
+	return pairs
+}
+
+// bandKeys hashes each band of a signature into a bucket key.
+func bandKeys(sig []uint64) []uint64 {
+	keys := make([]uint64, numBands)
+	for band := range keys {
+		var key uint64
+		for _, v := range sig[band*rowsPerBand : (band+1)*rowsPerBand] {
+			key = mix(key ^ v)
+		}
+		keys[band] = key
+	}
+	return keys
+}
@@ -0,0 +1,87 @@
+package dedup
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"Hello, World!", "hello world"},
+		{"  spaced\t\nout  ", "spaced out"},
+		{"...", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := Normalize(tt.in); got != tt.want {
+			t.Errorf("Normalize(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestJaccard(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want float64
+	}{
+		{name: "identical", a: "the quick brown fox", b: "the quick brown fox", want: 1},
+		{name: "formatting only", a: "The quick, brown fox!", b: "the quick brown fox", want: 1},
+		{name: "disjoint", a: "abcdefgh", b: "stuvwxyz", want: 0},
+		{name: "both empty", a: "", b: "", want: 0},
+		{name: "punctuation only", a: "?!", b: "...", want: 0},
+		{name: "one empty", a: "", b: "the quick brown fox", want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Jaccard(tt.a, tt.b); got != tt.want {
+				t.Errorf("Jaccard(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIndexQuery(t *testing.T) {
+	ix := NewIndex()
+	ix.Add("a", "Summarize the following article about climate change in three sentences.")
+	ix.Add("b", "Translate this paragraph into French, keeping the tone formal.")
+	ix.Add("a", "a later text under the same id is ignored")
+
+	matches := ix.Query("Summarize the following article about climate change in 3 sentences.", 0.7)
+	if len(matches) != 1 || matches[0].ID != "a" {
+		t.Fatalf("got %+v, want a single match for a", matches)
+	}
+	if matches := ix.Query("a later text under the same id is ignored", 0.7); len(matches) != 0 {
+		t.Errorf("got %+v, want no matches", matches)
+	}
+}
+
+func TestIndexSkipsEmptyTexts(t *testing.T) {
+	ix := NewIndex()
+	ix.Add("empty", "")
+	ix.Add("image", "\n")
+	ix.Add("punct", "!!!")
+	ix.Add("text", "some real text to compare")
+
+	if matches := ix.Query("", 0); len(matches) != 0 {
+		t.Errorf("empty query got %+v, want no matches", matches)
+	}
+	if matches := ix.Query("some real text to compare", 0.5); len(matches) != 1 || matches[0].ID != "text" {
+		t.Errorf("got %+v, want a single match for text", matches)
+	}
+	if pairs := ix.Pairs(0); len(pairs) != 0 {
+		t.Errorf("got pairs %+v, want none", pairs)
+	}
+}
+
+func TestIndexPairs(t *testing.T) {
+	ix := NewIndex()
+	ix.Add("a", "What is the capital of France? Answer in one word.")
+	ix.Add("b", "Write a haiku about autumn leaves falling.")
+	ix.Add("c", "What is the capital of France? Answer in one word!")
+
+	pairs := ix.Pairs(0.8)
+	if len(pairs) != 1 {
+		t.Fatalf("got %+v, want one pair", pairs)
+	}
+	if p := pairs[0]; p.A != "a" || p.B != "c" || p.Similarity != 1 {
+		t.Errorf("got %+v, want a and c with similarity 1", p)
+	}
+}
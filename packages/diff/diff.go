@@ -0,0 +1,216 @@
+// Package diff compares texts line by line, and the words of changed lines.
+package diff
+
+import (
+	"strings"
+	"unicode"
+)
+
+type Op int
+
+const (
+	Equal Op = iota
+	Insert
+	Delete
+	// Replace is a deleted line paired with the line inserted in its place.
+	Replace
+)
+
+// Word is a run of words, spaces or punctuation that is the same, inserted or
+// deleted. Joining the texts of the Equal and Delete words gives the old line, and
+// of the Equal and Insert words the new one.
+type Word struct {
+	Op   Op
+	Text string
+}
+
+// Line is one line of a diff. Line numbers start at 1 and are 0 for a line that is
+// not in the old or new text.
+type Line struct {
+	Op      Op
+	OldText string
+	NewText string
+	OldLine int
+	NewLine int
+	// Words is the word-level diff of a Replace line.
+	Words []Word
+}
+
+// Lines diffs two texts line by line. Runs of deleted and inserted lines are
+// paired up in order as Replace lines, which get a word-level diff.
+func Lines(a, b string) []Line {
+	oldLines, newLines := splitLines(a), splitLines(b)
+	edits := myers(oldLines, newLines)
+
+	out := make([]Line, 0, len(edits))
+	oldLine, newLine := 0, 0
+	for i := 0; i < len(edits); {
+		if edits[i] == Equal {
+			oldLine++
+			newLine++
+			out = append(out, Line{Op: Equal, OldText: oldLines[oldLine-1], NewText: newLines[newLine-1], OldLine: oldLine, NewLine: newLine})
+			i++
+			continue
+		}
+		// a run of changes
+		var deleted, inserted []int
+		for ; i < len(edits) && edits[i] != Equal; i++ {
+			if edits[i] == Delete {
+				oldLine++
+				deleted = append(deleted, oldLine)
+			} else {
+				newLine++
+				inserted = append(inserted, newLine)
+			}
+		}
+		n := min(len(deleted), len(inserted))
+		for j := 0; j < n; j++ {
+			o, w := oldLines[deleted[j]-1], newLines[inserted[j]-1]
+			out = append(out, Line{Op: Replace, OldText: o, NewText: w, OldLine: deleted[j], NewLine: inserted[j], Words: Words(o, w)})
+		}
+		for _, d := range deleted[n:] {
+			out = append(out, Line{Op: Delete, OldText: oldLines[d-1], OldLine: d})
+		}
+		for _, ins := range inserted[n:] {
+			out = append(out, Line{Op: Insert, NewText: newLines[ins-1], NewLine: ins})
+		}
+	}
+	return out
+}
+
+// Words diffs two texts word by word, merging neighbouring words with the same Op.
+func Words(a, b string) []Word {
+	oldWords, newWords := splitWords(a), splitWords(b)
+	edits := myers(oldWords, newWords)
+
+	out := make([]Word, 0)
+	i, j := 0, 0
+	for _, op := range edits {
+		var text string
+		switch op {
+		case Equal:
+			text = oldWords[i]
+			i++
+			j++
+		case Delete:
+			text = oldWords[i]
+			i++
+		case Insert:
+			text = newWords[j]
+			j++
+		}
+		if len(out) > 0 && out[len(out)-1].Op == op {
+			out[len(out)-1].Text += text
+		} else {
+			out = append(out, Word{Op: op, Text: text})
+		}
+	}
+	return out
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
+
+// splitWords splits s into runs of letters and digits, runs of spaces, and single
+// other characters.
+func splitWords(s string) []string {
+	kind := func(r rune) int {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_':
+			return 1
+		case unicode.IsSpace(r):
+			return 2
+		default:
+			return 0
+		}
+	}
+	out := make([]string, 0)
+	start := 0
+	prev := -1
+	for i, r := range s {
+		k := kind(r)
+		if i > start && (k != prev || k == 0) {
+			out = append(out, s[start:i])
+			start = i
+		}
+		prev = k
+	}
+	if start < len(s) {
+		out = append(out, s[start:])
+	}
+	return out
+}
+
+// myers returns the shortest edit script turning a into b, as Equal, Insert and
+// Delete ops in order, with Myers' algorithm.
+func myers(a, b []string) []Op {
+	n, m := len(a), len(b)
+	maxD := n + m
+	offset := maxD + 1
+	v := make([]int, 2*maxD+3)
+	// trace holds v as it was before each round d
+	trace := make([][]int, 0)
+
+	for d := 0; d <= maxD; d++ {
+		trace = append(trace, append([]int(nil), v...))
+		done := false
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				done = true
+				break
+			}
+		}
+		if done {
+			break
+		}
+	}
+
+	// walk back from the end to recover the script
+	ops := make([]Op, 0, maxD)
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := v[offset+prevK]
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			ops = append(ops, Equal)
+			x--
+			y--
+		}
+		if d > 0 {
+			if x == prevX {
+				ops = append(ops, Insert)
+			} else {
+				ops = append(ops, Delete)
+			}
+		}
+		x, y = prevX, prevY
+	}
+	for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+		ops[i], ops[j] = ops[j], ops[i]
+	}
+	return ops
+}
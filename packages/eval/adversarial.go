@@ -0,0 +1,74 @@
+package eval
+
+import (
+	_ "embed"
+	"fmt"
+	"github.com/tincans-ai/evalite/gen/eval/v1"
+)
+
+//go:embed prompts/generate_adversarial_test_case_prompt.txt
+var generateAdversarialTestCasePrompt string
+
+//go:embed prompts/adversarial/prompt_injection.txt
+var promptInjectionStrategy string
+
+//go:embed prompts/adversarial/jailbreak.txt
+var jailbreakStrategy string
+
+//go:embed prompts/adversarial/edge_case_length.txt
+var edgeCaseLengthStrategy string
+
+//go:embed prompts/adversarial/unicode.txt
+var unicodeStrategy string
+
+//go:embed prompts/adversarial/contradictory_instructions.txt
+var contradictoryInstructionsStrategy string
+
+// adversarialTag marks every test case generated in adversarial mode.
+const adversarialTag = "adversarial"
+
+// attackCategory is a kind of adversarial input, with the meta-prompt strategy
+// used to generate it.
+type attackCategory struct {
+	category evalv1.AttackCategory
+	name     string
+	title    string
+	strategy string
+}
+
+// tag is the tag test cases of the category are created with, so robustness can be
+// compared per category with tag filters.
+func (c attackCategory) tag() string {
+	return adversarialTag + ":" + c.name
+}
+
+var attackCategories = []attackCategory{
+	{evalv1.AttackCategory_ATTACK_CATEGORY_PROMPT_INJECTION, "prompt_injection", "Prompt injection", promptInjectionStrategy},
+	{evalv1.AttackCategory_ATTACK_CATEGORY_JAILBREAK, "jailbreak", "Jailbreak", jailbreakStrategy},
+	{evalv1.AttackCategory_ATTACK_CATEGORY_EDGE_CASE_LENGTH, "edge_case_length", "Edge-case lengths", edgeCaseLengthStrategy},
+	{evalv1.AttackCategory_ATTACK_CATEGORY_UNICODE, "unicode", "Unicode oddities", unicodeStrategy},
+	{evalv1.AttackCategory_ATTACK_CATEGORY_CONTRADICTORY_INSTRUCTIONS, "contradictory_instructions", "Contradictory instructions", contradictoryInstructionsStrategy},
+}
+
+// attackCategoriesFromProto returns the requested categories in a fixed order, or
+// all of them if none are requested.
+func attackCategoriesFromProto(requested []evalv1.AttackCategory) ([]attackCategory, error) {
+	if len(requested) == 0 {
+		return attackCategories, nil
+	}
+	want := make(map[evalv1.AttackCategory]bool, len(requested))
+	for _, r := range requested {
+		want[r] = true
+	}
+	out := make([]attackCategory, 0, len(want))
+	for _, c := range attackCategories {
+		if want[c.category] {
+			out = append(out, c)
+			delete(want, c.category)
+		}
+	}
+	for r := range want {
+		return nil, fmt.Errorf("unknown attack category %v", r)
+	}
+	return out, nil
+}
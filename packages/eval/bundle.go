@@ -0,0 +1,709 @@
+package eval
+
+import (
+	"bytes"
+	"connectrpc.com/connect"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/rs/xid"
+	"github.com/tincans-ai/evalite/gen/eval/v1"
+	"gopkg.in/yaml.v3"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"slices"
+	"sort"
+	"time"
+)
+
+// bundleVersion is the version of the bundle format written by
+// ExportWorkspaceBundle.
+const bundleVersion = 1
+
+// workspaceBundle is a whole workspace as one YAML document. Version numbers in
+// the bundle are the exporting workspace's; on import they are matched to
+// existing versions by content.
+type workspaceBundle struct {
+	BundleVersion              int                     `yaml:"bundle_version"`
+	ID                         string                  `yaml:"id,omitempty"`
+	Name                       string                  `yaml:"name"`
+	XMLMode                    bool                    `yaml:"xml_mode"`
+	CurrentPromptVersion       uint32                  `yaml:"current_prompt_version,omitempty"`
+	CurrentSystemPromptVersion uint32                  `yaml:"current_system_prompt_version,omitempty"`
+	Prompts                    []bundlePrompt          `yaml:"prompts"`
+	SystemPrompts              []bundleSystemPrompt    `yaml:"system_prompts,omitempty"`
+	WorkspaceConfigs           []bundleWorkspaceConfig `yaml:"workspace_configs,omitempty"`
+	TestCases                  []bundleTestCase        `yaml:"test_cases,omitempty"`
+}
+
+type bundlePrompt struct {
+	Version       uint32           `yaml:"version"`
+	Parent        *uint32          `yaml:"parent,omitempty"`
+	CommitMessage string           `yaml:"commit_message,omitempty"`
+	Variables     []bundleVariable `yaml:"variables,omitempty"`
+	Content       string           `yaml:"content"`
+}
+
+type bundleVariable struct {
+	Name string       `yaml:"name"`
+	Type VariableType `yaml:"type"`
+}
+
+type bundleSystemPrompt struct {
+	Version       uint32  `yaml:"version"`
+	Parent        *uint32 `yaml:"parent,omitempty"`
+	CommitMessage string  `yaml:"commit_message,omitempty"`
+	Content       string  `yaml:"content"`
+}
+
+// bundleWorkspaceConfig is matched to the workspace's configs by name.
+type bundleWorkspaceConfig struct {
+	Name            string  `yaml:"name"`
+	ModelConfigName string  `yaml:"model_config"`
+	MaxTokens       int32   `yaml:"max_tokens"`
+	Temperature     float32 `yaml:"temperature"`
+	Active          bool    `yaml:"active"`
+}
+
+// bundleTestCase is matched to the workspace's test cases by ID, then by its text
+// variable values.
+type bundleTestCase struct {
+	ID        string            `yaml:"id,omitempty"`
+	Variables map[string]string `yaml:"variables"`
+	Images    map[string][]byte `yaml:"images,omitempty"`
+	Reference *string           `yaml:"reference,omitempty"`
+	Tags      []string          `yaml:"tags,omitempty"`
+	Split     Split             `yaml:"split,omitempty"`
+	Results   []bundleResult    `yaml:"results,omitempty"`
+}
+
+// bundleResult refers to its workspace config by name and to its prompt version
+// by the bundle's version number.
+type bundleResult struct {
+	ID              string `yaml:"id,omitempty"`
+	Config          string `yaml:"config"`
+	ModelConfigName string `yaml:"model_config,omitempty"`
+	PromptVersion   uint32 `yaml:"prompt_version"`
+	Response        string `yaml:"response"`
+	Rating          int32  `yaml:"rating,omitempty"`
+}
+
+// ExportWorkspaceBundle exports a workspace's versions and configs, and optionally
+// its test cases and results, as one YAML bundle.
+func (s *Service) ExportWorkspaceBundle(ctx context.Context, req *connect.Request[evalv1.ExportWorkspaceBundleRequest]) (*connect.Response[evalv1.ExportWorkspaceBundleResponse], error) {
+	if req.Msg.IncludeResults && !req.Msg.IncludeTestCases {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("exporting results needs the test cases exported too"))
+	}
+	workspace, err := s.getWorkspace(req.Msg.WorkspaceId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+
+	b := workspaceBundle{
+		BundleVersion:              bundleVersion,
+		ID:                         workspace.ID,
+		Name:                       workspace.Name,
+		XMLMode:                    workspace.XMLMode,
+		CurrentPromptVersion:       workspace.CurrentPromptVersionNumber,
+		CurrentSystemPromptVersion: workspace.CurrentSystemPromptVersionNumber,
+	}
+	prompts := slices.Clone(workspace.Prompts)
+	sort.Slice(prompts, func(i, j int) bool { return prompts[i].VersionNumber < prompts[j].VersionNumber })
+	for _, p := range prompts {
+		bp := bundlePrompt{
+			Version:       p.VersionNumber,
+			Parent:        p.ParentVersionNumber,
+			CommitMessage: p.CommitMessage,
+			Content:       p.Content,
+		}
+		for _, v := range p.Variables {
+			bp.Variables = append(bp.Variables, bundleVariable{Name: v.Name, Type: v.Type})
+		}
+		b.Prompts = append(b.Prompts, bp)
+	}
+	systemPrompts := slices.Clone(workspace.SystemPrompts)
+	sort.Slice(systemPrompts, func(i, j int) bool { return systemPrompts[i].VersionNumber < systemPrompts[j].VersionNumber })
+	for _, p := range systemPrompts {
+		b.SystemPrompts = append(b.SystemPrompts, bundleSystemPrompt{
+			Version:       p.VersionNumber,
+			Parent:        p.ParentVersionNumber,
+			CommitMessage: p.CommitMessage,
+			Content:       p.Content,
+		})
+	}
+	configs := slices.Clone(workspace.WorkspaceConfigs)
+	sort.Slice(configs, func(i, j int) bool { return configs[i].CreatedAt.Before(configs[j].CreatedAt) })
+	configNames := make(map[string]string, len(configs))
+	for _, wc := range configs {
+		configNames[wc.ID] = wc.Name
+		b.WorkspaceConfigs = append(b.WorkspaceConfigs, bundleWorkspaceConfig{
+			Name:            wc.Name,
+			ModelConfigName: wc.ModelConfigName,
+			MaxTokens:       wc.MessageOptions.MaxTokens,
+			Temperature:     wc.MessageOptions.Temperature,
+			Active:          wc.Active,
+		})
+	}
+
+	if req.Msg.IncludeTestCases {
+		var testCases []TestCase
+		if err := s.db.Where("workspace_id = ?", workspace.ID).Order("created_at").Find(&testCases).Error; err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to fetch test cases: %v", err))
+		}
+		results := make(map[string][]bundleResult)
+		if req.Msg.IncludeResults && len(configNames) > 0 {
+			testCaseIDs := make([]string, 0, len(testCases))
+			for _, tc := range testCases {
+				testCaseIDs = append(testCaseIDs, tc.ID)
+			}
+			configIDs := make([]string, 0, len(configNames))
+			for id := range configNames {
+				configIDs = append(configIDs, id)
+			}
+			for chunk := range slices.Chunk(testCaseIDs, importBatchSize) {
+				var batch []TestResult
+				if err := s.db.Where("test_case_id IN ? AND workspace_config_id IN ?", chunk, configIDs).
+					Order("created_at").Find(&batch).Error; err != nil {
+					return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to fetch test results: %v", err))
+				}
+				for _, r := range batch {
+					results[r.TestCaseID] = append(results[r.TestCaseID], bundleResult{
+						ID:              r.ID,
+						Config:          configNames[r.WorkspaceConfigID],
+						ModelConfigName: r.ModelConfigName,
+						PromptVersion:   r.PromptVersionNumber,
+						Response:        r.Response,
+						Rating:          r.Rating,
+					})
+				}
+			}
+		}
+		for _, tc := range testCases {
+			btc := bundleTestCase{
+				ID:        tc.ID,
+				Variables: make(map[string]string),
+				Reference: tc.Response,
+				Tags:      tc.Tags,
+				Split:     tc.Split,
+				Results:   results[tc.ID],
+			}
+			for name, v := range tc.VariableValues {
+				switch {
+				case v.TextValue != nil:
+					btc.Variables[name] = *v.TextValue
+				case v.ImageValue != nil:
+					if btc.Images == nil {
+						btc.Images = make(map[string][]byte)
+					}
+					btc.Images[name] = v.ImageValue
+				}
+			}
+			b.TestCases = append(b.TestCases, btc)
+		}
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&b); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to encode bundle: %v", err))
+	}
+	if err := enc.Close(); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to encode bundle: %v", err))
+	}
+
+	res := connect.NewResponse(&evalv1.ExportWorkspaceBundleResponse{
+		Bundle: buf.Bytes(),
+	})
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
+
+// ImportWorkspaceBundle imports a YAML bundle into the workspace it was exported
+// from, or into req.WorkspaceId, creating the workspace if it does not exist.
+// Anything already in the workspace is matched rather than created again, so
+// importing the same bundle twice changes nothing and importing an edited bundle
+// only adds what was edited.
+func (s *Service) ImportWorkspaceBundle(ctx context.Context, req *connect.Request[evalv1.ImportWorkspaceBundleRequest]) (*connect.Response[evalv1.ImportWorkspaceBundleResponse], error) {
+	b, err := parseBundle(req.Msg.Bundle)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	workspaceID := req.Msg.WorkspaceId
+	if workspaceID == "" {
+		workspaceID = b.ID
+	}
+
+	out := &evalv1.ImportWorkspaceBundleResponse{}
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		var workspace Workspace
+		err := tx.Preload("Prompts").Preload("SystemPrompts").Preload("WorkspaceConfigs").First(&workspace, "id = ?", workspaceID).Error
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound) && req.Msg.WorkspaceId != "":
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("workspace not found"))
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			if b.Name == "" {
+				return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("bundle has no name"))
+			}
+			if len(b.Prompts) == 0 {
+				return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("bundle has no prompts"))
+			}
+			if workspaceID == "" {
+				workspaceID = xid.New().String()
+			}
+			workspace = Workspace{ID: workspaceID, Name: b.Name}
+			if err := tx.Omit(clause.Associations).Create(&workspace).Error; err != nil {
+				return err
+			}
+			out.WorkspaceCreated = true
+		case err != nil:
+			return err
+		}
+		if b.Name != "" {
+			workspace.Name = b.Name
+		}
+		workspace.XMLMode = b.XMLMode
+
+		prompts, err := importBundlePrompts(tx, &workspace, b)
+		if err != nil {
+			return err
+		}
+		systemPrompts, err := importBundleSystemPrompts(tx, &workspace, b)
+		if err != nil {
+			return err
+		}
+		out.NPromptsAdded, out.NSystemPromptsAdded = prompts.added, systemPrompts.added
+		if v, ok := prompts.versions[b.CurrentPromptVersion]; ok {
+			workspace.CurrentPromptVersionNumber = v
+		} else if out.WorkspaceCreated {
+			workspace.CurrentPromptVersionNumber = prompts.versions[b.Prompts[len(b.Prompts)-1].Version]
+		}
+		if v, ok := systemPrompts.versions[b.CurrentSystemPromptVersion]; ok {
+			workspace.CurrentSystemPromptVersionNumber = v
+		}
+
+		configIDs, err := importBundleConfigs(tx, &workspace, b, out)
+		if err != nil {
+			return err
+		}
+		if err := importBundleTestCases(tx, &workspace, b, prompts.versions, configIDs, out); err != nil {
+			return err
+		}
+
+		return tx.Model(&Workspace{}).Where("id = ?", workspace.ID).Updates(map[string]any{
+			"name":                                 workspace.Name,
+			"xml_mode":                             workspace.XMLMode,
+			"current_prompt_version_number":        workspace.CurrentPromptVersionNumber,
+			"current_system_prompt_version_number": workspace.CurrentSystemPromptVersionNumber,
+			"active_version_numbers":               workspace.ActiveVersionNumbers,
+			"updated_at":                           time.Now(),
+		}).Error
+	})
+	if err != nil {
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			return nil, connectErr
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to import bundle: %v", err))
+	}
+
+	workspace, err := s.getWorkspace(workspaceID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	out.Workspace = workspaceToProto(workspace)
+	res := connect.NewResponse(out)
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
+
+// parseBundle decodes and checks a bundle. Versions are sorted so parents come
+// before the versions edited from them.
+func parseBundle(data []byte) (*workspaceBundle, error) {
+	var b workspaceBundle
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&b); err != nil {
+		return nil, fmt.Errorf("invalid bundle: %w", err)
+	}
+	if b.BundleVersion != bundleVersion {
+		return nil, fmt.Errorf("unsupported bundle version %d", b.BundleVersion)
+	}
+
+	sort.SliceStable(b.Prompts, func(i, j int) bool { return b.Prompts[i].Version < b.Prompts[j].Version })
+	for i, p := range b.Prompts {
+		if i > 0 && b.Prompts[i-1].Version == p.Version {
+			return nil, fmt.Errorf("prompt version %d appears more than once", p.Version)
+		}
+		if _, err := promptVariables(p.Content); err != nil {
+			return nil, fmt.Errorf("prompt version %d: %w", p.Version, err)
+		}
+	}
+	if b.CurrentPromptVersion != 0 && !slices.ContainsFunc(b.Prompts, func(p bundlePrompt) bool { return p.Version == b.CurrentPromptVersion }) {
+		return nil, fmt.Errorf("current prompt version %d is not in the bundle", b.CurrentPromptVersion)
+	}
+	sort.SliceStable(b.SystemPrompts, func(i, j int) bool { return b.SystemPrompts[i].Version < b.SystemPrompts[j].Version })
+	for i, p := range b.SystemPrompts {
+		if i > 0 && b.SystemPrompts[i-1].Version == p.Version {
+			return nil, fmt.Errorf("system prompt version %d appears more than once", p.Version)
+		}
+	}
+	if b.CurrentSystemPromptVersion != 0 && !slices.ContainsFunc(b.SystemPrompts, func(p bundleSystemPrompt) bool { return p.Version == b.CurrentSystemPromptVersion }) {
+		return nil, fmt.Errorf("current system prompt version %d is not in the bundle", b.CurrentSystemPromptVersion)
+	}
+
+	configs := make(map[string]bool, len(b.WorkspaceConfigs))
+	for _, wc := range b.WorkspaceConfigs {
+		if wc.Name == "" || wc.ModelConfigName == "" {
+			return nil, fmt.Errorf("workspace configs need a name and a model config")
+		}
+		if configs[wc.Name] {
+			return nil, fmt.Errorf("workspace config %q appears more than once", wc.Name)
+		}
+		configs[wc.Name] = true
+	}
+	for _, tc := range b.TestCases {
+		switch tc.Split {
+		case "", SplitDev, SplitTest, SplitHoldout:
+		default:
+			return nil, fmt.Errorf("test case %s: unknown split %q", tc.ID, tc.Split)
+		}
+		for _, r := range tc.Results {
+			if !slices.ContainsFunc(b.Prompts, func(p bundlePrompt) bool { return p.Version == r.PromptVersion }) {
+				return nil, fmt.Errorf("test case %s: result for prompt version %d, which is not in the bundle", tc.ID, r.PromptVersion)
+			}
+		}
+	}
+	return &b, nil
+}
+
+// importedVersions maps the bundle's version numbers to the workspace's.
+type importedVersions struct {
+	versions map[uint32]uint32
+	added    uint32
+}
+
+// importBundlePrompts matches the bundle's prompt versions to existing ones with
+// the same content and creates the rest as new versions.
+func importBundlePrompts(tx *gorm.DB, workspace *Workspace, b *workspaceBundle) (importedVersions, error) {
+	out := importedVersions{versions: make(map[uint32]uint32, len(b.Prompts))}
+	for _, bp := range b.Prompts {
+		if i := slices.IndexFunc(workspace.Prompts, func(p Prompt) bool { return p.Content == bp.Content }); i >= 0 {
+			out.versions[bp.Version] = workspace.Prompts[i].VersionNumber
+			continue
+		}
+		variables, err := promptVariables(bp.Content)
+		if err != nil {
+			return out, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		// the bundle can mark variables as images; the template cannot
+		for i := range variables {
+			if j := slices.IndexFunc(bp.Variables, func(v bundleVariable) bool { return v.Name == variables[i].Name }); j >= 0 && bp.Variables[j].Type != "" {
+				variables[i].Type = bp.Variables[j].Type
+			}
+		}
+		p := workspace.newPromptVersion(bp.Content, variables)
+		if workspace.ID == b.ID && workspace.PromptByVersion(bp.Version) != nil {
+			// the version was edited in the bundle
+			parent := bp.Version
+			p.ParentVersionNumber = &parent
+		} else if bp.Parent != nil {
+			if parent, ok := out.versions[*bp.Parent]; ok {
+				p.ParentVersionNumber = &parent
+			}
+		}
+		p.CommitMessage = bp.CommitMessage
+		if err := tx.Create(&p).Error; err != nil {
+			return out, err
+		}
+		workspace.Prompts = append(workspace.Prompts, p)
+		workspace.ActiveVersionNumbers = append(workspace.ActiveVersionNumbers, p.VersionNumber)
+		out.versions[bp.Version] = p.VersionNumber
+		out.added++
+	}
+	return out, nil
+}
+
+// importBundleSystemPrompts is importBundlePrompts for system prompts.
+func importBundleSystemPrompts(tx *gorm.DB, workspace *Workspace, b *workspaceBundle) (importedVersions, error) {
+	out := importedVersions{versions: make(map[uint32]uint32, len(b.SystemPrompts))}
+	for _, bp := range b.SystemPrompts {
+		if i := slices.IndexFunc(workspace.SystemPrompts, func(p SystemPrompt) bool { return p.Content == bp.Content }); i >= 0 {
+			out.versions[bp.Version] = workspace.SystemPrompts[i].VersionNumber
+			continue
+		}
+		p := workspace.newSystemPromptVersion(bp.Content)
+		if workspace.ID == b.ID && workspace.SystemPromptByVersion(bp.Version) != nil {
+			// the version was edited in the bundle
+			parent := bp.Version
+			p.ParentVersionNumber = &parent
+		} else if bp.Parent != nil {
+			if parent, ok := out.versions[*bp.Parent]; ok {
+				p.ParentVersionNumber = &parent
+			}
+		}
+		p.CommitMessage = bp.CommitMessage
+		if err := tx.Create(&p).Error; err != nil {
+			return out, err
+		}
+		workspace.SystemPrompts = append(workspace.SystemPrompts, p)
+		out.versions[bp.Version] = p.VersionNumber
+		out.added++
+	}
+	return out, nil
+}
+
+// importBundleConfigs creates the bundle's workspace configs missing from the
+// workspace and updates the ones whose settings were edited. It returns the IDs
+// of all the workspace's configs by name.
+func importBundleConfigs(tx *gorm.DB, workspace *Workspace, b *workspaceBundle, out *evalv1.ImportWorkspaceBundleResponse) (map[string]string, error) {
+	configIDs := make(map[string]string, len(workspace.WorkspaceConfigs))
+	for _, wc := range workspace.WorkspaceConfigs {
+		configIDs[wc.Name] = wc.ID
+	}
+	for _, bc := range b.WorkspaceConfigs {
+		options := MessageOptions{MaxTokens: bc.MaxTokens, Temperature: bc.Temperature}
+		i := slices.IndexFunc(workspace.WorkspaceConfigs, func(wc WorkspaceConfig) bool { return wc.Name == bc.Name })
+		if i < 0 {
+			wc := workspace.CreateWorkspaceConfig(bc.Name, bc.ModelConfigName, options)
+			wc.Active = bc.Active
+			if err := tx.Create(&wc).Error; err != nil {
+				return nil, err
+			}
+			configIDs[wc.Name] = wc.ID
+			out.NWorkspaceConfigsAdded++
+			continue
+		}
+		wc := workspace.WorkspaceConfigs[i]
+		if wc.ModelConfigName == bc.ModelConfigName && wc.MessageOptions == options && wc.Active == bc.Active {
+			continue
+		}
+		if err := tx.Model(&WorkspaceConfig{}).Where("id = ?", wc.ID).Updates(map[string]any{
+			"model_config_name": bc.ModelConfigName,
+			"max_tokens":        bc.MaxTokens,
+			"temperature":       bc.Temperature,
+			"active":            bc.Active,
+		}).Error; err != nil {
+			return nil, err
+		}
+	}
+	return configIDs, nil
+}
+
+// importBundleTestCases matches the bundle's test cases to the workspace's own by
+// ID or by their text variable values, creates the rest, and does the same for
+// their results. IDs from the bundle are kept when they are not taken.
+func importBundleTestCases(tx *gorm.DB, workspace *Workspace, b *workspaceBundle, versions map[uint32]uint32, configIDs map[string]string, out *evalv1.ImportWorkspaceBundleResponse) error {
+	if len(b.TestCases) == 0 {
+		return nil
+	}
+	var existing []TestCase
+	if err := tx.Where("workspace_id = ?", workspace.ID).Find(&existing).Error; err != nil {
+		return err
+	}
+	byID := make(map[string]*TestCase, len(existing))
+	byKey := make(map[string]*TestCase, len(existing))
+	for i := range existing {
+		byID[existing[i].ID] = &existing[i]
+		byKey[variablesKey(existing[i].VariableValues)] = &existing[i]
+	}
+
+	bundleIDs := make([]string, 0, len(b.TestCases))
+	for _, btc := range b.TestCases {
+		if btc.ID != "" {
+			bundleIDs = append(bundleIDs, btc.ID)
+		}
+	}
+	takenTestCaseIDs, err := takenIDs(tx, &TestCase{}, bundleIDs)
+	if err != nil {
+		return err
+	}
+
+	// testCaseIDs maps each bundle test case, by index, to the workspace's
+	testCaseIDs := make([]string, len(b.TestCases))
+	var created []TestCase
+	for i, btc := range b.TestCases {
+		values := make(VariableValues, len(btc.Variables)+len(btc.Images))
+		for name, v := range btc.Variables {
+			values[name] = VariableValue{TextValue: &v}
+		}
+		for name, v := range btc.Images {
+			values[name] = VariableValue{ImageValue: v}
+		}
+		tags := normalizeTags(btc.Tags)
+
+		tc, ok := byID[btc.ID]
+		if !ok {
+			tc, ok = byKey[variablesKey(values)]
+		}
+		if ok {
+			testCaseIDs[i] = tc.ID
+			if !slices.Equal(tc.Tags, tags) || tc.Split != btc.Split || !equalStringPtr(tc.Response, btc.Reference) {
+				if err := tx.Model(&TestCase{}).Where("id = ?", tc.ID).Updates(map[string]any{
+					"response": btc.Reference,
+					"tags":     datatypes.JSONSlice[string](tags),
+					"split":    btc.Split,
+				}).Error; err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		id := btc.ID
+		if id == "" || takenTestCaseIDs[id] {
+			id = xid.New().String()
+		}
+		takenTestCaseIDs[id] = true
+		tc = &TestCase{
+			ID:             id,
+			WorkspaceID:    workspace.ID,
+			VariableValues: values,
+			Response:       btc.Reference,
+			Tags:           tags,
+			Split:          btc.Split,
+		}
+		created = append(created, *tc)
+		// a later duplicate in the bundle matches this one
+		if btc.ID != "" {
+			byID[btc.ID] = tc
+		}
+		byKey[variablesKey(values)] = tc
+		testCaseIDs[i] = id
+	}
+	if len(created) > 0 {
+		if err := tx.CreateInBatches(created, importBatchSize).Error; err != nil {
+			return err
+		}
+		out.NTestCasesAdded = uint32(len(created))
+	}
+
+	return importBundleResults(tx, b, testCaseIDs, versions, configIDs, out)
+}
+
+// importBundleResults creates the results of the bundle's test cases that are not
+// in the workspace yet. A result matches an existing one with the same ID, or with
+// the same test case, config, version and response.
+func importBundleResults(tx *gorm.DB, b *workspaceBundle, testCaseIDs []string, versions map[uint32]uint32, configIDs map[string]string, out *evalv1.ImportWorkspaceBundleResponse) error {
+	var bundleIDs []string
+	hasResults := false
+	for _, btc := range b.TestCases {
+		for _, r := range btc.Results {
+			hasResults = true
+			if _, ok := configIDs[r.Config]; !ok {
+				return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("test case %s: result for unknown workspace config %q", btc.ID, r.Config))
+			}
+			if r.ID != "" {
+				bundleIDs = append(bundleIDs, r.ID)
+			}
+		}
+	}
+	if !hasResults {
+		return nil
+	}
+
+	resultKey := func(testCaseID, configID string, version uint32, response string) string {
+		return fmt.Sprintf("%s\x00%s\x00%d\x00%s", testCaseID, configID, version, response)
+	}
+	existing := make(map[string]bool)
+	existingIDs := make(map[string]bool)
+	for chunk := range slices.Chunk(testCaseIDs, importBatchSize) {
+		var batch []TestResult
+		if err := tx.Where("test_case_id IN ?", chunk).Find(&batch).Error; err != nil {
+			return err
+		}
+		for _, r := range batch {
+			existing[resultKey(r.TestCaseID, r.WorkspaceConfigID, r.PromptVersionNumber, r.Response)] = true
+			existingIDs[r.ID] = true
+		}
+	}
+	takenResultIDs, err := takenIDs(tx, &TestResult{}, bundleIDs)
+	if err != nil {
+		return err
+	}
+
+	configs := make(map[string]WorkspaceConfig)
+	var created []TestResult
+	evaluated := make(map[string]bool)
+	for i, btc := range b.TestCases {
+		for _, r := range btc.Results {
+			configID := configIDs[r.Config]
+			version := versions[r.PromptVersion]
+			key := resultKey(testCaseIDs[i], configID, version, r.Response)
+			if existingIDs[r.ID] || existing[key] {
+				continue
+			}
+			existing[key] = true
+
+			wc, ok := configs[configID]
+			if !ok {
+				if err := tx.First(&wc, "id = ?", configID).Error; err != nil {
+					return err
+				}
+				configs[configID] = wc
+			}
+			id := r.ID
+			if id == "" || takenResultIDs[id] {
+				id = xid.New().String()
+			}
+			takenResultIDs[id] = true
+			modelConfigName := r.ModelConfigName
+			if modelConfigName == "" {
+				modelConfigName = wc.ModelConfigName
+			}
+			created = append(created, TestResult{
+				ID:                  id,
+				TestCaseID:          testCaseIDs[i],
+				Response:            r.Response,
+				PromptVersionNumber: version,
+				ModelConfigName:     modelConfigName,
+				WorkspaceConfigID:   configID,
+				Rating:              r.Rating,
+				MessageOptions:      wc.MessageOptions,
+			})
+			evaluated[testCaseIDs[i]] = true
+		}
+	}
+	if len(created) == 0 {
+		return nil
+	}
+	if err := tx.CreateInBatches(created, importBatchSize).Error; err != nil {
+		return err
+	}
+	out.NResultsAdded = uint32(len(created))
+
+	ids := make([]string, 0, len(evaluated))
+	for id := range evaluated {
+		ids = append(ids, id)
+	}
+	for chunk := range slices.Chunk(ids, importBatchSize) {
+		if err := tx.Model(&TestCase{}).Where("id IN ? AND dataset_id IS NULL", chunk).Update("has_been_evaluated", true).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// takenIDs returns which of ids are already used by rows of model, including
+// deleted ones.
+func takenIDs(tx *gorm.DB, model any, ids []string) (map[string]bool, error) {
+	taken := make(map[string]bool)
+	for chunk := range slices.Chunk(ids, importBatchSize) {
+		var found []string
+		if err := tx.Unscoped().Model(model).Where("id IN ?", chunk).Pluck("id", &found).Error; err != nil {
+			return nil, err
+		}
+		for _, id := range found {
+			taken[id] = true
+		}
+	}
+	return taken, nil
+}
+
+func equalStringPtr(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
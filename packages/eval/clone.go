@@ -0,0 +1,258 @@
+package eval
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/rs/xid"
+	"github.com/tincans-ai/evalite/gen/eval/v1"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"slices"
+)
+
+// CloneWorkspace copies a workspace into a new one with new IDs, so a different
+// approach can be tried without adding versions and results to the original.
+func (s *Service) CloneWorkspace(ctx context.Context, req *connect.Request[evalv1.CloneWorkspaceRequest]) (*connect.Response[evalv1.CloneWorkspaceResponse], error) {
+	if req.Msg.CopyResults && (!req.Msg.CopyWorkspaceConfigs || !req.Msg.CopyTestCases) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("copying results needs the workspace configs and test cases copied too"))
+	}
+	source, err := s.getWorkspace(req.Msg.WorkspaceId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+
+	name := req.Msg.Name
+	if name == "" {
+		name = source.Name + " (copy)"
+	}
+	sourceID := source.ID
+	clone := &Workspace{
+		ID:                               xid.New().String(),
+		Name:                             name,
+		ModelConfigNames:                 source.ModelConfigNames,
+		CurrentPromptVersionNumber:       source.CurrentPromptVersionNumber,
+		XMLMode:                          source.XMLMode,
+		CurrentSystemPromptVersionNumber: source.CurrentSystemPromptVersionNumber,
+		ExampleCount:                     source.ExampleCount,
+		ExampleStrategy:                  source.ExampleStrategy,
+		ExampleSeed:                      source.ExampleSeed,
+		SourceWorkspaceID:                &sourceID,
+	}
+
+	// versions keep their numbers, so copied results still point at the right one
+	prompts := source.Prompts
+	systemPrompts := source.SystemPrompts
+	if !req.Msg.AllVersions {
+		prompts, systemPrompts = nil, nil
+		if p := source.CurrentPrompt(); p != nil {
+			prompts = []Prompt{*p}
+		}
+		if p := source.CurrentSystemPrompt(); p != nil {
+			systemPrompts = []SystemPrompt{*p}
+		}
+	}
+	versions := make([]uint32, 0, len(prompts))
+	for _, p := range prompts {
+		versions = append(versions, p.VersionNumber)
+	}
+	for i := range prompts {
+		prompts[i].WorkspaceID = clone.ID
+		if prompts[i].ParentVersionNumber != nil && !slices.Contains(versions, *prompts[i].ParentVersionNumber) {
+			prompts[i].ParentVersionNumber = nil
+		}
+	}
+	systemVersions := make([]uint32, 0, len(systemPrompts))
+	for _, p := range systemPrompts {
+		systemVersions = append(systemVersions, p.VersionNumber)
+	}
+	for i := range systemPrompts {
+		systemPrompts[i].WorkspaceID = clone.ID
+		if systemPrompts[i].ParentVersionNumber != nil && !slices.Contains(systemVersions, *systemPrompts[i].ParentVersionNumber) {
+			systemPrompts[i].ParentVersionNumber = nil
+		}
+	}
+	for _, v := range source.ActiveVersionNumbers {
+		if slices.Contains(versions, v) {
+			clone.ActiveVersionNumbers = append(clone.ActiveVersionNumbers, v)
+		}
+	}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Create(clone).Error; err != nil {
+			return err
+		}
+		// BeforeCreate resets the current version
+		if err := tx.Model(&Workspace{}).Where("id = ?", clone.ID).
+			Update("current_prompt_version_number", source.CurrentPromptVersionNumber).Error; err != nil {
+			return err
+		}
+		clone.CurrentPromptVersionNumber = source.CurrentPromptVersionNumber
+		if len(prompts) > 0 {
+			if err := tx.Create(&prompts).Error; err != nil {
+				return err
+			}
+		}
+		if len(systemPrompts) > 0 {
+			if err := tx.Create(&systemPrompts).Error; err != nil {
+				return err
+			}
+		}
+
+		configIDs := make(map[string]string)
+		if req.Msg.CopyWorkspaceConfigs {
+			configs := make([]WorkspaceConfig, 0, len(source.WorkspaceConfigs))
+			for _, wc := range source.WorkspaceConfigs {
+				id := xid.New().String()
+				configIDs[wc.ID] = id
+				wc.ID = id
+				wc.WorkspaceID = clone.ID
+				configs = append(configs, wc)
+			}
+			if len(configs) > 0 {
+				if err := tx.Create(&configs).Error; err != nil {
+					return err
+				}
+			}
+		}
+
+		testCaseIDs := make(map[string]string)
+		if req.Msg.CopyTestCases {
+			var testCases []TestCase
+			if err := tx.Where("workspace_id = ?", source.ID).Order("created_at").Find(&testCases).Error; err != nil {
+				return err
+			}
+			for _, tc := range testCases {
+				testCaseIDs[tc.ID] = xid.New().String()
+			}
+			for i := range testCases {
+				testCases[i].ID = testCaseIDs[testCases[i].ID]
+				testCases[i].WorkspaceID = clone.ID
+				if parent := testCases[i].ParentTestCaseID; parent != nil {
+					if id, ok := testCaseIDs[*parent]; ok {
+						testCases[i].ParentTestCaseID = &id
+					} else {
+						testCases[i].ParentTestCaseID = nil
+					}
+				}
+				if !req.Msg.CopyResults {
+					testCases[i].HasBeenEvaluated = false
+				}
+			}
+			if len(testCases) > 0 {
+				if err := tx.CreateInBatches(testCases, importBatchSize).Error; err != nil {
+					return err
+				}
+			}
+
+			var attachments []DatasetAttachment
+			if err := tx.Where("workspace_id = ?", source.ID).Find(&attachments).Error; err != nil {
+				return err
+			}
+			for i := range attachments {
+				attachments[i].ID = xid.New().String()
+				attachments[i].WorkspaceID = clone.ID
+			}
+			if len(attachments) > 0 {
+				if err := tx.Create(&attachments).Error; err != nil {
+					return err
+				}
+			}
+		}
+
+		resultIDs := make(map[string]string)
+		if req.Msg.CopyResults && len(configIDs) > 0 && len(versions) > 0 {
+			sourceConfigIDs := make([]string, 0, len(configIDs))
+			for id := range configIDs {
+				sourceConfigIDs = append(sourceConfigIDs, id)
+			}
+			var results []TestResult
+			if err := tx.Where("workspace_config_id IN ? AND prompt_version_number IN ?", sourceConfigIDs, versions).
+				Order("created_at").Find(&results).Error; err != nil {
+				return err
+			}
+			for i := range results {
+				id := xid.New().String()
+				resultIDs[results[i].ID] = id
+				results[i].ID = id
+				results[i].WorkspaceConfigID = configIDs[results[i].WorkspaceConfigID]
+				// dataset test cases are shared, so their results keep pointing at them
+				if tcID, ok := testCaseIDs[results[i].TestCaseID]; ok {
+					results[i].TestCaseID = tcID
+				}
+			}
+			if len(results) > 0 {
+				if err := tx.CreateInBatches(results, importBatchSize).Error; err != nil {
+					return err
+				}
+			}
+
+			sourceResultIDs := make([]string, 0, len(resultIDs))
+			for id := range resultIDs {
+				sourceResultIDs = append(sourceResultIDs, id)
+			}
+			var ratings []Rating
+			for chunk := range slices.Chunk(sourceResultIDs, importBatchSize) {
+				var batch []Rating
+				if err := tx.Where("test_result_id IN ?", chunk).Find(&batch).Error; err != nil {
+					return err
+				}
+				ratings = append(ratings, batch...)
+			}
+			for i := range ratings {
+				ratings[i].ID = xid.New().String()
+				ratings[i].TestResultID = resultIDs[ratings[i].TestResultID]
+			}
+			if len(ratings) > 0 {
+				if err := tx.CreateInBatches(ratings, importBatchSize).Error; err != nil {
+					return err
+				}
+			}
+		}
+
+		// examples follow their results and test cases into the clone, and are kept
+		// without them when those are not copied
+		var examples []Example
+		if err := tx.Where("workspace_id = ?", source.ID).Order("created_at").Find(&examples).Error; err != nil {
+			return err
+		}
+		for i := range examples {
+			examples[i].ID = xid.New().String()
+			examples[i].WorkspaceID = clone.ID
+			if resultID := examples[i].TestResultID; resultID != nil {
+				if id, ok := resultIDs[*resultID]; ok {
+					examples[i].TestResultID = &id
+				} else {
+					examples[i].TestResultID = nil
+				}
+			}
+			if tcID := examples[i].TestCaseID; tcID != nil {
+				if id, ok := testCaseIDs[*tcID]; ok {
+					examples[i].TestCaseID = &id
+				} else if !req.Msg.CopyTestCases {
+					examples[i].TestCaseID = nil
+				}
+				// dataset test cases are shared, so their examples keep pointing at them
+			}
+		}
+		if len(examples) > 0 {
+			if err := tx.CreateInBatches(examples, importBatchSize).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to clone workspace: %v", err))
+	}
+
+	cloned, err := s.getWorkspace(clone.ID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	res := connect.NewResponse(&evalv1.CloneWorkspaceResponse{
+		Workspace: workspaceToProto(cloned),
+	})
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
@@ -0,0 +1,209 @@
+package eval
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/tincans-ai/evalite/gen/eval/v1"
+	"github.com/tincans-ai/evalite/packages/stats"
+)
+
+// pairedResult is the outcome of one test case under both prompt versions.
+type pairedResult struct {
+	a TestResult
+	b TestResult
+}
+
+// CompareVersions checks whether prompt version b is better than version a on the
+// same test cases, using paired tests so per-case difficulty cancels out.
+func (s *Service) CompareVersions(ctx context.Context, req *connect.Request[evalv1.CompareVersionsRequest]) (*connect.Response[evalv1.CompareVersionsResponse], error) {
+	workspace, err := s.getWorkspace(req.Msg.WorkspaceId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	for _, v := range []uint32{req.Msg.VersionNumberA, req.Msg.VersionNumberB} {
+		if workspace.PromptByVersion(v) == nil {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("prompt version %d not found", v))
+		}
+	}
+
+	// a single config applies to both sides
+	configA, configB := req.Msg.GetWorkspaceConfigIdA(), req.Msg.GetWorkspaceConfigIdB()
+	if configA == "" {
+		configA = configB
+	}
+	if configB == "" {
+		configB = configA
+	}
+
+	resultsA, err := s.latestResultsForVersion(workspace.ID, req.Msg.VersionNumberA, configA, req.Msg.Filter)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load results: %v", err))
+	}
+	resultsB, err := s.latestResultsForVersion(workspace.ID, req.Msg.VersionNumberB, configB, req.Msg.Filter)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load results: %v", err))
+	}
+
+	// pair within the same config unless the caller chose the configs explicitly
+	pairKey := func(tr TestResult) string {
+		if configA != "" {
+			return tr.TestCaseID
+		}
+		return tr.TestCaseID + "/" + tr.WorkspaceConfigID
+	}
+	byKey := make(map[string]TestResult, len(resultsA))
+	for _, tr := range resultsA {
+		byKey[pairKey(tr)] = tr
+	}
+	pairs := make([]pairedResult, 0)
+	resultIDs := make([]string, 0)
+	for _, b := range resultsB {
+		a, ok := byKey[pairKey(b)]
+		if !ok {
+			continue
+		}
+		pairs = append(pairs, pairedResult{a: a, b: b})
+		resultIDs = append(resultIDs, a.ID, b.ID)
+	}
+
+	ratings, err := s.findRatings(resultIDs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load ratings: %v", err))
+	}
+	ratingsByResult := make(map[string][]Rating)
+	for _, r := range ratings {
+		ratingsByResult[r.TestResultID] = append(ratingsByResult[r.TestResultID], r)
+	}
+	thumbs := func(tr TestResult) int32 {
+		if req.Msg.Rater == "" {
+			return tr.Rating
+		}
+		for _, r := range ratingsByResult[tr.ID] {
+			if r.Rater == req.Msg.Rater {
+				return r.Thumbs
+			}
+		}
+		return 0
+	}
+	score := func(tr TestResult) (float64, bool) {
+		var sum float64
+		var n int
+		for _, r := range ratingsByResult[tr.ID] {
+			if r.Score == nil || (req.Msg.Rater != "" && r.Rater != req.Msg.Rater) {
+				continue
+			}
+			sum += *r.Score
+			n++
+		}
+		if n == 0 {
+			return 0, false
+		}
+		return sum / float64(n), true
+	}
+
+	passFail := &evalv1.PassFailComparison{}
+	regressions := make([]*evalv1.FlippedCase, 0)
+	improvements := make([]*evalv1.FlippedCase, 0)
+	scores := &evalv1.ScoreComparison{}
+	diffs := make([]float64, 0)
+	var sumA, sumB float64
+	for _, p := range pairs {
+		ta, tb := thumbs(p.a), thumbs(p.b)
+		if ta != 0 && tb != 0 {
+			passFail.NPairs++
+			flipped := &evalv1.FlippedCase{
+				TestCaseId:        p.a.TestCaseID,
+				TestResultIdA:     p.a.ID,
+				TestResultIdB:     p.b.ID,
+				WorkspaceConfigId: p.b.WorkspaceConfigID,
+			}
+			switch {
+			case ta > 0 && tb > 0:
+				passFail.BothPass++
+			case ta < 0 && tb < 0:
+				passFail.BothFail++
+			case ta > 0:
+				passFail.Regressed++
+				regressions = append(regressions, flipped)
+			default:
+				passFail.Improved++
+				improvements = append(improvements, flipped)
+			}
+		}
+
+		sa, okA := score(p.a)
+		sb, okB := score(p.b)
+		if okA && okB {
+			scores.NPairs++
+			sumA += sa
+			sumB += sb
+			diffs = append(diffs, sb-sa)
+		}
+	}
+
+	if passFail.NPairs > 0 {
+		n := float64(passFail.NPairs)
+		passFail.PassRateA = float64(passFail.BothPass+passFail.Regressed) / n
+		passFail.PassRateB = float64(passFail.BothPass+passFail.Improved) / n
+		passFail.PassRateDifference = passFail.PassRateB - passFail.PassRateA
+	}
+	if passFail.Regressed > 0 {
+		passFail.OddsRatio = optionalFloat(float64(passFail.Improved) / float64(passFail.Regressed))
+	}
+	passFail.PValue = stats.McNemar(int(passFail.Regressed), int(passFail.Improved))
+
+	wilcoxon := stats.WilcoxonSignedRank(diffs)
+	if scores.NPairs > 0 {
+		scores.MeanA = sumA / float64(scores.NPairs)
+		scores.MeanB = sumB / float64(scores.NPairs)
+		scores.MeanDifference = scores.MeanB - scores.MeanA
+	}
+	scores.RankBiserial = optionalFloat(wilcoxon.RankBiserial)
+	scores.WPlus = wilcoxon.WPlus
+	scores.WMinus = wilcoxon.WMinus
+	scores.PValue = wilcoxon.PValue
+
+	res := connect.NewResponse(&evalv1.CompareVersionsResponse{
+		PassFail:     passFail,
+		Scores:       scores,
+		Regressions:  regressions,
+		Improvements: improvements,
+	})
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
+
+// latestResultsForVersion returns the most recent result per test case and config for a
+// prompt version, optionally restricted to a single workspace config and to the test
+// cases matching filter.
+func (s *Service) latestResultsForVersion(workspaceID string, versionNumber uint32, workspaceConfigID string, filter *evalv1.TestCaseFilter) ([]TestResult, error) {
+	query := s.db.Model(&TestResult{}).
+		Scopes(filterTestCases(filter)).
+		Select("test_results.*").
+		Joins("JOIN test_cases ON test_cases.id = test_results.test_case_id").
+		Where("test_cases.deleted_at IS NULL").
+		Scopes(workspaceTestCases(workspaceID), workspaceResults(workspaceID)).
+		Where("test_results.prompt_version_number = ?", versionNumber)
+	if workspaceConfigID != "" {
+		query = query.Where("test_results.workspace_config_id = ?", workspaceConfigID)
+	}
+
+	var results []TestResult
+	if err := query.Order("test_results.created_at").Find(&results).Error; err != nil {
+		return nil, err
+	}
+
+	latest := make(map[string]int)
+	out := make([]TestResult, 0, len(results))
+	for _, tr := range results {
+		key := tr.TestCaseID + "/" + tr.WorkspaceConfigID
+		if i, ok := latest[key]; ok {
+			out[i] = tr
+			continue
+		}
+		latest[key] = len(out)
+		out = append(out, tr)
+	}
+	return out, nil
+}
@@ -0,0 +1,300 @@
+package eval
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/rs/xid"
+	"github.com/tincans-ai/evalite/gen/eval/v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// workspaceTestCases returns a scope that restricts a query on test_cases, or on a
+// join with it, to the test cases of a workspace: its own and those of the datasets
+// attached to it.
+func workspaceTestCases(workspaceID string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("(test_cases.workspace_id = ? OR test_cases.dataset_id IN (SELECT dataset_id FROM dataset_attachments WHERE workspace_id = ?))",
+			workspaceID, workspaceID)
+	}
+}
+
+// workspaceResults returns a scope that restricts a join of test_results and
+// test_cases to a workspace's results. Dataset test cases are shared, so only the
+// results of the workspace's own configs count for them.
+func workspaceResults(workspaceID string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("(test_cases.dataset_id IS NULL OR test_results.workspace_config_id IN (SELECT id FROM workspace_configs WHERE workspace_id = ?))",
+			workspaceID)
+	}
+}
+
+// workspaceConfigResults returns a scope that restricts a query on test_results to
+// the results of a workspace's configs.
+func workspaceConfigResults(workspaceID string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("test_results.workspace_config_id IN (SELECT id FROM workspace_configs WHERE workspace_id = ?)", workspaceID)
+	}
+}
+
+// datasetMappings returns the variable mapping of each dataset attached to a
+// workspace, keyed by dataset ID.
+func (s *Service) datasetMappings(workspaceID string) (map[string]map[string]string, error) {
+	var attachments []DatasetAttachment
+	if err := s.db.Where("workspace_id = ?", workspaceID).Find(&attachments).Error; err != nil {
+		return nil, fmt.Errorf("failed to load dataset attachments: %w", err)
+	}
+	mappings := make(map[string]map[string]string, len(attachments))
+	for _, a := range attachments {
+		mappings[a.DatasetID] = a.VariableMapping
+	}
+	return mappings, nil
+}
+
+// mapDatasetVariables renames the variables of dataset test cases to the names the
+// workspace uses. Other test cases are left alone.
+func mapDatasetVariables(testCases []TestCase, mappings map[string]map[string]string) {
+	for i, tc := range testCases {
+		if tc.DatasetID == nil {
+			continue
+		}
+		testCases[i].VariableValues = renameVariables(tc.VariableValues, mappings[*tc.DatasetID])
+	}
+}
+
+func renameVariables(vv VariableValues, mapping map[string]string) VariableValues {
+	if len(mapping) == 0 {
+		return vv
+	}
+	out := make(VariableValues, len(vv))
+	for name, v := range vv {
+		if to, ok := mapping[name]; ok {
+			name = to
+		}
+		out[name] = v
+	}
+	return out
+}
+
+// invertMapping maps workspace variable names back to dataset variable names.
+func invertMapping(mapping map[string]string) map[string]string {
+	out := make(map[string]string, len(mapping))
+	for from, to := range mapping {
+		out[to] = from
+	}
+	return out
+}
+
+func (s *Service) datasetToProto(d *Dataset) (*evalv1.Dataset, error) {
+	var n int64
+	if err := s.db.Model(&TestCase{}).Where("dataset_id = ?", d.ID).Count(&n).Error; err != nil {
+		return nil, fmt.Errorf("failed to count test cases: %w", err)
+	}
+	attachments := make([]*evalv1.DatasetAttachment, 0, len(d.Attachments))
+	for i := range d.Attachments {
+		attachments = append(attachments, datasetAttachmentToProto(&d.Attachments[i]))
+	}
+	return &evalv1.Dataset{
+		Id:          d.ID,
+		Name:        d.Name,
+		Description: d.Description,
+		NTestCases:  uint32(n),
+		Attachments: attachments,
+		CreatedAt:   timestamppb.New(d.CreatedAt),
+		UpdatedAt:   timestamppb.New(d.UpdatedAt),
+	}, nil
+}
+
+func datasetAttachmentToProto(a *DatasetAttachment) *evalv1.DatasetAttachment {
+	return &evalv1.DatasetAttachment{
+		WorkspaceId:     a.WorkspaceID,
+		DatasetId:       a.DatasetID,
+		VariableMapping: a.VariableMapping,
+		CreatedAt:       timestamppb.New(a.CreatedAt),
+	}
+}
+
+// CreateDataset creates a dataset, optionally moving a workspace's test cases into it.
+func (s *Service) CreateDataset(ctx context.Context, req *connect.Request[evalv1.CreateDatasetRequest]) (*connect.Response[evalv1.CreateDatasetResponse], error) {
+	if req.Msg.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
+	}
+	if req.Msg.SourceWorkspaceId != nil {
+		if _, err := s.getWorkspace(req.Msg.GetSourceWorkspaceId()); err != nil {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+	}
+
+	d := Dataset{
+		ID:          xid.New().String(),
+		Name:        req.Msg.Name,
+		Description: req.Msg.Description,
+	}
+	var nMoved int64
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&d).Error; err != nil {
+			return err
+		}
+		if req.Msg.SourceWorkspaceId == nil {
+			return nil
+		}
+
+		// perturbed variants belong to the workspace's own experiments, so they stay
+		result := tx.Model(&TestCase{}).
+			Where("test_cases.workspace_id = ? AND test_cases.dataset_id IS NULL AND test_cases.parent_test_case_id IS NULL", req.Msg.GetSourceWorkspaceId()).
+			Scopes(filterTestCases(req.Msg.Filter)).
+			Updates(map[string]interface{}{"dataset_id": d.ID, "workspace_id": ""})
+		if result.Error != nil {
+			return result.Error
+		}
+		nMoved = result.RowsAffected
+
+		attachment := DatasetAttachment{
+			ID:          xid.New().String(),
+			WorkspaceID: req.Msg.GetSourceWorkspaceId(),
+			DatasetID:   d.ID,
+		}
+		if err := tx.Create(&attachment).Error; err != nil {
+			return err
+		}
+		d.Attachments = append(d.Attachments, attachment)
+		return nil
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create dataset: %v", err))
+	}
+
+	pbDataset, err := s.datasetToProto(&d)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	res := connect.NewResponse(&evalv1.CreateDatasetResponse{
+		Dataset: pbDataset,
+		NMoved:  uint32(nMoved),
+	})
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
+
+func (s *Service) ListDatasets(ctx context.Context, req *connect.Request[evalv1.ListDatasetsRequest]) (*connect.Response[evalv1.ListDatasetsResponse], error) {
+	query := s.db.Preload("Attachments").Order("created_at")
+	if req.Msg.WorkspaceId != nil {
+		query = query.Where("id IN (SELECT dataset_id FROM dataset_attachments WHERE workspace_id = ?)", req.Msg.GetWorkspaceId())
+	}
+	var datasets []Dataset
+	if err := query.Find(&datasets).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list datasets: %v", err))
+	}
+
+	pbDatasets := make([]*evalv1.Dataset, 0, len(datasets))
+	for i := range datasets {
+		d, err := s.datasetToProto(&datasets[i])
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		pbDatasets = append(pbDatasets, d)
+	}
+
+	res := connect.NewResponse(&evalv1.ListDatasetsResponse{Datasets: pbDatasets})
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
+
+// DeleteDataset permanently deletes a detached dataset with its test cases, their
+// results and ratings.
+func (s *Service) DeleteDataset(ctx context.Context, req *connect.Request[evalv1.DeleteDatasetRequest]) (*connect.Response[emptypb.Empty], error) {
+	var d Dataset
+	if err := s.db.Preload("Attachments").First(&d, "id = ?", req.Msg.Id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("dataset not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to fetch dataset: %v", err))
+	}
+	if len(d.Attachments) > 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("dataset is attached to %d workspaces", len(d.Attachments)))
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var ids []string
+		if err := tx.Unscoped().Model(&TestCase{}).Where("dataset_id = ?", d.ID).Pluck("id", &ids).Error; err != nil {
+			return err
+		}
+		if len(ids) > 0 {
+			if _, err := purgeTestCases(tx, ids); err != nil {
+				return err
+			}
+		}
+		return tx.Delete(&d).Error
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete dataset: %v", err))
+	}
+
+	res := connect.NewResponse(&emptypb.Empty{})
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
+
+// AttachDataset attaches a dataset to a workspace, or updates its variable mapping.
+func (s *Service) AttachDataset(ctx context.Context, req *connect.Request[evalv1.AttachDatasetRequest]) (*connect.Response[evalv1.AttachDatasetResponse], error) {
+	if _, err := s.getWorkspace(req.Msg.WorkspaceId); err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	var d Dataset
+	if err := s.db.First(&d, "id = ?", req.Msg.DatasetId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("dataset not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to fetch dataset: %v", err))
+	}
+
+	targets := make(map[string]string, len(req.Msg.VariableMapping))
+	for from, to := range req.Msg.VariableMapping {
+		if from == "" || to == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("variable mapping has an empty name"))
+		}
+		if other, ok := targets[to]; ok {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("variables %q and %q are both mapped to %q", other, from, to))
+		}
+		targets[to] = from
+	}
+
+	attachment := DatasetAttachment{
+		ID:              xid.New().String(),
+		WorkspaceID:     req.Msg.WorkspaceId,
+		DatasetID:       d.ID,
+		VariableMapping: req.Msg.VariableMapping,
+	}
+	err := s.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "workspace_id"}, {Name: "dataset_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"variable_mapping", "updated_at"}),
+	}).Create(&attachment).Error
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to attach dataset: %v", err))
+	}
+	if err := s.db.First(&attachment, "workspace_id = ? AND dataset_id = ?", req.Msg.WorkspaceId, d.ID).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to fetch attachment: %v", err))
+	}
+
+	res := connect.NewResponse(&evalv1.AttachDatasetResponse{Attachment: datasetAttachmentToProto(&attachment)})
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
+
+func (s *Service) DetachDataset(ctx context.Context, req *connect.Request[evalv1.DetachDatasetRequest]) (*connect.Response[emptypb.Empty], error) {
+	result := s.db.Where("workspace_id = ? AND dataset_id = ?", req.Msg.WorkspaceId, req.Msg.DatasetId).Delete(&DatasetAttachment{})
+	if result.Error != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to detach dataset: %v", result.Error))
+	}
+	if result.RowsAffected == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("dataset is not attached to the workspace"))
+	}
+
+	res := connect.NewResponse(&emptypb.Empty{})
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
@@ -0,0 +1,208 @@
+package eval
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/tincans-ai/evalite/gen/eval/v1"
+	"github.com/tincans-ai/evalite/packages/dedup"
+	"math"
+	"sort"
+	"strings"
+)
+
+const (
+	// defaultDedupThreshold is the similarity above which test cases are near-duplicates
+	// when the request does not say.
+	defaultDedupThreshold = 0.8
+	// nearDuplicateTag marks test cases created despite being near-duplicates.
+	nearDuplicateTag = "near-duplicate"
+)
+
+// dedupThreshold validates a requested similarity threshold, defaulting it if unset.
+func dedupThreshold(t float64) (float64, error) {
+	if t == 0 {
+		return defaultDedupThreshold, nil
+	}
+	if t < 0 || t > 1 || math.IsNaN(t) {
+		return 0, fmt.Errorf("threshold must be in (0, 1], got %v", t)
+	}
+	return t, nil
+}
+
+// dedupText is the text test cases are compared by: their text variable values, in
+// variable name order.
+func dedupText(vv VariableValues) string {
+	names := make([]string, 0, len(vv))
+	for name, v := range vv {
+		if v.TextValue != nil {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var sb strings.Builder
+	for _, name := range names {
+		sb.WriteString(*vv[name].TextValue)
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// nearDuplicateChecker checks new test cases against existing ones and against the
+// new cases checked before them.
+type nearDuplicateChecker struct {
+	index     *dedup.Index
+	threshold float64
+	action    evalv1.DuplicateAction
+}
+
+func newNearDuplicateChecker(opts *evalv1.DedupOptions, existing []TestCase) (*nearDuplicateChecker, error) {
+	threshold, err := dedupThreshold(opts.GetThreshold())
+	if err != nil {
+		return nil, err
+	}
+	c := &nearDuplicateChecker{index: dedup.NewIndex(), threshold: threshold, action: opts.GetAction()}
+	if c.action != evalv1.DuplicateAction_DUPLICATE_ACTION_ALLOW {
+		for _, tc := range existing {
+			c.index.Add(tc.ID, dedupText(tc.VariableValues))
+		}
+	}
+	return c, nil
+}
+
+// check reports whether tc is a near-duplicate, and whether it should be skipped.
+// Flagged cases are tagged. Cases that are not skipped are indexed, so later cases
+// are also checked against them; tc.ID must be set. Cases without text, such as
+// image-only cases, are never duplicates.
+func (c *nearDuplicateChecker) check(tc *TestCase) (*evalv1.NearDuplicate, bool) {
+	if c.action == evalv1.DuplicateAction_DUPLICATE_ACTION_ALLOW {
+		return nil, false
+	}
+
+	text := dedupText(tc.VariableValues)
+	if dedup.Normalize(text) == "" {
+		return nil, false
+	}
+	matches := c.index.Query(text, c.threshold)
+	if len(matches) == 0 {
+		c.index.Add(tc.ID, text)
+		return nil, false
+	}
+
+	nd := &evalv1.NearDuplicate{
+		DuplicateOf: matches[0].ID,
+		Similarity:  matches[0].Similarity,
+	}
+	if c.action == evalv1.DuplicateAction_DUPLICATE_ACTION_SKIP {
+		nd.Skipped = true
+		return nd, true
+	}
+	nd.TestCaseId = tc.ID
+	tc.Tags = normalizeTags(append(append([]string{}, tc.Tags...), nearDuplicateTag))
+	c.index.Add(tc.ID, text)
+	return nd, false
+}
+
+// FindDuplicateTestCases groups the workspace's test cases that are near-duplicates
+// of each other, and optionally deletes all but the oldest case of each group.
+// Dataset test cases are shared with other workspaces and are never deleted.
+func (s *Service) FindDuplicateTestCases(ctx context.Context, req *connect.Request[evalv1.FindDuplicateTestCasesRequest]) (*connect.Response[evalv1.FindDuplicateTestCasesResponse], error) {
+	threshold, err := dedupThreshold(req.Msg.Threshold)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	workspace, err := s.getWorkspace(req.Msg.WorkspaceId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	testCases, err := s.getTestCases(workspace.ID, req.Msg.Filter)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	sort.SliceStable(testCases, func(i, j int) bool {
+		return testCases[i].CreatedAt.Before(testCases[j].CreatedAt)
+	})
+
+	index := dedup.NewIndex()
+	order := make(map[string]int, len(testCases))
+	for i, tc := range testCases {
+		index.Add(tc.ID, dedupText(tc.VariableValues))
+		order[tc.ID] = i
+	}
+
+	// union the cases linked by a near-duplicate pair
+	parent := make(map[string]string)
+	var find func(string) string
+	find = func(id string) string {
+		p := parent[id]
+		if p == id {
+			return id
+		}
+		root := find(p)
+		parent[id] = root
+		return root
+	}
+	minSimilarity := make(map[string]float64)
+	for _, p := range index.Pairs(threshold) {
+		for _, id := range []string{p.A, p.B} {
+			if _, ok := parent[id]; !ok {
+				parent[id] = id
+			}
+		}
+		a, b := find(p.A), find(p.B)
+		if order[b] < order[a] {
+			a, b = b, a
+		}
+		sim := p.Similarity
+		if v, ok := minSimilarity[a]; ok {
+			sim = math.Min(sim, v)
+		}
+		if v, ok := minSimilarity[b]; ok && a != b {
+			sim = math.Min(sim, v)
+		}
+		parent[b] = a
+		minSimilarity[a] = sim
+	}
+
+	members := make(map[string][]string)
+	roots := make([]string, 0)
+	for _, tc := range testCases {
+		if _, ok := parent[tc.ID]; !ok {
+			continue
+		}
+		root := find(tc.ID)
+		if len(members[root]) == 0 {
+			roots = append(roots, root)
+		}
+		members[root] = append(members[root], tc.ID)
+	}
+
+	groups := make([]*evalv1.DuplicateGroup, 0, len(roots))
+	toDelete := make([]string, 0)
+	for _, root := range roots {
+		ids := members[root]
+		sort.SliceStable(ids, func(i, j int) bool { return order[ids[i]] < order[ids[j]] })
+		groups = append(groups, &evalv1.DuplicateGroup{
+			TestCaseIds:   ids,
+			MinSimilarity: minSimilarity[root],
+		})
+		toDelete = append(toDelete, ids[1:]...)
+	}
+
+	var nDeleted uint32
+	if req.Msg.Delete && len(toDelete) > 0 {
+		n, err := s.softDeleteTestCases(toDelete)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete test cases: %v", err))
+		}
+		nDeleted = uint32(n)
+	}
+
+	res := connect.NewResponse(&evalv1.FindDuplicateTestCasesResponse{
+		Groups:   groups,
+		NDeleted: nDeleted,
+	})
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
@@ -0,0 +1,45 @@
+package eval
+
+import (
+	"github.com/tincans-ai/evalite/gen/eval/v1"
+	"testing"
+)
+
+func textValues(values map[string]string) VariableValues {
+	vv := make(VariableValues, len(values))
+	for name, v := range values {
+		vv[name] = VariableValue{TextValue: &v}
+	}
+	return vv
+}
+
+func TestNearDuplicateCheckerSkipsEmptyText(t *testing.T) {
+	existing := []TestCase{
+		{ID: "text", VariableValues: textValues(map[string]string{"INPUT": "What is the capital of France?"})},
+		{ID: "image", VariableValues: VariableValues{"IMAGE": {ImageValue: []byte{0x89, 'P', 'N', 'G'}}}},
+		{ID: "blank", VariableValues: textValues(map[string]string{"INPUT": ""})},
+	}
+	c, err := newNearDuplicateChecker(&evalv1.DedupOptions{Action: evalv1.DuplicateAction_DUPLICATE_ACTION_SKIP}, existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		tc       TestCase
+		wantSkip bool
+	}{
+		{name: "image only", tc: TestCase{ID: "new-image", VariableValues: VariableValues{"IMAGE": {ImageValue: []byte{1}}}}},
+		{name: "blank", tc: TestCase{ID: "new-blank", VariableValues: textValues(map[string]string{"INPUT": "  "})}},
+		{name: "punctuation", tc: TestCase{ID: "new-punct", VariableValues: textValues(map[string]string{"INPUT": "?"})}},
+		{name: "duplicate text", tc: TestCase{ID: "new-text", VariableValues: textValues(map[string]string{"INPUT": "what is the capital of france"})}, wantSkip: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nd, skip := c.check(&tt.tc)
+			if skip != tt.wantSkip || (nd != nil) != tt.wantSkip {
+				t.Errorf("check() = %v, %v, want skip %v", nd, skip, tt.wantSkip)
+			}
+		})
+	}
+}
@@ -15,6 +15,8 @@ import (
 	"github.com/tincans-ai/evalite/packages/logutil"
 	"github.com/tincans-ai/evalite/packages/providerstore"
 	"gorm.io/gorm"
+	"os"
+	"slices"
 	"sync"
 )
 
@@ -31,6 +33,8 @@ type Service struct {
 	providers               *providerstore.ProviderStore
 	defaultSmallModelConfig string
 	defaultLargeModelConfig string
+	// allowExternalGraders gates graders that run local executables.
+	allowExternalGraders bool
 }
 
 func NewService(db *gorm.DB) *Service {
@@ -41,6 +45,7 @@ func NewService(db *gorm.DB) *Service {
 		// todo: check if openai provider is available
 		defaultSmallModelConfig: "gpt-4-mini",
 		defaultLargeModelConfig: "gpt-4o",
+		allowExternalGraders:    os.Getenv("ALLOW_EXTERNAL_GRADERS") == "1",
 	}
 }
 
@@ -53,15 +58,20 @@ func (s *Service) Evaluate(ctx context.Context, req *connect.Request[pb.Evaluati
 		return nil, err
 	}
 
+	versionNumber, systemPromptVersionNumber, err := s.runVersions(workspace.ID, req.Msg.Label, req.Msg.VersionNumber, req.Msg.SystemPromptVersionNumber)
+	if err != nil {
+		return nil, err
+	}
+
 	testCase, err := s.getOrCreateTestCase(req.Msg.TestCase, workspace.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	prompt := workspace.PromptByVersion(req.Msg.VersionNumber)
-	systemPrompt := workspace.SystemPromptByVersion(req.Msg.SystemPromptVersionNumber)
+	prompt := workspace.PromptByVersion(versionNumber)
+	systemPrompt := workspace.SystemPromptByVersion(systemPromptVersionNumber)
 
-	workspaceConfigs := s.getActiveWorkspaceConfigs(workspace, testCase.ID, int32(req.Msg.VersionNumber))
+	workspaceConfigs := s.getActiveWorkspaceConfigs(workspace, testCase.ID, int32(versionNumber))
 
 	results, err := s.processTestCaseWithConfigs(ctx, testCase, prompt, systemPrompt, workspaceConfigs, 1)
 	if err != nil {
@@ -84,7 +94,7 @@ func (s *Service) SyntheticGeneration(ctx context.Context, req *connect.Request[
 		return nil, err
 	}
 
-	testCases, err := s.getTestCases(workspace.ID)
+	testCases, err := s.getTestCases(workspace.ID, req.Msg.Filter)
 	if err != nil {
 		return nil, err
 	}
@@ -94,8 +104,12 @@ func (s *Service) SyntheticGeneration(ctx context.Context, req *connect.Request[
 		return nil, fmt.Errorf("no active workspace config found")
 	}
 
-	prompt := workspace.PromptByVersion(req.Msg.VersionNumber)
-	systemPrompt := workspace.SystemPromptByVersion(req.Msg.SystemPromptVersionNumber)
+	versionNumber, systemPromptVersionNumber, err := s.runVersions(workspace.ID, req.Msg.Label, req.Msg.VersionNumber, req.Msg.SystemPromptVersionNumber)
+	if err != nil {
+		return nil, err
+	}
+	prompt := workspace.PromptByVersion(versionNumber)
+	systemPrompt := workspace.SystemPromptByVersion(systemPromptVersionNumber)
 
 	logger.Debug("found %d test cases", "count", len(testCases))
 	var results []*pb.TestResult
@@ -119,8 +133,21 @@ func (s *Service) SyntheticGeneration(ctx context.Context, req *connect.Request[
 }
 
 func (s *Service) processTestCaseWithConfigs(ctx context.Context, testCase TestCase, prompt *Prompt, systemPrompt *SystemPrompt, configs []WorkspaceConfig, nTotalEvals int) ([]*pb.TestResult, error) {
+	// versions saved before templates were checked may not parse strictly
+	tmpl := llmutils.ParseTemplateLenient(prompt.Content)
 	vars := s.prepareVariables(testCase)
-	promptStr := llmutils.ReplacePromptVariables(prompt.Content, vars)
+	var exampleIDs []string
+	if slices.Contains(tmpl.Variables(), examplesVariable) {
+		examples, err := s.selectExamples(prompt.WorkspaceID, testCase)
+		if err != nil {
+			return nil, err
+		}
+		vars[examplesVariable] = formatExamples(examples)
+		for _, e := range examples {
+			exampleIDs = append(exampleIDs, e.ID)
+		}
+	}
+	promptStr := tmpl.Render(vars)
 
 	// should we cache the system prompt or not
 	baseMessages := s.prepareBaseMessages(systemPrompt, promptStr, nTotalEvals > 5)
@@ -141,7 +168,7 @@ func (s *Service) processTestCaseWithConfigs(ctx context.Context, testCase TestC
 				return
 			}
 
-			result, err := s.processSingleConfig(ctx, testCase, prompt, baseMessages, config, modelConfig)
+			result, err := s.processSingleConfig(ctx, testCase, prompt, baseMessages, exampleIDs, config, modelConfig)
 			if err != nil {
 				errorsChan <- err
 				return
@@ -170,10 +197,11 @@ func (s *Service) processTestCaseWithConfigs(ctx context.Context, testCase TestC
 	return results, nil
 }
 
-func (s *Service) processSingleConfig(ctx context.Context, testCase TestCase, prompt *Prompt, baseMessages []llm.InferMessage, config WorkspaceConfig, modelConfig llm.ModelConfig) (*pb.TestResult, error) {
+func (s *Service) processSingleConfig(ctx context.Context, testCase TestCase, prompt *Prompt, baseMessages []llm.InferMessage, exampleIDs []string, config WorkspaceConfig, modelConfig llm.ModelConfig) (*pb.TestResult, error) {
 	logger := logutil.LoggerFromContext(ctx)
-	// check if this has been evaluated already
-	if testCase.HasBeenEvaluated {
+	// check if this config has evaluated the version already; dataset test cases
+	// are shared, so their own flag says nothing about this workspace's configs
+	if testCase.HasBeenEvaluated || testCase.DatasetID != nil {
 		var tr TestResult
 		if err := s.db.First(&tr, "test_case_id = ? AND workspace_config_id = ? AND prompt_version_number = ?", testCase.ID, config.ID, prompt.VersionNumber).Error; err == nil {
 			logger.Debug("test case already evaluated", "test_case_id", testCase.ID, "config_name", config.ModelConfigName)
@@ -188,6 +216,7 @@ func (s *Service) processSingleConfig(ctx context.Context, testCase TestCase, pr
 				},
 				PromptVersionNumber: prompt.VersionNumber,
 				WorkspaceConfigId:   config.ID,
+				ExampleIds:          tr.ExampleIDs,
 			}, nil
 		}
 	}
@@ -214,6 +243,7 @@ func (s *Service) processSingleConfig(ctx context.Context, testCase TestCase, pr
 		ModelConfigName:     config.ModelConfigName,
 		MessageOptions:      config.MessageOptions,
 		WorkspaceConfigID:   config.ID,
+		ExampleIDs:          exampleIDs,
 	}
 	if err := s.db.Create(&tr).Error; err != nil {
 		return nil, fmt.Errorf("failed to save test result: %w", err)
@@ -224,7 +254,7 @@ func (s *Service) processSingleConfig(ctx context.Context, testCase TestCase, pr
 	updateFields := map[string]interface{}{
 		"HasBeenEvaluated": true,
 	}
-	if err := s.db.Model(&TestCase{}).Where("id = ?", testCase.ID).Updates(updateFields).Error; err != nil {
+	if err := s.db.Model(&TestCase{}).Where("id = ? AND dataset_id IS NULL", testCase.ID).Updates(updateFields).Error; err != nil {
 		return nil, fmt.Errorf("failed to update test case evaluation status: %w", err)
 	}
 
@@ -239,6 +269,7 @@ func (s *Service) processSingleConfig(ctx context.Context, testCase TestCase, pr
 		},
 		PromptVersionNumber: prompt.VersionNumber,
 		WorkspaceConfigId:   config.ID,
+		ExampleIds:          exampleIDs,
 	}, nil
 }
 
@@ -254,6 +285,10 @@ func (s *Service) getOrCreateTestCase(testCase *pb.TestCase, workspaceID string)
 	var newTestCase TestCase
 	if err := s.db.First(&newTestCase, "id = ?", testCase.Id).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// a test case in the trash has to be restored before it is evaluated again
+			if testCase.Id != "" && s.db.Unscoped().Where("id = ? AND deleted_at IS NOT NULL", testCase.Id).First(&TestCase{}).Error == nil {
+				return TestCase{}, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("test case %s is deleted", testCase.Id))
+			}
 			newTestCase = TestCase{
 				ID:             xid.New().String(),
 				VariableValues: s.convertVariableValues(testCase.VariableValues),
@@ -267,6 +302,16 @@ func (s *Service) getOrCreateTestCase(testCase *pb.TestCase, workspaceID string)
 		}
 	}
 
+	// dataset test cases are shared, so they are not updated from a workspace
+	if newTestCase.DatasetID != nil {
+		mappings, err := s.datasetMappings(workspaceID)
+		if err != nil {
+			return TestCase{}, err
+		}
+		newTestCase.VariableValues = renameVariables(newTestCase.VariableValues, mappings[*newTestCase.DatasetID])
+		return newTestCase, nil
+	}
+
 	// Update test case if needed
 	if s.shouldUpdateTestCase(&newTestCase, testCase) {
 		newTestCase.VariableValues = s.convertVariableValues(testCase.VariableValues)
@@ -279,11 +324,16 @@ func (s *Service) getOrCreateTestCase(testCase *pb.TestCase, workspaceID string)
 	return newTestCase, nil
 }
 
-func (s *Service) getTestCases(workspaceID string) ([]TestCase, error) {
+func (s *Service) getTestCases(workspaceID string, filter *pb.TestCaseFilter) ([]TestCase, error) {
 	var testCases []TestCase
-	if err := s.db.Where("workspace_id = ?", workspaceID).Find(&testCases).Error; err != nil {
+	if err := s.db.Scopes(workspaceTestCases(workspaceID), filterTestCases(filter)).Find(&testCases).Error; err != nil {
 		return nil, fmt.Errorf("failed to fetch test cases: %w", err)
 	}
+	mappings, err := s.datasetMappings(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	mapDatasetVariables(testCases, mappings)
 	return testCases, nil
 }
 
@@ -0,0 +1,344 @@
+package eval
+
+import (
+	"cmp"
+	"connectrpc.com/connect"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/rs/xid"
+	"github.com/tincans-ai/evalite/gen/eval/v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"hash/fnv"
+	"math/rand"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// examplesVariable is the template variable the selected examples are inserted
+// as. It is filled in at render time, so it is not one of a prompt's variables.
+const examplesVariable = "EXAMPLES"
+
+const defaultExampleCount = 3
+
+func exampleStrategyFromProto(s evalv1.ExampleStrategy) ExampleStrategy {
+	switch s {
+	case evalv1.ExampleStrategy_EXAMPLE_STRATEGY_RANDOM:
+		return ExampleStrategyRandom
+	case evalv1.ExampleStrategy_EXAMPLE_STRATEGY_SIMILAR:
+		return ExampleStrategySimilar
+	default:
+		return ExampleStrategyFixed
+	}
+}
+
+func exampleStrategyToProto(s ExampleStrategy) evalv1.ExampleStrategy {
+	switch s {
+	case ExampleStrategyRandom:
+		return evalv1.ExampleStrategy_EXAMPLE_STRATEGY_RANDOM
+	case ExampleStrategySimilar:
+		return evalv1.ExampleStrategy_EXAMPLE_STRATEGY_SIMILAR
+	default:
+		return evalv1.ExampleStrategy_EXAMPLE_STRATEGY_FIXED
+	}
+}
+
+func exampleSettingsToProto(w *Workspace) *evalv1.ExampleSettings {
+	return &evalv1.ExampleSettings{
+		Count:    cmp.Or(w.ExampleCount, defaultExampleCount),
+		Strategy: exampleStrategyToProto(w.ExampleStrategy),
+		Seed:     w.ExampleSeed,
+	}
+}
+
+func exampleToProto(e *Example) *evalv1.Example {
+	return &evalv1.Example{
+		Id:             e.ID,
+		WorkspaceId:    e.WorkspaceID,
+		VariableValues: variableValuesToProto(e.VariableValues),
+		Output:         e.Output,
+		TestResultId:   e.TestResultID,
+		CreatedAt:      timestamppb.New(e.CreatedAt),
+	}
+}
+
+// CreateExample adds a hand-written example to a workspace's example bank.
+func (s *Service) CreateExample(ctx context.Context, req *connect.Request[evalv1.CreateExampleRequest]) (*connect.Response[evalv1.CreateExampleResponse], error) {
+	if req.Msg.Output == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("example output is required"))
+	}
+	if err := s.db.First(&Workspace{}, "id = ?", req.Msg.WorkspaceId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("workspace not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to fetch workspace: %w", err))
+	}
+
+	example := &Example{
+		ID:             xid.New().String(),
+		WorkspaceID:    req.Msg.WorkspaceId,
+		VariableValues: variableValuesFromProto(req.Msg.VariableValues),
+		Output:         req.Msg.Output,
+	}
+	if err := s.db.Create(example).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create example: %v", err))
+	}
+
+	res := connect.NewResponse(&evalv1.CreateExampleResponse{
+		Example: exampleToProto(example),
+	})
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
+
+func (s *Service) ListExamples(ctx context.Context, req *connect.Request[evalv1.ListExamplesRequest]) (*connect.Response[evalv1.ListExamplesResponse], error) {
+	var examples []Example
+	if err := s.db.Where("workspace_id = ?", req.Msg.WorkspaceId).Order("created_at, id").Find(&examples).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list examples: %v", err))
+	}
+
+	pbExamples := make([]*evalv1.Example, 0, len(examples))
+	for i := range examples {
+		pbExamples = append(pbExamples, exampleToProto(&examples[i]))
+	}
+
+	res := connect.NewResponse(&evalv1.ListExamplesResponse{
+		Examples: pbExamples,
+	})
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
+
+func (s *Service) DeleteExample(ctx context.Context, req *connect.Request[evalv1.DeleteExampleRequest]) (*connect.Response[emptypb.Empty], error) {
+	result := s.db.Where("id = ?", req.Msg.Id).Delete(&Example{})
+	if result.Error != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete example: %v", result.Error))
+	}
+
+	res := connect.NewResponse(&emptypb.Empty{})
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
+
+// AddExamplesFromResults copies rated results into the example bank, with their
+// test case's variables as the input and the response as the output.
+func (s *Service) AddExamplesFromResults(ctx context.Context, req *connect.Request[evalv1.AddExamplesFromResultsRequest]) (*connect.Response[evalv1.AddExamplesFromResultsResponse], error) {
+	workspaceID := req.Msg.WorkspaceId
+	if err := s.db.First(&Workspace{}, "id = ?", workspaceID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("workspace not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to fetch workspace: %w", err))
+	}
+
+	query := s.db.Model(&TestResult{}).
+		Select("test_results.*").
+		Joins("JOIN test_cases ON test_cases.id = test_results.test_case_id").
+		Where("test_cases.deleted_at IS NULL").
+		Scopes(workspaceTestCases(workspaceID), workspaceResults(workspaceID))
+	switch {
+	case len(req.Msg.TestResultIds) > 0:
+		query = query.Where("test_results.id IN ?", req.Msg.TestResultIds)
+	case req.Msg.MinScore != nil:
+		if req.Msg.Rater == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("min_score needs a rater"))
+		}
+		query = query.Where("test_results.id IN (SELECT test_result_id FROM ratings WHERE rater = ? AND score >= ?)", req.Msg.Rater, req.Msg.GetMinScore())
+	case req.Msg.Rater != "":
+		query = query.Where("test_results.id IN (SELECT test_result_id FROM ratings WHERE rater = ? AND thumbs > 0)", req.Msg.Rater)
+	default:
+		query = query.Where("test_results.rating > 0")
+	}
+	var results []TestResult
+	if err := query.Order("test_results.created_at").Find(&results).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load test results: %v", err))
+	}
+	if len(req.Msg.TestResultIds) > 0 && len(results) != len(req.Msg.TestResultIds) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("found %d of %d test results", len(results), len(req.Msg.TestResultIds)))
+	}
+
+	resultIDs := make([]string, 0, len(results))
+	testCaseIDs := make([]string, 0, len(results))
+	for _, tr := range results {
+		resultIDs = append(resultIDs, tr.ID)
+		testCaseIDs = append(testCaseIDs, tr.TestCaseID)
+	}
+	var existing []string
+	var testCases []TestCase
+	if len(results) > 0 {
+		if err := s.db.Model(&Example{}).Where("workspace_id = ? AND test_result_id IN ?", workspaceID, resultIDs).
+			Pluck("test_result_id", &existing).Error; err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load examples: %v", err))
+		}
+		if err := s.db.Where("id IN ?", testCaseIDs).Find(&testCases).Error; err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load test cases: %v", err))
+		}
+	}
+	mappings, err := s.datasetMappings(workspaceID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	mapDatasetVariables(testCases, mappings)
+	testCasesByID := make(map[string]TestCase, len(testCases))
+	for _, tc := range testCases {
+		testCasesByID[tc.ID] = tc
+	}
+
+	var nSkipped uint32
+	examples := make([]Example, 0, len(results))
+	for _, tr := range results {
+		if slices.Contains(existing, tr.ID) {
+			nSkipped++
+			continue
+		}
+		resultID, testCaseID := tr.ID, tr.TestCaseID
+		examples = append(examples, Example{
+			ID:             xid.New().String(),
+			WorkspaceID:    workspaceID,
+			VariableValues: testCasesByID[tr.TestCaseID].VariableValues,
+			Output:         tr.Response,
+			TestResultID:   &resultID,
+			TestCaseID:     &testCaseID,
+		})
+	}
+	if len(examples) > 0 {
+		if err := s.db.Create(&examples).Error; err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create examples: %v", err))
+		}
+	}
+
+	pbExamples := make([]*evalv1.Example, 0, len(examples))
+	for i := range examples {
+		pbExamples = append(pbExamples, exampleToProto(&examples[i]))
+	}
+
+	res := connect.NewResponse(&evalv1.AddExamplesFromResultsResponse{
+		Examples: pbExamples,
+		NSkipped: nSkipped,
+	})
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
+
+func (s *Service) SetExampleSettings(ctx context.Context, req *connect.Request[evalv1.SetExampleSettingsRequest]) (*connect.Response[emptypb.Empty], error) {
+	settings := req.Msg.GetSettings()
+	result := s.db.Model(&Workspace{}).Where("id = ?", req.Msg.WorkspaceId).Updates(map[string]any{
+		"example_count":    settings.GetCount(),
+		"example_strategy": exampleStrategyFromProto(settings.GetStrategy()),
+		"example_seed":     settings.GetSeed(),
+	})
+	if result.Error != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to save example settings: %v", result.Error))
+	}
+	if result.RowsAffected == 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("workspace not found"))
+	}
+
+	res := connect.NewResponse(&emptypb.Empty{})
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
+
+// selectExamples picks the examples from a workspace's bank that are inserted into
+// the prompt for a test case.
+func (s *Service) selectExamples(workspaceID string, testCase TestCase) ([]Example, error) {
+	var workspace Workspace
+	if err := s.db.First(&workspace, "id = ?", workspaceID).Error; err != nil {
+		return nil, fmt.Errorf("failed to fetch workspace: %w", err)
+	}
+	var bank []Example
+	if err := s.db.Where("workspace_id = ?", workspaceID).Order("created_at, id").Find(&bank).Error; err != nil {
+		return nil, fmt.Errorf("failed to load examples: %w", err)
+	}
+	count := int(cmp.Or(workspace.ExampleCount, defaultExampleCount))
+	return pickExamples(bank, testCase, count, workspace.ExampleStrategy, workspace.ExampleSeed), nil
+}
+
+// pickExamples picks up to count examples from bank for a test case. Examples taken
+// from the test case itself are left out.
+func pickExamples(bank []Example, testCase TestCase, count int, strategy ExampleStrategy, seed int64) []Example {
+	candidates := make([]Example, 0, len(bank))
+	for _, e := range bank {
+		if e.TestCaseID == nil || *e.TestCaseID != testCase.ID {
+			candidates = append(candidates, e)
+		}
+	}
+	count = min(count, len(candidates))
+
+	switch strategy {
+	case ExampleStrategyRandom:
+		// the same seed picks the same examples for a test case on every run
+		h := fnv.New64a()
+		h.Write([]byte(testCase.ID))
+		rng := rand.New(rand.NewSource(seed ^ int64(h.Sum64())))
+		out := make([]Example, 0, count)
+		for _, i := range rng.Perm(len(candidates))[:count] {
+			out = append(out, candidates[i])
+		}
+		return out
+	case ExampleStrategySimilar:
+		words := wordSet(dedupText(testCase.VariableValues))
+		scores := make(map[string]float64, len(candidates))
+		for _, e := range candidates {
+			scores[e.ID] = jaccard(words, wordSet(dedupText(e.VariableValues)))
+		}
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return scores[candidates[i].ID] > scores[candidates[j].ID]
+		})
+		return candidates[:count]
+	default:
+		return candidates[:count]
+	}
+}
+
+// wordSet returns the lowercased words of a text.
+func wordSet(text string) map[string]bool {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	set := make(map[string]bool, len(words))
+	for _, w := range words {
+		set[w] = true
+	}
+	return set
+}
+
+func jaccard(a, b map[string]bool) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 0
+	}
+	shared := 0
+	for w := range a {
+		if b[w] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(a)+len(b)-shared)
+}
+
+// formatExamples renders examples the way they are inserted into a prompt.
+func formatExamples(examples []Example) string {
+	var sb strings.Builder
+	for i, e := range examples {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString("<example>\n<input>\n")
+		names := make([]string, 0, len(e.VariableValues))
+		for name, v := range e.VariableValues {
+			if v.TextValue != nil {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Fprintf(&sb, "<%s>\n%s\n</%s>\n", name, *e.VariableValues[name].TextValue, name)
+		}
+		fmt.Fprintf(&sb, "</input>\n<output>\n%s\n</output>\n</example>", e.Output)
+	}
+	return sb.String()
+}
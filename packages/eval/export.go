@@ -0,0 +1,249 @@
+package eval
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/tincans-ai/evalite/gen/eval/v1"
+	"github.com/tincans-ai/evalite/packages/llmutils"
+	"github.com/tincans-ai/evalite/packages/tabular"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// exportChunkSize is the size of the chunks an export is streamed in.
+const exportChunkSize = 64 * 1024
+
+// chunkSender buffers an export and sends it over the stream in chunks.
+type chunkSender struct {
+	stream *connect.ServerStream[evalv1.ExportWorkspaceResponse]
+	buf    []byte
+}
+
+func (c *chunkSender) Write(p []byte) (int, error) {
+	c.buf = append(c.buf, p...)
+	for len(c.buf) >= exportChunkSize {
+		if err := c.send(c.buf[:exportChunkSize]); err != nil {
+			return 0, err
+		}
+		c.buf = c.buf[exportChunkSize:]
+	}
+	return len(p), nil
+}
+
+func (c *chunkSender) Close() error {
+	if len(c.buf) == 0 {
+		return nil
+	}
+	err := c.send(c.buf)
+	c.buf = nil
+	return err
+}
+
+func (c *chunkSender) send(chunk []byte) error {
+	return c.stream.Send(&evalv1.ExportWorkspaceResponse{Chunk: append([]byte(nil), chunk...)})
+}
+
+// ExportWorkspace streams the workspace's test cases joined with their results as a
+// CSV, JSONL or YAML file. Besides result metadata, each row has a column per
+// variable ("var.<name>"), per XML field of the response ("field.<name>") and per
+// rater ("thumbs.<rater>", "score.<rater>", "reason.<rater>").
+func (s *Service) ExportWorkspace(ctx context.Context, req *connect.Request[evalv1.ExportWorkspaceRequest], stream *connect.ServerStream[evalv1.ExportWorkspaceResponse]) error {
+	workspace, err := s.getWorkspace(req.Msg.WorkspaceId)
+	if err != nil {
+		return connect.NewError(connect.CodeNotFound, err)
+	}
+
+	var testCaseIDs []string
+	if err := s.db.Model(&TestCase{}).Scopes(workspaceTestCases(workspace.ID), filterTestCases(req.Msg.Filter)).
+		Order("test_cases.created_at, test_cases.id").Pluck("test_cases.id", &testCaseIDs).Error; err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to fetch test cases: %v", err))
+	}
+	mappings, err := s.datasetMappings(workspace.ID)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	e := &exporter{s: s, workspace: workspace, versionNumber: req.Msg.VersionNumber, mappings: mappings}
+
+	// the columns must be known before the header is written, so the test cases are
+	// read twice, one batch at a time: once for the columns and once for the rows
+	extraColumns := make(map[string]bool)
+	err = e.eachRow(testCaseIDs, func(row map[string]string) error {
+		for col := range row {
+			if strings.Contains(col, ".") {
+				extraColumns[col] = true
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+
+	columns := exportColumns(workspace, extraColumns)
+	out := &chunkSender{stream: stream}
+	w, err := tabular.NewWriter(formatFromProto(req.Msg.Format), out, columns)
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	err = e.eachRow(testCaseIDs, func(row map[string]string) error {
+		if err := w.Write(row); err != nil {
+			return fmt.Errorf("failed to write export: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if err := w.Flush(); err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to write export: %v", err))
+	}
+	return out.Close()
+}
+
+// exporter builds the rows of a workspace export.
+type exporter struct {
+	s             *Service
+	workspace     *Workspace
+	versionNumber *uint32
+	mappings      map[string]map[string]string
+}
+
+// eachRow calls fn with the rows of the given test cases in order, loading them
+// with their results and ratings importBatchSize test cases at a time.
+func (e *exporter) eachRow(testCaseIDs []string, fn func(row map[string]string) error) error {
+	configNames := make(map[string]string, len(e.workspace.WorkspaceConfigs))
+	for _, wc := range e.workspace.WorkspaceConfigs {
+		configNames[wc.ID] = wc.Name
+	}
+
+	for batch := range slices.Chunk(testCaseIDs, importBatchSize) {
+		var testCases []TestCase
+		if err := e.s.db.Where("id IN ?", batch).Order("created_at, id").Find(&testCases).Error; err != nil {
+			return fmt.Errorf("failed to fetch test cases: %w", err)
+		}
+		mapDatasetVariables(testCases, e.mappings)
+
+		var results []TestResult
+		query := e.s.db.Model(&TestResult{}).
+			Joins("JOIN test_cases ON test_cases.id = test_results.test_case_id").
+			Where("test_results.test_case_id IN ?", batch).
+			Scopes(workspaceResults(e.workspace.ID))
+		if e.versionNumber != nil {
+			query = query.Where("test_results.prompt_version_number = ?", *e.versionNumber)
+		}
+		if err := query.Select("test_results.*").Order("test_results.created_at").Find(&results).Error; err != nil {
+			return fmt.Errorf("failed to load test results: %w", err)
+		}
+		resultIDs := make([]string, 0, len(results))
+		resultsByCase := make(map[string][]TestResult)
+		for _, tr := range results {
+			resultIDs = append(resultIDs, tr.ID)
+			resultsByCase[tr.TestCaseID] = append(resultsByCase[tr.TestCaseID], tr)
+		}
+
+		ratings, err := e.s.findRatings(resultIDs)
+		if err != nil {
+			return fmt.Errorf("failed to load ratings: %w", err)
+		}
+		ratingsByResult := make(map[string][]Rating)
+		for _, r := range ratings {
+			ratingsByResult[r.TestResultID] = append(ratingsByResult[r.TestResultID], r)
+		}
+
+		for _, tc := range testCases {
+			base := map[string]string{"test_case_id": tc.ID}
+			for name, v := range e.s.prepareVariables(tc) {
+				base["var."+name] = v
+			}
+			if tc.Response != nil {
+				base["reference"] = *tc.Response
+			}
+
+			// one row per result, or the bare test case if it has none
+			caseResults := resultsByCase[tc.ID]
+			if len(caseResults) == 0 {
+				if e.versionNumber == nil {
+					if err := fn(base); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+			for _, tr := range caseResults {
+				row := make(map[string]string, len(base)+16)
+				for k, v := range base {
+					row[k] = v
+				}
+				row["test_result_id"] = tr.ID
+				row["prompt_version_number"] = strconv.FormatUint(uint64(tr.PromptVersionNumber), 10)
+				row["workspace_config_id"] = tr.WorkspaceConfigID
+				row["workspace_config_name"] = configNames[tr.WorkspaceConfigID]
+				row["model_config_name"] = tr.ModelConfigName
+				row["temperature"] = strconv.FormatFloat(float64(tr.MessageOptions.Temperature), 'g', -1, 32)
+				row["max_tokens"] = strconv.Itoa(int(tr.MessageOptions.MaxTokens))
+				row["response"] = tr.Response
+				row["rating"] = strconv.Itoa(int(tr.Rating))
+				for name, v := range llmutils.ParseXMLFields(tr.Response) {
+					row["field."+name] = v
+				}
+				for _, r := range ratingsByResult[tr.ID] {
+					if r.Thumbs != 0 {
+						row["thumbs."+r.Rater] = strconv.Itoa(int(r.Thumbs))
+					}
+					if r.Score != nil {
+						row["score."+r.Rater] = strconv.FormatFloat(*r.Score, 'g', -1, 64)
+					}
+					if r.Reason != "" {
+						row["reason."+r.Rater] = r.Reason
+					}
+				}
+				if err := fn(row); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// exportColumns orders the export columns: the test case and its variables (in the
+// order the current prompt declares them), then result metadata, XML fields and
+// ratings.
+func exportColumns(workspace *Workspace, extra map[string]bool) []string {
+	columns := []string{"test_case_id"}
+	if prompt := workspace.CurrentPrompt(); prompt != nil {
+		for _, v := range prompt.Variables {
+			if extra["var."+v.Name] {
+				columns = append(columns, "var."+v.Name)
+				delete(extra, "var."+v.Name)
+			}
+		}
+	}
+
+	rest := make([]string, 0, len(extra))
+	for col := range extra {
+		rest = append(rest, col)
+	}
+	sort.Strings(rest)
+	group := func(prefix string) {
+		for _, col := range rest {
+			if strings.HasPrefix(col, prefix) {
+				columns = append(columns, col)
+			}
+		}
+	}
+
+	group("var.")
+	columns = append(columns, "reference", "test_result_id", "prompt_version_number",
+		"workspace_config_id", "workspace_config_name", "model_config_name",
+		"temperature", "max_tokens", "response")
+	group("field.")
+	columns = append(columns, "rating")
+	group("thumbs.")
+	group("score.")
+	group("reason.")
+	return columns
+}
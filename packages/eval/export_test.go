@@ -0,0 +1,104 @@
+package eval
+
+import (
+	"bytes"
+	"connectrpc.com/connect"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"github.com/tincans-ai/evalite/gen/eval/v1"
+	"github.com/tincans-ai/evalite/gen/eval/v1/evalv1connect"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestExportWorkspaceBatches(t *testing.T) {
+	s := newTestService(t)
+	w := &Workspace{ID: "w1", Name: "export"}
+	prompt := w.CreatePrompt("{{INPUT}}", []Variable{{Name: "INPUT", Type: VariableTypeText}})
+	if err := s.db.Create(w).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := s.db.Create(&prompt).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := s.db.Model(w).Update("current_prompt_version_number", prompt.VersionNumber).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := s.db.Create(&WorkspaceConfig{ID: "c1", WorkspaceID: w.ID, Name: "default", ModelConfigName: "fake-model"}).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	// enough test cases for several batches; only the last one has a result, so its
+	// columns are only seen in the last batch
+	n := 2*importBatchSize + 5
+	start := time.Now()
+	testCases := make([]TestCase, n)
+	for i := range testCases {
+		input := fmt.Sprintf("input %d", i)
+		testCases[i] = TestCase{
+			ID:             fmt.Sprintf("tc%03d", i),
+			WorkspaceID:    w.ID,
+			VariableValues: VariableValues{"INPUT": {TextValue: &input}},
+			CreatedAt:      start.Add(time.Duration(i) * time.Second),
+		}
+	}
+	if err := s.db.CreateInBatches(testCases, importBatchSize).Error; err != nil {
+		t.Fatal(err)
+	}
+	last := testCases[n-1].ID
+	if err := s.db.Create(&TestResult{ID: "r1", TestCaseID: last, PromptVersionNumber: prompt.VersionNumber, WorkspaceConfigID: "c1",
+		Response: "<reply><answer>42</answer></reply>"}).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := s.db.Create(&Rating{ID: "rt1", TestResultID: "r1", Rater: "alice", Thumbs: 1}).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(evalv1connect.NewEvaluationServiceHandler(s))
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	client := evalv1connect.NewEvaluationServiceClient(srv.Client(), srv.URL)
+
+	stream, err := client.ExportWorkspace(context.Background(), connect.NewRequest(&evalv1.ExportWorkspaceRequest{
+		WorkspaceId: w.ID,
+		Format:      evalv1.FileFormat_FILE_FORMAT_CSV,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	for stream.Receive() {
+		buf.Write(stream.Msg().Chunk)
+	}
+	if err := stream.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != n+1 {
+		t.Fatalf("got %d rows, want a header and %d rows", len(records), n)
+	}
+	header := records[0]
+	for _, col := range []string{"var.INPUT", "field.answer", "thumbs.alice"} {
+		if !slices.Contains(header, col) {
+			t.Errorf("header %v is missing %s", header, col)
+		}
+	}
+	for i, record := range records[1:] {
+		if record[0] != testCases[i].ID {
+			t.Fatalf("row %d is %s, want %s", i, record[0], testCases[i].ID)
+		}
+	}
+	answer := slices.Index(header, "field.answer")
+	if got := records[n][answer]; got != "42" {
+		t.Errorf("field.answer = %q, want 42", got)
+	}
+}
@@ -0,0 +1,80 @@
+package eval
+
+import (
+	"github.com/tincans-ai/evalite/gen/eval/v1"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"strings"
+)
+
+func splitFromProto(s evalv1.Split) Split {
+	switch s {
+	case evalv1.Split_SPLIT_DEV:
+		return SplitDev
+	case evalv1.Split_SPLIT_TEST:
+		return SplitTest
+	case evalv1.Split_SPLIT_HOLDOUT:
+		return SplitHoldout
+	default:
+		return ""
+	}
+}
+
+func splitToProto(s Split) evalv1.Split {
+	switch s {
+	case SplitDev:
+		return evalv1.Split_SPLIT_DEV
+	case SplitTest:
+		return evalv1.Split_SPLIT_TEST
+	case SplitHoldout:
+		return evalv1.Split_SPLIT_HOLDOUT
+	default:
+		return evalv1.Split_SPLIT_UNSPECIFIED
+	}
+}
+
+// normalizeTags trims tags and drops empty and repeated ones, keeping their order.
+func normalizeTags(tags []string) []string {
+	out := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, t := range tags {
+		t = strings.TrimSpace(t)
+		if t == "" || seen[t] {
+			continue
+		}
+		seen[t] = true
+		out = append(out, t)
+	}
+	return out
+}
+
+// filterTestCases returns a scope that restricts a query on test_cases, or on a
+// join with it, to the test cases matching filter. A nil filter matches everything.
+func filterTestCases(filter *evalv1.TestCaseFilter) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if filter == nil {
+			return db
+		}
+		for _, tag := range normalizeTags(filter.Tags) {
+			db = db.Where(datatypes.JSONArrayQuery("test_cases.tags").Contains(tag))
+		}
+		for _, tag := range normalizeTags(filter.ExcludeTags) {
+			db = db.Not(datatypes.JSONArrayQuery("test_cases.tags").Contains(tag))
+		}
+		if len(filter.Splits) > 0 {
+			splits := make([]Split, 0, len(filter.Splits))
+			unassigned := false
+			for _, s := range filter.Splits {
+				splits = append(splits, splitFromProto(s))
+				unassigned = unassigned || s == evalv1.Split_SPLIT_UNSPECIFIED
+			}
+			if unassigned {
+				// cases created before splits existed have a NULL split
+				db = db.Where("test_cases.split IN ? OR test_cases.split IS NULL", splits)
+			} else {
+				db = db.Where("test_cases.split IN ?", splits)
+			}
+		}
+		return db
+	}
+}
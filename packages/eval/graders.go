@@ -0,0 +1,380 @@
+package eval
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/rs/xid"
+	"github.com/tincans-ai/evalite/gen/eval/v1"
+	"github.com/tincans-ai/evalite/packages/grader"
+	"github.com/tincans-ai/evalite/packages/llmutils"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"time"
+)
+
+var errExternalGradersDisabled = errors.New("external graders are disabled; set ALLOW_EXTERNAL_GRADERS=1 to enable them")
+
+func (s *Service) CreateGrader(ctx context.Context, req *connect.Request[evalv1.CreateGraderRequest]) (*connect.Response[evalv1.CreateGraderResponse], error) {
+	if req.Msg.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("grader name is required"))
+	}
+	if req.Msg.Type == evalv1.GraderType_GRADER_TYPE_UNSPECIFIED {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("grader type is required"))
+	}
+	if req.Msg.Type == evalv1.GraderType_GRADER_TYPE_EXTERNAL {
+		if !s.allowExternalGraders {
+			return nil, connect.NewError(connect.CodePermissionDenied, errExternalGradersDisabled)
+		}
+		if req.Msg.Command == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("external graders need a command"))
+		}
+	}
+	if req.Msg.Type == evalv1.GraderType_GRADER_TYPE_JUDGE {
+		if req.Msg.Rubric == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("judge graders need a rubric"))
+		}
+		if req.Msg.ModelConfigName != "" {
+			if _, ok := s.models.GetConfig(req.Msg.ModelConfigName); !ok {
+				return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("model config %s not found", req.Msg.ModelConfigName))
+			}
+		}
+	}
+
+	var workspace Workspace
+	result := s.db.First(&workspace, "id = ?", req.Msg.WorkspaceId)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("workspace not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to fetch workspace: %w", result.Error))
+	}
+
+	g := &Grader{
+		ID:              xid.New().String(),
+		WorkspaceID:     workspace.ID,
+		Name:            req.Msg.Name,
+		Type:            graderTypeFromProto(req.Msg.Type),
+		Command:         req.Msg.Command,
+		Args:            req.Msg.Args,
+		Batch:           req.Msg.Batch,
+		BatchSize:       req.Msg.BatchSize,
+		TimeoutSeconds:  req.Msg.TimeoutSeconds,
+		Concurrency:     req.Msg.Concurrency,
+		ModelConfigName: req.Msg.ModelConfigName,
+		Rubric:          req.Msg.Rubric,
+	}
+	if err := s.db.Create(g).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create grader: %v", err))
+	}
+
+	res := connect.NewResponse(&evalv1.CreateGraderResponse{
+		Grader: graderToProto(g),
+	})
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
+
+func (s *Service) ListGraders(ctx context.Context, req *connect.Request[evalv1.ListGradersRequest]) (*connect.Response[evalv1.ListGradersResponse], error) {
+	var graders []Grader
+	if err := s.db.Where("workspace_id = ?", req.Msg.WorkspaceId).Order("created_at").Find(&graders).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list graders: %v", err))
+	}
+
+	pbGraders := make([]*evalv1.Grader, 0, len(graders))
+	for i := range graders {
+		pbGraders = append(pbGraders, graderToProto(&graders[i]))
+	}
+
+	res := connect.NewResponse(&evalv1.ListGradersResponse{
+		Graders: pbGraders,
+	})
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
+
+func (s *Service) DeleteGrader(ctx context.Context, req *connect.Request[evalv1.DeleteGraderRequest]) (*connect.Response[emptypb.Empty], error) {
+	var g Grader
+	if err := s.db.First(&g, "id = ?", req.Msg.Id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("grader not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to fetch grader: %v", err))
+	}
+
+	// the grader's ratings go with it, including those on trashed results
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		resultIDs := tx.Unscoped().Model(&TestResult{}).
+			Select("test_results.id").
+			Joins("JOIN test_cases ON test_cases.id = test_results.test_case_id").
+			Scopes(workspaceTestCases(g.WorkspaceID), workspaceResults(g.WorkspaceID))
+		if err := tx.Where("rater = ? AND test_result_id IN (?)", g.Rater(), resultIDs).Delete(&Rating{}).Error; err != nil {
+			return fmt.Errorf("failed to delete ratings: %w", err)
+		}
+		if err := tx.Delete(&g).Error; err != nil {
+			return fmt.Errorf("failed to delete grader: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	res := connect.NewResponse(&emptypb.Empty{})
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
+
+// RunGrader grades the workspace's test results with a grader and stores each
+// grade as an automated Rating under the grader's rater name.
+func (s *Service) RunGrader(ctx context.Context, req *connect.Request[evalv1.RunGraderRequest]) (*connect.Response[evalv1.RunGraderResponse], error) {
+	var g Grader
+	result := s.db.First(&g, "id = ?", req.Msg.GraderId)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("grader not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to fetch grader: %w", result.Error))
+	}
+	if g.Type == GraderTypeExternal && !s.allowExternalGraders {
+		return nil, connect.NewError(connect.CodePermissionDenied, errExternalGradersDisabled)
+	}
+
+	query := s.db.Model(&TestResult{}).
+		Select("test_results.*").
+		Joins("JOIN test_cases ON test_cases.id = test_results.test_case_id").
+		Where("test_cases.deleted_at IS NULL").
+		Scopes(workspaceTestCases(g.WorkspaceID), workspaceResults(g.WorkspaceID)).
+		Scopes(filterTestCases(req.Msg.Filter))
+	if req.Msg.VersionNumber != nil {
+		query = query.Where("test_results.prompt_version_number = ?", req.Msg.GetVersionNumber())
+	}
+	if len(req.Msg.TestResultIds) > 0 {
+		query = query.Where("test_results.id IN ?", req.Msg.TestResultIds)
+	}
+	var results []TestResult
+	if err := query.Order("test_results.created_at").Find(&results).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load test results: %v", err))
+	}
+
+	// skip results this grader has already scored
+	var nSkipped uint32
+	if !req.Msg.Regrade && len(results) > 0 {
+		resultIDs := make([]string, 0, len(results))
+		for _, tr := range results {
+			resultIDs = append(resultIDs, tr.ID)
+		}
+		var graded []string
+		if err := s.db.Model(&Rating{}).
+			Where("test_result_id IN ? AND rater = ?", resultIDs, g.Rater()).
+			Pluck("test_result_id", &graded).Error; err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load ratings: %v", err))
+		}
+		gradedSet := make(map[string]bool, len(graded))
+		for _, id := range graded {
+			gradedSet[id] = true
+		}
+		pending := make([]TestResult, 0, len(results))
+		for _, tr := range results {
+			if gradedSet[tr.ID] {
+				nSkipped++
+				continue
+			}
+			pending = append(pending, tr)
+		}
+		results = pending
+	}
+
+	ratings, gradeErrors, err := s.gradeResults(ctx, &g, results)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	res := connect.NewResponse(&evalv1.RunGraderResponse{
+		Ratings:  ratings,
+		Errors:   gradeErrors,
+		NSkipped: nSkipped,
+	})
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
+
+// gradeResults runs a grader on test results and stores its grades as ratings. Grades
+// that fail are returned as errors rather than failing the whole run.
+func (s *Service) gradeResults(ctx context.Context, g *Grader, results []TestResult) ([]*evalv1.Rating, []*evalv1.GradeError, error) {
+	testCaseIDs := make([]string, 0, len(results))
+	for _, tr := range results {
+		testCaseIDs = append(testCaseIDs, tr.TestCaseID)
+	}
+	var testCases []TestCase
+	if len(testCaseIDs) > 0 {
+		if err := s.db.Where("id IN ?", testCaseIDs).Find(&testCases).Error; err != nil {
+			return nil, nil, fmt.Errorf("failed to load test cases: %v", err)
+		}
+	}
+	mappings, err := s.datasetMappings(g.WorkspaceID)
+	if err != nil {
+		return nil, nil, err
+	}
+	mapDatasetVariables(testCases, mappings)
+	testCasesByID := make(map[string]TestCase, len(testCases))
+	for _, tc := range testCases {
+		testCasesByID[tc.ID] = tc
+	}
+
+	inputs := make([]grader.Input, 0, len(results))
+	for _, tr := range results {
+		tc := testCasesByID[tr.TestCaseID]
+		inputs = append(inputs, grader.Input{
+			TestCaseID:   tr.TestCaseID,
+			TestResultID: tr.ID,
+			Variables:    s.prepareVariables(tc),
+			Response:     tr.Response,
+			Reference:    tc.Response,
+			Fields:       llmutils.ParseXMLFields(tr.Response),
+		})
+	}
+
+	var grades []grader.Result
+	if g.Type == GraderTypeJudge {
+		grades = s.judgeGrades(ctx, g, inputs)
+	} else {
+		external := grader.External{
+			Command:     g.Command,
+			Args:        g.Args,
+			Timeout:     time.Duration(g.TimeoutSeconds) * time.Second,
+			Concurrency: int(g.Concurrency),
+			Batch:       g.Batch,
+			BatchSize:   int(g.BatchSize),
+		}
+		grades = external.Grade(ctx, inputs)
+	}
+
+	ratings := make([]*evalv1.Rating, 0, len(grades))
+	gradeErrors := make([]*evalv1.GradeError, 0)
+	for _, grade := range grades {
+		if grade.Err != nil {
+			gradeErrors = append(gradeErrors, &evalv1.GradeError{
+				TestResultId: grade.TestResultID,
+				Error:        grade.Err.Error(),
+			})
+			continue
+		}
+
+		rating := &Rating{
+			TestResultID: grade.TestResultID,
+			Rater:        g.Rater(),
+			Automated:    true,
+			Score:        grade.Output.Score,
+			Reason:       grade.Output.Reason,
+		}
+		if grade.Output.Pass != nil {
+			rating.Thumbs = -1
+			if *grade.Output.Pass {
+				rating.Thumbs = 1
+			}
+		}
+		if err := upsertRating(s.db, rating); err != nil {
+			gradeErrors = append(gradeErrors, &evalv1.GradeError{
+				TestResultId: grade.TestResultID,
+				Error:        fmt.Sprintf("failed to save rating: %v", err),
+			})
+			continue
+		}
+		ratings = append(ratings, ratingToProto(*rating))
+	}
+	return ratings, gradeErrors, nil
+}
+
+// getGrader loads a workspace's grader, and checks that it is allowed to run.
+func (s *Service) getGrader(workspaceID, id string) (*Grader, error) {
+	var g Grader
+	if err := s.db.First(&g, "id = ? AND workspace_id = ?", id, workspaceID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("grader not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to fetch grader: %w", err))
+	}
+	if g.Type == GraderTypeExternal && !s.allowExternalGraders {
+		return nil, connect.NewError(connect.CodePermissionDenied, errExternalGradersDisabled)
+	}
+	return &g, nil
+}
+
+// gradePending grades the results a grader has not scored yet, and returns the
+// grader's ratings of all of them by test result ID.
+func (s *Service) gradePending(ctx context.Context, g *Grader, results []TestResult) (map[string]Rating, []*evalv1.GradeError, error) {
+	ids := make([]string, 0, len(results))
+	for _, tr := range results {
+		ids = append(ids, tr.ID)
+	}
+	var graded []string
+	if err := s.db.Model(&Rating{}).Where("test_result_id IN ? AND rater = ?", ids, g.Rater()).Pluck("test_result_id", &graded).Error; err != nil {
+		return nil, nil, fmt.Errorf("failed to load ratings: %v", err)
+	}
+	gradedSet := make(map[string]bool, len(graded))
+	for _, id := range graded {
+		gradedSet[id] = true
+	}
+	pending := make([]TestResult, 0, len(results))
+	for _, tr := range results {
+		if !gradedSet[tr.ID] {
+			pending = append(pending, tr)
+		}
+	}
+	_, gradeErrors, err := s.gradeResults(ctx, g, pending)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ratings []Rating
+	if err := s.db.Where("test_result_id IN ? AND rater = ?", ids, g.Rater()).Find(&ratings).Error; err != nil {
+		return nil, nil, fmt.Errorf("failed to load ratings: %v", err)
+	}
+	grades := make(map[string]Rating, len(ratings))
+	for _, r := range ratings {
+		grades[r.TestResultID] = r
+	}
+	return grades, gradeErrors, nil
+}
+
+func graderTypeFromProto(t evalv1.GraderType) GraderType {
+	switch t {
+	case evalv1.GraderType_GRADER_TYPE_EXTERNAL:
+		return GraderTypeExternal
+	case evalv1.GraderType_GRADER_TYPE_JUDGE:
+		return GraderTypeJudge
+	}
+	return GraderTypeExternal
+}
+
+func graderTypeToProto(t GraderType) evalv1.GraderType {
+	switch t {
+	case GraderTypeExternal:
+		return evalv1.GraderType_GRADER_TYPE_EXTERNAL
+	case GraderTypeJudge:
+		return evalv1.GraderType_GRADER_TYPE_JUDGE
+	}
+	return evalv1.GraderType_GRADER_TYPE_EXTERNAL
+}
+
+func graderToProto(g *Grader) *evalv1.Grader {
+	return &evalv1.Grader{
+		Id:              g.ID,
+		WorkspaceId:     g.WorkspaceID,
+		Name:            g.Name,
+		Type:            graderTypeToProto(g.Type),
+		Command:         g.Command,
+		Args:            g.Args,
+		Batch:           g.Batch,
+		BatchSize:       g.BatchSize,
+		TimeoutSeconds:  g.TimeoutSeconds,
+		Concurrency:     g.Concurrency,
+		CreatedAt:       timestamppb.New(g.CreatedAt),
+		UpdatedAt:       timestamppb.New(g.UpdatedAt),
+		ModelConfigName: g.ModelConfigName,
+		Rubric:          g.Rubric,
+	}
+}
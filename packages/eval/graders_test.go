@@ -0,0 +1,29 @@
+package eval
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"github.com/tincans-ai/evalite/gen/eval/v1"
+	"testing"
+)
+
+func TestCreateGraderRequiresType(t *testing.T) {
+	s := newTestService(t)
+	if err := s.db.Create(&Workspace{ID: "w1", Name: "graders"}).Error; err != nil {
+		t.Fatal(err)
+	}
+	_, err := s.CreateGrader(context.Background(), connect.NewRequest(&evalv1.CreateGraderRequest{
+		WorkspaceId: "w1",
+		Name:        "untyped",
+	}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("err = %v, want InvalidArgument", err)
+	}
+	var n int64
+	if err := s.db.Model(&Grader{}).Count(&n).Error; err != nil {
+		t.Fatal(err)
+	}
+	if n != 0 {
+		t.Errorf("stored %d graders, want none", n)
+	}
+}
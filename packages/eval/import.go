@@ -0,0 +1,220 @@
+package eval
+
+import (
+	"bytes"
+	"connectrpc.com/connect"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/rs/xid"
+	"github.com/tincans-ai/evalite/gen/eval/v1"
+	"github.com/tincans-ai/evalite/packages/tabular"
+	"gorm.io/gorm"
+	"sort"
+	"strings"
+)
+
+// importBatchSize is how many test cases are inserted per statement during an import.
+const importBatchSize = 100
+
+func formatFromProto(f evalv1.FileFormat) tabular.Format {
+	switch f {
+	case evalv1.FileFormat_FILE_FORMAT_JSONL:
+		return tabular.JSONL
+	case evalv1.FileFormat_FILE_FORMAT_YAML:
+		return tabular.YAML
+	default:
+		return tabular.CSV
+	}
+}
+
+// ImportTestCases creates test cases from the rows of a CSV, JSONL or YAML file.
+// Columns are mapped to the current prompt's variables, rows missing a variable are
+// rejected, and rows whose variables match an existing case are skipped. The import
+// runs in a single transaction.
+func (s *Service) ImportTestCases(ctx context.Context, req *connect.Request[evalv1.ImportTestCasesRequest]) (*connect.Response[evalv1.ImportTestCasesResponse], error) {
+	workspace, err := s.getWorkspace(req.Msg.WorkspaceId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	prompt := workspace.CurrentPrompt()
+	if prompt == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("workspace has no prompt"))
+	}
+
+	// only text variables can be filled from a file
+	required := make([]string, 0, len(prompt.Variables))
+	isVariable := make(map[string]bool, len(prompt.Variables))
+	for _, v := range prompt.Variables {
+		if v.Type == VariableTypeText {
+			required = append(required, v.Name)
+			isVariable[v.Name] = true
+		}
+	}
+	for col, variable := range req.Msg.ColumnMapping {
+		if !isVariable[variable] {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("column %q is mapped to unknown variable %q", col, variable))
+		}
+	}
+
+	// cases imported into a dataset are stored under the dataset's variable names
+	var datasetID *string
+	var toDataset map[string]string
+	if req.Msg.DatasetId != nil {
+		mappings, err := s.datasetMappings(workspace.ID)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		mapping, ok := mappings[req.Msg.GetDatasetId()]
+		if !ok {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("dataset is not attached to the workspace"))
+		}
+		datasetID = req.Msg.DatasetId
+		toDataset = invertMapping(mapping)
+	}
+
+	rows, err := tabular.Read(formatFromProto(req.Msg.Format), bytes.NewReader(req.Msg.Data))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	existing, err := s.getTestCases(workspace.ID, nil)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	seen := make(map[string]bool, len(existing))
+	for _, tc := range existing {
+		seen[variablesKey(tc.VariableValues)] = true
+	}
+	duplicates, err := newNearDuplicateChecker(req.Msg.Dedup, existing)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	tags := normalizeTags(req.Msg.Tags)
+	testCases := make([]*TestCase, 0, len(rows))
+	rowErrors := make([]*evalv1.ImportRowError, 0)
+	nearDuplicates := make([]*evalv1.NearDuplicate, 0)
+	var nDuplicates uint32
+	for _, row := range rows {
+		if row.Err != nil {
+			rowErrors = append(rowErrors, &evalv1.ImportRowError{Row: uint32(row.Line), Error: row.Err.Error()})
+			continue
+		}
+
+		tc, err := testCaseFromRow(row.Values, req.Msg.ColumnMapping, req.Msg.ReferenceColumn, isVariable, required)
+		if err != nil {
+			rowErrors = append(rowErrors, &evalv1.ImportRowError{Row: uint32(row.Line), Error: err.Error()})
+			continue
+		}
+		key := variablesKey(tc.VariableValues)
+		if seen[key] {
+			nDuplicates++
+			continue
+		}
+		seen[key] = true
+
+		tc.ID = xid.New().String()
+		tc.WorkspaceID = workspace.ID
+		tc.Tags = tags
+		tc.Split = splitFromProto(req.Msg.Split)
+		nd, skip := duplicates.check(tc)
+		if nd != nil {
+			nd.Row = uint32(row.Line)
+			nearDuplicates = append(nearDuplicates, nd)
+		}
+		if skip {
+			continue
+		}
+		if datasetID != nil {
+			tc.WorkspaceID = ""
+			tc.DatasetID = datasetID
+			tc.VariableValues = renameVariables(tc.VariableValues, toDataset)
+		}
+		testCases = append(testCases, tc)
+	}
+
+	// a partial import is only written if the caller asked for it
+	if len(rowErrors) > 0 && !req.Msg.SkipInvalid {
+		testCases = nil
+	}
+	if !req.Msg.DryRun && len(testCases) > 0 {
+		err := s.db.Transaction(func(tx *gorm.DB) error {
+			return tx.CreateInBatches(testCases, importBatchSize).Error
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to import test cases: %v", err))
+		}
+	}
+
+	pbTestCases := make([]*evalv1.TestCase, 0, len(testCases))
+	for _, tc := range testCases {
+		pbTestCases = append(pbTestCases, testCaseToProto(tc))
+	}
+
+	res := connect.NewResponse(&evalv1.ImportTestCasesResponse{
+		TestCases:      pbTestCases,
+		NDuplicates:    nDuplicates,
+		Errors:         rowErrors,
+		NearDuplicates: nearDuplicates,
+	})
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
+
+// testCaseFromRow maps the columns of one imported row to variable values. Explicitly
+// mapped columns take precedence over columns that share a variable's name.
+func testCaseFromRow(values map[string]string, mapping map[string]string, referenceColumn *string, isVariable map[string]bool, required []string) (*TestCase, error) {
+	texts := make(map[string]string, len(required))
+	for col, v := range values {
+		if _, ok := mapping[col]; !ok && isVariable[col] {
+			texts[col] = v
+		}
+	}
+	for col, variable := range mapping {
+		if v, ok := values[col]; ok {
+			texts[variable] = v
+		}
+	}
+
+	missing := make([]string, 0)
+	for _, name := range required {
+		if strings.TrimSpace(texts[name]) == "" {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("missing required variables: %s", strings.Join(missing, ", "))
+	}
+
+	tc := &TestCase{VariableValues: make(VariableValues, len(texts))}
+	for name, text := range texts {
+		text := text
+		tc.VariableValues[name] = VariableValue{TextValue: &text}
+	}
+	if referenceColumn != nil {
+		if ref, ok := values[*referenceColumn]; ok && ref != "" {
+			tc.Response = &ref
+		}
+	}
+	return tc, nil
+}
+
+// variablesKey identifies a test case by its text variable values, so imports can
+// skip cases that already exist.
+func variablesKey(vv VariableValues) string {
+	names := make([]string, 0, len(vv))
+	for name, v := range vv {
+		if v.TextValue != nil {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	pairs := make([][2]string, 0, len(names))
+	for _, name := range names {
+		pairs = append(pairs, [2]string{name, *vv[name].TextValue})
+	}
+	b, _ := json.Marshal(pairs)
+	return string(b)
+}
@@ -0,0 +1,125 @@
+package eval
+
+import (
+	"context"
+	_ "embed"
+	"encoding/xml"
+	"fmt"
+	"github.com/stillmatic/gollum/packages/llm"
+	"github.com/tincans-ai/evalite/packages/grader"
+	"github.com/tincans-ai/evalite/packages/llmutils"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+//go:embed prompts/judge_prompt.txt
+var judgePrompt string
+
+const (
+	defaultJudgeConcurrency = 4
+	judgeMaxTokens          = 1024
+)
+
+type judgeOutput struct {
+	XMLName  xml.Name `xml:"reply"`
+	Thinking string   `xml:"thinking"`
+	Score    string   `xml:"score"`
+	Pass     string   `xml:"pass"`
+	Reason   string   `xml:"reason"`
+}
+
+// judgeGrades asks a judge grader's model to grade each input against the grader's
+// rubric. The returned results are in the same order as inputs.
+func (s *Service) judgeGrades(ctx context.Context, g *Grader, inputs []grader.Input) []grader.Result {
+	results := make([]grader.Result, len(inputs))
+	name := g.ModelConfigName
+	if name == "" {
+		name = s.defaultLargeModelConfig
+	}
+	modelConfig, ok := s.models.GetConfig(name)
+	if !ok {
+		err := fmt.Errorf("model config %s not found", name)
+		for i, in := range inputs {
+			results[i] = grader.Result{TestResultID: in.TestResultID, Err: err}
+		}
+		return results
+	}
+
+	concurrency := int(g.Concurrency)
+	if concurrency == 0 {
+		concurrency = defaultJudgeConcurrency
+	}
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, in := range inputs {
+		wg.Add(1)
+		go func(i int, in grader.Input) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			judgeCtx := ctx
+			if g.TimeoutSeconds > 0 {
+				var cancel context.CancelFunc
+				judgeCtx, cancel = context.WithTimeout(ctx, time.Duration(g.TimeoutSeconds)*time.Second)
+				defer cancel()
+			}
+			output, err := s.judge(judgeCtx, g.Rubric, modelConfig, in)
+			results[i] = grader.Result{TestResultID: in.TestResultID, Output: output, Err: err}
+		}(i, in)
+	}
+	wg.Wait()
+	return results
+}
+
+func (s *Service) judge(ctx context.Context, rubric string, modelConfig llm.ModelConfig, in grader.Input) (grader.Output, error) {
+	names := make([]string, 0, len(in.Variables))
+	for name := range in.Variables {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	var inputs strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&inputs, "<%s>\n%s\n</%s>\n", name, in.Variables[name], name)
+	}
+
+	vars := map[string]string{
+		"RUBRIC":   rubric,
+		"INPUTS":   strings.TrimSpace(inputs.String()),
+		"RESPONSE": in.Response,
+	}
+	if in.Reference != nil {
+		vars["REFERENCE"] = *in.Reference
+	}
+	resp, err := s.InferSync(ctx, llm.InferRequest{
+		ModelConfig: modelConfig,
+		Messages: []llm.InferMessage{{
+			Content: llmutils.ReplacePromptVariables(judgePrompt, vars),
+			Role:    "user",
+		}},
+		MessageOptions: llm.MessageOptions{
+			MaxTokens:   judgeMaxTokens,
+			Temperature: 0,
+		},
+	})
+	if err != nil {
+		return grader.Output{}, fmt.Errorf("failed to infer: %w", err)
+	}
+
+	parsed, err := llmutils.ParseResponse[judgeOutput](resp)
+	if err != nil {
+		return grader.Output{}, fmt.Errorf("failed to parse judge response: %w", err)
+	}
+	score, err := strconv.ParseFloat(parsed.Score, 64)
+	if err != nil || score < 0 || score > 1 {
+		return grader.Output{}, fmt.Errorf("judge gave an invalid score %q", parsed.Score)
+	}
+	out := grader.Output{TestResultID: in.TestResultID, Score: &score, Reason: parsed.Reason}
+	if pass, err := strconv.ParseBool(strings.ToLower(parsed.Pass)); err == nil {
+		out.Pass = &pass
+	}
+	return out, nil
+}
@@ -0,0 +1,286 @@
+package eval
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/rs/xid"
+	"github.com/tincans-ai/evalite/gen/eval/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"regexp"
+)
+
+// anonymousActor is recorded for label moves made without a moved_by.
+const anonymousActor = "anonymous"
+
+var labelNameRegex = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]{0,63}$`)
+
+func labelToProto(l *PromptLabel) *evalv1.PromptLabel {
+	return &evalv1.PromptLabel{
+		WorkspaceId:               l.WorkspaceID,
+		Name:                      l.Name,
+		PromptVersionNumber:       l.PromptVersionNumber,
+		SystemPromptVersionNumber: l.SystemPromptVersionNumber,
+		MovedBy:                   l.MovedBy,
+		UpdatedAt:                 timestamppb.New(l.UpdatedAt),
+	}
+}
+
+func labelActionToProto(a LabelAction) evalv1.LabelAction {
+	switch a {
+	case LabelActionPromote:
+		return evalv1.LabelAction_LABEL_ACTION_PROMOTE
+	case LabelActionRollback:
+		return evalv1.LabelAction_LABEL_ACTION_ROLLBACK
+	default:
+		return evalv1.LabelAction_LABEL_ACTION_UNSPECIFIED
+	}
+}
+
+func labelEventToProto(e *PromptLabelEvent) *evalv1.PromptLabelEvent {
+	return &evalv1.PromptLabelEvent{
+		Id:                                e.ID,
+		Label:                             e.Label,
+		Action:                            labelActionToProto(e.Action),
+		PromptVersionNumber:               e.PromptVersionNumber,
+		SystemPromptVersionNumber:         e.SystemPromptVersionNumber,
+		PreviousPromptVersionNumber:       e.PreviousPromptVersionNumber,
+		PreviousSystemPromptVersionNumber: e.PreviousSystemPromptVersionNumber,
+		MovedBy:                           e.MovedBy,
+		Reason:                            e.Reason,
+		CreatedAt:                         timestamppb.New(e.CreatedAt),
+	}
+}
+
+// getLabel loads a label of a workspace.
+func (s *Service) getLabel(workspaceID, name string) (*PromptLabel, error) {
+	var label PromptLabel
+	if err := s.db.First(&label, "workspace_id = ? AND name = ?", workspaceID, name).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("label %q not found", name))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load label: %v", err))
+	}
+	return &label, nil
+}
+
+// runVersions returns the prompt and system prompt version numbers a run targets:
+// the ones the label points at if a label is given, and the requested ones otherwise.
+func (s *Service) runVersions(workspaceID, label string, versionNumber, systemPromptVersionNumber uint32) (uint32, uint32, error) {
+	if label == "" {
+		return versionNumber, systemPromptVersionNumber, nil
+	}
+	if versionNumber != 0 || systemPromptVersionNumber != 0 {
+		return 0, 0, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("set either a label or version numbers, not both"))
+	}
+	l, err := s.getLabel(workspaceID, label)
+	if err != nil {
+		return 0, 0, err
+	}
+	return l.PromptVersionNumber, l.SystemPromptVersionNumber, nil
+}
+
+// moveLabel points a label at versions and records the move, unless the label
+// already points at them, in which case the returned event is nil.
+func (s *Service) moveLabel(workspace *Workspace, name string, action LabelAction, promptVersion, systemPromptVersion uint32, movedBy, reason string) (*PromptLabel, *PromptLabelEvent, error) {
+	if workspace.PromptByVersion(promptVersion) == nil {
+		return nil, nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("prompt version %d not found", promptVersion))
+	}
+	if systemPromptVersion != 0 && workspace.SystemPromptByVersion(systemPromptVersion) == nil {
+		return nil, nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("system prompt version %d not found", systemPromptVersion))
+	}
+	if movedBy == "" {
+		movedBy = anonymousActor
+	}
+
+	var label PromptLabel
+	var event *PromptLabelEvent
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.First(&label, "workspace_id = ? AND name = ?", workspace.ID, name).Error
+		isNew := errors.Is(err, gorm.ErrRecordNotFound)
+		if err != nil && !isNew {
+			return err
+		}
+		if !isNew && label.PromptVersionNumber == promptVersion && label.SystemPromptVersionNumber == systemPromptVersion {
+			return nil
+		}
+
+		event = &PromptLabelEvent{
+			ID:                        xid.New().String(),
+			WorkspaceID:               workspace.ID,
+			Label:                     name,
+			Action:                    action,
+			PromptVersionNumber:       promptVersion,
+			SystemPromptVersionNumber: systemPromptVersion,
+			MovedBy:                   movedBy,
+			Reason:                    reason,
+		}
+		if isNew {
+			label = PromptLabel{ID: xid.New().String(), WorkspaceID: workspace.ID, Name: name}
+		} else {
+			previousPrompt, previousSystemPrompt := label.PromptVersionNumber, label.SystemPromptVersionNumber
+			event.PreviousPromptVersionNumber = &previousPrompt
+			event.PreviousSystemPromptVersionNumber = &previousSystemPrompt
+		}
+		label.PromptVersionNumber = promptVersion
+		label.SystemPromptVersionNumber = systemPromptVersion
+		label.MovedBy = movedBy
+		if err := tx.Save(&label).Error; err != nil {
+			return err
+		}
+		return tx.Create(event).Error
+	})
+	if err != nil {
+		return nil, nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to move label: %v", err))
+	}
+	return &label, event, nil
+}
+
+// PromoteVersion points a label at prompt versions, or at the versions of another
+// label, and records who moved it. Without a system prompt version the current one
+// is used.
+func (s *Service) PromoteVersion(ctx context.Context, req *connect.Request[evalv1.PromoteVersionRequest]) (*connect.Response[evalv1.PromoteVersionResponse], error) {
+	if !labelNameRegex.MatchString(req.Msg.Label) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid label name %q", req.Msg.Label))
+	}
+	workspace, err := s.getWorkspace(req.Msg.WorkspaceId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+
+	promptVersion, systemPromptVersion := req.Msg.PromptVersionNumber, req.Msg.GetSystemPromptVersionNumber()
+	if req.Msg.FromLabel != "" {
+		if promptVersion != 0 || req.Msg.SystemPromptVersionNumber != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("set either from_label or version numbers, not both"))
+		}
+		from, err := s.getLabel(workspace.ID, req.Msg.FromLabel)
+		if err != nil {
+			return nil, err
+		}
+		promptVersion, systemPromptVersion = from.PromptVersionNumber, from.SystemPromptVersionNumber
+	} else if promptVersion == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("prompt_version_number or from_label is required"))
+	} else if req.Msg.SystemPromptVersionNumber == nil {
+		systemPromptVersion = workspace.CurrentSystemPromptVersionNumber
+	}
+
+	label, event, err := s.moveLabel(workspace, req.Msg.Label, LabelActionPromote, promptVersion, systemPromptVersion, req.Msg.MovedBy, req.Msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &evalv1.PromoteVersionResponse{Label: labelToProto(label)}
+	if event != nil {
+		out.Event = labelEventToProto(event)
+	}
+	res := connect.NewResponse(out)
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
+
+// RollbackLabel moves a label back to where it pointed before its last promotion,
+// or to the versions of an earlier history event. Rollbacks since that promotion
+// are skipped, so rolling back again does not undo the first rollback.
+func (s *Service) RollbackLabel(ctx context.Context, req *connect.Request[evalv1.RollbackLabelRequest]) (*connect.Response[evalv1.RollbackLabelResponse], error) {
+	workspace, err := s.getWorkspace(req.Msg.WorkspaceId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	label, err := s.getLabel(workspace.ID, req.Msg.Label)
+	if err != nil {
+		return nil, err
+	}
+
+	var promptVersion, systemPromptVersion uint32
+	if req.Msg.ToEventId != nil {
+		var target PromptLabelEvent
+		err := s.db.First(&target, "id = ? AND workspace_id = ? AND label = ?", req.Msg.GetToEventId(), workspace.ID, label.Name).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("event %s not found for label %q", req.Msg.GetToEventId(), label.Name))
+			}
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load label event: %v", err))
+		}
+		promptVersion, systemPromptVersion = target.PromptVersionNumber, target.SystemPromptVersionNumber
+	} else {
+		var last PromptLabelEvent
+		err := s.db.Where("workspace_id = ? AND label = ? AND action = ?", workspace.ID, label.Name, LabelActionPromote).
+			Order("created_at DESC").Order("id DESC").
+			First(&last).Error
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load label history: %v", err))
+		}
+		if last.PreviousPromptVersionNumber == nil {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("label %q has no previous version", label.Name))
+		}
+		promptVersion = *last.PreviousPromptVersionNumber
+		if last.PreviousSystemPromptVersionNumber != nil {
+			systemPromptVersion = *last.PreviousSystemPromptVersionNumber
+		}
+	}
+
+	moved, event, err := s.moveLabel(workspace, label.Name, LabelActionRollback, promptVersion, systemPromptVersion, req.Msg.MovedBy, req.Msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+	if event == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("label %q already points at prompt version %d", label.Name, promptVersion))
+	}
+
+	res := connect.NewResponse(&evalv1.RollbackLabelResponse{
+		Label: labelToProto(moved),
+		Event: labelEventToProto(event),
+	})
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
+
+func (s *Service) ListLabels(ctx context.Context, req *connect.Request[evalv1.ListLabelsRequest]) (*connect.Response[evalv1.ListLabelsResponse], error) {
+	var labels []PromptLabel
+	if err := s.db.Where("workspace_id = ?", req.Msg.WorkspaceId).Order("name").Find(&labels).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list labels: %v", err))
+	}
+
+	pbLabels := make([]*evalv1.PromptLabel, 0, len(labels))
+	for i := range labels {
+		pbLabels = append(pbLabels, labelToProto(&labels[i]))
+	}
+	res := connect.NewResponse(&evalv1.ListLabelsResponse{Labels: pbLabels})
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
+
+// ListLabelHistory lists the moves of a workspace's labels, newest first.
+func (s *Service) ListLabelHistory(ctx context.Context, req *connect.Request[evalv1.ListLabelHistoryRequest]) (*connect.Response[evalv1.ListLabelHistoryResponse], error) {
+	var events []PromptLabelEvent
+	var totalCount int64
+
+	query := s.db.Model(&PromptLabelEvent{}).Where("workspace_id = ?", req.Msg.WorkspaceId)
+	if req.Msg.Label != "" {
+		query = query.Where("label = ?", req.Msg.Label)
+	}
+	if err := query.Count(&totalCount).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to count label events: %v", err))
+	}
+	query = query.Order("created_at DESC").Order("id DESC")
+	if req.Msg.PageSize > 0 {
+		page := max(req.Msg.Page, 1)
+		query = query.Offset(int((page - 1) * req.Msg.PageSize)).Limit(int(req.Msg.PageSize))
+	}
+	if err := query.Find(&events).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list label events: %v", err))
+	}
+
+	pbEvents := make([]*evalv1.PromptLabelEvent, 0, len(events))
+	for i := range events {
+		pbEvents = append(pbEvents, labelEventToProto(&events[i]))
+	}
+	res := connect.NewResponse(&evalv1.ListLabelHistoryResponse{
+		Events:     pbEvents,
+		TotalCount: int32(totalCount),
+	})
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
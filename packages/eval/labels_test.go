@@ -0,0 +1,90 @@
+package eval
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"github.com/tincans-ai/evalite/gen/eval/v1"
+	"testing"
+)
+
+func TestLabels(t *testing.T) {
+	s := newTestService(t)
+	ctx := context.Background()
+	w := &Workspace{ID: "w1", Name: "labels"}
+	prompts := []Prompt{
+		w.CreatePrompt("v1 {{INPUT}}", []Variable{{Name: "INPUT", Type: VariableTypeText}}),
+		w.CreatePrompt("v2 {{INPUT}}", []Variable{{Name: "INPUT", Type: VariableTypeText}}),
+		w.CreatePrompt("v3 {{INPUT}}", []Variable{{Name: "INPUT", Type: VariableTypeText}}),
+	}
+	systemPrompt := w.CreateSystemPrompt("Be brief.")
+	if err := s.db.Create(w).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := s.db.Create(&prompts).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := s.db.Create(&systemPrompt).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := s.db.Model(w).Updates(map[string]any{
+		"current_prompt_version_number":        prompts[2].VersionNumber,
+		"current_system_prompt_version_number": systemPrompt.VersionNumber,
+	}).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	promote := func(t *testing.T, label string, version uint32, systemPromptVersion *uint32) *evalv1.PromptLabel {
+		t.Helper()
+		res, err := s.PromoteVersion(ctx, connect.NewRequest(&evalv1.PromoteVersionRequest{
+			WorkspaceId:               w.ID,
+			Label:                     label,
+			PromptVersionNumber:       version,
+			SystemPromptVersionNumber: systemPromptVersion,
+		}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res.Msg.Label
+	}
+
+	t.Run("promote defaults to the current system prompt", func(t *testing.T) {
+		if l := promote(t, "staging", prompts[0].VersionNumber, nil); l.SystemPromptVersionNumber != systemPrompt.VersionNumber {
+			t.Errorf("system prompt version = %d, want %d", l.SystemPromptVersionNumber, systemPrompt.VersionNumber)
+		}
+		none := uint32(0)
+		if l := promote(t, "bare", prompts[0].VersionNumber, &none); l.SystemPromptVersionNumber != 0 {
+			t.Errorf("system prompt version = %d, want none", l.SystemPromptVersionNumber)
+		}
+	})
+
+	t.Run("rollback skips earlier rollbacks", func(t *testing.T) {
+		promote(t, "production", prompts[0].VersionNumber, nil)
+		promote(t, "production", prompts[1].VersionNumber, nil)
+		promote(t, "production", prompts[2].VersionNumber, nil)
+
+		rollback := func() (*evalv1.PromptLabel, error) {
+			res, err := s.RollbackLabel(ctx, connect.NewRequest(&evalv1.RollbackLabelRequest{WorkspaceId: w.ID, Label: "production"}))
+			if err != nil {
+				return nil, err
+			}
+			return res.Msg.Label, nil
+		}
+		l, err := rollback()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if l.PromptVersionNumber != prompts[1].VersionNumber {
+			t.Errorf("rolled back to %d, want %d", l.PromptVersionNumber, prompts[1].VersionNumber)
+		}
+		if _, err := rollback(); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+			t.Errorf("second rollback err = %v, want FailedPrecondition", err)
+		}
+		label, err := s.getLabel(w.ID, "production")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if label.PromptVersionNumber != prompts[1].VersionNumber {
+			t.Errorf("label points at %d after a second rollback, want %d", label.PromptVersionNumber, prompts[1].VersionNumber)
+		}
+	})
+}
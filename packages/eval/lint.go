@@ -0,0 +1,243 @@
+package eval
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/tincans-ai/evalite/gen/eval/v1"
+	"github.com/tincans-ai/evalite/packages/llmutils"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// LintPrompt lints a saved prompt version, or unsaved content, against the
+// workspace's test cases and active configs.
+func (s *Service) LintPrompt(ctx context.Context, req *connect.Request[evalv1.LintPromptRequest]) (*connect.Response[evalv1.LintPromptResponse], error) {
+	workspace, err := s.getWorkspace(req.Msg.WorkspaceId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+
+	var content string
+	switch {
+	case req.Msg.Content != nil:
+		content = req.Msg.GetContent()
+	case req.Msg.VersionNumber != nil:
+		p := workspace.PromptByVersion(req.Msg.GetVersionNumber())
+		if p == nil {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("prompt version %d not found", req.Msg.GetVersionNumber()))
+		}
+		content = p.Content
+	default:
+		p := workspace.CurrentPrompt()
+		if p == nil {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("workspace has no prompt"))
+		}
+		content = p.Content
+	}
+	systemPrompt := req.Msg.GetSystemPrompt()
+	if req.Msg.SystemPrompt == nil {
+		if p := workspace.CurrentSystemPrompt(); p != nil {
+			systemPrompt = p.Content
+		}
+	}
+
+	findings, err := s.lintPrompt(workspace, content, systemPrompt)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to lint prompt: %v", err))
+	}
+	res := connect.NewResponse(&evalv1.LintPromptResponse{
+		Findings: findings,
+	})
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
+
+// lintPrompt checks a prompt before it is evaluated. Checks against test cases are
+// skipped while the workspace has none.
+func (s *Service) lintPrompt(workspace *Workspace, content, systemPrompt string) ([]*evalv1.LintFinding, error) {
+	findings := make([]*evalv1.LintFinding, 0)
+	tmpl, err := llmutils.ParseTemplate(content)
+	if err != nil {
+		return append(findings, templateFinding(content, err)), nil
+	}
+
+	var testCases []TestCase
+	if err := s.db.Scopes(workspaceTestCases(workspace.ID)).Order("created_at").Find(&testCases).Error; err != nil {
+		return nil, fmt.Errorf("failed to load test cases: %w", err)
+	}
+	mappings, err := s.datasetMappings(workspace.ID)
+	if err != nil {
+		return nil, err
+	}
+	mapDatasetVariables(testCases, mappings)
+	provided := make(map[string]bool)
+	for _, tc := range testCases {
+		for name := range tc.VariableValues {
+			provided[name] = true
+		}
+	}
+
+	used := make(map[string]bool)
+	for _, name := range tmpl.Variables() {
+		if name == examplesVariable {
+			continue
+		}
+		used[name] = true
+		if name != strings.ToUpper(name) {
+			f := &evalv1.LintFinding{
+				Code:     evalv1.LintCode_LINT_CODE_LOWERCASE_VARIABLE,
+				Message:  fmt.Sprintf("variable %s is not upper case", name),
+				Line:     variableLine(content, name),
+				Variable: name,
+			}
+			other, miscased := matchCase(provided, name)
+			if miscased {
+				f.Message = fmt.Sprintf("variable %s does not match %s from the test cases and is left as written", name, other)
+			}
+			findings = append(findings, f)
+			if miscased {
+				// reported instead of a missing variable
+				continue
+			}
+		}
+		if len(testCases) > 0 && !provided[name] {
+			findings = append(findings, &evalv1.LintFinding{
+				Code:     evalv1.LintCode_LINT_CODE_MISSING_VARIABLE,
+				Message:  fmt.Sprintf("no test case provides variable %s", name),
+				Line:     variableLine(content, name),
+				Variable: name,
+			})
+		}
+	}
+	unused := make([]string, 0)
+	for name := range provided {
+		if !used[name] {
+			unused = append(unused, name)
+		}
+	}
+	sort.Strings(unused)
+	for _, name := range unused {
+		findings = append(findings, &evalv1.LintFinding{
+			Code:     evalv1.LintCode_LINT_CODE_UNUSED_VARIABLE,
+			Message:  fmt.Sprintf("test cases provide variable %s, which the prompt does not use", name),
+			Variable: name,
+		})
+	}
+
+	if workspace.XMLMode && !strings.Contains(content, "<reply>") && !strings.Contains(systemPrompt, "<reply>") {
+		findings = append(findings, &evalv1.LintFinding{
+			Code:    evalv1.LintCode_LINT_CODE_XML_MODE_WITHOUT_REPLY,
+			Message: "XML mode is on, but neither the prompt nor the system prompt asks for a <reply>",
+		})
+	}
+
+	// the longest rendering decides whether every test case fits
+	longest, longestID := llmutils.EstimateTokens(tmpl.Render(nil)), ""
+	if len(testCases) > 0 {
+		longest = 0
+	}
+	for _, tc := range testCases {
+		if n := llmutils.EstimateTokens(tmpl.Render(s.prepareVariables(tc))); n > longest {
+			longest, longestID = n, tc.ID
+		}
+	}
+	promptTokens := longest + llmutils.EstimateTokens(systemPrompt)
+	var configs []WorkspaceConfig
+	if err := s.db.Where("workspace_id = ? AND active = ?", workspace.ID, true).Order("created_at").Find(&configs).Error; err != nil {
+		return nil, fmt.Errorf("failed to load workspace configs: %w", err)
+	}
+	for _, wc := range configs {
+		modelName := wc.ModelConfigName
+		if mc, ok := s.models.GetConfig(wc.ModelConfigName); ok && mc.ModelName != "" {
+			modelName = mc.ModelName
+		}
+		window, ok := llmutils.ContextWindow(modelName)
+		if !ok {
+			continue
+		}
+		if total := promptTokens + int(wc.MessageOptions.MaxTokens); total > window {
+			findings = append(findings, &evalv1.LintFinding{
+				Code: evalv1.LintCode_LINT_CODE_CONTEXT_WINDOW_EXCEEDED,
+				Message: fmt.Sprintf("config %s needs about %d tokens (%d prompt, %d max tokens), more than the %d of %s",
+					wc.Name, total, promptTokens, wc.MessageOptions.MaxTokens, window, modelName),
+				TestCaseId:        longestID,
+				WorkspaceConfigId: wc.ID,
+			})
+		}
+	}
+	return findings, nil
+}
+
+// templateFinding reports why content failed to parse as a template.
+func templateFinding(content string, err error) *evalv1.LintFinding {
+	if line, ok := unclosedAction(content); ok {
+		return &evalv1.LintFinding{
+			Code:    evalv1.LintCode_LINT_CODE_UNCLOSED_ACTION,
+			Message: "{{ is not closed with }}",
+			Line:    line,
+		}
+	}
+	f := &evalv1.LintFinding{Code: evalv1.LintCode_LINT_CODE_TEMPLATE_ERROR, Message: err.Error()}
+	var templateErr *llmutils.TemplateError
+	if errors.As(err, &templateErr) {
+		f.Message = templateErr.Msg
+		f.Line = uint32(templateErr.Line)
+	}
+	return f
+}
+
+// invalidPromptError rejects a prompt that is not a valid template, with its lint
+// finding attached as an error detail.
+func invalidPromptError(content string, err error) *connect.Error {
+	connectErr := connect.NewError(connect.CodeInvalidArgument, err)
+	if detail, detailErr := connect.NewErrorDetail(templateFinding(content, err)); detailErr == nil {
+		connectErr.AddDetail(detail)
+	}
+	return connectErr
+}
+
+// unclosedAction returns the line of the first {{ that has no }} before the next
+// {{ or the end of the text.
+func unclosedAction(content string) (uint32, bool) {
+	pos := 0
+	for {
+		open := strings.Index(content[pos:], "{{")
+		if open < 0 {
+			return 0, false
+		}
+		open += pos
+		rest := content[open+2:]
+		closing := strings.Index(rest, "}}")
+		next := strings.Index(rest, "{{")
+		if closing < 0 || (next >= 0 && next < closing) {
+			return uint32(strings.Count(content[:open], "\n") + 1), true
+		}
+		pos = open + 2 + closing + 2
+	}
+}
+
+// variableLine returns the line of the first action using a variable, or 0.
+func variableLine(content, name string) uint32 {
+	re := regexp.MustCompile(`\{\{-?\s*(?:(?:if|range)\s+)?` + regexp.QuoteMeta(name) + `\b`)
+	loc := re.FindStringIndex(content)
+	if loc == nil {
+		return 0
+	}
+	return uint32(strings.Count(content[:loc[0]], "\n") + 1)
+}
+
+// matchCase returns the provided variable that differs from name only in case.
+func matchCase(provided map[string]bool, name string) (string, bool) {
+	if provided[name] {
+		return "", false
+	}
+	for other := range provided {
+		if strings.EqualFold(other, name) {
+			return other, true
+		}
+	}
+	return "", false
+}
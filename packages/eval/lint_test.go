@@ -0,0 +1,76 @@
+package eval
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"errors"
+	"github.com/tincans-ai/evalite/gen/eval/v1"
+	"strings"
+	"testing"
+)
+
+func TestUpdateWorkspaceInvalidTemplate(t *testing.T) {
+	s := newTestService(t)
+	tests := []struct {
+		name    string
+		content string
+		code    evalv1.LintCode
+		line    uint32
+	}{
+		{name: "unclosed action", content: "Answer:\n{{ INPUT", code: evalv1.LintCode_LINT_CODE_UNCLOSED_ACTION, line: 2},
+		{name: "template error", content: "{{INPUT | shout}}", code: evalv1.LintCode_LINT_CODE_TEMPLATE_ERROR, line: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.UpdateWorkspace(context.Background(), connect.NewRequest(&evalv1.UpdateWorkspaceRequest{
+				WorkspaceId: "w1",
+				NewContent:  tt.content,
+			}))
+			var connectErr *connect.Error
+			if !errors.As(err, &connectErr) || connectErr.Code() != connect.CodeInvalidArgument {
+				t.Fatalf("err = %v, want InvalidArgument", err)
+			}
+			details := connectErr.Details()
+			if len(details) != 1 {
+				t.Fatalf("got %d error details, want 1", len(details))
+			}
+			value, err := details[0].Value()
+			if err != nil {
+				t.Fatal(err)
+			}
+			f, ok := value.(*evalv1.LintFinding)
+			if !ok || f.Code != tt.code || f.Line != tt.line {
+				t.Errorf("detail = %v, want %v on line %d", value, tt.code, tt.line)
+			}
+		})
+	}
+}
+
+func TestLintPromptMiscasedVariable(t *testing.T) {
+	s := newTestService(t)
+	w := &Workspace{ID: "w1", Name: "lint"}
+	if err := s.db.Create(w).Error; err != nil {
+		t.Fatal(err)
+	}
+	input := "hi"
+	if err := s.db.Create(&TestCase{ID: "tc1", WorkspaceID: w.ID, VariableValues: VariableValues{"INPUT": {TextValue: &input}}}).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	findings, err := s.lintPrompt(w, "Reply to {{Input}}", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var miscased *evalv1.LintFinding
+	for _, f := range findings {
+		if f.Code == evalv1.LintCode_LINT_CODE_MISSING_VARIABLE && f.Variable == "Input" {
+			t.Errorf("miscased variable also reported as missing: %v", f)
+		}
+		if f.Code == evalv1.LintCode_LINT_CODE_LOWERCASE_VARIABLE {
+			miscased = f
+		}
+	}
+	if miscased == nil || !strings.Contains(miscased.Message, "INPUT") || !strings.Contains(miscased.Message, "left as written") {
+		t.Errorf("lower-case finding = %v, want it to name INPUT", miscased)
+	}
+}
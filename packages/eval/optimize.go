@@ -0,0 +1,504 @@
+package eval
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	_ "embed"
+	"fmt"
+	"github.com/rs/xid"
+	"github.com/stillmatic/gollum/packages/llm"
+	"github.com/tincans-ai/evalite/gen/eval/v1"
+	"github.com/tincans-ai/evalite/packages/llmutils"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"slices"
+	"strings"
+)
+
+//go:embed prompts/optimize_prompt.txt
+var optimizePromptPrompt string
+
+const (
+	defaultOptimizeRounds        = 3
+	defaultOptimizeCandidates    = 3
+	defaultOptimizeImprovement   = 0.01
+	defaultOptimizePassThreshold = 0.5
+	// maxOptimizeFailures bounds how many failing cases are shown to the model
+	maxOptimizeFailures = 10
+)
+
+// optimizeFailure is a test case a prompt version failed on.
+type optimizeFailure struct {
+	testCase TestCase
+	response string
+	reason   string
+}
+
+// versionScore is how a prompt version did on the dev split.
+type versionScore struct {
+	score    float64
+	nScored  uint32
+	failures []optimizeFailure
+}
+
+// optimizer holds what every round of an OptimizePrompt run evaluates with.
+type optimizer struct {
+	s             *Service
+	workspace     *Workspace
+	grader        *Grader
+	systemPrompt  *SystemPrompt
+	configs       []WorkspaceConfig
+	testCases     []TestCase
+	passThreshold float64
+	errs          []string
+}
+
+// OptimizePrompt revises a prompt version over several rounds. Each round asks a
+// model for revisions of the best version so far that fix its failing cases, saves
+// them as new versions and scores them with a grader on the dev split. It stops
+// after max_rounds, or when a round does not improve the best score enough. The run
+// is saved before the first round and every candidate is recorded with its version,
+// so a run that fails part way still accounts for the versions it created.
+func (s *Service) OptimizePrompt(ctx context.Context, req *connect.Request[evalv1.OptimizePromptRequest]) (*connect.Response[evalv1.OptimizePromptResponse], error) {
+	workspace, err := s.getWorkspace(req.Msg.WorkspaceId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	start := workspace.CurrentPrompt()
+	if req.Msg.VersionNumber != nil {
+		start = workspace.PromptByVersion(req.Msg.GetVersionNumber())
+	}
+	if start == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("prompt version not found"))
+	}
+
+	if req.Msg.Scoring == evalv1.OptimizationScoring_OPTIMIZATION_SCORING_RATINGS {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("ratings cannot score candidates, which are new versions without ratings; use grader scoring"))
+	}
+	if req.Msg.GraderId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("a grader is required to score candidates"))
+	}
+	g, err := s.getGrader(workspace.ID, req.Msg.GraderId)
+	if err != nil {
+		return nil, err
+	}
+
+	modelConfigName := s.defaultLargeModelConfig
+	if req.Msg.ModelConfigName != nil {
+		modelConfigName = req.Msg.GetModelConfigName()
+	}
+	modelConfig, ok := s.models.GetConfig(modelConfigName)
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("model config %s not found", modelConfigName))
+	}
+
+	configs, err := perturbationConfigs(workspace, req.Msg.WorkspaceConfigIds)
+	if err != nil {
+		return nil, err
+	}
+	testCases, err := s.getTestCases(workspace.ID, &evalv1.TestCaseFilter{Splits: []evalv1.Split{evalv1.Split_SPLIT_DEV}})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if len(testCases) == 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("no test cases in the dev split"))
+	}
+
+	maxRounds := defaultOptimizeRounds
+	if req.Msg.MaxRounds > 0 {
+		maxRounds = int(req.Msg.MaxRounds)
+	}
+	nCandidates := defaultOptimizeCandidates
+	if req.Msg.NCandidates > 0 {
+		nCandidates = int(req.Msg.NCandidates)
+	}
+	minImprovement := defaultOptimizeImprovement
+	if req.Msg.MinImprovement != nil {
+		minImprovement = req.Msg.GetMinImprovement()
+	}
+	passThreshold := defaultOptimizePassThreshold
+	if req.Msg.PassThreshold != nil {
+		passThreshold = req.Msg.GetPassThreshold()
+	}
+
+	o := &optimizer{
+		s:             s,
+		workspace:     workspace,
+		grader:        g,
+		systemPrompt:  workspace.CurrentSystemPrompt(),
+		configs:       configs,
+		testCases:     testCases,
+		passThreshold: passThreshold,
+		errs:          make([]string, 0),
+	}
+
+	run := &OptimizationRun{
+		ID:                 xid.New().String(),
+		WorkspaceID:        workspace.ID,
+		GraderID:           g.ID,
+		Scoring:            OptimizationScoringGrader,
+		ModelConfigName:    modelConfigName,
+		StartVersionNumber: start.VersionNumber,
+		BestVersionNumber:  start.VersionNumber,
+		StopReason:         OptimizationStopMaxRounds,
+	}
+	if err := s.db.Create(run).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to save optimization run: %v", err))
+	}
+	// fail records the error that ends a run early on the saved run
+	fail := func(err error) error {
+		o.errs = append(o.errs, err.Error())
+		if saveErr := s.db.Model(&OptimizationRun{}).Where("id = ?", run.ID).
+			Update("errors", datatypes.JSONSlice[string](o.errs)).Error; saveErr != nil {
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("%v; failed to save optimization run: %v", err, saveErr))
+		}
+		return connect.NewError(connect.CodeInternal, err)
+	}
+
+	best := *start
+	bestScore, err := o.score(ctx, &best)
+	if err != nil {
+		return nil, fail(err)
+	}
+	run.StartScore = bestScore.score
+	run.BestScore = bestScore.score
+	failures := bestScore.failures
+
+	for round := 1; round <= maxRounds; round++ {
+		if len(failures) == 0 {
+			run.StopReason = OptimizationStopNoFailures
+			break
+		}
+		run.NRounds = uint32(round)
+
+		var roundBest *Prompt
+		var roundBestScore versionScore
+		seen := map[string]bool{best.Content: true}
+		for i := 0; i < nCandidates; i++ {
+			content, err := o.propose(ctx, modelConfig, &best, failures)
+			if err != nil {
+				o.errs = append(o.errs, fmt.Sprintf("round %d: %v", round, err))
+				continue
+			}
+			if seen[content] {
+				continue
+			}
+			seen[content] = true
+
+			candidate, record, err := o.createCandidate(run, &best, content, round)
+			if err != nil {
+				o.errs = append(o.errs, fmt.Sprintf("round %d: %v", round, err))
+				continue
+			}
+			score, err := o.score(ctx, candidate)
+			if err != nil {
+				return nil, fail(err)
+			}
+			candidate.CommitMessage = fmt.Sprintf("Optimization round %d, score %.3f", round, score.score)
+			record.Score, record.NScored, record.NFailed = score.score, score.nScored, uint32(len(score.failures))
+			err = s.db.Transaction(func(tx *gorm.DB) error {
+				if err := tx.Model(&Prompt{}).
+					Where("workspace_id = ? AND version_number = ?", workspace.ID, candidate.VersionNumber).
+					Update("commit_message", candidate.CommitMessage).Error; err != nil {
+					return err
+				}
+				return tx.Model(record).Select("score", "n_scored", "n_failed").Updates(record).Error
+			})
+			if err != nil {
+				return nil, fail(fmt.Errorf("failed to save candidate score: %v", err))
+			}
+
+			run.Candidates = append(run.Candidates, *record)
+			if roundBest == nil || score.score > roundBestScore.score {
+				roundBest, roundBestScore = candidate, score
+			}
+		}
+
+		if roundBest == nil {
+			run.StopReason = OptimizationStopNoCandidates
+			break
+		}
+		improvement := roundBestScore.score - bestScore.score
+		if improvement > 0 {
+			best, bestScore = *roundBest, roundBestScore
+			failures = bestScore.failures
+		}
+		if improvement < minImprovement {
+			run.StopReason = OptimizationStopPlateau
+			break
+		}
+	}
+	run.BestVersionNumber = best.VersionNumber
+	run.BestScore = bestScore.score
+	run.Errors = o.errs
+
+	if err := s.db.Model(&OptimizationRun{}).Where("id = ?", run.ID).Updates(map[string]any{
+		"start_score":         run.StartScore,
+		"best_version_number": run.BestVersionNumber,
+		"best_score":          run.BestScore,
+		"n_rounds":            run.NRounds,
+		"stop_reason":         run.StopReason,
+		"errors":              run.Errors,
+	}).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to save optimization run: %v", err))
+	}
+
+	res := connect.NewResponse(&evalv1.OptimizePromptResponse{
+		Run: optimizationRunToProto(run),
+	})
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
+
+// ListOptimizationRuns lists a workspace's optimization runs, newest first.
+func (s *Service) ListOptimizationRuns(ctx context.Context, req *connect.Request[evalv1.ListOptimizationRunsRequest]) (*connect.Response[evalv1.ListOptimizationRunsResponse], error) {
+	var runs []OptimizationRun
+	if err := s.db.Preload("Candidates", func(db *gorm.DB) *gorm.DB {
+		return db.Order("round, prompt_version_number")
+	}).Where("workspace_id = ?", req.Msg.WorkspaceId).Order("created_at DESC").Find(&runs).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list optimization runs: %v", err))
+	}
+
+	pbRuns := make([]*evalv1.OptimizationRun, 0, len(runs))
+	for i := range runs {
+		pbRuns = append(pbRuns, optimizationRunToProto(&runs[i]))
+	}
+
+	res := connect.NewResponse(&evalv1.ListOptimizationRunsResponse{
+		Runs: pbRuns,
+	})
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
+
+// score evaluates a prompt version on the test cases, grades the results and
+// collects the failing ones. Grades with a pass fail when they do not pass, and
+// grades with only a score fail below the pass threshold.
+func (o *optimizer) score(ctx context.Context, prompt *Prompt) (versionScore, error) {
+	nTotalEvals := len(o.testCases) * len(o.configs)
+	testCasesByID := make(map[string]TestCase, len(o.testCases))
+	ids := make([]string, 0, nTotalEvals)
+	for _, tc := range o.testCases {
+		testCasesByID[tc.ID] = tc
+		results, err := o.s.processTestCaseWithConfigs(ctx, tc, prompt, o.systemPrompt, o.configs, nTotalEvals)
+		if err != nil {
+			o.errs = append(o.errs, fmt.Sprintf("version %d: test case %s: %v", prompt.VersionNumber, tc.ID, err))
+			continue
+		}
+		for _, r := range results {
+			ids = append(ids, r.Id)
+		}
+	}
+
+	out := versionScore{failures: make([]optimizeFailure, 0)}
+	if len(ids) == 0 {
+		return out, nil
+	}
+	var results []TestResult
+	if err := o.s.db.Where("id IN ?", ids).Order("created_at").Find(&results).Error; err != nil {
+		return out, fmt.Errorf("failed to load test results: %v", err)
+	}
+	grades, gradeErrors, err := o.s.gradePending(ctx, o.grader, results)
+	if err != nil {
+		return out, err
+	}
+	for _, ge := range gradeErrors {
+		o.errs = append(o.errs, fmt.Sprintf("version %d: test result %s: %s", prompt.VersionNumber, ge.TestResultId, ge.Error))
+	}
+
+	var total float64
+	for _, tr := range results {
+		grade, ok := grades[tr.ID]
+		if !ok || (grade.Score == nil && grade.Thumbs == 0) {
+			continue
+		}
+		value := 0.0
+		if grade.Score != nil {
+			value = *grade.Score
+		} else if grade.Thumbs > 0 {
+			value = 1
+		}
+		failed := grade.Thumbs < 0 || (grade.Thumbs == 0 && value < o.passThreshold)
+
+		out.nScored++
+		total += value
+		if failed {
+			out.failures = append(out.failures, optimizeFailure{
+				testCase: testCasesByID[tr.TestCaseID],
+				response: tr.Response,
+				reason:   grade.Reason,
+			})
+		}
+	}
+	if out.nScored > 0 {
+		out.score = total / float64(out.nScored)
+	}
+	return out, nil
+}
+
+// propose asks the model for a revision of prompt that fixes the failures.
+func (o *optimizer) propose(ctx context.Context, modelConfig llm.ModelConfig, prompt *Prompt, failures []optimizeFailure) (string, error) {
+	var sb strings.Builder
+	for _, f := range failures[:min(len(failures), maxOptimizeFailures)] {
+		sb.WriteString("<failure>\n<inputs>\n")
+		vars := o.s.prepareVariables(f.testCase)
+		names := make([]string, 0, len(vars))
+		for name := range vars {
+			names = append(names, name)
+		}
+		slices.Sort(names)
+		for _, name := range names {
+			fmt.Fprintf(&sb, "<%s>\n%s\n</%s>\n", name, vars[name], name)
+		}
+		fmt.Fprintf(&sb, "</inputs>\n<response>\n%s\n</response>\n", f.response)
+		if f.reason != "" {
+			fmt.Fprintf(&sb, "<reason>\n%s\n</reason>\n", f.reason)
+		}
+		sb.WriteString("</failure>\n")
+	}
+
+	variables := make([]string, 0, len(prompt.Variables))
+	for _, v := range prompt.Variables {
+		variables = append(variables, v.Name)
+	}
+	vars := map[string]string{
+		"PROMPT":    prompt.Content,
+		"VARIABLES": strings.Join(variables, ", "),
+		"FAILURES":  strings.TrimSpace(sb.String()),
+	}
+	if len(variables) == 0 {
+		vars["VARIABLES"] = "none"
+	}
+	if o.systemPrompt != nil {
+		vars["SYSTEM_PROMPT"] = o.systemPrompt.Content
+	}
+
+	resp, err := o.s.InferSync(ctx, llm.InferRequest{
+		ModelConfig: modelConfig,
+		Messages: []llm.InferMessage{{
+			Content: llmutils.ReplacePromptVariables(optimizePromptPrompt, vars),
+			Role:    "user",
+		}},
+		MessageOptions: llm.MessageOptions{
+			MaxTokens:   defaultGenerateMaxTokens,
+			Temperature: 0.8,
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to propose a revision: %w", err)
+	}
+	parsed, err := llmutils.ParseResponse[genPromptOutput](resp)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse response: %v", err)
+	}
+	if parsed.Prompt == "" {
+		return "", fmt.Errorf("model proposed an empty prompt")
+	}
+	return parsed.Prompt, nil
+}
+
+// createCandidate saves a proposed revision of parent as a new prompt version and
+// records it as a candidate of the run, in one transaction. The revision has to use
+// the same variables as its parent.
+func (o *optimizer) createCandidate(run *OptimizationRun, parent *Prompt, content string, round int) (*Prompt, *OptimizationCandidate, error) {
+	variables, err := promptVariables(content)
+	if err != nil {
+		return nil, nil, err
+	}
+	names := func(vs []Variable) []string {
+		out := make([]string, 0, len(vs))
+		for _, v := range vs {
+			out = append(out, v.Name)
+		}
+		slices.Sort(out)
+		return slices.Compact(out)
+	}
+	if !slices.Equal(names(variables), names(parent.Variables)) {
+		return nil, nil, fmt.Errorf("candidate uses variables %v instead of %v", names(variables), names(parent.Variables))
+	}
+
+	candidate := o.workspace.newPromptVersion(content, variables)
+	parentVersion := parent.VersionNumber
+	candidate.ParentVersionNumber = &parentVersion
+	candidate.CommitMessage = fmt.Sprintf("Optimization round %d", round)
+	record := &OptimizationCandidate{
+		ID:                  xid.New().String(),
+		RunID:               run.ID,
+		Round:               uint32(round),
+		PromptVersionNumber: candidate.VersionNumber,
+		ParentVersionNumber: parent.VersionNumber,
+	}
+	err = o.s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&candidate).Error; err != nil {
+			return err
+		}
+		return tx.Create(record).Error
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create prompt version: %v", err)
+	}
+	o.workspace.Prompts = append(o.workspace.Prompts, candidate)
+	return &candidate, record, nil
+}
+
+func optimizationScoringFromProto(s evalv1.OptimizationScoring) OptimizationScoring {
+	if s == evalv1.OptimizationScoring_OPTIMIZATION_SCORING_RATINGS {
+		return OptimizationScoringRatings
+	}
+	return OptimizationScoringGrader
+}
+
+func optimizationScoringToProto(s OptimizationScoring) evalv1.OptimizationScoring {
+	if s == OptimizationScoringRatings {
+		return evalv1.OptimizationScoring_OPTIMIZATION_SCORING_RATINGS
+	}
+	return evalv1.OptimizationScoring_OPTIMIZATION_SCORING_GRADER
+}
+
+func optimizationStopReasonToProto(r OptimizationStopReason) evalv1.OptimizationStopReason {
+	switch r {
+	case OptimizationStopMaxRounds:
+		return evalv1.OptimizationStopReason_OPTIMIZATION_STOP_REASON_MAX_ROUNDS
+	case OptimizationStopPlateau:
+		return evalv1.OptimizationStopReason_OPTIMIZATION_STOP_REASON_PLATEAU
+	case OptimizationStopNoFailures:
+		return evalv1.OptimizationStopReason_OPTIMIZATION_STOP_REASON_NO_FAILURES
+	case OptimizationStopNoCandidates:
+		return evalv1.OptimizationStopReason_OPTIMIZATION_STOP_REASON_NO_CANDIDATES
+	default:
+		return evalv1.OptimizationStopReason_OPTIMIZATION_STOP_REASON_UNSPECIFIED
+	}
+}
+
+func optimizationRunToProto(run *OptimizationRun) *evalv1.OptimizationRun {
+	candidates := make([]*evalv1.OptimizationCandidate, 0, len(run.Candidates))
+	for _, c := range run.Candidates {
+		candidates = append(candidates, &evalv1.OptimizationCandidate{
+			Round:               c.Round,
+			PromptVersionNumber: c.PromptVersionNumber,
+			ParentVersionNumber: c.ParentVersionNumber,
+			Score:               c.Score,
+			NScored:             c.NScored,
+			NFailed:             c.NFailed,
+		})
+	}
+	errs := make([]string, 0, len(run.Errors))
+	errs = append(errs, run.Errors...)
+	return &evalv1.OptimizationRun{
+		Id:                 run.ID,
+		WorkspaceId:        run.WorkspaceID,
+		GraderId:           run.GraderID,
+		Scoring:            optimizationScoringToProto(run.Scoring),
+		ModelConfigName:    run.ModelConfigName,
+		StartVersionNumber: run.StartVersionNumber,
+		StartScore:         run.StartScore,
+		BestVersionNumber:  run.BestVersionNumber,
+		BestScore:          run.BestScore,
+		NRounds:            run.NRounds,
+		StopReason:         optimizationStopReasonToProto(run.StopReason),
+		Candidates:         candidates,
+		Errors:             errs,
+		CreatedAt:          timestamppb.New(run.CreatedAt),
+	}
+}
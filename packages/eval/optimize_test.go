@@ -0,0 +1,108 @@
+package eval
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"github.com/stillmatic/gollum/packages/llm"
+	"github.com/tincans-ai/evalite/gen/eval/v1"
+	"strings"
+	"testing"
+)
+
+// optimizeResponder proposes a fixed revision, judges every response a failure and
+// echoes everything else. onPropose runs before each proposal.
+type optimizeResponder struct {
+	onPropose func()
+}
+
+func (r *optimizeResponder) GenerateResponse(ctx context.Context, req llm.InferRequest) (string, error) {
+	content := req.Messages[len(req.Messages)-1].Content
+	switch {
+	case strings.Contains(content, "<rubric>"):
+		return "<reply><thinking>no</thinking><score>0</score><pass>false</pass><reason>wrong</reason></reply>", nil
+	case strings.Contains(content, "<failures>"):
+		if r.onPropose != nil {
+			r.onPropose()
+		}
+		return "<reply><thinking>x</thinking><prompt>Answer carefully: {{Q}}</prompt></reply>", nil
+	}
+	return "echo", nil
+}
+
+func (r *optimizeResponder) GenerateResponseAsync(ctx context.Context, req llm.InferRequest) (<-chan llm.StreamDelta, error) {
+	return nil, nil
+}
+
+func newOptimizeWorkspace(t *testing.T, s *Service, responder *optimizeResponder) string {
+	t.Helper()
+	s.providers.AddProvider("fake", responder)
+	s.models.AddConfig("fake-model", llm.ModelConfig{ProviderType: "fake", ModelName: "fake-1"})
+	s.defaultLargeModelConfig = "fake-model"
+
+	w := &Workspace{ID: "w1", Name: "optimize"}
+	prompt := w.CreatePrompt("Answer {{Q}}", []Variable{{Name: "Q", Type: VariableTypeText}})
+	q := "What is 2 + 2?"
+	for _, v := range []any{w, &prompt,
+		&WorkspaceConfig{ID: "c1", WorkspaceID: w.ID, Name: "default", ModelConfigName: "fake-model", Active: true},
+		&TestCase{ID: "tc1", WorkspaceID: w.ID, Split: SplitDev, VariableValues: VariableValues{"Q": {TextValue: &q}}},
+		&Grader{ID: "g1", WorkspaceID: w.ID, Name: "judge", Type: GraderTypeJudge, ModelConfigName: "fake-model", Rubric: "be right"},
+	} {
+		if err := s.db.Create(v).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := s.db.Model(w).Update("current_prompt_version_number", prompt.VersionNumber).Error; err != nil {
+		t.Fatal(err)
+	}
+	return w.ID
+}
+
+func TestOptimizePromptRejectsRatingsScoring(t *testing.T) {
+	s := newTestService(t)
+	workspaceID := newOptimizeWorkspace(t, s, &optimizeResponder{})
+	_, err := s.OptimizePrompt(context.Background(), connect.NewRequest(&evalv1.OptimizePromptRequest{
+		WorkspaceId: workspaceID,
+		GraderId:    "g1",
+		Scoring:     evalv1.OptimizationScoring_OPTIMIZATION_SCORING_RATINGS,
+	}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("err = %v, want InvalidArgument", err)
+	}
+}
+
+func TestOptimizePromptRecordsCandidatesOfFailedRuns(t *testing.T) {
+	s := newTestService(t)
+	// candidates are scored after they are proposed, so losing the ratings table
+	// here fails the run while it scores its first candidate
+	responder := &optimizeResponder{onPropose: func() {
+		if err := s.db.Migrator().DropTable(&Rating{}); err != nil {
+			t.Error(err)
+		}
+	}}
+	workspaceID := newOptimizeWorkspace(t, s, responder)
+
+	_, err := s.OptimizePrompt(context.Background(), connect.NewRequest(&evalv1.OptimizePromptRequest{
+		WorkspaceId: workspaceID,
+		GraderId:    "g1",
+		NCandidates: 1,
+	}))
+	if err == nil {
+		t.Fatal("OptimizePrompt succeeded, want the scoring error")
+	}
+
+	var runs []OptimizationRun
+	if err := s.db.Preload("Candidates").Find(&runs).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(runs) != 1 || len(runs[0].Errors) == 0 {
+		t.Fatalf("runs = %+v, want one run with the error", runs)
+	}
+	var versions []uint32
+	if err := s.db.Model(&Prompt{}).Where("workspace_id = ? AND version_number > 1", workspaceID).
+		Pluck("version_number", &versions).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(versions) != 1 || len(runs[0].Candidates) != 1 || runs[0].Candidates[0].PromptVersionNumber != versions[0] {
+		t.Errorf("candidate versions %v, run candidates %+v, want the one version recorded", versions, runs[0].Candidates)
+	}
+}
@@ -0,0 +1,437 @@
+package eval
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	_ "embed"
+	"encoding/xml"
+	"fmt"
+	"github.com/rs/xid"
+	"github.com/stillmatic/gollum/packages/llm"
+	"github.com/tincans-ai/evalite/gen/eval/v1"
+	"github.com/tincans-ai/evalite/packages/llmutils"
+	"github.com/tincans-ai/evalite/packages/perturb"
+	"maps"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+//go:embed prompts/paraphrase_prompt.txt
+var paraphrasePrompt string
+
+type paraphraseOutput struct {
+	XMLName    xml.Name `xml:"reply"`
+	Paraphrase string   `xml:"paraphrase"`
+}
+
+// perturbationTag marks every perturbed variant, so variants can be left out of
+// statistics with an exclude_tags filter.
+const perturbationTag = "perturbation"
+
+var perturbationKinds = []struct {
+	kind  evalv1.PerturbationKind
+	value perturb.Kind
+}{
+	{evalv1.PerturbationKind_PERTURBATION_KIND_TYPOS, perturb.Typos},
+	{evalv1.PerturbationKind_PERTURBATION_KIND_CASING, perturb.Casing},
+	{evalv1.PerturbationKind_PERTURBATION_KIND_WHITESPACE, perturb.Whitespace},
+	{evalv1.PerturbationKind_PERTURBATION_KIND_PARAPHRASE, perturb.Paraphrase},
+	{evalv1.PerturbationKind_PERTURBATION_KIND_REORDER_LIST, perturb.ReorderList},
+}
+
+func perturbationFromProto(k evalv1.PerturbationKind) (perturb.Kind, bool) {
+	for _, pk := range perturbationKinds {
+		if pk.kind == k {
+			return pk.value, true
+		}
+	}
+	return "", false
+}
+
+func perturbationToProto(k perturb.Kind) evalv1.PerturbationKind {
+	for _, pk := range perturbationKinds {
+		if pk.value == k {
+			return pk.kind
+		}
+	}
+	return evalv1.PerturbationKind_PERTURBATION_KIND_UNSPECIFIED
+}
+
+// RunPerturbations creates perturbed variants of test cases, evaluates the originals
+// and the variants with the same configs, and reports per config how often the
+// result or grade changed.
+func (s *Service) RunPerturbations(ctx context.Context, req *connect.Request[evalv1.RunPerturbationsRequest]) (*connect.Response[evalv1.RunPerturbationsResponse], error) {
+	workspace, err := s.getWorkspace(req.Msg.WorkspaceId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	prompt := workspace.CurrentPrompt()
+	systemPrompt := workspace.CurrentSystemPrompt()
+	if req.Msg.Label != "" {
+		if req.Msg.VersionNumber != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("set either a label or a version number, not both"))
+		}
+		label, err := s.getLabel(workspace.ID, req.Msg.Label)
+		if err != nil {
+			return nil, err
+		}
+		prompt = workspace.PromptByVersion(label.PromptVersionNumber)
+		systemPrompt = workspace.SystemPromptByVersion(label.SystemPromptVersionNumber)
+	} else if req.Msg.VersionNumber != nil {
+		prompt = workspace.PromptByVersion(req.Msg.GetVersionNumber())
+	}
+	if prompt == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("prompt version not found"))
+	}
+
+	configs, err := perturbationConfigs(workspace, req.Msg.WorkspaceConfigIds)
+	if err != nil {
+		return nil, err
+	}
+
+	kinds := make([]perturb.Kind, 0, len(perturbationKinds))
+	if len(req.Msg.Kinds) == 0 {
+		for _, pk := range perturbationKinds {
+			kinds = append(kinds, pk.value)
+		}
+	}
+	for _, k := range req.Msg.Kinds {
+		kind, ok := perturbationFromProto(k)
+		if !ok {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown perturbation kind %v", k))
+		}
+		kinds = append(kinds, kind)
+	}
+
+	var paraphraseModel llm.ModelConfig
+	for _, kind := range kinds {
+		if kind != perturb.Paraphrase {
+			continue
+		}
+		name := s.defaultSmallModelConfig
+		if req.Msg.ParaphraseModelConfigName != nil {
+			name = req.Msg.GetParaphraseModelConfigName()
+		}
+		var ok bool
+		paraphraseModel, ok = s.models.GetConfig(name)
+		if !ok {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("model config %s not found", name))
+		}
+	}
+
+	var g *Grader
+	if req.Msg.GraderId != nil {
+		g, err = s.getGrader(workspace.ID, req.Msg.GetGraderId())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var originals []TestCase
+	if len(req.Msg.TestCaseIds) > 0 {
+		if err := s.db.Scopes(workspaceTestCases(workspace.ID)).Where("id IN ?", req.Msg.TestCaseIds).Find(&originals).Error; err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load test cases: %v", err))
+		}
+		if len(originals) != len(req.Msg.TestCaseIds) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("found %d of %d test cases", len(originals), len(req.Msg.TestCaseIds)))
+		}
+		mappings, err := s.datasetMappings(workspace.ID)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		mapDatasetVariables(originals, mappings)
+	} else {
+		originals, err = s.getTestCases(workspace.ID, req.Msg.Filter)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	// variants are not perturbed again
+	filtered := originals[:0]
+	for _, tc := range originals {
+		if tc.ParentTestCaseID == nil {
+			filtered = append(filtered, tc)
+		}
+	}
+	originals = filtered
+
+	seed := req.Msg.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	rng := rand.New(rand.NewSource(seed))
+	nVariants := max(int(req.Msg.NVariants), 1)
+
+	errs := make([]string, 0)
+	variants := make([]TestCase, 0, len(originals)*len(kinds)*nVariants)
+	for _, tc := range originals {
+		for _, kind := range kinds {
+			for i := 0; i < nVariants; i++ {
+				values, changed, err := s.perturbValues(ctx, tc.VariableValues, kind, rng, paraphraseModel)
+				if err != nil {
+					errs = append(errs, fmt.Sprintf("test case %s: %s: %v", tc.ID, kind, err))
+					continue
+				}
+				if !changed {
+					continue
+				}
+				parentID := tc.ID
+				variants = append(variants, TestCase{
+					ID:               xid.New().String(),
+					WorkspaceID:      workspace.ID,
+					VariableValues:   values,
+					Response:         tc.Response,
+					Tags:             []string{perturbationTag, perturbationTag + ":" + string(kind)},
+					ParentTestCaseID: &parentID,
+					Perturbation:     kind,
+				})
+			}
+		}
+	}
+	if len(variants) > 0 {
+		if err := s.db.CreateInBatches(variants, importBatchSize).Error; err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create variants: %v", err))
+		}
+	}
+
+	// evaluate originals and variants alike; originals reuse earlier results
+	nTotalEvals := (len(originals) + len(variants)) * len(configs)
+	pbResults := make([]*evalv1.TestResult, 0, nTotalEvals)
+	resultIDs := make(map[string]map[string]string)
+	for _, tc := range append(append([]TestCase{}, originals...), variants...) {
+		results, err := s.processTestCaseWithConfigs(ctx, tc, prompt, systemPrompt, configs, nTotalEvals)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("test case %s: %v", tc.ID, err))
+			continue
+		}
+		resultIDs[tc.ID] = make(map[string]string, len(results))
+		for _, r := range results {
+			resultIDs[tc.ID][r.WorkspaceConfigId] = r.Id
+		}
+		pbResults = append(pbResults, results...)
+	}
+
+	ids := make([]string, 0, len(pbResults))
+	for _, r := range pbResults {
+		ids = append(ids, r.Id)
+	}
+	var testResults []TestResult
+	if len(ids) > 0 {
+		if err := s.db.Where("id IN ?", ids).Find(&testResults).Error; err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load test results: %v", err))
+		}
+	}
+	responses := make(map[string]string, len(testResults))
+	for _, tr := range testResults {
+		responses[tr.ID] = tr.Response
+	}
+
+	grades := make(map[string]Rating)
+	if g != nil && len(testResults) > 0 {
+		var gradeErrors []*evalv1.GradeError
+		grades, gradeErrors, err = s.gradePending(ctx, g, testResults)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		for _, ge := range gradeErrors {
+			errs = append(errs, fmt.Sprintf("test result %s: %s", ge.TestResultId, ge.Error))
+		}
+	}
+
+	stability := make([]*evalv1.PerturbationStability, 0)
+	for _, config := range configs {
+		overall := &evalv1.PerturbationStability{
+			WorkspaceConfigId: config.ID,
+			ModelConfigName:   config.ModelConfigName,
+		}
+		byKind := make(map[perturb.Kind]*evalv1.PerturbationStability, len(kinds))
+		for _, v := range variants {
+			original, ok := resultIDs[*v.ParentTestCaseID][config.ID]
+			if !ok {
+				continue
+			}
+			variant, ok := resultIDs[v.ID][config.ID]
+			if !ok {
+				continue
+			}
+			if byKind[v.Perturbation] == nil {
+				byKind[v.Perturbation] = &evalv1.PerturbationStability{
+					WorkspaceConfigId: config.ID,
+					ModelConfigName:   config.ModelConfigName,
+					Kind:              perturbationToProto(v.Perturbation),
+				}
+			}
+
+			resultChanged := responseChanged(responses[original], responses[variant])
+			gradeA, okA := grades[original]
+			gradeB, okB := grades[variant]
+			graded := okA && okB
+			gradeChanged := graded && gradeKey(gradeA) != gradeKey(gradeB)
+			for _, st := range []*evalv1.PerturbationStability{overall, byKind[v.Perturbation]} {
+				st.NPairs++
+				if resultChanged {
+					st.NResultChanged++
+				}
+				if graded {
+					st.NGradedPairs++
+				}
+				if gradeChanged {
+					st.NGradeChanged++
+				}
+				if !resultChanged && !gradeChanged {
+					st.Stability++
+				}
+			}
+		}
+
+		stability = append(stability, overall)
+		for _, kind := range kinds {
+			if st := byKind[kind]; st != nil {
+				stability = append(stability, st)
+			}
+		}
+	}
+	for _, st := range stability {
+		// Stability counts the unchanged pairs until here
+		if st.NPairs > 0 {
+			st.Stability /= float64(st.NPairs)
+		}
+	}
+
+	pbVariants := make([]*evalv1.TestCase, 0, len(variants))
+	for i := range variants {
+		pbVariants = append(pbVariants, testCaseToProto(&variants[i]))
+	}
+
+	res := connect.NewResponse(&evalv1.RunPerturbationsResponse{
+		Variants:  pbVariants,
+		Results:   pbResults,
+		Stability: stability,
+		Errors:    errs,
+	})
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
+
+// perturbationConfigs returns the requested workspace configs, or the active ones.
+func perturbationConfigs(workspace *Workspace, ids []string) ([]WorkspaceConfig, error) {
+	configs := make([]WorkspaceConfig, 0)
+	if len(ids) == 0 {
+		for _, wc := range workspace.WorkspaceConfigs {
+			if wc.Active {
+				configs = append(configs, wc)
+			}
+		}
+		if len(configs) == 0 {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("no active workspace config found"))
+		}
+		return configs, nil
+	}
+
+	byID := make(map[string]WorkspaceConfig, len(workspace.WorkspaceConfigs))
+	for _, wc := range workspace.WorkspaceConfigs {
+		byID[wc.ID] = wc
+	}
+	for _, id := range ids {
+		wc, ok := byID[id]
+		if !ok {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("workspace config %s not found", id))
+		}
+		configs = append(configs, wc)
+	}
+	return configs, nil
+}
+
+// perturbValues perturbs every text value of a test case, and reports whether any
+// of them changed.
+func (s *Service) perturbValues(ctx context.Context, vv VariableValues, kind perturb.Kind, rng *rand.Rand, paraphraseModel llm.ModelConfig) (VariableValues, bool, error) {
+	out := maps.Clone(vv)
+	changed := false
+	for name, v := range vv {
+		if v.TextValue == nil {
+			continue
+		}
+
+		var text string
+		var ok bool
+		if kind == perturb.Paraphrase {
+			var err error
+			text, err = s.paraphrase(ctx, *v.TextValue, paraphraseModel)
+			if err != nil {
+				return nil, false, err
+			}
+			ok = text != *v.TextValue
+		} else {
+			var err error
+			text, ok, err = perturb.Apply(kind, *v.TextValue, rng)
+			if err != nil {
+				return nil, false, err
+			}
+		}
+		if ok {
+			out[name] = VariableValue{TextValue: &text}
+			changed = true
+		}
+	}
+	return out, changed, nil
+}
+
+func (s *Service) paraphrase(ctx context.Context, text string, modelConfig llm.ModelConfig) (string, error) {
+	resp, err := s.InferSync(ctx, llm.InferRequest{
+		ModelConfig: modelConfig,
+		Messages: []llm.InferMessage{{
+			Content: llmutils.ReplacePromptVariables(paraphrasePrompt, map[string]string{"TEXT": text}),
+			Role:    "user",
+		}},
+		MessageOptions: llm.MessageOptions{
+			MaxTokens:   defaultGenerateMaxTokens,
+			Temperature: 0.7,
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to paraphrase: %w", err)
+	}
+	out, err := llmutils.ParseResponse[paraphraseOutput](resp)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse paraphrase: %w", err)
+	}
+	if out.Paraphrase == "" {
+		return "", fmt.Errorf("model returned an empty paraphrase")
+	}
+	return out.Paraphrase, nil
+}
+
+// responseChanged compares two responses by their XML fields, or by their text if
+// neither has any, ignoring differences in whitespace.
+func responseChanged(a, b string) bool {
+	fieldsA, fieldsB := llmutils.ParseXMLFields(a), llmutils.ParseXMLFields(b)
+	if len(fieldsA) == 0 && len(fieldsB) == 0 {
+		return collapseSpace(a) != collapseSpace(b)
+	}
+	if len(fieldsA) != len(fieldsB) {
+		return true
+	}
+	for k, v := range fieldsA {
+		if w, ok := fieldsB[k]; !ok || collapseSpace(v) != collapseSpace(w) {
+			return true
+		}
+	}
+	return false
+}
+
+func collapseSpace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// gradeKey is what two grades are compared by: pass or fail if the grader gave one,
+// otherwise the score.
+func gradeKey(r Rating) string {
+	if r.Thumbs != 0 {
+		return fmt.Sprintf("thumbs:%d", r.Thumbs)
+	}
+	if r.Score != nil {
+		return fmt.Sprintf("score:%g", *r.Score)
+	}
+	return ""
+}
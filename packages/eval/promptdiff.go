@@ -0,0 +1,112 @@
+package eval
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/tincans-ai/evalite/gen/eval/v1"
+	"github.com/tincans-ai/evalite/packages/diff"
+)
+
+func diffOpToProto(op diff.Op) evalv1.DiffOp {
+	switch op {
+	case diff.Equal:
+		return evalv1.DiffOp_DIFF_OP_EQUAL
+	case diff.Insert:
+		return evalv1.DiffOp_DIFF_OP_INSERT
+	case diff.Delete:
+		return evalv1.DiffOp_DIFF_OP_DELETE
+	case diff.Replace:
+		return evalv1.DiffOp_DIFF_OP_REPLACE
+	default:
+		return evalv1.DiffOp_DIFF_OP_UNSPECIFIED
+	}
+}
+
+func textDiffToProto(lines []diff.Line) *evalv1.TextDiff {
+	out := &evalv1.TextDiff{Lines: make([]*evalv1.LineDiff, 0, len(lines))}
+	for _, l := range lines {
+		pb := &evalv1.LineDiff{
+			Op:      diffOpToProto(l.Op),
+			OldText: l.OldText,
+			NewText: l.NewText,
+			OldLine: uint32(l.OldLine),
+			NewLine: uint32(l.NewLine),
+		}
+		for _, w := range l.Words {
+			pb.Words = append(pb.Words, &evalv1.WordDiff{Op: diffOpToProto(w.Op), Text: w.Text})
+		}
+		switch l.Op {
+		case diff.Insert:
+			out.NInserted++
+		case diff.Delete:
+			out.NDeleted++
+		case diff.Replace:
+			out.NReplaced++
+		}
+		out.Lines = append(out.Lines, pb)
+	}
+	return out
+}
+
+// DiffPromptVersions diffs two prompt versions of a workspace, and optionally two
+// of its system prompt versions, by line and by word.
+func (s *Service) DiffPromptVersions(ctx context.Context, req *connect.Request[evalv1.DiffPromptVersionsRequest]) (*connect.Response[evalv1.DiffPromptVersionsResponse], error) {
+	workspace, err := s.getWorkspace(req.Msg.WorkspaceId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+
+	from := workspace.PromptByVersion(req.Msg.FromVersionNumber)
+	if from == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("prompt version %d not found", req.Msg.FromVersionNumber))
+	}
+	to := workspace.PromptByVersion(req.Msg.ToVersionNumber)
+	if to == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("prompt version %d not found", req.Msg.ToVersionNumber))
+	}
+
+	out := &evalv1.DiffPromptVersionsResponse{
+		Prompt:           textDiffToProto(diff.Lines(from.Content, to.Content)),
+		AddedVariables:   make([]string, 0),
+		RemovedVariables: make([]string, 0),
+	}
+
+	fromVariables := make(map[string]bool, len(from.Variables))
+	for _, v := range from.Variables {
+		fromVariables[v.Name] = true
+	}
+	toVariables := make(map[string]bool, len(to.Variables))
+	for _, v := range to.Variables {
+		if toVariables[v.Name] {
+			continue
+		}
+		toVariables[v.Name] = true
+		if !fromVariables[v.Name] {
+			out.AddedVariables = append(out.AddedVariables, v.Name)
+		}
+	}
+	for _, v := range from.Variables {
+		if !toVariables[v.Name] {
+			out.RemovedVariables = append(out.RemovedVariables, v.Name)
+			// report each name once
+			toVariables[v.Name] = true
+		}
+	}
+
+	if req.Msg.FromSystemPromptVersionNumber != nil && req.Msg.ToSystemPromptVersionNumber != nil {
+		fromSystem := workspace.SystemPromptByVersion(*req.Msg.FromSystemPromptVersionNumber)
+		if fromSystem == nil {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("system prompt version %d not found", *req.Msg.FromSystemPromptVersionNumber))
+		}
+		toSystem := workspace.SystemPromptByVersion(*req.Msg.ToSystemPromptVersionNumber)
+		if toSystem == nil {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("system prompt version %d not found", *req.Msg.ToSystemPromptVersionNumber))
+		}
+		out.SystemPrompt = textDiffToProto(diff.Lines(fromSystem.Content, toSystem.Content))
+	}
+
+	res := connect.NewResponse(out)
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
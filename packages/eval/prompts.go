@@ -4,13 +4,15 @@ import (
 	"connectrpc.com/connect"
 	"context"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"github.com/stillmatic/gollum/packages/llm"
 	"github.com/tincans-ai/evalite/gen/eval/v1"
 	"github.com/tincans-ai/evalite/packages/llmutils"
 	"github.com/tincans-ai/evalite/packages/logutil"
-	"regexp"
-	"strings"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"time"
 )
 
 type summarizePromptOutput struct {
@@ -64,24 +66,23 @@ func (s *Service) GetNameForWorkspace(ctx context.Context, promptInput string) (
 	return parsedOutput.Title, nil
 }
 
-func parseDoubleBrackets(input string) []string {
-	// Regular expression to match content inside double curly brackets
-	re := regexp.MustCompile(`{{(.*?)}}`)
-
-	// Find all matches
-	matches := re.FindAllStringSubmatch(input, -1)
-
-	// Extract the content from each match
-	var result []string
-	for _, match := range matches {
-		if len(match) > 1 {
-			// Trim any whitespace from the matched content
-			content := strings.TrimSpace(match[1])
-			result = append(result, content)
+// promptVariables parses a prompt template and returns the variables it uses.
+func promptVariables(content string) ([]Variable, error) {
+	t, err := llmutils.ParseTemplate(content)
+	if err != nil {
+		return nil, fmt.Errorf("invalid prompt template: %w", err)
+	}
+	variables := make([]Variable, 0)
+	for _, name := range t.Variables() {
+		if name == examplesVariable {
+			continue
 		}
+		variables = append(variables, Variable{
+			Name: name,
+			Type: VariableTypeText,
+		})
 	}
-
-	return result
+	return variables, nil
 }
 
 type genPromptOutput struct {
@@ -139,40 +140,100 @@ func (s *Service) GeneratePrompt(ctx context.Context, req *connect.Request[evalv
 	return res, nil
 }
 
+// UpdateWorkspace renames a workspace and creates new prompt and system prompt
+// versions, in one transaction. Content identical to the current version does not
+// create a new version.
 func (s *Service) UpdateWorkspace(ctx context.Context, req *connect.Request[evalv1.UpdateWorkspaceRequest]) (*connect.Response[evalv1.UpdateWorkspaceResponse], error) {
-	var workspace Workspace
-	s.db.First(&workspace, "id = ?", req.Msg.WorkspaceId)
-	if req.Msg.NewTitle != nil {
-		workspace.Name = *req.Msg.NewTitle
-	}
-	if err := s.db.Save(&workspace).Error; err != nil {
-		return nil, err
+	variables, err := promptVariables(req.Msg.NewContent)
+	if err != nil {
+		return nil, invalidPromptError(req.Msg.NewContent, err)
 	}
 
-	// Create a new version
-	variableNames := parseDoubleBrackets(req.Msg.NewContent)
-	variables := make([]Variable, 0)
-	for _, name := range variableNames {
-		variables = append(variables, Variable{
-			Name: name,
-			Type: VariableTypeText,
-		})
-	}
-	newVersion := workspace.CreatePrompt(req.Msg.NewContent, variables)
-	s.db.Create(newVersion)
+	var workspace Workspace
+	var promptChanged, systemPromptChanged bool
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Preload("Prompts").Preload("SystemPrompts").First(&workspace, "id = ?", req.Msg.WorkspaceId).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return connect.NewError(connect.CodeNotFound, fmt.Errorf("workspace not found"))
+			}
+			return err
+		}
+		if req.Msg.NewTitle != nil {
+			workspace.Name = *req.Msg.NewTitle
+		}
 
-	newSystemPrompt := workspace.CreateSystemPrompt(req.Msg.NewSystemPrompt)
-	s.db.Create(newSystemPrompt)
+		current := workspace.CurrentPrompt()
+		if current == nil || current.Content != req.Msg.NewContent {
+			var parent *uint32
+			if req.Msg.ParentVersionNumber != nil {
+				if workspace.PromptByVersion(req.Msg.GetParentVersionNumber()) == nil {
+					return connect.NewError(connect.CodeNotFound, fmt.Errorf("parent prompt version %d not found", req.Msg.GetParentVersionNumber()))
+				}
+				parent = req.Msg.ParentVersionNumber
+			} else if current != nil {
+				parent = &current.VersionNumber
+			}
+			newVersion := workspace.CreatePrompt(req.Msg.NewContent, variables)
+			newVersion.ParentVersionNumber = parent
+			newVersion.CommitMessage = req.Msg.CommitMessage
+			if err := tx.Create(&newVersion).Error; err != nil {
+				return err
+			}
+			workspace.Prompts = append(workspace.Prompts, newVersion)
+			promptChanged = true
+		}
 
-	// Save the new version and update the prompt
-	if err := s.db.Save(&workspace).Error; err != nil {
-		return nil, err
-	}
+		currentSystemPrompt := workspace.CurrentSystemPrompt()
+		if (currentSystemPrompt == nil && req.Msg.NewSystemPrompt != "") ||
+			(currentSystemPrompt != nil && currentSystemPrompt.Content != req.Msg.NewSystemPrompt) {
+			newSystemPrompt := workspace.CreateSystemPrompt(req.Msg.NewSystemPrompt)
+			if currentSystemPrompt != nil {
+				newSystemPrompt.ParentVersionNumber = &currentSystemPrompt.VersionNumber
+			}
+			newSystemPrompt.CommitMessage = req.Msg.CommitMessage
+			if err := tx.Create(&newSystemPrompt).Error; err != nil {
+				return err
+			}
+			workspace.SystemPrompts = append(workspace.SystemPrompts, newSystemPrompt)
+			systemPromptChanged = true
+		}
 
-	res := connect.NewResponse(&evalv1.UpdateWorkspaceResponse{
-		NewVersionNumber: workspace.CurrentPromptVersionNumber,
-		Content:          newVersion.Content,
-		SystemPrompt:     newSystemPrompt.Content,
+		return tx.Model(&Workspace{}).Where("id = ?", workspace.ID).Updates(map[string]any{
+			"name":                                 workspace.Name,
+			"current_prompt_version_number":        workspace.CurrentPromptVersionNumber,
+			"current_system_prompt_version_number": workspace.CurrentSystemPromptVersionNumber,
+			"active_version_numbers":               workspace.ActiveVersionNumbers,
+			"updated_at":                           time.Now(),
+		}).Error
 	})
+	if err != nil {
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			return nil, connectErr
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update workspace: %v", err))
+	}
+
+	var systemPromptContent string
+	if systemPrompt := workspace.CurrentSystemPrompt(); systemPrompt != nil {
+		systemPromptContent = systemPrompt.Content
+	}
+	findings, err := s.lintPrompt(&workspace, req.Msg.NewContent, systemPromptContent)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to lint prompt: %v", err))
+	}
+
+	out := &evalv1.UpdateWorkspaceResponse{
+		NewVersionNumber:          workspace.CurrentPromptVersionNumber,
+		Content:                   req.Msg.NewContent,
+		PromptChanged:             promptChanged,
+		SystemPromptChanged:       systemPromptChanged,
+		SystemPromptVersionNumber: workspace.CurrentSystemPromptVersionNumber,
+		UpdatedAt:                 timestamppb.Now(),
+		SystemPrompt:              systemPromptContent,
+		LintFindings:              findings,
+	}
+	res := connect.NewResponse(out)
+	res.Header().Set("Eval-Version", "v1")
 	return res, nil
 }
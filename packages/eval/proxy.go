@@ -0,0 +1,379 @@
+package eval
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/rs/xid"
+	"github.com/stillmatic/gollum/packages/llm"
+	"github.com/tincans-ai/evalite/packages/logutil"
+	"github.com/tincans-ai/evalite/packages/redact"
+	"math/rand"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// proxyTag marks every test case logged by the proxy.
+	proxyTag = "proxy"
+	// proxyInputVariable holds the last user message when the workspace's prompt
+	// does not have exactly one other text variable.
+	proxyInputVariable = "INPUT"
+	// proxySystemVariable holds the request's system messages.
+	proxySystemVariable = "SYSTEM"
+	// proxyHistoryVariable holds the turns before the last user message, one
+	// "role: content" block per message.
+	proxyHistoryVariable = "HISTORY"
+	// proxyWorkspaceHeader chooses the workspace a request is logged into.
+	proxyWorkspaceHeader = "X-Evalite-Workspace"
+	// proxyVariablesHeader sets the test case's variables explicitly, as a JSON
+	// object of variable names to values.
+	proxyVariablesHeader = "X-Evalite-Variables"
+
+	defaultProxyTemperature = 1.0
+	defaultProxyMaxTokens   = 4096
+)
+
+// ProxyConfig configures the OpenAI-compatible logging proxy.
+type ProxyConfig struct {
+	// WorkspaceID is the workspace sampled requests are logged into, unless the
+	// request names another one. Requests are not logged without a workspace.
+	WorkspaceID string
+	// SampleRate is the fraction of requests that are logged, from 0 to 1.
+	SampleRate float64
+	Redactor   *redact.Redactor
+}
+
+// ProxyConfigFromEnv reads the proxy configuration from PROXY_WORKSPACE_ID,
+// PROXY_SAMPLE_RATE (default 1), PROXY_REDACT (comma-separated built-in patterns)
+// and PROXY_REDACT_PATTERN (a custom regular expression).
+func ProxyConfigFromEnv() (ProxyConfig, error) {
+	cfg := ProxyConfig{
+		WorkspaceID: os.Getenv("PROXY_WORKSPACE_ID"),
+		SampleRate:  1,
+	}
+	if v := os.Getenv("PROXY_SAMPLE_RATE"); v != "" {
+		rate, err := strconv.ParseFloat(v, 64)
+		if err != nil || rate < 0 || rate > 1 {
+			return ProxyConfig{}, fmt.Errorf("PROXY_SAMPLE_RATE must be between 0 and 1, got %q", v)
+		}
+		cfg.SampleRate = rate
+	}
+	redactor, err := redact.New(strings.Split(os.Getenv("PROXY_REDACT"), ","), []string{os.Getenv("PROXY_REDACT_PATTERN")})
+	if err != nil {
+		return ProxyConfig{}, err
+	}
+	cfg.Redactor = redactor
+	return cfg, nil
+}
+
+type chatMessage struct {
+	Role    string          `json:"role"`
+	Content json.RawMessage `json:"content"`
+}
+
+// text returns the message's content, which is either a string or a list of parts
+// of which only the text parts are kept.
+func (m chatMessage) text() (string, error) {
+	var s string
+	if err := json.Unmarshal(m.Content, &s); err == nil {
+		return s, nil
+	}
+	var parts []struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	}
+	if err := json.Unmarshal(m.Content, &parts); err != nil {
+		return "", fmt.Errorf("unsupported content for %s message", m.Role)
+	}
+	texts := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p.Type == "text" {
+			texts = append(texts, p.Text)
+		}
+	}
+	return strings.Join(texts, "\n"), nil
+}
+
+type chatCompletionRequest struct {
+	Model               string        `json:"model"`
+	Messages            []chatMessage `json:"messages"`
+	Temperature         *float32      `json:"temperature"`
+	MaxTokens           *int          `json:"max_tokens"`
+	MaxCompletionTokens *int          `json:"max_completion_tokens"`
+	Stream              bool          `json:"stream"`
+}
+
+type chatCompletionChoice struct {
+	Index        int             `json:"index"`
+	Message      *chatOutMessage `json:"message,omitempty"`
+	Delta        *chatOutMessage `json:"delta,omitempty"`
+	FinishReason *string         `json:"finish_reason"`
+}
+
+type chatOutMessage struct {
+	Role    string `json:"role,omitempty"`
+	Content string `json:"content,omitempty"`
+}
+
+type chatCompletionResponse struct {
+	ID      string                 `json:"id"`
+	Object  string                 `json:"object"`
+	Created int64                  `json:"created"`
+	Model   string                 `json:"model"`
+	Choices []chatCompletionChoice `json:"choices"`
+}
+
+// writeProxyError writes an error in the shape OpenAI clients expect.
+func writeProxyError(w http.ResponseWriter, status int, errType string, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]any{
+		"error": map[string]string{"message": err.Error(), "type": errType},
+	})
+}
+
+// resolveProxyModel finds the model config for a request's model, which is either
+// a model config name or "<provider>/<model name>".
+func (s *Service) resolveProxyModel(model string) (llm.ModelConfig, error) {
+	if mc, ok := s.models.GetConfig(model); ok {
+		return mc, nil
+	}
+	if provider, name, ok := strings.Cut(model, "/"); ok && s.providers.GetProvider(llm.ProviderType(provider)) != nil {
+		return llm.ModelConfig{ProviderType: llm.ProviderType(provider), ModelName: name}, nil
+	}
+	return llm.ModelConfig{}, fmt.Errorf("model %q not found", model)
+}
+
+// ProxyHandler serves an OpenAI-compatible /v1/chat/completions endpoint that
+// forwards requests to the configured providers and logs a sample of them as test
+// cases, with the upstream output as the reference response. Logging never fails
+// a request.
+func (s *Service) ProxyHandler(cfg ProxyConfig) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := logutil.LoggerFromContext(r.Context())
+		if r.Method != http.MethodPost {
+			writeProxyError(w, http.StatusMethodNotAllowed, "invalid_request_error", fmt.Errorf("method %s not allowed", r.Method))
+			return
+		}
+
+		var req chatCompletionRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeProxyError(w, http.StatusBadRequest, "invalid_request_error", fmt.Errorf("failed to parse request: %v", err))
+			return
+		}
+		if len(req.Messages) == 0 {
+			writeProxyError(w, http.StatusBadRequest, "invalid_request_error", fmt.Errorf("messages are required"))
+			return
+		}
+		modelConfig, err := s.resolveProxyModel(req.Model)
+		if err != nil {
+			writeProxyError(w, http.StatusNotFound, "invalid_request_error", err)
+			return
+		}
+		workspaceID := cfg.WorkspaceID
+		if h := r.Header.Get(proxyWorkspaceHeader); h != "" {
+			if err := s.db.Select("id").First(&Workspace{}, "id = ?", h).Error; err != nil {
+				writeProxyError(w, http.StatusNotFound, "invalid_request_error", fmt.Errorf("workspace %q not found", h))
+				return
+			}
+			workspaceID = h
+		}
+
+		msgs := make([]llm.InferMessage, 0, len(req.Messages))
+		for _, m := range req.Messages {
+			text, err := m.text()
+			if err != nil {
+				writeProxyError(w, http.StatusBadRequest, "invalid_request_error", err)
+				return
+			}
+			msgs = append(msgs, llm.InferMessage{Role: m.Role, Content: text})
+		}
+		llmReq := llm.InferRequest{
+			Messages:    msgs,
+			ModelConfig: modelConfig,
+			MessageOptions: llm.MessageOptions{
+				Temperature: defaultProxyTemperature,
+				MaxTokens:   defaultProxyMaxTokens,
+			},
+		}
+		if req.Temperature != nil {
+			llmReq.MessageOptions.Temperature = *req.Temperature
+		}
+		if req.MaxCompletionTokens != nil {
+			llmReq.MessageOptions.MaxTokens = *req.MaxCompletionTokens
+		} else if req.MaxTokens != nil {
+			llmReq.MessageOptions.MaxTokens = *req.MaxTokens
+		}
+
+		completion := chatCompletionResponse{
+			ID:      "chatcmpl-" + xid.New().String(),
+			Created: time.Now().Unix(),
+			Model:   req.Model,
+		}
+		var output string
+		if req.Stream {
+			output, err = s.streamCompletion(w, r, llmReq, completion)
+			if err != nil {
+				logger.Error("proxy stream failed", "model", req.Model, "err", err)
+				return
+			}
+		} else {
+			output, err = s.InferSync(r.Context(), llmReq)
+			if err != nil {
+				writeProxyError(w, http.StatusBadGateway, "upstream_error", fmt.Errorf("upstream request failed: %v", err))
+				return
+			}
+			stop := "stop"
+			completion.Object = "chat.completion"
+			completion.Choices = []chatCompletionChoice{{
+				Message:      &chatOutMessage{Role: "assistant", Content: output},
+				FinishReason: &stop,
+			}}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(completion)
+		}
+
+		if workspaceID == "" || rand.Float64() >= cfg.SampleRate {
+			return
+		}
+		if err := s.logProxyTestCase(cfg, workspaceID, req, msgs, r.Header.Get(proxyVariablesHeader), output); err != nil {
+			logger.Error("failed to log proxied request", "workspace", workspaceID, "err", err)
+		}
+	})
+}
+
+// streamCompletion forwards a streamed completion as server-sent events, and
+// returns the full output.
+func (s *Service) streamCompletion(w http.ResponseWriter, r *http.Request, llmReq llm.InferRequest, completion chatCompletionResponse) (string, error) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeProxyError(w, http.StatusInternalServerError, "server_error", fmt.Errorf("streaming is not supported"))
+		return "", fmt.Errorf("response writer cannot flush")
+	}
+	deltas, err := s.Infer(r.Context(), llmReq)
+	if err != nil {
+		writeProxyError(w, http.StatusBadGateway, "upstream_error", fmt.Errorf("upstream request failed: %v", err))
+		return "", err
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	completion.Object = "chat.completion.chunk"
+	writeChunk := func(choice chatCompletionChoice) error {
+		completion.Choices = []chatCompletionChoice{choice}
+		b, err := json.Marshal(completion)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "data: %s\n\n", b); err != nil {
+			return err
+		}
+		flusher.Flush()
+		return nil
+	}
+
+	var sb strings.Builder
+	if err := writeChunk(chatCompletionChoice{Delta: &chatOutMessage{Role: "assistant"}}); err != nil {
+		return "", err
+	}
+	for delta := range deltas {
+		if delta.Text != "" {
+			sb.WriteString(delta.Text)
+			if err := writeChunk(chatCompletionChoice{Delta: &chatOutMessage{Content: delta.Text}}); err != nil {
+				return "", err
+			}
+		}
+		if delta.EOF {
+			break
+		}
+	}
+	if err := r.Context().Err(); err != nil {
+		return "", err
+	}
+	stop := "stop"
+	if err := writeChunk(chatCompletionChoice{Delta: &chatOutMessage{}, FinishReason: &stop}); err != nil {
+		return "", err
+	}
+	fmt.Fprint(w, "data: [DONE]\n\n")
+	flusher.Flush()
+	return sb.String(), nil
+}
+
+// logProxyTestCase stores a proxied request as a test case. The variables come
+// from the variables header if it is set. Otherwise the last user message is
+// stored in the prompt's only text variable, or in INPUT, and the system messages
+// and earlier turns, if any, in SYSTEM and HISTORY.
+func (s *Service) logProxyTestCase(cfg ProxyConfig, workspaceID string, req chatCompletionRequest, msgs []llm.InferMessage, variablesHeader string, output string) error {
+	workspace, err := s.getWorkspace(workspaceID)
+	if err != nil {
+		return err
+	}
+
+	texts := make(map[string]string)
+	if variablesHeader != "" {
+		if err := json.Unmarshal([]byte(variablesHeader), &texts); err != nil {
+			return fmt.Errorf("invalid %s header: %w", proxyVariablesHeader, err)
+		}
+	} else {
+		last := -1
+		for i := len(msgs) - 1; i >= 0; i-- {
+			if msgs[i].Role == "user" {
+				last = i
+				break
+			}
+		}
+		var system, history []string
+		for i, m := range msgs {
+			switch {
+			case m.Role == "system" || m.Role == "developer":
+				system = append(system, m.Content)
+			case i < last:
+				history = append(history, m.Role+": "+m.Content)
+			}
+		}
+		if len(system) > 0 {
+			texts[proxySystemVariable] = strings.Join(system, "\n\n")
+		}
+		if len(history) > 0 {
+			texts[proxyHistoryVariable] = strings.Join(history, "\n\n")
+		}
+
+		name := proxyInputVariable
+		if prompt := workspace.CurrentPrompt(); prompt != nil {
+			textVariables := make([]string, 0, len(prompt.Variables))
+			for _, v := range prompt.Variables {
+				if v.Type == VariableTypeText && v.Name != proxySystemVariable && v.Name != proxyHistoryVariable {
+					textVariables = append(textVariables, v.Name)
+				}
+			}
+			if len(textVariables) == 1 {
+				name = textVariables[0]
+			}
+		}
+		var input string
+		if last >= 0 {
+			input = msgs[last].Content
+		}
+		texts[name] = input
+	}
+
+	tc := TestCase{
+		ID:             xid.New().String(),
+		WorkspaceID:    workspace.ID,
+		VariableValues: make(VariableValues, len(texts)),
+		Tags:           []string{proxyTag, proxyTag + ":" + req.Model},
+	}
+	for name, text := range texts {
+		text := cfg.Redactor.Redact(text)
+		tc.VariableValues[name] = VariableValue{TextValue: &text}
+	}
+	response := cfg.Redactor.Redact(output)
+	tc.Response = &response
+	if err := s.db.Create(&tc).Error; err != nil {
+		return fmt.Errorf("failed to create test case: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package eval
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/stillmatic/gollum/packages/llm"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// newTestService returns a service backed by a fresh in-memory database, shared by
+// the connections of one test.
+func newTestService(t *testing.T) *Service {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open("file:"+t.Name()+"?mode=memory&cache=shared"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = db.AutoMigrate(&Workspace{}, &Prompt{}, &TestResult{}, &TestCase{}, &WorkspaceConfig{}, &SystemPrompt{},
+		&Rating{}, &Grader{}, &Dataset{}, &DatasetAttachment{}, &PromptLabel{}, &PromptLabelEvent{},
+		&OptimizationRun{}, &OptimizationCandidate{}, &Example{}, &Trace{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewService(db)
+}
+
+// fakeResponder answers every request with a fixed reply and records the requests.
+type fakeResponder struct {
+	reply    string
+	requests []llm.InferRequest
+}
+
+func (f *fakeResponder) GenerateResponse(ctx context.Context, req llm.InferRequest) (string, error) {
+	f.requests = append(f.requests, req)
+	return f.reply, nil
+}
+
+func (f *fakeResponder) GenerateResponseAsync(ctx context.Context, req llm.InferRequest) (<-chan llm.StreamDelta, error) {
+	f.requests = append(f.requests, req)
+	out := make(chan llm.StreamDelta, 2)
+	out <- llm.StreamDelta{Text: f.reply}
+	out <- llm.StreamDelta{EOF: true}
+	close(out)
+	return out, nil
+}
+
+func TestProxyHandler(t *testing.T) {
+	s := newTestService(t)
+	fake := &fakeResponder{reply: "Paris."}
+	s.providers.AddProvider("fake", fake)
+	s.models.AddConfig("fake-model", llm.ModelConfig{ProviderType: "fake", ModelName: "fake-1"})
+
+	w := &Workspace{ID: "w1", Name: "proxy"}
+	prompt := w.CreatePrompt("{{QUESTION}}", []Variable{{Name: "QUESTION", Type: VariableTypeText}})
+	if err := s.db.Create(w).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := s.db.Create(&prompt).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := s.db.Model(w).Update("current_prompt_version_number", prompt.VersionNumber).Error; err != nil {
+		t.Fatal(err)
+	}
+	handler := s.ProxyHandler(ProxyConfig{SampleRate: 1})
+
+	// post returns once the handler is done, so the test case is already logged
+	post := func(workspace, body string) *http.Response {
+		req := httptest.NewRequest(http.MethodPost, "/v1/chat/completions", strings.NewReader(body))
+		req.Header.Set(proxyWorkspaceHeader, workspace)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Result()
+	}
+
+	t.Run("logs the conversation", func(t *testing.T) {
+		res := post("w1", `{"model": "fake-model", "messages": [
+			{"role": "system", "content": "Answer briefly."},
+			{"role": "user", "content": "What is the capital of Italy?"},
+			{"role": "assistant", "content": "Rome."},
+			{"role": "user", "content": [{"type": "text", "text": "And of France?"}]}
+		]}`)
+		if res.StatusCode != http.StatusOK {
+			t.Fatalf("status = %d, want 200", res.StatusCode)
+		}
+		var completion chatCompletionResponse
+		if err := json.NewDecoder(res.Body).Decode(&completion); err != nil {
+			t.Fatal(err)
+		}
+		if len(completion.Choices) != 1 || completion.Choices[0].Message.Content != "Paris." {
+			t.Fatalf("choices = %+v, want the fake reply", completion.Choices)
+		}
+		if n := len(fake.requests); n != 1 || len(fake.requests[0].Messages) != 4 {
+			t.Fatalf("upstream got %d requests, want one with all 4 messages", n)
+		}
+
+		var tc TestCase
+		if err := s.db.First(&tc, "workspace_id = ?", "w1").Error; err != nil {
+			t.Fatal(err)
+		}
+		want := map[string]string{
+			"QUESTION":           "And of France?",
+			proxySystemVariable:  "Answer briefly.",
+			proxyHistoryVariable: "user: What is the capital of Italy?\n\nassistant: Rome.",
+		}
+		if len(tc.VariableValues) != len(want) {
+			t.Errorf("variables = %v, want %v", tc.VariableValues, want)
+		}
+		for name, v := range want {
+			if got := tc.VariableValues[name].TextValue; got == nil || *got != v {
+				t.Errorf("variable %s = %v, want %q", name, got, v)
+			}
+		}
+		if tc.Response == nil || *tc.Response != "Paris." {
+			t.Errorf("response = %v, want the upstream output", tc.Response)
+		}
+		if !slices.Equal(tc.Tags, []string{proxyTag, proxyTag + ":fake-model"}) {
+			t.Errorf("tags = %v", tc.Tags)
+		}
+	})
+
+	t.Run("rejects an unknown workspace", func(t *testing.T) {
+		before := len(fake.requests)
+		res := post("missing", `{"model": "fake-model", "messages": [{"role": "user", "content": "hi"}]}`)
+		if res.StatusCode != http.StatusNotFound {
+			t.Errorf("status = %d, want 404", res.StatusCode)
+		}
+		if len(fake.requests) != before {
+			t.Error("request was forwarded upstream")
+		}
+	})
+
+	t.Run("single turn uses only the input variable", func(t *testing.T) {
+		s.db.Where("workspace_id = ?", "w1").Delete(&TestCase{})
+		res := post("w1", `{"model": "fake-model", "stream": true, "messages": [{"role": "user", "content": "Capital of Spain?"}]}`)
+		if res.StatusCode != http.StatusOK {
+			t.Fatalf("status = %d, want 200", res.StatusCode)
+		}
+		body, err := io.ReadAll(res.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasSuffix(string(body), "data: [DONE]\n\n") {
+			t.Errorf("stream = %q, want it to end with [DONE]", body)
+		}
+		var tc TestCase
+		if err := s.db.First(&tc, "workspace_id = ?", "w1").Error; err != nil {
+			t.Fatal(err)
+		}
+		if got := tc.VariableValues["QUESTION"].TextValue; len(tc.VariableValues) != 1 || got == nil || *got != "Capital of Spain?" {
+			t.Errorf("variables = %v, want only QUESTION", tc.VariableValues)
+		}
+	})
+}
@@ -0,0 +1,321 @@
+package eval
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/rs/xid"
+	"github.com/tincans-ai/evalite/gen/eval/v1"
+	"github.com/tincans-ai/evalite/packages/stats"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"math"
+	"sort"
+)
+
+// defaultMaxDisagreements is how many disagreement items are returned when the request does not say.
+const defaultMaxDisagreements = 10
+
+// upsertRating stores a rating, replacing any earlier rating by the same rater on the same test result.
+// An earlier score is kept if the new rating only carries thumbs.
+func upsertRating(db *gorm.DB, rating *Rating) error {
+	var existing Rating
+	err := db.Where("test_result_id = ? AND rater = ?", rating.TestResultID, rating.Rater).First(&existing).Error
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		if rating.ID == "" {
+			rating.ID = xid.New().String()
+		}
+		return db.Create(rating).Error
+	}
+
+	existing.Automated = rating.Automated
+	existing.Thumbs = rating.Thumbs
+	existing.Reason = rating.Reason
+	if rating.Score != nil {
+		existing.Score = rating.Score
+	}
+	if err := db.Save(&existing).Error; err != nil {
+		return err
+	}
+	*rating = existing
+	return nil
+}
+
+// BackfillRatings copies thumbs stored on test results before ratings had their own
+// table into Rating rows by the default rater. It is safe to run on every start.
+func BackfillRatings(db *gorm.DB) error {
+	var results []TestResult
+	err := db.Unscoped().Model(&TestResult{}).
+		Where("rating != 0").
+		Where("NOT EXISTS (SELECT 1 FROM ratings WHERE ratings.test_result_id = test_results.id AND ratings.rater = ?)", defaultRater).
+		Find(&results).Error
+	if err != nil {
+		return fmt.Errorf("failed to load rated test results: %w", err)
+	}
+	if len(results) == 0 {
+		return nil
+	}
+	ratings := make([]Rating, 0, len(results))
+	for _, tr := range results {
+		ratings = append(ratings, Rating{
+			ID:           xid.New().String(),
+			TestResultID: tr.ID,
+			Rater:        defaultRater,
+			Thumbs:       tr.Rating,
+		})
+	}
+	return db.CreateInBatches(&ratings, importBatchSize).Error
+}
+
+// findRatings returns every rating given to the given test results.
+func (s *Service) findRatings(testResultIDs []string) ([]Rating, error) {
+	var ratings []Rating
+	if len(testResultIDs) == 0 {
+		return ratings, nil
+	}
+	if err := s.db.Where("test_result_id IN ?", testResultIDs).Order("rater").Find(&ratings).Error; err != nil {
+		return nil, err
+	}
+	return ratings, nil
+}
+
+// loadRatings returns the ratings for the given test results, keyed by test result ID.
+func (s *Service) loadRatings(testResultIDs []string) (map[string][]*evalv1.Rating, error) {
+	out := make(map[string][]*evalv1.Rating)
+	ratings, err := s.findRatings(testResultIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range ratings {
+		out[r.TestResultID] = append(out[r.TestResultID], ratingToProto(r))
+	}
+	return out, nil
+}
+
+func ratingToProto(r Rating) *evalv1.Rating {
+	return &evalv1.Rating{
+		Id:           r.ID,
+		TestResultId: r.TestResultID,
+		Rater:        r.Rater,
+		Automated:    r.Automated,
+		Thumbs:       r.Thumbs,
+		Score:        r.Score,
+		Reason:       r.Reason,
+		CreatedAt:    timestamppb.New(r.CreatedAt),
+		UpdatedAt:    timestamppb.New(r.UpdatedAt),
+	}
+}
+
+// ratingWithTestCase is a Rating joined with the test case of the result it rates.
+type ratingWithTestCase struct {
+	Rating
+	TestCaseID string
+}
+
+// ratedItem holds every rater's value for one test result.
+type ratedItem struct {
+	testResultID string
+	testCaseID   string
+	values       map[string]float64
+}
+
+// ComputeAgreement measures how well the raters of a workspace agree with each other,
+// over either thumbs ratings or scale scores.
+func (s *Service) ComputeAgreement(ctx context.Context, req *connect.Request[evalv1.ComputeAgreementRequest]) (*connect.Response[evalv1.ComputeAgreementResponse], error) {
+	var workspace Workspace
+	result := s.db.First(&workspace, "id = ?", req.Msg.WorkspaceId)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("workspace not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to fetch workspace: %w", result.Error))
+	}
+
+	query := s.db.Table("ratings").
+		Select("ratings.*, test_results.test_case_id").
+		Joins("JOIN test_results ON test_results.id = ratings.test_result_id").
+		Joins("JOIN test_cases ON test_cases.id = test_results.test_case_id").
+		Where("test_cases.deleted_at IS NULL").
+		Scopes(workspaceTestCases(workspace.ID), workspaceResults(workspace.ID)).
+		Scopes(filterTestCases(req.Msg.Filter))
+	if len(req.Msg.Raters) > 0 {
+		query = query.Where("ratings.rater IN ?", req.Msg.Raters)
+	}
+
+	var ratings []ratingWithTestCase
+	if err := query.Find(&ratings).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load ratings: %v", err))
+	}
+
+	items, raters := groupRatings(ratings, req.Msg.Scale)
+
+	metric := stats.Nominal
+	if req.Msg.Scale == evalv1.RatingScale_RATING_SCALE_SCORE {
+		metric = stats.Interval
+	}
+
+	// overall agreement
+	units := make([][]float64, 0, len(items))
+	complete := make([][]float64, 0, len(items))
+	for _, item := range items {
+		unit := make([]float64, 0, len(item.values))
+		for _, rater := range raters {
+			if v, ok := item.values[rater]; ok {
+				unit = append(unit, v)
+			}
+		}
+		units = append(units, unit)
+		if len(unit) == len(raters) {
+			complete = append(complete, unit)
+		}
+	}
+
+	// pairwise agreement
+	pairs := make([]*evalv1.RaterPairAgreement, 0)
+	for i := 0; i < len(raters); i++ {
+		for j := i + 1; j < len(raters); j++ {
+			var a, b []float64
+			var pairUnits [][]float64
+			for _, item := range items {
+				va, okA := item.values[raters[i]]
+				vb, okB := item.values[raters[j]]
+				if okA && okB {
+					a = append(a, va)
+					b = append(b, vb)
+					pairUnits = append(pairUnits, []float64{va, vb})
+				}
+			}
+			pairs = append(pairs, &evalv1.RaterPairAgreement{
+				RaterA:            raters[i],
+				RaterB:            raters[j],
+				NItems:            uint32(len(a)),
+				PercentAgreement:  optionalFloat(stats.PercentAgreement(a, b)),
+				CohensKappa:       optionalFloat(stats.CohenKappa(a, b, metric)),
+				KrippendorffAlpha: optionalFloat(stats.KrippendorffAlpha(pairUnits, metric)),
+			})
+		}
+	}
+
+	maxDisagreements := int(req.Msg.MaxDisagreements)
+	if maxDisagreements == 0 {
+		maxDisagreements = defaultMaxDisagreements
+	}
+
+	res := connect.NewResponse(&evalv1.ComputeAgreementResponse{
+		Raters:            raters,
+		NItems:            uint32(len(items)),
+		NCompleteItems:    uint32(len(complete)),
+		FleissKappa:       optionalFloat(stats.FleissKappa(complete)),
+		KrippendorffAlpha: optionalFloat(stats.KrippendorffAlpha(units, metric)),
+		Pairs:             pairs,
+		Disagreements:     topDisagreements(items, req.Msg.Scale, maxDisagreements),
+	})
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
+
+// groupRatings collects the ratings on each test result into a ratedItem. Unrated
+// thumbs and missing scores are skipped, so a rater only counts on the items they
+// actually judged on the requested scale.
+func groupRatings(ratings []ratingWithTestCase, scale evalv1.RatingScale) ([]*ratedItem, []string) {
+	byResult := make(map[string]*ratedItem)
+	order := make([]string, 0)
+	raterSet := make(map[string]bool)
+	for _, r := range ratings {
+		var v float64
+		switch scale {
+		case evalv1.RatingScale_RATING_SCALE_SCORE:
+			if r.Score == nil {
+				continue
+			}
+			v = *r.Score
+		default:
+			if r.Thumbs == 0 {
+				continue
+			}
+			v = float64(r.Thumbs)
+		}
+
+		item, ok := byResult[r.TestResultID]
+		if !ok {
+			item = &ratedItem{testResultID: r.TestResultID, testCaseID: r.TestCaseID, values: make(map[string]float64)}
+			byResult[r.TestResultID] = item
+			order = append(order, r.TestResultID)
+		}
+		item.values[r.Rater] = v
+		raterSet[r.Rater] = true
+	}
+
+	items := make([]*ratedItem, 0, len(order))
+	for _, id := range order {
+		items = append(items, byResult[id])
+	}
+	raters := make([]string, 0, len(raterSet))
+	for r := range raterSet {
+		raters = append(raters, r)
+	}
+	sort.Strings(raters)
+	return items, raters
+}
+
+// topDisagreements returns the items the raters disagreed on most. For thumbs the
+// spread is the share of raters who differ from the majority; for scores it is the
+// range between the highest and lowest score.
+func topDisagreements(items []*ratedItem, scale evalv1.RatingScale, limit int) []*evalv1.DisagreementItem {
+	out := make([]*evalv1.DisagreementItem, 0)
+	for _, item := range items {
+		if len(item.values) < 2 {
+			continue
+		}
+
+		var spread float64
+		if scale == evalv1.RatingScale_RATING_SCALE_SCORE {
+			lo, hi := math.Inf(1), math.Inf(-1)
+			for _, v := range item.values {
+				lo = math.Min(lo, v)
+				hi = math.Max(hi, v)
+			}
+			spread = hi - lo
+		} else {
+			counts := make(map[float64]int)
+			majority := 0
+			for _, v := range item.values {
+				counts[v]++
+				if counts[v] > majority {
+					majority = counts[v]
+				}
+			}
+			spread = 1 - float64(majority)/float64(len(item.values))
+		}
+		if spread == 0 {
+			continue
+		}
+
+		out = append(out, &evalv1.DisagreementItem{
+			TestResultId: item.testResultID,
+			TestCaseId:   item.testCaseID,
+			Values:       item.values,
+			Spread:       spread,
+		})
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Spread > out[j].Spread
+	})
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out
+}
+
+// optionalFloat maps undefined statistics (NaN) to an unset optional field.
+func optionalFloat(v float64) *float64 {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return nil
+	}
+	return &v
+}
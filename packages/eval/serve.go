@@ -0,0 +1,323 @@
+package eval
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/rs/xid"
+	"github.com/stillmatic/gollum/packages/llm"
+	"github.com/tincans-ai/evalite/gen/eval/v1"
+	"github.com/tincans-ai/evalite/packages/llmutils"
+	"github.com/tincans-ai/evalite/packages/logutil"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Complete renders a prompt version, or the versions a label points at, with the
+// request's variables, calls the chosen workspace config's model with it and logs
+// the call as a Trace. Without a label the current system prompt is used; with one,
+// only the label's system prompt is.
+func (s *Service) Complete(ctx context.Context, req *connect.Request[evalv1.CompleteRequest]) (*connect.Response[evalv1.CompleteResponse], error) {
+	out, err := s.complete(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	res := connect.NewResponse(out)
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
+
+// complete serves a CompleteRequest for both the RPC and the JSON endpoint.
+// Failing to log the trace does not fail the call.
+func (s *Service) complete(ctx context.Context, req *evalv1.CompleteRequest) (*evalv1.CompleteResponse, error) {
+	logger := logutil.LoggerFromContext(ctx)
+	workspace, err := s.getWorkspace(req.WorkspaceId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+
+	versionNumber, systemPromptVersionNumber, err := s.runVersions(workspace.ID, req.Label, req.VersionNumber, req.SystemPromptVersionNumber)
+	if err != nil {
+		return nil, err
+	}
+	if versionNumber == 0 {
+		versionNumber = workspace.CurrentPromptVersionNumber
+	}
+	prompt := workspace.PromptByVersion(versionNumber)
+	if prompt == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("prompt version %d not found", versionNumber))
+	}
+	// rendering keeps a missing variable as written, which must not reach the model
+	missing := make([]string, 0)
+	for _, v := range prompt.Variables {
+		if _, ok := req.Variables[v.Name]; !ok {
+			missing = append(missing, v.Name)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("missing variables: %s", strings.Join(missing, ", ")))
+	}
+	// a label without a system prompt serves none
+	var systemPrompt *SystemPrompt
+	switch {
+	case systemPromptVersionNumber != 0:
+		systemPrompt = workspace.SystemPromptByVersion(systemPromptVersionNumber)
+		if systemPrompt == nil {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("system prompt version %d not found", systemPromptVersionNumber))
+		}
+	case req.Label == "":
+		systemPrompt = workspace.CurrentSystemPrompt()
+	}
+	if systemPrompt != nil {
+		systemPromptVersionNumber = systemPrompt.VersionNumber
+	}
+
+	config, err := completeConfig(workspace, req.WorkspaceConfig)
+	if err != nil {
+		return nil, err
+	}
+	modelConfig, ok := s.models.GetConfig(config.ModelConfigName)
+	if !ok {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("model config %s not found", config.ModelConfigName))
+	}
+
+	vars := make(map[string]string, len(req.Variables)+1)
+	maps.Copy(vars, req.Variables)
+	var exampleIDs []string
+	if slices.Contains(llmutils.ParseTemplateLenient(prompt.Content).Variables(), examplesVariable) {
+		testCase := TestCase{VariableValues: make(VariableValues, len(req.Variables))}
+		for name, value := range req.Variables {
+			testCase.VariableValues[name] = VariableValue{TextValue: &value}
+		}
+		examples, err := s.selectExamples(workspace.ID, testCase)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to select examples: %v", err))
+		}
+		vars[examplesVariable] = formatExamples(examples)
+		for _, e := range examples {
+			exampleIDs = append(exampleIDs, e.ID)
+		}
+	}
+	promptStr := llmutils.ReplacePromptVariables(prompt.Content, vars)
+
+	llmReq := llm.InferRequest{
+		ModelConfig: modelConfig,
+		Messages:    s.prepareBaseMessages(systemPrompt, promptStr, false),
+		MessageOptions: llm.MessageOptions{
+			MaxTokens:   int(config.MessageOptions.MaxTokens),
+			Temperature: config.MessageOptions.Temperature,
+		},
+	}
+	start := time.Now()
+	response, inferErr := s.InferSync(ctx, llmReq)
+
+	trace := Trace{
+		ID:                        xid.New().String(),
+		WorkspaceID:               workspace.ID,
+		Label:                     req.Label,
+		PromptVersionNumber:       prompt.VersionNumber,
+		SystemPromptVersionNumber: systemPromptVersionNumber,
+		WorkspaceConfigID:         config.ID,
+		ModelConfigName:           config.ModelConfigName,
+		MessageOptions:            config.MessageOptions,
+		Variables:                 req.Variables,
+		ExampleIDs:                exampleIDs,
+		Response:                  response,
+		LatencyMs:                 uint32(time.Since(start).Milliseconds()),
+	}
+	if inferErr != nil {
+		trace.Error = inferErr.Error()
+	}
+	var traceID string
+	if err := s.db.Create(&trace).Error; err != nil {
+		logger.Error("failed to log trace", "workspace", workspace.ID, "err", err)
+	} else {
+		traceID = trace.ID
+	}
+	if inferErr != nil {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("failed to infer: %v", inferErr))
+	}
+
+	return &evalv1.CompleteResponse{
+		Response:                  response,
+		TraceId:                   traceID,
+		PromptVersionNumber:       prompt.VersionNumber,
+		SystemPromptVersionNumber: systemPromptVersionNumber,
+		WorkspaceConfigId:         config.ID,
+		ModelConfigName:           config.ModelConfigName,
+	}, nil
+}
+
+// completeConfig finds the workspace config to call by ID or name, or the only
+// active config if none is named.
+func completeConfig(workspace *Workspace, ref string) (*WorkspaceConfig, error) {
+	if ref != "" {
+		for i, wc := range workspace.WorkspaceConfigs {
+			if wc.ID == ref || wc.Name == ref {
+				return &workspace.WorkspaceConfigs[i], nil
+			}
+		}
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("workspace config %q not found", ref))
+	}
+	var active []*WorkspaceConfig
+	for i, wc := range workspace.WorkspaceConfigs {
+		if wc.Active {
+			active = append(active, &workspace.WorkspaceConfigs[i])
+		}
+	}
+	if len(active) != 1 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("workspace has %d active configs, choose one with workspace_config", len(active)))
+	}
+	return active[0], nil
+}
+
+func traceToProto(t *Trace) *evalv1.Trace {
+	return &evalv1.Trace{
+		Id:                        t.ID,
+		WorkspaceId:               t.WorkspaceID,
+		Label:                     t.Label,
+		PromptVersionNumber:       t.PromptVersionNumber,
+		SystemPromptVersionNumber: t.SystemPromptVersionNumber,
+		WorkspaceConfigId:         t.WorkspaceConfigID,
+		ModelConfigName:           t.ModelConfigName,
+		Variables:                 t.Variables,
+		ExampleIds:                t.ExampleIDs,
+		Response:                  t.Response,
+		Error:                     t.Error,
+		LatencyMs:                 t.LatencyMs,
+		CreatedAt:                 timestamppb.New(t.CreatedAt),
+	}
+}
+
+// ListTraces lists the calls served for a workspace, newest first.
+func (s *Service) ListTraces(ctx context.Context, req *connect.Request[evalv1.ListTracesRequest]) (*connect.Response[evalv1.ListTracesResponse], error) {
+	var traces []Trace
+	var totalCount int64
+
+	query := s.db.Model(&Trace{}).Where("workspace_id = ?", req.Msg.WorkspaceId)
+	if req.Msg.Label != "" {
+		query = query.Where("label = ?", req.Msg.Label)
+	}
+	if req.Msg.ErrorsOnly {
+		query = query.Where("error != ''")
+	}
+	if err := query.Count(&totalCount).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to count traces: %v", err))
+	}
+	query = query.Order("created_at DESC").Order("id DESC")
+	if req.Msg.PageSize > 0 {
+		page := max(req.Msg.Page, 1)
+		query = query.Offset(int((page - 1) * req.Msg.PageSize)).Limit(int(req.Msg.PageSize))
+	}
+	if err := query.Find(&traces).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list traces: %v", err))
+	}
+
+	pbTraces := make([]*evalv1.Trace, 0, len(traces))
+	for i := range traces {
+		pbTraces = append(pbTraces, traceToProto(&traces[i]))
+	}
+	res := connect.NewResponse(&evalv1.ListTracesResponse{
+		Traces:     pbTraces,
+		TotalCount: int32(totalCount),
+	})
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
+
+// completeHTTPRequest is the body of the plain JSON complete endpoint.
+type completeHTTPRequest struct {
+	WorkspaceID               string            `json:"workspace_id"`
+	Label                     string            `json:"label"`
+	VersionNumber             uint32            `json:"version_number"`
+	SystemPromptVersionNumber uint32            `json:"system_prompt_version_number"`
+	Variables                 map[string]string `json:"variables"`
+	WorkspaceConfig           string            `json:"workspace_config"`
+}
+
+type completeHTTPResponse struct {
+	Response                  string `json:"response"`
+	TraceID                   string `json:"trace_id"`
+	PromptVersionNumber       uint32 `json:"prompt_version_number"`
+	SystemPromptVersionNumber uint32 `json:"system_prompt_version_number"`
+	WorkspaceConfigID         string `json:"workspace_config_id"`
+	ModelConfigName           string `json:"model_config_name"`
+}
+
+// writeCompleteError writes an error as {"error": {"code", "message"}} with the
+// HTTP status of its connect code.
+func writeCompleteError(w http.ResponseWriter, err error) {
+	code := connect.CodeInternal
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		code = connectErr.Code()
+		err = errors.New(connectErr.Message())
+	}
+	status := http.StatusInternalServerError
+	switch code {
+	case connect.CodeInvalidArgument:
+		status = http.StatusBadRequest
+	case connect.CodeNotFound:
+		status = http.StatusNotFound
+	case connect.CodeFailedPrecondition:
+		status = http.StatusPreconditionFailed
+	case connect.CodeUnavailable:
+		status = http.StatusBadGateway
+	}
+	writeCompleteErrorStatus(w, status, code, err)
+}
+
+// writeCompleteErrorStatus writes an error like writeCompleteError, with a given
+// HTTP status.
+func writeCompleteErrorStatus(w http.ResponseWriter, status int, code connect.Code, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]any{
+		"error": map[string]string{"code": code.String(), "message": err.Error()},
+	})
+}
+
+// CompleteHandler serves Complete as a plain JSON POST endpoint, for applications
+// that do not use a Connect client.
+func (s *Service) CompleteHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			writeCompleteErrorStatus(w, http.StatusMethodNotAllowed, connect.CodeInvalidArgument, fmt.Errorf("method %s not allowed", r.Method))
+			return
+		}
+		var req completeHTTPRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeCompleteError(w, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("failed to parse request: %v", err)))
+			return
+		}
+
+		out, err := s.complete(r.Context(), &evalv1.CompleteRequest{
+			WorkspaceId:               req.WorkspaceID,
+			Label:                     req.Label,
+			VersionNumber:             req.VersionNumber,
+			SystemPromptVersionNumber: req.SystemPromptVersionNumber,
+			Variables:                 req.Variables,
+			WorkspaceConfig:           req.WorkspaceConfig,
+		})
+		if err != nil {
+			writeCompleteError(w, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(completeHTTPResponse{
+			Response:                  out.Response,
+			TraceID:                   out.TraceId,
+			PromptVersionNumber:       out.PromptVersionNumber,
+			SystemPromptVersionNumber: out.SystemPromptVersionNumber,
+			WorkspaceConfigID:         out.WorkspaceConfigId,
+			ModelConfigName:           out.ModelConfigName,
+		})
+	})
+}
@@ -0,0 +1,79 @@
+package eval
+
+import (
+	"github.com/stillmatic/gollum/packages/llm"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCompleteHandler(t *testing.T) {
+	s := newTestService(t)
+	fake := &fakeResponder{reply: "ok"}
+	s.providers.AddProvider("fake", fake)
+	s.models.AddConfig("fake-model", llm.ModelConfig{ProviderType: "fake", ModelName: "fake-1"})
+
+	w := &Workspace{ID: "w1", Name: "serve"}
+	prompt := w.CreatePrompt("Hi {{NAME}}", []Variable{{Name: "NAME", Type: VariableTypeText}})
+	systemPrompt := w.CreateSystemPrompt("Be brief.")
+	for _, v := range []any{w, &prompt, &systemPrompt,
+		&WorkspaceConfig{ID: "c1", WorkspaceID: w.ID, Name: "default", ModelConfigName: "fake-model", Active: true},
+		&PromptLabel{ID: "l1", WorkspaceID: w.ID, Name: "production", PromptVersionNumber: prompt.VersionNumber},
+	} {
+		if err := s.db.Create(v).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := s.db.Model(w).Update("current_prompt_version_number", prompt.VersionNumber).Error; err != nil {
+		t.Fatal(err)
+	}
+	handler := s.CompleteHandler()
+
+	t.Run("rejects other methods", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/complete", nil))
+		if rec.Code != http.StatusMethodNotAllowed || rec.Header().Get("Allow") != http.MethodPost {
+			t.Errorf("status = %d, Allow = %q, want 405 and POST", rec.Code, rec.Header().Get("Allow"))
+		}
+	})
+
+	t.Run("rejects missing variables", func(t *testing.T) {
+		fake.requests = nil
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/complete", strings.NewReader(`{"workspace_id": "w1", "variables": {"OTHER": "x"}}`)))
+		if rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), "NAME") {
+			t.Errorf("status = %d, body %s, want 400 naming NAME", rec.Code, rec.Body)
+		}
+		if len(fake.requests) != 0 {
+			t.Error("request with a missing variable was sent to the model")
+		}
+	})
+
+	tests := []struct {
+		name       string
+		body       string
+		wantSystem bool
+	}{
+		{name: "no label serves the current system prompt", body: `{"workspace_id": "w1", "variables": {"NAME": "Ada"}}`, wantSystem: true},
+		{name: "label serves only its own system prompt", body: `{"workspace_id": "w1", "label": "production", "variables": {"NAME": "Ada"}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake.requests = nil
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/complete", strings.NewReader(tt.body)))
+			if rec.Code != http.StatusOK {
+				t.Fatalf("status = %d, body %s", rec.Code, rec.Body)
+			}
+			if len(fake.requests) != 1 {
+				t.Fatalf("upstream got %d requests, want 1", len(fake.requests))
+			}
+			messages := fake.requests[0].Messages
+			hasSystem := len(messages) == 2 && messages[0].Role == "system"
+			if hasSystem != tt.wantSystem || messages[len(messages)-1].Content != "Hi Ada" {
+				t.Errorf("messages = %+v, want system prompt %v", messages, tt.wantSystem)
+			}
+		})
+	}
+}
@@ -4,7 +4,10 @@ import (
 	"connectrpc.com/connect"
 	"context"
 	_ "embed"
+	"encoding/base64"
+	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"github.com/rs/xid"
 	"github.com/stillmatic/gollum/packages/llm"
@@ -13,8 +16,10 @@ import (
 	"github.com/tincans-ai/evalite/packages/logutil"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 	"math"
 	"strings"
+	"time"
 )
 
 func variableValuesToProto(vv VariableValues) map[string]*evalv1.VariableValue {
@@ -47,68 +52,265 @@ func variableValuesFromProto(vv map[string]*evalv1.VariableValue) VariableValues
 	return variableValues
 }
 
+func testCaseToProto(tc *TestCase) *evalv1.TestCase {
+	pb := &evalv1.TestCase{
+		Id:               tc.ID,
+		WorkspaceId:      tc.WorkspaceID,
+		VariableValues:   variableValuesToProto(tc.VariableValues),
+		Response:         tc.Response,
+		Tags:             tc.Tags,
+		Split:            splitToProto(tc.Split),
+		ParentTestCaseId: tc.ParentTestCaseID,
+		Perturbation:     perturbationToProto(tc.Perturbation),
+		DatasetId:        tc.DatasetID,
+		CreatedAt:        timestamppb.New(tc.CreatedAt),
+		UpdatedAt:        timestamppb.New(tc.UpdatedAt),
+	}
+	if tc.DeletedAt.Valid {
+		pb.DeletedAt = timestamppb.New(tc.DeletedAt.Time)
+	}
+	return pb
+}
+
+// testCaseCursor is the position after the last test case of a page, in the sort
+// order it was listed with.
+type testCaseCursor struct {
+	Sort       evalv1.TestCaseSort `json:"s"`
+	Descending bool                `json:"d"`
+	Time       time.Time           `json:"t"`
+	ID         string              `json:"id"`
+}
+
+func (c testCaseCursor) encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeTestCaseCursor(token string) (testCaseCursor, error) {
+	var c testCaseCursor
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return c, err
+	}
+	err = json.Unmarshal(b, &c)
+	return c, err
+}
+
+// sortColumn returns the test_cases column a sort orders by.
+func sortColumn(sort evalv1.TestCaseSort) (string, error) {
+	switch sort {
+	case evalv1.TestCaseSort_TEST_CASE_SORT_UNSPECIFIED, evalv1.TestCaseSort_TEST_CASE_SORT_CREATED_AT:
+		return "test_cases.created_at", nil
+	case evalv1.TestCaseSort_TEST_CASE_SORT_UPDATED_AT:
+		return "test_cases.updated_at", nil
+	default:
+		return "", fmt.Errorf("unknown sort %v", sort)
+	}
+}
+
+// filterListedResults returns a scope that restricts a query on test_results to
+// the results ListTestCases returns: the workspace's results that match the
+// request's result filters.
+func filterListedResults(msg *evalv1.ListTestCasesRequest) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if msg.WorkspaceId != "" {
+			db = db.Scopes(workspaceResults(msg.WorkspaceId))
+		}
+		if len(msg.WorkspaceConfigIds) > 0 {
+			db = db.Where("test_results.workspace_config_id IN ?", msg.WorkspaceConfigIds)
+		}
+		if msg.PromptVersionNumber != nil {
+			db = db.Where("test_results.prompt_version_number = ?", *msg.PromptVersionNumber)
+		}
+		if msg.Rating != nil {
+			db = db.Where("test_results.rating = ?", *msg.Rating)
+		}
+		return db
+	}
+}
+
+// searchTestCases returns a scope that keeps test cases whose variable values or
+// reference response contain text, ignoring case.
+func searchTestCases(text string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if text == "" {
+			return db
+		}
+		escape := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+		// variable values are stored as JSON, so search for the text as JSON encodes it
+		encoded, _ := json.Marshal(text)
+		jsonPattern := "%" + escape.Replace(strings.ToLower(string(encoded[1:len(encoded)-1]))) + "%"
+		pattern := "%" + escape.Replace(strings.ToLower(text)) + "%"
+		return db.Where(`(LOWER(test_cases.variable_values) LIKE ? ESCAPE '\' OR LOWER(test_cases.response) LIKE ? ESCAPE '\')`,
+			jsonPattern, pattern)
+	}
+}
+
+func testResultToProto(tr TestResult) *evalv1.TestResult {
+	return &evalv1.TestResult{
+		Id:                  tr.ID,
+		TestCaseId:          tr.TestCaseID,
+		CreatedAt:           timestamppb.New(tr.CreatedAt),
+		UpdatedAt:           timestamppb.New(tr.UpdatedAt),
+		Response:            tr.Response,
+		ModelConfigName:     tr.ModelConfigName,
+		PromptVersionNumber: tr.PromptVersionNumber,
+		MessageOptions: &evalv1.MessageOptions{
+			MaxTokens:   tr.MessageOptions.MaxTokens,
+			Temperature: tr.MessageOptions.Temperature,
+		},
+		WorkspaceConfigId: tr.WorkspaceConfigID,
+		Rating:            tr.Rating,
+		ExampleIds:        tr.ExampleIDs,
+	}
+}
+
+// ListTestCases lists test cases with their results. Pages are fetched with page
+// tokens, which seek past the previous page instead of counting rows to skip.
 func (s *Service) ListTestCases(ctx context.Context, req *connect.Request[evalv1.ListTestCasesRequest]) (*connect.Response[evalv1.ListTestCasesResponse], error) {
 	var testCases []TestCase
 	var totalCount int64
 
-	offset := (req.Msg.Page - 1) * req.Msg.PageSize
-	limit := req.Msg.PageSize
+	column, err := sortColumn(req.Msg.Sort)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
 
-	query := s.db.Model(&TestCase{})
+	query := s.db.Model(&TestCase{}).
+		Scopes(filterTestCases(req.Msg.Filter), searchTestCases(req.Msg.Search))
 	if req.Msg.WorkspaceId != "" {
-		query = query.Where("workspace_id = ?", req.Msg.WorkspaceId)
+		query = query.Scopes(workspaceTestCases(req.Msg.WorkspaceId))
+	}
+	hasResultFilter := len(req.Msg.WorkspaceConfigIds) > 0 || req.Msg.PromptVersionNumber != nil || req.Msg.Rating != nil
+	if req.Msg.EvaluationStatus != evalv1.EvaluationStatus_EVALUATION_STATUS_UNSPECIFIED || hasResultFilter {
+		matching := s.db.Model(&TestResult{}).Select("1").
+			Where("test_results.test_case_id = test_cases.id").
+			Scopes(filterListedResults(req.Msg))
+		if req.Msg.EvaluationStatus == evalv1.EvaluationStatus_EVALUATION_STATUS_NOT_EVALUATED {
+			query = query.Where("NOT EXISTS (?)", matching)
+		} else {
+			query = query.Where("EXISTS (?)", matching)
+		}
+	}
+	if err := query.Count(&totalCount).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to count test cases: %v", err))
 	}
 
-	query.Count(&totalCount)
-	result := query.Offset(int(offset)).Limit(int(limit)).Find(&testCases)
-	if result.Error != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list test cases: %v", result.Error))
+	direction, cmp := "ASC", ">"
+	if req.Msg.Descending {
+		direction, cmp = "DESC", "<"
+	}
+	if req.Msg.PageToken != "" {
+		cursor, err := decodeTestCaseCursor(req.Msg.PageToken)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid page token: %v", err))
+		}
+		if cursor.Sort != req.Msg.Sort || cursor.Descending != req.Msg.Descending {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("page token was issued for a different sort"))
+		}
+		t := cursor.Time.In(time.Local)
+		query = query.Where(fmt.Sprintf("(%s %s ? OR (%s = ? AND test_cases.id %s ?))", column, cmp, column, cmp), t, t, cursor.ID)
+	} else if req.Msg.Page > 1 && req.Msg.PageSize > 0 {
+		query = query.Offset(int((req.Msg.Page - 1) * req.Msg.PageSize))
+	}
+	query = query.Order(fmt.Sprintf("%s %s, test_cases.id %s", column, direction, direction))
+	if req.Msg.PageSize > 0 {
+		// fetch one extra row to know whether there is a next page
+		query = query.Limit(int(req.Msg.PageSize) + 1)
+	}
+	if err := query.Find(&testCases).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list test cases: %v", err))
 	}
 
-	testResults := make([]*evalv1.TestResult, 0)
-	for _, tc := range testCases {
-		var results []TestResult
-		result := s.db.Model(&TestResult{}).Where("test_case_id = ?", tc.ID).Find(&results)
-		if result.Error != nil {
-			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load test results: %v", result.Error))
-		}
-		for _, tr := range results {
-			testResults = append(testResults, &evalv1.TestResult{
-				Id:                  tr.ID,
-				TestCaseId:          tr.TestCaseID,
-				CreatedAt:           timestamppb.New(tc.CreatedAt),
-				UpdatedAt:           timestamppb.New(tc.UpdatedAt),
-				Response:            tr.Response,
-				ModelConfigName:     tr.ModelConfigName,
-				PromptVersionNumber: tr.PromptVersionNumber,
-				MessageOptions: &evalv1.MessageOptions{
-					MaxTokens:   tr.MessageOptions.MaxTokens,
-					Temperature: tr.MessageOptions.Temperature,
-				},
-				WorkspaceConfigId: tr.WorkspaceConfigID,
-				Rating:            tr.Rating,
-			})
+	var nextPageToken string
+	if req.Msg.PageSize > 0 && len(testCases) > int(req.Msg.PageSize) {
+		testCases = testCases[:req.Msg.PageSize]
+		last := testCases[len(testCases)-1]
+		cursor := testCaseCursor{Sort: req.Msg.Sort, Descending: req.Msg.Descending, Time: last.CreatedAt, ID: last.ID}
+		if req.Msg.Sort == evalv1.TestCaseSort_TEST_CASE_SORT_UPDATED_AT {
+			cursor.Time = last.UpdatedAt
 		}
+		nextPageToken = cursor.encode()
+	}
 
+	if req.Msg.WorkspaceId != "" {
+		mappings, err := s.datasetMappings(req.Msg.WorkspaceId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		mapDatasetVariables(testCases, mappings)
 	}
 
-	pbTestCases := make([]*evalv1.TestCase, len(testCases))
+	testCaseIDs := make([]string, len(testCases))
 	for i, tc := range testCases {
-		variableValues := variableValuesToProto(tc.VariableValues)
-		pbTestCases[i] = &evalv1.TestCase{
-			Id:               tc.ID,
-			WorkspaceId:      tc.WorkspaceID,
-			VariableValues:   variableValues,
-			CreatedAt:        timestamppb.New(tc.CreatedAt),
-			UpdatedAt:        timestamppb.New(tc.UpdatedAt),
-			HasBeenEvaluated: tc.HasBeenEvaluated,
+		testCaseIDs[i] = tc.ID
+	}
+	var results []TestResult
+	if len(testCaseIDs) > 0 {
+		err := s.db.Model(&TestResult{}).
+			Joins("JOIN test_cases ON test_cases.id = test_results.test_case_id").
+			Where("test_results.test_case_id IN ?", testCaseIDs).
+			Scopes(filterListedResults(req.Msg)).
+			Order("test_results.created_at").
+			Find(&results).Error
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load test results: %v", err))
+		}
+	}
+
+	testResults := make([]*evalv1.TestResult, 0, len(results))
+	resultIDs := make([]string, 0, len(results))
+	for _, tr := range results {
+		testResults = append(testResults, testResultToProto(tr))
+		resultIDs = append(resultIDs, tr.ID)
+	}
+
+	ratings, err := s.loadRatings(resultIDs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load ratings: %v", err))
+	}
+	for _, tr := range testResults {
+		tr.Ratings = ratings[tr.Id]
+	}
+
+	// dataset test cases count as evaluated once one of the workspace's configs has a result for them
+	datasetEvaluated := make(map[string]bool)
+	if req.Msg.WorkspaceId != "" {
+		datasetCaseIDs := make([]string, 0)
+		for _, tc := range testCases {
+			if tc.DatasetID != nil {
+				datasetCaseIDs = append(datasetCaseIDs, tc.ID)
+			}
+		}
+		if len(datasetCaseIDs) > 0 {
+			var evaluated []string
+			err := s.db.Model(&TestResult{}).
+				Scopes(workspaceConfigResults(req.Msg.WorkspaceId)).
+				Where("test_results.test_case_id IN ?", datasetCaseIDs).
+				Distinct().Pluck("test_results.test_case_id", &evaluated).Error
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load test results: %v", err))
+			}
+			for _, id := range evaluated {
+				datasetEvaluated[id] = true
+			}
+		}
+	}
+
+	pbTestCases := make([]*evalv1.TestCase, len(testCases))
+	for i := range testCases {
+		pbTestCases[i] = testCaseToProto(&testCases[i])
+		pbTestCases[i].HasBeenEvaluated = testCases[i].HasBeenEvaluated
+		if testCases[i].DatasetID != nil {
+			pbTestCases[i].HasBeenEvaluated = datasetEvaluated[testCases[i].ID]
 		}
 	}
 
 	res := connect.NewResponse(&evalv1.ListTestCasesResponse{
-		TestCases:   pbTestCases,
-		TotalCount:  int32(totalCount),
-		TestResults: testResults,
+		TestCases:     pbTestCases,
+		TotalCount:    int32(totalCount),
+		TestResults:   testResults,
+		NextPageToken: nextPageToken,
 	})
 	res.Header().Set("Eval-Version", "v1")
 	return res, nil
@@ -119,6 +321,9 @@ func (s *Service) CreateTestCase(ctx context.Context, req *connect.Request[evalv
 		ID:             xid.New().String(),
 		WorkspaceID:    req.Msg.WorkspaceId,
 		VariableValues: variableValuesFromProto(req.Msg.VariableValues),
+		Response:       req.Msg.Response,
+		Tags:           normalizeTags(req.Msg.Tags),
+		Split:          splitFromProto(req.Msg.Split),
 	}
 	result := s.db.Create(testCase)
 	if result.Error != nil {
@@ -126,13 +331,7 @@ func (s *Service) CreateTestCase(ctx context.Context, req *connect.Request[evalv
 	}
 
 	res := connect.NewResponse(&evalv1.CreateTestCaseResponse{
-		TestCase: &evalv1.TestCase{
-			Id:             testCase.ID,
-			WorkspaceId:    testCase.WorkspaceID,
-			VariableValues: variableValuesToProto(testCase.VariableValues),
-			CreatedAt:      timestamppb.New(testCase.CreatedAt),
-			UpdatedAt:      timestamppb.New(testCase.UpdatedAt),
-		},
+		TestCase: testCaseToProto(testCase),
 	})
 	res.Header().Set("Eval-Version", "v1")
 	return res, nil
@@ -157,13 +356,46 @@ type generatedTestCaseOutput struct {
 	TestCase               []generatedTestCase `xml:"test_cases>case"`
 }
 
-// GenerateTestCase generates a test case based on the given input.
-func (s *Service) GenerateTestCase(ctx context.Context, req *connect.Request[evalv1.GenerateTestCaseRequest]) (*connect.Response[evalv1.GenerateTestCaseResponse], error) {
-	logger := logutil.LoggerFromContext(ctx)
+const (
+	defaultGenerateTemperature = 1.2
+	defaultGenerateMaxTokens   = 4000
+	defaultGenerateAttempts    = 3
+	maxGenerateAttempts        = 10
+)
 
-	modelConfig, ok := s.models.GetConfig(s.defaultSmallModelConfig)
+// GenerateTestCase generates test cases for a prompt version with an LLM. Generated
+// cases are validated against the prompt's variables; if the reply cannot be parsed
+// or cases are rejected, the model is re-prompted with the problems, up to
+// max_attempts times.
+func (s *Service) GenerateTestCase(ctx context.Context, req *connect.Request[evalv1.GenerateTestCaseRequest]) (*connect.Response[evalv1.GenerateTestCaseResponse], error) {
+	modelConfigName := s.defaultSmallModelConfig
+	if req.Msg.ModelConfigName != nil {
+		modelConfigName = req.Msg.GetModelConfigName()
+	}
+	modelConfig, ok := s.models.GetConfig(modelConfigName)
 	if !ok {
-		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("model config %s not found", s.defaultSmallModelConfig))
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("model config %s not found", modelConfigName))
+	}
+	messageOptions := llm.MessageOptions{
+		MaxTokens:   defaultGenerateMaxTokens,
+		Temperature: defaultGenerateTemperature,
+	}
+	if req.Msg.MaxTokens != nil {
+		messageOptions.MaxTokens = int(req.Msg.GetMaxTokens())
+	}
+	if req.Msg.Temperature != nil {
+		messageOptions.Temperature = req.Msg.GetTemperature()
+	}
+	maxAttempts := int(req.Msg.MaxAttempts)
+	if maxAttempts == 0 {
+		maxAttempts = defaultGenerateAttempts
+	}
+	if maxAttempts > maxGenerateAttempts {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("max_attempts must be at most %d", maxGenerateAttempts))
+	}
+	nWanted := int(req.Msg.NTestCases)
+	if nWanted == 0 {
+		nWanted = 1
 	}
 
 	// load workspace
@@ -180,17 +412,24 @@ func (s *Service) GenerateTestCase(ctx context.Context, req *connect.Request[eva
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("prompt version %d not found", req.Msg.VersionNumber))
 	}
 
+	// only text variables can be generated
 	variableSb := strings.Builder{}
 	for _, v := range promptVersion.Variables {
+		if v.Type != VariableTypeText {
+			continue
+		}
 		variableSb.WriteString("- ")
 		variableSb.WriteString(v.Name)
 		variableSb.WriteString("\n")
 	}
 
-	var testCases []TestCase
-	result = s.db.Model(&TestCase{}).Where("workspace_id = ?", req.Msg.WorkspaceId).Find(&testCases)
-	if result.Error != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load test cases: %v", result.Error))
+	testCases, err := s.getTestCases(req.Msg.WorkspaceId, nil)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load test cases: %v", err))
+	}
+	duplicates, err := newNearDuplicateChecker(req.Msg.Dedup, testCases)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
 	exampleValuesSb := strings.Builder{}
@@ -220,20 +459,90 @@ func (s *Service) GenerateTestCase(ctx context.Context, req *connect.Request[eva
 		"PROMPT_TEMPLATE": promptVersion.Content,
 		"VARIABLES":       variableSb.String(),
 		"EXAMPLE_VALUES":  exampleValuesSb.String(),
-		"N_TEST_CASES":    fmt.Sprintf("%v", req.Msg.NTestCases),
+		"N_TEST_CASES":    fmt.Sprintf("%v", nWanted),
 	}
 	sysPrompt := workspace.CurrentSystemPrompt()
 	if sysPrompt != nil {
 		vars["SYSTEM_PROMPT"] = sysPrompt.Content
 	}
-	llmPrompt := llmutils.ReplacePromptVariables(generateTestCasePrompt, vars)
 
-	// add optional seed prompt
-	if req.Msg.SeedPrompt != nil && *req.Msg.SeedPrompt != "" {
-		llmPrompt += "\n\n" + "The user requests that your new examples be inspired by the following seed phrases: \n" + *req.Msg.SeedPrompt
+	gen := &caseGenerator{
+		s:              s,
+		modelConfig:    modelConfig,
+		messageOptions: messageOptions,
+		maxAttempts:    maxAttempts,
+		workspaceID:    workspace.ID,
+		variables:      promptVersion.Variables,
+		duplicates:     duplicates,
+		nearDuplicates: make([]*evalv1.NearDuplicate, 0),
+		rejected:       make([]*evalv1.RejectedTestCase, 0),
+	}
+	testcases := make([]*evalv1.TestCase, 0)
+	switch req.Msg.Mode {
+	case evalv1.GenerationMode_GENERATION_MODE_ADVERSARIAL:
+		categories, err := attackCategoriesFromProto(req.Msg.AttackCategories)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		for _, c := range categories {
+			vars["ATTACK_CATEGORY"] = c.title
+			vars["ATTACK_STRATEGY"] = c.strategy
+			llmPrompt := withSeedPrompt(llmutils.ReplacePromptVariables(generateAdversarialTestCasePrompt, vars), req.Msg.SeedPrompt)
+			cases, err := gen.generate(ctx, llmPrompt, nWanted, []string{adversarialTag, c.tag()})
+			if err != nil {
+				return nil, err
+			}
+			testcases = append(testcases, cases...)
+		}
+	default:
+		llmPrompt := withSeedPrompt(llmutils.ReplacePromptVariables(generateTestCasePrompt, vars), req.Msg.SeedPrompt)
+		testcases, err = gen.generate(ctx, llmPrompt, nWanted, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res := connect.NewResponse(&evalv1.GenerateTestCaseResponse{
+		TestCases:      testcases,
+		NearDuplicates: gen.nearDuplicates,
+		Rejected:       gen.rejected,
+		NAttempts:      uint32(gen.attempts),
+	})
+
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
+
+// withSeedPrompt appends the user's optional seed phrases to a generation prompt.
+func withSeedPrompt(llmPrompt string, seedPrompt *string) string {
+	if seedPrompt == nil || *seedPrompt == "" {
+		return llmPrompt
 	}
+	return llmPrompt + "\n\n" + "The user requests that your new examples be inspired by the following seed phrases: \n" + *seedPrompt
+}
 
-	logger.Debug("inferring prompt", "prompt", llmPrompt, "vars", vars)
+// caseGenerator runs the generate, validate and re-prompt loop for one
+// GenerateTestCase request. Near-duplicates, rejections and attempts are
+// accumulated across calls to generate.
+type caseGenerator struct {
+	s              *Service
+	modelConfig    llm.ModelConfig
+	messageOptions llm.MessageOptions
+	maxAttempts    int
+	workspaceID    string
+	variables      []Variable
+	duplicates     *nearDuplicateChecker
+
+	nearDuplicates []*evalv1.NearDuplicate
+	rejected       []*evalv1.RejectedTestCase
+	attempts       int
+}
+
+// generate prompts the model with llmPrompt until nWanted valid cases have been
+// created with the given tags, or the attempts run out.
+func (g *caseGenerator) generate(ctx context.Context, llmPrompt string, nWanted int, tags []string) ([]*evalv1.TestCase, error) {
+	logger := logutil.LoggerFromContext(ctx)
+	logger.Debug("inferring prompt", "prompt", llmPrompt)
 
 	msgs := []llm.InferMessage{
 		{
@@ -242,83 +551,248 @@ func (s *Service) GenerateTestCase(ctx context.Context, req *connect.Request[eva
 		},
 	}
 
-	llmReq := llm.InferRequest{
-		ModelConfig: modelConfig,
-		Messages:    msgs,
-		MessageOptions: llm.MessageOptions{
-			MaxTokens:   4000,
-			Temperature: 1.2,
-		},
+	testcases := make([]*evalv1.TestCase, 0)
+	attempt := 0
+	var lastParseErr error
+	for attempt < g.maxAttempts && len(testcases) < nWanted {
+		attempt++
+		g.attempts++
+		resp, err := g.s.InferSync(ctx, llm.InferRequest{
+			ModelConfig:    g.modelConfig,
+			Messages:       msgs,
+			MessageOptions: g.messageOptions,
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to generate prompt: %v", err))
+		}
+
+		logger.Debug("got response", "resp", resp, "attempt", attempt)
+		msgs = append(msgs, llm.InferMessage{Content: resp, Role: "assistant"})
+
+		parsedOutput, err := llmutils.ParseResponse[generatedTestCaseOutput](resp)
+		if err != nil {
+			lastParseErr = err
+			logger.Debug("failed to parse response", "err", err, "attempt", attempt)
+			g.rejected = append(g.rejected, &evalv1.RejectedTestCase{
+				Reason:  fmt.Sprintf("failed to parse response: %v", err),
+				Attempt: uint32(g.attempts),
+			})
+			msgs = append(msgs, llm.InferMessage{
+				Content: fmt.Sprintf("Your reply could not be parsed (%v). Reply again with %d test cases, following the <reply> format exactly.", err, nWanted-len(testcases)),
+				Role:    "user",
+			})
+			continue
+		}
+		logger.Debug("parsed output", "output", parsedOutput)
+
+		problems := make([]string, 0)
+		for _, c := range parsedOutput.TestCase {
+			if len(testcases) >= nWanted {
+				break
+			}
+			values, err := validateGeneratedCase(c, g.variables)
+			if err != nil {
+				g.rejected = append(g.rejected, &evalv1.RejectedTestCase{
+					VariableValues: values,
+					Reason:         err.Error(),
+					Attempt:        uint32(g.attempts),
+				})
+				problems = append(problems, err.Error())
+				continue
+			}
+
+			outVars := make(map[string]*evalv1.VariableValue, len(values))
+			for k, v := range values {
+				outVars[k] = &evalv1.VariableValue{Value: &evalv1.VariableValue_TextValue{TextValue: v}}
+			}
+			tc := &TestCase{
+				ID:               xid.New().String(),
+				WorkspaceID:      g.workspaceID,
+				VariableValues:   variableValuesFromProto(outVars),
+				Tags:             tags,
+				HasBeenEvaluated: false,
+			}
+			nd, skip := g.duplicates.check(tc)
+			if nd != nil {
+				g.nearDuplicates = append(g.nearDuplicates, nd)
+			}
+			if skip {
+				problems = append(problems, "a case was too similar to an existing test case")
+				continue
+			}
+			tcResult := g.s.db.Create(tc)
+			if tcResult.Error != nil {
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create test case: %v", tcResult.Error))
+			}
+			testcases = append(testcases, &evalv1.TestCase{
+				Id:               tc.ID,
+				WorkspaceId:      tc.WorkspaceID,
+				VariableValues:   outVars,
+				Tags:             tc.Tags,
+				HasBeenEvaluated: tc.HasBeenEvaluated,
+			})
+		}
+
+		if missing := nWanted - len(testcases); missing > 0 {
+			feedback := fmt.Sprintf("Generate %d more test cases, different from the ones above.", missing)
+			if len(problems) > 0 {
+				feedback = fmt.Sprintf("Some test cases were rejected:\n- %s\n\nUse exactly the variable names listed in <variables> and give every variable a value. %s",
+					strings.Join(problems, "\n- "), feedback)
+			}
+			msgs = append(msgs, llm.InferMessage{Content: feedback, Role: "user"})
+		}
 	}
 
-	resp, err := s.InferSync(ctx, llmReq)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to generate prompt: %v", err))
+	if len(testcases) == 0 && lastParseErr != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to parse response after %d attempts: %v", attempt, lastParseErr))
 	}
+	return testcases, nil
+}
 
-	logger.Debug("got response", "resp", resp)
+// validateGeneratedCase checks a generated case against the prompt's text variables
+// and returns its values keyed by variable name. Keys that only differ from a variable
+// name in case are accepted. The values are returned even if the case is rejected.
+func validateGeneratedCase(c generatedTestCase, variables []Variable) (map[string]string, error) {
+	byLower := make(map[string]string, len(variables))
+	for _, v := range variables {
+		if v.Type == VariableTypeText {
+			byLower[strings.ToLower(v.Name)] = v.Name
+		}
+	}
 
-	parsedOutput, err := llmutils.ParseResponse[generatedTestCaseOutput](resp)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to parse response: %v", err))
+	values := make(map[string]string, len(c.Case))
+	unknown := make([]string, 0)
+	for _, kv := range c.Case {
+		key := strings.TrimSpace(kv.VariableKey)
+		value := strings.TrimSpace(kv.VariableValue)
+		name, ok := byLower[strings.ToLower(key)]
+		if !ok {
+			unknown = append(unknown, key)
+			values[key] = value
+			continue
+		}
+		values[name] = value
 	}
-	logger.Debug("parsed output", "output", parsedOutput)
 
-	testcases := make([]*evalv1.TestCase, 0)
+	missing := make([]string, 0)
+	for _, v := range variables {
+		if v.Type == VariableTypeText && values[v.Name] == "" {
+			missing = append(missing, v.Name)
+		}
+	}
 
-	for _, c := range parsedOutput.TestCase {
-		outVars := make(map[string]*evalv1.VariableValue)
-		for _, kv := range c.Case {
-			outVars[strings.TrimSpace(kv.VariableKey)] = &evalv1.VariableValue{Value: &evalv1.VariableValue_TextValue{TextValue: strings.TrimSpace(kv.VariableValue)}}
-		}
-		tc := &TestCase{
-			ID:               xid.New().String(),
-			WorkspaceID:      req.Msg.WorkspaceId,
-			VariableValues:   variableValuesFromProto(outVars),
-			HasBeenEvaluated: false,
-		}
-		tcResult := s.db.Create(tc)
-		if tcResult.Error != nil {
-			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create test case: %v", tcResult.Error))
-		}
-		testcases = append(testcases, &evalv1.TestCase{
-			Id:               tc.ID,
-			WorkspaceId:      tc.WorkspaceID,
-			VariableValues:   outVars,
-			HasBeenEvaluated: tc.HasBeenEvaluated,
-		})
+	switch {
+	case len(unknown) > 0:
+		return values, fmt.Errorf("unknown variables: %s", strings.Join(unknown, ", "))
+	case len(missing) > 0:
+		return values, fmt.Errorf("missing variables: %s", strings.Join(missing, ", "))
 	}
+	return values, nil
+}
 
-	res := connect.NewResponse(&evalv1.GenerateTestCaseResponse{
-		TestCases: testcases,
-	})
+// DeleteTestCase moves a test case and its test results to the trash.
+func (s *Service) DeleteTestCase(ctx context.Context, req *connect.Request[evalv1.DeleteTestCaseRequest]) (*connect.Response[emptypb.Empty], error) {
+	var tc TestCase
+	if err := s.db.First(&tc, "id = ?", req.Msg.Id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("test case not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load test case: %v", err))
+	}
+	// dataset test cases are shared by every workspace the dataset is attached to
+	if tc.DatasetID != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("test case %s belongs to dataset %s", tc.ID, *tc.DatasetID))
+	}
+	if _, err := s.softDeleteTestCases([]string{req.Msg.Id}); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete test case: %v", err))
+	}
 
+	res := connect.NewResponse(&emptypb.Empty{})
 	res.Header().Set("Eval-Version", "v1")
 	return res, nil
 }
 
-func (s *Service) DeleteTestCase(ctx context.Context, req *connect.Request[evalv1.DeleteTestCaseRequest]) (*connect.Response[emptypb.Empty], error) {
-	result := s.db.Where("id = ?", req.Msg.Id).Delete(&TestCase{})
-	if result.Error != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete test case: %v", result.Error))
+// UpdateTestCases sets the split of the given test cases and adds or removes tags.
+func (s *Service) UpdateTestCases(ctx context.Context, req *connect.Request[evalv1.UpdateTestCasesRequest]) (*connect.Response[evalv1.UpdateTestCasesResponse], error) {
+	var testCases []TestCase
+	if len(req.Msg.Ids) > 0 {
+		if err := s.db.Where("id IN ?", req.Msg.Ids).Find(&testCases).Error; err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load test cases: %v", err))
+		}
+	}
+	if len(testCases) != len(req.Msg.Ids) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("found %d of %d test cases", len(testCases), len(req.Msg.Ids)))
 	}
 
-	res := connect.NewResponse(&emptypb.Empty{})
+	remove := make(map[string]bool, len(req.Msg.RemoveTags))
+	for _, t := range normalizeTags(req.Msg.RemoveTags) {
+		remove[t] = true
+	}
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		for i := range testCases {
+			tc := &testCases[i]
+			tags := make([]string, 0, len(tc.Tags)+len(req.Msg.AddTags))
+			for _, t := range append(tc.Tags, req.Msg.AddTags...) {
+				if !remove[strings.TrimSpace(t)] {
+					tags = append(tags, t)
+				}
+			}
+			tc.Tags = normalizeTags(tags)
+			if req.Msg.Split != nil {
+				tc.Split = splitFromProto(req.Msg.GetSplit())
+			}
+			if err := tx.Model(tc).Select("tags", "split").Updates(tc).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update test cases: %v", err))
+	}
+
+	pbTestCases := make([]*evalv1.TestCase, 0, len(testCases))
+	for i := range testCases {
+		pbTestCases = append(pbTestCases, testCaseToProto(&testCases[i]))
+	}
+
+	res := connect.NewResponse(&evalv1.UpdateTestCasesResponse{
+		TestCases: pbTestCases,
+	})
 	res.Header().Set("Eval-Version", "v1")
 	return res, nil
 }
 
 func (s *Service) RateTestResult(ctx context.Context, req *connect.Request[evalv1.RateTestResultRequest]) (*connect.Response[emptypb.Empty], error) {
+	if req.Msg.Rating < -1 || req.Msg.Rating > 1 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("rating must be -1, 0 or 1, got %d", req.Msg.Rating))
+	}
+
 	var tr TestResult
 	result := s.db.Model(&TestResult{}).Where("id = ?", req.Msg.TestResultId).First(&tr)
 	if result.Error != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load test result: %v", result.Error))
 	}
 
-	tr.Rating = req.Msg.Rating
-	result = s.db.Save(&tr)
-	if result.Error != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to save test result: %v", result.Error))
+	rater := req.Msg.Rater
+	if rater == "" {
+		rater = defaultRater
+		tr.Rating = req.Msg.Rating
+		result = s.db.Save(&tr)
+		if result.Error != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to save test result: %v", result.Error))
+		}
+	}
+
+	rating := Rating{
+		TestResultID: tr.ID,
+		Rater:        rater,
+		Automated:    req.Msg.Automated,
+		Thumbs:       req.Msg.Rating,
+		Score:        req.Msg.Score,
+	}
+	if err := upsertRating(s.db, &rating); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to save rating: %v", err))
 	}
 
 	res := connect.NewResponse(&emptypb.Empty{})
@@ -0,0 +1,174 @@
+package eval
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/tincans-ai/evalite/gen/eval/v1"
+	"gorm.io/gorm"
+	"time"
+)
+
+// softDeleteTestCases moves test cases and their test results to the trash, and
+// returns how many test cases were deleted. Both share one deletion time, so a
+// restore can tell them from results that were trashed on their own. Dataset test
+// cases are shared between workspaces and are left alone.
+func (s *Service) softDeleteTestCases(ids []string) (int64, error) {
+	var n int64
+	now := time.Now()
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var owned []string
+		if err := tx.Model(&TestCase{}).Where("id IN ? AND dataset_id IS NULL", ids).Pluck("id", &owned).Error; err != nil {
+			return err
+		}
+		if len(owned) == 0 {
+			return nil
+		}
+		result := tx.Model(&TestCase{}).Where("id IN ?", owned).Update("deleted_at", now)
+		if result.Error != nil {
+			return result.Error
+		}
+		n = result.RowsAffected
+		return tx.Model(&TestResult{}).Where("test_case_id IN ?", owned).Update("deleted_at", now).Error
+	})
+	return n, err
+}
+
+// ListDeletedTestCases lists the test cases in a workspace's trash.
+func (s *Service) ListDeletedTestCases(ctx context.Context, req *connect.Request[evalv1.ListDeletedTestCasesRequest]) (*connect.Response[evalv1.ListDeletedTestCasesResponse], error) {
+	var testCases []TestCase
+	var totalCount int64
+
+	query := s.db.Unscoped().Model(&TestCase{}).
+		Scopes(workspaceTestCases(req.Msg.WorkspaceId)).
+		Where("deleted_at IS NOT NULL")
+	if err := query.Count(&totalCount).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to count deleted test cases: %v", err))
+	}
+	query = query.Order("deleted_at DESC")
+	if req.Msg.PageSize > 0 {
+		page := max(req.Msg.Page, 1)
+		query = query.Offset(int((page - 1) * req.Msg.PageSize)).Limit(int(req.Msg.PageSize))
+	}
+	if err := query.Find(&testCases).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list deleted test cases: %v", err))
+	}
+
+	pbTestCases := make([]*evalv1.TestCase, 0, len(testCases))
+	for i := range testCases {
+		pbTestCases = append(pbTestCases, testCaseToProto(&testCases[i]))
+	}
+
+	res := connect.NewResponse(&evalv1.ListDeletedTestCasesResponse{
+		TestCases:  pbTestCases,
+		TotalCount: int32(totalCount),
+	})
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
+
+// RestoreTestCase takes a test case and its test results out of the trash.
+func (s *Service) RestoreTestCase(ctx context.Context, req *connect.Request[evalv1.RestoreTestCaseRequest]) (*connect.Response[evalv1.RestoreTestCaseResponse], error) {
+	var tc TestCase
+	if err := s.db.Unscoped().First(&tc, "id = ?", req.Msg.Id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("test case not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load test case: %v", err))
+	}
+	if !tc.DeletedAt.Valid {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("test case %s is not deleted", tc.ID))
+	}
+
+	var nResults int64
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Model(&TestCase{}).Where("id = ?", tc.ID).Update("deleted_at", nil).Error; err != nil {
+			return err
+		}
+		// only the results trashed along with the test case come back
+		result := tx.Unscoped().Model(&TestResult{}).
+			Where("test_case_id = ? AND deleted_at = ?", tc.ID, tc.DeletedAt.Time.In(time.Local)).
+			Update("deleted_at", nil)
+		nResults = result.RowsAffected
+		return result.Error
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to restore test case: %v", err))
+	}
+	tc.DeletedAt = gorm.DeletedAt{}
+
+	res := connect.NewResponse(&evalv1.RestoreTestCaseResponse{
+		TestCase:     testCaseToProto(&tc),
+		NTestResults: uint32(nResults),
+	})
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
+
+// PurgeTestCases permanently deletes test cases from a workspace's trash, together
+// with their test results and ratings. Test cases that are not deleted are never
+// purged.
+func (s *Service) PurgeTestCases(ctx context.Context, req *connect.Request[evalv1.PurgeTestCasesRequest]) (*connect.Response[evalv1.PurgeTestCasesResponse], error) {
+	query := s.db.Unscoped().Model(&TestCase{}).
+		Scopes(workspaceTestCases(req.Msg.WorkspaceId)).
+		Where("deleted_at IS NOT NULL")
+	if len(req.Msg.Ids) > 0 {
+		query = query.Where("id IN ?", req.Msg.Ids)
+	}
+	if req.Msg.DeletedBefore != nil {
+		query = query.Where("deleted_at < ?", req.Msg.DeletedBefore.AsTime().In(time.Local))
+	}
+	var ids []string
+	if err := query.Pluck("id", &ids).Error; err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to find deleted test cases: %v", err))
+	}
+
+	out := &evalv1.PurgeTestCasesResponse{}
+	if len(ids) > 0 {
+		err := s.db.Transaction(func(tx *gorm.DB) error {
+			var err error
+			out, err = purgeTestCases(tx, ids)
+			return err
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to purge test cases: %v", err))
+		}
+	}
+
+	res := connect.NewResponse(out)
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
+
+// purgeTestCases permanently deletes test cases with their test results and ratings.
+// Examples taken from them keep their text but no longer point at them.
+func purgeTestCases(tx *gorm.DB, ids []string) (*evalv1.PurgeTestCasesResponse, error) {
+	out := &evalv1.PurgeTestCasesResponse{}
+	resultIDs := tx.Unscoped().Model(&TestResult{}).Select("id").Where("test_case_id IN ?", ids)
+	result := tx.Where("test_result_id IN (?)", resultIDs).Delete(&Rating{})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	out.NRatings = uint32(result.RowsAffected)
+
+	if err := tx.Model(&Example{}).Where("test_result_id IN (?)", resultIDs).Update("test_result_id", nil).Error; err != nil {
+		return nil, err
+	}
+	if err := tx.Model(&Example{}).Where("test_case_id IN ?", ids).Update("test_case_id", nil).Error; err != nil {
+		return nil, err
+	}
+
+	result = tx.Unscoped().Where("test_case_id IN ?", ids).Delete(&TestResult{})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	out.NTestResults = uint32(result.RowsAffected)
+
+	result = tx.Unscoped().Where("id IN ?", ids).Delete(&TestCase{})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	out.NTestCases = uint32(result.RowsAffected)
+	return out, nil
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/rs/xid"
+	"github.com/tincans-ai/evalite/packages/perturb"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
 	"time"
@@ -60,6 +61,15 @@ type Workspace struct {
 	XMLMode                          bool
 	SystemPrompts                    []SystemPrompt `gorm:"foreignKey:WorkspaceID"`
 	CurrentSystemPromptVersionNumber uint32
+	// ExampleCount, ExampleStrategy and ExampleSeed pick the examples inserted for
+	// {{EXAMPLES}}.
+	ExampleCount    uint32
+	ExampleStrategy ExampleStrategy
+	ExampleSeed     int64
+	// SourceWorkspaceID is set on clones.
+	SourceWorkspaceID *string
+	// ArchivedAt hides the workspace from ListWorkspaces while it is set.
+	ArchivedAt *time.Time `gorm:"index"`
 }
 
 type WorkspaceConfig struct {
@@ -96,12 +106,17 @@ type Prompt struct {
 	Variables     datatypes.JSONSlice[Variable] `gorm:"type:json"`
 	CreatedAt     time.Time
 	WorkspaceID   string `gorm:"index"`
+	// ParentVersionNumber is the version this one was edited from.
+	ParentVersionNumber *uint32
+	CommitMessage       string
 }
 
 type SystemPrompt struct {
-	VersionNumber uint32
-	Content       string
-	WorkspaceID   string `gorm:"index"`
+	VersionNumber       uint32
+	Content             string
+	WorkspaceID         string `gorm:"index"`
+	ParentVersionNumber *uint32
+	CommitMessage       string
 }
 
 func (w *Workspace) BeforeCreate(tx *gorm.DB) error {
@@ -110,26 +125,45 @@ func (w *Workspace) BeforeCreate(tx *gorm.DB) error {
 }
 
 func (w *Workspace) CreatePrompt(content string, variables []Variable) Prompt {
-	w.CurrentPromptVersionNumber++
-	p := Prompt{
+	p := w.newPromptVersion(content, variables)
+	w.CurrentPromptVersionNumber = p.VersionNumber
+	w.ActiveVersionNumbers = append(w.ActiveVersionNumbers, w.CurrentPromptVersionNumber)
+	return p
+}
+
+// newPromptVersion returns a prompt version numbered after every existing one,
+// without making it the current version.
+func (w *Workspace) newPromptVersion(content string, variables []Variable) Prompt {
+	version := w.CurrentPromptVersionNumber
+	for _, p := range w.Prompts {
+		version = max(version, p.VersionNumber)
+	}
+	return Prompt{
 		WorkspaceID:   w.ID,
-		VersionNumber: w.CurrentPromptVersionNumber,
+		VersionNumber: version + 1,
 		Content:       content,
 		Variables:     datatypes.JSONSlice[Variable](variables),
 		CreatedAt:     time.Now(),
 	}
-	w.ActiveVersionNumbers = append(w.ActiveVersionNumbers, w.CurrentPromptVersionNumber)
-	return p
 }
 
 func (w *Workspace) CreateSystemPrompt(content string) SystemPrompt {
-	w.CurrentSystemPromptVersionNumber++
-	p := SystemPrompt{
+	p := w.newSystemPromptVersion(content)
+	w.CurrentSystemPromptVersionNumber = p.VersionNumber
+	return p
+}
+
+// newSystemPromptVersion is newPromptVersion for system prompts.
+func (w *Workspace) newSystemPromptVersion(content string) SystemPrompt {
+	version := w.CurrentSystemPromptVersionNumber
+	for _, p := range w.SystemPrompts {
+		version = max(version, p.VersionNumber)
+	}
+	return SystemPrompt{
 		WorkspaceID:   w.ID,
-		VersionNumber: w.CurrentSystemPromptVersionNumber,
+		VersionNumber: version + 1,
 		Content:       content,
 	}
-	return p
 }
 
 func (w *Workspace) CurrentPrompt() *Prompt {
@@ -177,22 +211,238 @@ type TestResult struct {
 	WorkspaceConfigID   string
 	Rating              int32
 	MessageOptions      MessageOptions `gorm:"embedded"`
-	CreatedAt           time.Time
-	UpdatedAt           time.Time
+	// ExampleIDs are the examples inserted into the prompt, in order.
+	ExampleIDs datatypes.JSONSlice[string] `gorm:"type:json"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	// DeletedAt is set when the test case is deleted, so results follow their test
+	// case into and out of the trash.
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+// defaultRater is the rater recorded for ratings given without a rater name.
+const defaultRater = "default"
+
+// Rating is a single rater's judgement of a TestResult. TestResult.Rating holds
+// the primary thumbs rating shown in the UI, while Ratings from several raters
+// are kept here so their agreement can be measured.
+type Rating struct {
+	ID           string `gorm:"primarykey"`
+	TestResultID string `gorm:"uniqueIndex:idx_rating_result_rater"`
+	Rater        string `gorm:"uniqueIndex:idx_rating_result_rater"`
+	Automated    bool
+	Thumbs       int32
+	Score        *float64
+	Reason       string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+type GraderType string
+
+const (
+	GraderTypeExternal GraderType = "EXTERNAL"
+	GraderTypeJudge    GraderType = "JUDGE"
+)
+
+// Grader scores test results automatically and stores its scores as Ratings.
+type Grader struct {
+	ID             string     `gorm:"primarykey"`
+	WorkspaceID    string     `gorm:"index"`
+	Name           string     `gorm:"not null"`
+	Type           GraderType `gorm:"not null"`
+	Command        string
+	Args           datatypes.JSONSlice[string] `gorm:"type:json"`
+	Batch          bool
+	BatchSize      uint32
+	TimeoutSeconds uint32
+	Concurrency    uint32
+	// ModelConfigName and Rubric configure judge graders.
+	ModelConfigName string
+	Rubric          string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// Rater is the name a grader's scores are stored under.
+func (g *Grader) Rater() string {
+	return "grader:" + g.Name
+}
+
+type OptimizationScoring string
+
+const (
+	OptimizationScoringGrader  OptimizationScoring = "GRADER"
+	OptimizationScoringRatings OptimizationScoring = "RATINGS"
+)
+
+type OptimizationStopReason string
+
+const (
+	OptimizationStopMaxRounds    OptimizationStopReason = "MAX_ROUNDS"
+	OptimizationStopPlateau      OptimizationStopReason = "PLATEAU"
+	OptimizationStopNoFailures   OptimizationStopReason = "NO_FAILURES"
+	OptimizationStopNoCandidates OptimizationStopReason = "NO_CANDIDATES"
+)
+
+// OptimizationRun records an OptimizePrompt run and the prompt versions it created.
+type OptimizationRun struct {
+	ID                 string `gorm:"primarykey"`
+	WorkspaceID        string `gorm:"index"`
+	GraderID           string
+	Scoring            OptimizationScoring
+	ModelConfigName    string
+	StartVersionNumber uint32
+	StartScore         float64
+	BestVersionNumber  uint32
+	BestScore          float64
+	NRounds            uint32
+	StopReason         OptimizationStopReason
+	Candidates         []OptimizationCandidate     `gorm:"foreignKey:RunID"`
+	Errors             datatypes.JSONSlice[string] `gorm:"type:json"`
+	CreatedAt          time.Time
+}
+
+type OptimizationCandidate struct {
+	ID                  string `gorm:"primarykey"`
+	RunID               string `gorm:"index"`
+	Round               uint32
+	PromptVersionNumber uint32
+	ParentVersionNumber uint32
+	Score               float64
+	NScored             uint32
+	NFailed             uint32
 }
 
 type VariableValues map[string]VariableValue
 
+// Split is the dataset split a test case belongs to. Test cases without a split
+// have the empty Split.
+type Split string
+
+const (
+	SplitDev     Split = "dev"
+	SplitTest    Split = "test"
+	SplitHoldout Split = "holdout"
+)
+
 type TestCase struct {
 	gorm.Model
-	ID               string `gorm:"primarykey"`
-	WorkspaceID      string
-	VariableValues   VariableValues `gorm:"serializer:json"`
+	ID             string `gorm:"primarykey"`
+	WorkspaceID    string
+	VariableValues VariableValues `gorm:"serializer:json"`
+	// Response is the reference output for the test case, if known.
+	Response *string
+	Tags     datatypes.JSONSlice[string] `gorm:"type:json"`
+	Split    Split                       `gorm:"index;default:''"`
+	// ParentTestCaseID and Perturbation are set for perturbed variants of a test case.
+	ParentTestCaseID *string `gorm:"index"`
+	Perturbation     perturb.Kind
+	// DatasetID is set for test cases owned by a dataset, which have no WorkspaceID.
+	DatasetID        *string `gorm:"index"`
 	HasBeenEvaluated bool
 	CreatedAt        time.Time
 	UpdatedAt        time.Time
 }
 
+type ExampleStrategy string
+
+const (
+	ExampleStrategyFixed   ExampleStrategy = "FIXED"
+	ExampleStrategyRandom  ExampleStrategy = "RANDOM"
+	ExampleStrategySimilar ExampleStrategy = "SIMILAR"
+)
+
+// Example is a worked input and output in a workspace's example bank, inserted
+// into prompts that use {{EXAMPLES}}.
+type Example struct {
+	ID             string         `gorm:"primarykey"`
+	WorkspaceID    string         `gorm:"index"`
+	VariableValues VariableValues `gorm:"serializer:json"`
+	Output         string
+	// TestResultID is the rated result the example was taken from, and TestCaseID
+	// its test case, which is never shown its own example.
+	TestResultID *string `gorm:"index"`
+	TestCaseID   *string
+	CreatedAt    time.Time
+}
+
+// Dataset owns test cases that can be shared by several workspaces.
+type Dataset struct {
+	ID          string `gorm:"primarykey"`
+	Name        string `gorm:"not null"`
+	Description string
+	Attachments []DatasetAttachment
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// DatasetAttachment makes a dataset's test cases part of a workspace.
+// VariableMapping maps dataset variable names to the workspace's variable names.
+type DatasetAttachment struct {
+	ID              string            `gorm:"primarykey"`
+	WorkspaceID     string            `gorm:"uniqueIndex:idx_dataset_attachment"`
+	DatasetID       string            `gorm:"uniqueIndex:idx_dataset_attachment"`
+	VariableMapping map[string]string `gorm:"serializer:json"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// PromptLabel is a movable name, like production, for a prompt version and a
+// system prompt version of a workspace.
+type PromptLabel struct {
+	ID                  string `gorm:"primarykey"`
+	WorkspaceID         string `gorm:"uniqueIndex:idx_prompt_label"`
+	Name                string `gorm:"uniqueIndex:idx_prompt_label"`
+	PromptVersionNumber uint32
+	// SystemPromptVersionNumber is 0 for no system prompt.
+	SystemPromptVersionNumber uint32
+	MovedBy                   string
+	CreatedAt                 time.Time
+	UpdatedAt                 time.Time
+}
+
+type LabelAction string
+
+const (
+	LabelActionPromote  LabelAction = "PROMOTE"
+	LabelActionRollback LabelAction = "ROLLBACK"
+)
+
+// PromptLabelEvent records a label being moved. The previous version numbers are
+// nil when the label was created.
+type PromptLabelEvent struct {
+	ID                                string `gorm:"primarykey"`
+	WorkspaceID                       string `gorm:"index:idx_prompt_label_event"`
+	Label                             string `gorm:"index:idx_prompt_label_event"`
+	Action                            LabelAction
+	PromptVersionNumber               uint32
+	SystemPromptVersionNumber         uint32
+	PreviousPromptVersionNumber       *uint32
+	PreviousSystemPromptVersionNumber *uint32
+	MovedBy                           string
+	Reason                            string
+	CreatedAt                         time.Time
+}
+
+// Trace records a call served by Complete, including failed ones.
+type Trace struct {
+	ID                        string `gorm:"primarykey"`
+	WorkspaceID               string `gorm:"index:idx_trace"`
+	Label                     string
+	PromptVersionNumber       uint32
+	SystemPromptVersionNumber uint32
+	WorkspaceConfigID         string
+	ModelConfigName           string
+	MessageOptions            MessageOptions              `gorm:"embedded"`
+	Variables                 map[string]string           `gorm:"serializer:json"`
+	ExampleIDs                datatypes.JSONSlice[string] `gorm:"type:json"`
+	Response                  string
+	Error                     string
+	LatencyMs                 uint32
+	CreatedAt                 time.Time `gorm:"index:idx_trace"`
+}
+
 type VariableValue struct {
 	TextValue  *string `json:"text_value,omitempty"`
 	ImageValue []byte  `json:"image_value,omitempty"`
@@ -11,9 +11,16 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
+	"slices"
+	"time"
 )
 
 func (s *Service) CreateWorkspace(ctx context.Context, req *connect.Request[evalv1.CreateWorkspaceRequest]) (*connect.Response[evalv1.CreateWorkspaceResponse], error) {
+	variables, err := promptVariables(req.Msg.Content)
+	if err != nil {
+		return nil, invalidPromptError(req.Msg.Content, err)
+	}
+
 	var wkspName string
 	if req.Msg.Name == "" {
 		name, err := s.GetNameForWorkspace(ctx, req.Msg.Content)
@@ -31,15 +38,6 @@ func (s *Service) CreateWorkspace(ctx context.Context, req *connect.Request[eval
 		Prompts: make([]Prompt, 0),
 	}
 
-	variablesNames := parseDoubleBrackets(req.Msg.Content)
-	variables := make([]Variable, 0)
-	for _, name := range variablesNames {
-		variables = append(variables, Variable{
-			Name: name,
-			Type: VariableTypeText,
-		})
-	}
-
 	result := s.db.Create(workspace)
 	if result.Error != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create workspace: %v", result.Error))
@@ -51,6 +49,11 @@ func (s *Service) CreateWorkspace(ctx context.Context, req *connect.Request[eval
 	workspace.CurrentPromptVersionNumber = prompt.VersionNumber
 	s.db.Save(workspace)
 
+	findings, err := s.lintPrompt(workspace, prompt.Content, "")
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to lint prompt: %v", err))
+	}
+
 	res := connect.NewResponse(&evalv1.CreateWorkspaceResponse{
 		Workspace: &evalv1.Workspace{
 			Id:                         workspace.ID,
@@ -68,6 +71,7 @@ func (s *Service) CreateWorkspace(ctx context.Context, req *connect.Request[eval
 			},
 			ActiveVersionNumbers: []uint32{prompt.VersionNumber},
 		},
+		LintFindings: findings,
 	})
 	res.Header().Set("Eval-Version", "v1")
 	return res, nil
@@ -92,20 +96,18 @@ func variablesToProtobuf(variables datatypes.JSONSlice[Variable]) []*evalv1.Vari
 	return protoVars
 }
 
-func (s *Service) GetWorkspace(ctx context.Context, req *connect.Request[evalv1.GetWorkspaceRequest]) (*connect.Response[evalv1.GetWorkspaceResponse], error) {
-	var workspace Workspace
-	result := s.db.Preload("Prompts").Preload("WorkspaceConfigs").Preload("SystemPrompts").First(&workspace, "id = ?", req.Msg.Id)
-	if result.Error != nil {
-		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("workspace not found: %v", result.Error))
-	}
-
+// workspaceToProto converts a workspace with its prompts, system prompts and configs
+// preloaded.
+func workspaceToProto(workspace *Workspace) *evalv1.Workspace {
 	protoPrompts := make([]*evalv1.Workspace_Prompt, len(workspace.Prompts))
 	for i, prompt := range workspace.Prompts {
 		protoPrompts[i] = &evalv1.Workspace_Prompt{
-			VersionNumber: prompt.VersionNumber,
-			Content:       prompt.Content,
-			Variables:     variablesToProtobuf(prompt.Variables),
-			CreatedAt:     timestamppb.New(prompt.CreatedAt),
+			VersionNumber:       prompt.VersionNumber,
+			Content:             prompt.Content,
+			Variables:           variablesToProtobuf(prompt.Variables),
+			CreatedAt:           timestamppb.New(prompt.CreatedAt),
+			ParentVersionNumber: prompt.ParentVersionNumber,
+			CommitMessage:       prompt.CommitMessage,
 		}
 	}
 	workspaceConfigs := make([]*evalv1.WorkspaceConfig, len(workspace.WorkspaceConfigs))
@@ -125,26 +127,44 @@ func (s *Service) GetWorkspace(ctx context.Context, req *connect.Request[evalv1.
 	protoSystemPrompts := make([]*evalv1.Workspace_SystemPrompt, len(workspace.SystemPrompts))
 	for i, systemPrompt := range workspace.SystemPrompts {
 		protoSystemPrompts[i] = &evalv1.Workspace_SystemPrompt{
-			VersionNumber: systemPrompt.VersionNumber,
-			Content:       systemPrompt.Content,
+			VersionNumber:       systemPrompt.VersionNumber,
+			Content:             systemPrompt.Content,
+			ParentVersionNumber: systemPrompt.ParentVersionNumber,
+			CommitMessage:       systemPrompt.CommitMessage,
 		}
 	}
 
+	out := &evalv1.Workspace{
+		Id:                               workspace.ID,
+		Name:                             workspace.Name,
+		ModelConfigNames:                 workspace.ModelConfigNames,
+		CreatedAt:                        timestamppb.New(workspace.CreatedAt),
+		UpdatedAt:                        timestamppb.New(workspace.UpdatedAt),
+		Prompts:                          protoPrompts,
+		CurrentPromptVersionNumber:       workspace.CurrentPromptVersionNumber,
+		WorkspaceConfigs:                 workspaceConfigs,
+		ActiveVersionNumbers:             workspace.ActiveVersionNumbers,
+		XMLMode:                          workspace.XMLMode,
+		SystemPrompts:                    protoSystemPrompts,
+		CurrentSystemPromptVersionNumber: workspace.CurrentSystemPromptVersionNumber,
+		ExampleSettings:                  exampleSettingsToProto(workspace),
+		SourceWorkspaceId:                workspace.SourceWorkspaceID,
+	}
+	if workspace.ArchivedAt != nil {
+		out.ArchivedAt = timestamppb.New(*workspace.ArchivedAt)
+	}
+	return out
+}
+
+func (s *Service) GetWorkspace(ctx context.Context, req *connect.Request[evalv1.GetWorkspaceRequest]) (*connect.Response[evalv1.GetWorkspaceResponse], error) {
+	var workspace Workspace
+	result := s.db.Preload("Prompts").Preload("WorkspaceConfigs").Preload("SystemPrompts").First(&workspace, "id = ?", req.Msg.Id)
+	if result.Error != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("workspace not found: %v", result.Error))
+	}
+
 	res := connect.NewResponse(&evalv1.GetWorkspaceResponse{
-		Workspace: &evalv1.Workspace{
-			Id:                               workspace.ID,
-			Name:                             workspace.Name,
-			ModelConfigNames:                 workspace.ModelConfigNames,
-			CreatedAt:                        timestamppb.New(workspace.CreatedAt),
-			UpdatedAt:                        timestamppb.New(workspace.UpdatedAt),
-			Prompts:                          protoPrompts,
-			CurrentPromptVersionNumber:       workspace.CurrentPromptVersionNumber,
-			WorkspaceConfigs:                 workspaceConfigs,
-			ActiveVersionNumbers:             workspace.ActiveVersionNumbers,
-			XMLMode:                          workspace.XMLMode,
-			SystemPrompts:                    protoSystemPrompts,
-			CurrentSystemPromptVersionNumber: workspace.CurrentSystemPromptVersionNumber,
-		},
+		Workspace: workspaceToProto(&workspace),
 	})
 	res.Header().Set("Eval-Version", "v1")
 	return res, nil
@@ -157,8 +177,12 @@ func (s *Service) ListWorkspaces(ctx context.Context, req *connect.Request[evalv
 	offset := (req.Msg.Page - 1) * req.Msg.PageSize
 	limit := req.Msg.PageSize
 
-	s.db.Model(&Workspace{}).Count(&totalCount)
-	result := s.db.Offset(int(offset)).Limit(int(limit)).Find(&workspaces)
+	query := s.db.Model(&Workspace{})
+	if !req.Msg.IncludeArchived {
+		query = query.Where("archived_at IS NULL")
+	}
+	query.Count(&totalCount)
+	result := query.Offset(int(offset)).Limit(int(limit)).Find(&workspaces)
 	if result.Error != nil {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list workspaces: %v", result.Error))
 	}
@@ -171,6 +195,9 @@ func (s *Service) ListWorkspaces(ctx context.Context, req *connect.Request[evalv
 			CreatedAt: timestamppb.New(w.CreatedAt),
 			UpdatedAt: timestamppb.New(w.UpdatedAt),
 		}
+		if w.ArchivedAt != nil {
+			pbWorkspaces[i].ArchivedAt = timestamppb.New(*w.ArchivedAt)
+		}
 	}
 
 	res := connect.NewResponse(&evalv1.ListWorkspacesResponse{
@@ -181,6 +208,95 @@ func (s *Service) ListWorkspaces(ctx context.Context, req *connect.Request[evalv
 	return res, nil
 }
 
+// DeleteWorkspace permanently deletes a workspace with its versions, configs, test
+// cases, results and everything else it owns, in one transaction. Test cases of
+// attached datasets are kept, but their results from this workspace are deleted.
+func (s *Service) DeleteWorkspace(ctx context.Context, req *connect.Request[evalv1.DeleteWorkspaceRequest]) (*connect.Response[emptypb.Empty], error) {
+	var workspace Workspace
+	result := s.db.First(&workspace, "id = ?", req.Msg.WorkspaceId)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("workspace not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to fetch workspace: %w", result.Error))
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var testCaseIDs []string
+		if err := tx.Unscoped().Model(&TestCase{}).Where("workspace_id = ?", workspace.ID).Pluck("id", &testCaseIDs).Error; err != nil {
+			return err
+		}
+		for chunk := range slices.Chunk(testCaseIDs, importBatchSize) {
+			if _, err := purgeTestCases(tx, chunk); err != nil {
+				return err
+			}
+		}
+
+		// results of dataset test cases are only linked through the configs
+		configIDs := tx.Model(&WorkspaceConfig{}).Select("id").Where("workspace_id = ?", workspace.ID)
+		resultIDs := tx.Unscoped().Model(&TestResult{}).Select("id").Where("workspace_config_id IN (?)", configIDs)
+		if err := tx.Where("test_result_id IN (?)", resultIDs).Delete(&Rating{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("workspace_config_id IN (?)", configIDs).Delete(&TestResult{}).Error; err != nil {
+			return err
+		}
+
+		runIDs := tx.Model(&OptimizationRun{}).Select("id").Where("workspace_id = ?", workspace.ID)
+		if err := tx.Where("run_id IN (?)", runIDs).Delete(&OptimizationCandidate{}).Error; err != nil {
+			return err
+		}
+		for _, model := range []any{&Prompt{}, &SystemPrompt{}, &WorkspaceConfig{}, &Grader{}, &OptimizationRun{},
+			&Example{}, &DatasetAttachment{}, &PromptLabel{}, &PromptLabelEvent{}, &Trace{}} {
+			if err := tx.Unscoped().Where("workspace_id = ?", workspace.ID).Delete(model).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Model(&Workspace{}).Where("source_workspace_id = ?", workspace.ID).Update("source_workspace_id", nil).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&workspace).Error
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete workspace: %v", err))
+	}
+
+	res := connect.NewResponse(&emptypb.Empty{})
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
+
+// ArchiveWorkspace hides a workspace from ListWorkspaces, or shows it again.
+// Archived workspaces can still be fetched and evaluated by ID.
+func (s *Service) ArchiveWorkspace(ctx context.Context, req *connect.Request[evalv1.ArchiveWorkspaceRequest]) (*connect.Response[emptypb.Empty], error) {
+	var workspace Workspace
+	result := s.db.First(&workspace, "id = ?", req.Msg.WorkspaceId)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("workspace not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to fetch workspace: %w", result.Error))
+	}
+
+	// archiving again keeps the original time
+	if req.Msg.Archived != (workspace.ArchivedAt != nil) {
+		var archivedAt *time.Time
+		if req.Msg.Archived {
+			now := time.Now()
+			archivedAt = &now
+		}
+		result = s.db.Model(&Workspace{}).Where("id = ?", workspace.ID).Update("archived_at", archivedAt)
+		if result.Error != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to save workspace: %w", result.Error))
+		}
+	}
+
+	res := connect.NewResponse(&emptypb.Empty{})
+	res.Header().Set("Eval-Version", "v1")
+	return res, nil
+}
+
 func (s *Service) CreateWorkspaceConfig(ctx context.Context, req *connect.Request[evalv1.CreateWorkspaceConfigRequest]) (*connect.Response[evalv1.CreateWorkspaceConfigResponse], error) {
 	var workspace Workspace
 	result := s.db.First(&workspace, "id = ?", req.Msg.WorkspaceId)
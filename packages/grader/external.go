@@ -0,0 +1,204 @@
+package grader
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultTimeout     = 30 * time.Second
+	defaultConcurrency = 4
+	defaultBatchSize   = 50
+	// maxStderr bounds how much of a failing grader's stderr ends up in error messages.
+	maxStderr = 2048
+	// waitDelay bounds how long a killed grader's children may hold its output pipes open.
+	waitDelay = 5 * time.Second
+)
+
+// Input is the JSON document an external grader receives for each test result.
+type Input struct {
+	TestCaseID   string            `json:"test_case_id"`
+	TestResultID string            `json:"test_result_id"`
+	Variables    map[string]string `json:"variables"`
+	Response     string            `json:"response"`
+	Reference    *string           `json:"reference,omitempty"`
+	Fields       map[string]string `json:"fields,omitempty"`
+}
+
+// Output is the JSON document an external grader writes to stdout. Score and Pass
+// are both optional, but at least one must be set.
+type Output struct {
+	// TestResultID lets batch graders return outputs in any order.
+	TestResultID string   `json:"test_result_id,omitempty"`
+	Score        *float64 `json:"score"`
+	Pass         *bool    `json:"pass"`
+	Reason       string   `json:"reason"`
+}
+
+// Result is the grade for one Input, or the error that prevented grading it.
+type Result struct {
+	TestResultID string
+	Output       Output
+	Err          error
+}
+
+// External runs a local executable to grade results. In per-result mode the
+// executable is started once per Input, reading one JSON object on stdin and
+// writing one on stdout. In batch mode it reads a JSON array of inputs and writes
+// a JSON array of outputs.
+type External struct {
+	Command     string
+	Args        []string
+	Timeout     time.Duration
+	Concurrency int
+	Batch       bool
+	BatchSize   int
+}
+
+// Grade grades every input, running at most Concurrency processes at a time. The
+// returned results are in the same order as inputs. Zero-valued settings fall back
+// to defaults.
+func (e External) Grade(ctx context.Context, inputs []Input) []Result {
+	if e.Timeout == 0 {
+		e.Timeout = defaultTimeout
+	}
+	if e.Concurrency == 0 {
+		e.Concurrency = defaultConcurrency
+	}
+	if e.BatchSize == 0 {
+		e.BatchSize = defaultBatchSize
+	}
+
+	size := 1
+	if e.Batch {
+		size = e.BatchSize
+	}
+
+	results := make([]Result, len(inputs))
+	sem := make(chan struct{}, e.Concurrency)
+	var wg sync.WaitGroup
+	for start := 0; start < len(inputs); start += size {
+		end := start + size
+		if end > len(inputs) {
+			end = len(inputs)
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(start, end int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			var outputs []Output
+			var err error
+			if e.Batch {
+				outputs, err = e.runBatch(ctx, inputs[start:end])
+			} else {
+				var out Output
+				out, err = e.runOne(ctx, inputs[start])
+				outputs = []Output{out}
+			}
+			for i := start; i < end; i++ {
+				results[i] = Result{TestResultID: inputs[i].TestResultID, Err: err}
+				if err == nil {
+					results[i].Output = outputs[i-start]
+				}
+			}
+		}(start, end)
+	}
+	wg.Wait()
+
+	return results
+}
+
+func (e External) runOne(ctx context.Context, input Input) (Output, error) {
+	var out Output
+	stdout, err := e.run(ctx, input)
+	if err != nil {
+		return out, err
+	}
+	if err := json.Unmarshal(stdout, &out); err != nil {
+		return out, fmt.Errorf("failed to parse grader output: %w", err)
+	}
+	if err := out.validate(); err != nil {
+		return out, err
+	}
+	return out, nil
+}
+
+func (e External) runBatch(ctx context.Context, inputs []Input) ([]Output, error) {
+	stdout, err := e.run(ctx, inputs)
+	if err != nil {
+		return nil, err
+	}
+	var outs []Output
+	if err := json.Unmarshal(stdout, &outs); err != nil {
+		return nil, fmt.Errorf("failed to parse grader output: %w", err)
+	}
+	if len(outs) != len(inputs) {
+		return nil, fmt.Errorf("grader returned %d outputs for %d inputs", len(outs), len(inputs))
+	}
+
+	// match by test result ID when the grader provides it, otherwise by position
+	byID := make(map[string]Output, len(outs))
+	for _, o := range outs {
+		if o.TestResultID != "" {
+			byID[o.TestResultID] = o
+		}
+	}
+	ordered := make([]Output, len(inputs))
+	for i, in := range inputs {
+		o, ok := byID[in.TestResultID]
+		if !ok {
+			o = outs[i]
+		}
+		if err := o.validate(); err != nil {
+			return nil, fmt.Errorf("test result %s: %w", in.TestResultID, err)
+		}
+		ordered[i] = o
+	}
+	return ordered, nil
+}
+
+// run starts the executable with payload as JSON on stdin and returns its stdout.
+func (e External) run(ctx context.Context, payload any) ([]byte, error) {
+	stdin, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode grader input: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, e.Timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, e.Command, e.Args...)
+	cmd.WaitDelay = waitDelay
+	cmd.Stdin = bytes.NewReader(stdin)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, fmt.Errorf("grader timed out after %s", e.Timeout)
+		}
+		msg := strings.TrimSpace(stderr.String())
+		if len(msg) > maxStderr {
+			msg = msg[:maxStderr]
+		}
+		return nil, fmt.Errorf("grader failed: %w: %s", err, msg)
+	}
+	return stdout.Bytes(), nil
+}
+
+func (o Output) validate() error {
+	if o.Score == nil && o.Pass == nil {
+		return fmt.Errorf("grader output has neither score nor pass")
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package llmutils
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// contextWindows are the context window sizes in tokens of common models, keyed by
+// model name prefix. The longest matching prefix wins, so dated and suffixed model
+// names match their family.
+var contextWindows = map[string]int{
+	"gpt-3.5-turbo":     16385,
+	"gpt-4":             8192,
+	"gpt-4-32k":         32768,
+	"gpt-4-turbo":       128000,
+	"gpt-4o":            128000,
+	"gpt-4.1":           1047576,
+	"o1":                200000,
+	"o3":                200000,
+	"o4-mini":           200000,
+	"claude-2":          100000,
+	"claude-3":          200000,
+	"claude-sonnet-4":   200000,
+	"claude-opus-4":     200000,
+	"gemini-1.5-flash":  1048576,
+	"gemini-1.5-pro":    2097152,
+	"gemini-2":          1048576,
+	"llama3-":           8192,
+	"llama-3.1":         131072,
+	"llama-3.2":         131072,
+	"llama-3.3":         131072,
+	"mixtral-8x7b":      32768,
+	"gemma2-9b":         8192,
+	"deepseek-chat":     65536,
+	"deepseek-reasoner": 65536,
+}
+
+// ContextWindow returns the context window of a model in tokens, if it is known.
+func ContextWindow(modelName string) (int, bool) {
+	name := strings.ToLower(modelName)
+	// provider prefixes like "openai/gpt-4o"
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	best, window := "", 0
+	for prefix, w := range contextWindows {
+		if strings.HasPrefix(name, prefix) && len(prefix) > len(best) {
+			best, window = prefix, w
+		}
+	}
+	return window, best != ""
+}
+
+// EstimateTokens estimates the number of tokens in text at four characters a
+// token, which is close for English with most tokenizers.
+func EstimateTokens(text string) int {
+	return (utf8.RuneCountInString(text) + 3) / 4
+}
@@ -10,24 +10,10 @@ import (
 	"strings"
 )
 
+// ReplacePromptVariables renders a template with the given variables. Text that is
+// not a valid action is kept as it is; use ParseTemplate to check a prompt.
 func ReplacePromptVariables(prompt string, variables map[string]string) string {
-	// Create a regular expression to match {{VARIABLE_NAME}}
-	re := regexp.MustCompile(`\{\{([A-Z_]+)\}\}`)
-
-	// Use a function to replace each match
-	result := re.ReplaceAllStringFunc(prompt, func(match string) string {
-		// Extract the variable name without the curly braces
-		varName := strings.Trim(match, "{}")
-
-		// Look up the value in the variables map
-		if value, ok := variables[varName]; ok {
-			return value
-		}
-		// If the variable is not found, return the original match
-		return match
-	})
-
-	return result
+	return ParseTemplateLenient(prompt).Render(variables)
 }
 
 // wrapWithCDATA wraps the content of specified tags with CDATA sections
@@ -102,3 +88,46 @@ func ParseResponse[T any](input string) (T, error) {
 
 	return response, nil
 }
+
+// ParseXMLFields returns the content of each top-level tag in an XML-formatted reply,
+// keyed by tag name. A wrapping <reply> element is unwrapped first. Unlike ParseResponse
+// it needs no target struct, so it works for arbitrary reply formats.
+func ParseXMLFields(input string) map[string]string {
+	fields := make(map[string]string)
+
+	body := input
+	if start := strings.Index(body, "<reply>"); start >= 0 {
+		if end := strings.LastIndex(body, "</reply>"); end > start {
+			body = body[start+len("<reply>") : end]
+		}
+	}
+
+	for {
+		open := strings.Index(body, "<")
+		if open < 0 {
+			break
+		}
+		closeIdx := strings.Index(body[open:], ">")
+		if closeIdx < 0 {
+			break
+		}
+		tag := body[open+1 : open+closeIdx]
+		rest := body[open+closeIdx+1:]
+		if !xmlTagRegex.MatchString(tag) {
+			body = rest
+			continue
+		}
+
+		end := strings.Index(rest, "</"+tag+">")
+		if end < 0 {
+			body = rest
+			continue
+		}
+		fields[tag] = strings.TrimSpace(removeCDATA(rest[:end]))
+		body = rest[end+len("</"+tag+">"):]
+	}
+
+	return fields
+}
+
+var xmlTagRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.-]*$`)
@@ -0,0 +1,470 @@
+package llmutils
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Template is a parsed prompt template. Besides {{NAME}} substitution it supports
+//
+//	{{NAME | trim | upper}}          filters: trim, upper, lower, json, default "text"
+//	{{if NAME}}...{{else}}...{{end}} NAME is set and not empty
+//	{{range NAME}}{{.}}{{end}}       NAME is a list, as a JSON array or one item per line
+//	{{"{{"}}                         a quoted string is written as it is
+//
+// and {{- and -}} trim the whitespace before and after an action. Variables that
+// are missing and have no default are left as they are written.
+type Template struct {
+	nodes     []templateNode
+	variables []string
+}
+
+// TemplateError is a syntax error in a template.
+type TemplateError struct {
+	Line int
+	Msg  string
+}
+
+func (e *TemplateError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Msg)
+}
+
+var templateIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+var templateKeywords = map[string]bool{"if": true, "else": true, "end": true, "range": true}
+
+type templateFilter struct {
+	name string
+	arg  string
+}
+
+type templateNode interface {
+	render(sb *strings.Builder, vars map[string]string, item *string)
+}
+
+type textNode string
+
+type outputNode struct {
+	// name is empty for {{.}} and string literals
+	name    string
+	literal *string
+	filters []templateFilter
+	raw     string
+}
+
+type ifNode struct {
+	name     string
+	then     []templateNode
+	elseBody []templateNode
+}
+
+type rangeNode struct {
+	name     string
+	body     []templateNode
+	elseBody []templateNode
+}
+
+// ParseTemplate parses a prompt template, failing on any invalid action.
+func ParseTemplate(text string) (*Template, error) {
+	p := &templateParser{text: text, line: 1, seen: make(map[string]bool), unclosed: make(map[int]bool)}
+	nodes, end, err := p.parseList(0)
+	if err != nil {
+		return nil, err
+	}
+	if end != "" {
+		return nil, p.errorf("unexpected {{%s}}", end)
+	}
+	return &Template{nodes: nodes, variables: p.variables}, nil
+}
+
+// ParseTemplateLenient parses a prompt template, keeping anything that is not a
+// valid action, like the braces of a JSON example or an {{if}} without {{end}}, as
+// text. It is for rendering prompts saved before they were checked.
+func ParseTemplateLenient(text string) *Template {
+	p := &templateParser{text: text, line: 1, seen: make(map[string]bool), unclosed: make(map[int]bool), lenient: true}
+	nodes, _, _ := p.parseList(0)
+	return &Template{nodes: nodes, variables: p.variables}
+}
+
+// Variables returns the names of the variables the template uses, in order of first
+// use.
+func (t *Template) Variables() []string {
+	return append([]string(nil), t.variables...)
+}
+
+// Render fills in the template with vars.
+func (t *Template) Render(vars map[string]string) string {
+	var sb strings.Builder
+	renderNodes(&sb, t.nodes, vars, nil)
+	return sb.String()
+}
+
+func renderNodes(sb *strings.Builder, nodes []templateNode, vars map[string]string, item *string) {
+	for _, n := range nodes {
+		n.render(sb, vars, item)
+	}
+}
+
+func (n textNode) render(sb *strings.Builder, vars map[string]string, item *string) {
+	sb.WriteString(string(n))
+}
+
+func (n *outputNode) render(sb *strings.Builder, vars map[string]string, item *string) {
+	var value string
+	var ok bool
+	switch {
+	case n.literal != nil:
+		value, ok = *n.literal, true
+	case n.name == "":
+		value, ok = *item, true
+	default:
+		value, ok = vars[n.name]
+	}
+	for _, f := range n.filters {
+		switch f.name {
+		case "default":
+			if !ok || value == "" {
+				value, ok = f.arg, true
+			}
+		case "trim":
+			value = strings.TrimSpace(value)
+		case "upper":
+			value = strings.ToUpper(value)
+		case "lower":
+			value = strings.ToLower(value)
+		case "json":
+			b, _ := json.Marshal(value)
+			value = string(b)
+		}
+	}
+	if !ok {
+		sb.WriteString(n.raw)
+		return
+	}
+	sb.WriteString(value)
+}
+
+func (n *ifNode) render(sb *strings.Builder, vars map[string]string, item *string) {
+	if vars[n.name] != "" {
+		renderNodes(sb, n.then, vars, item)
+	} else {
+		renderNodes(sb, n.elseBody, vars, item)
+	}
+}
+
+func (n *rangeNode) render(sb *strings.Builder, vars map[string]string, item *string) {
+	items := listItems(vars[n.name])
+	if len(items) == 0 {
+		renderNodes(sb, n.elseBody, vars, item)
+		return
+	}
+	for i := range items {
+		renderNodes(sb, n.body, vars, &items[i])
+	}
+}
+
+// listItems splits a list variable's value into items. Values starting with [ are
+// read as a JSON array, and other values have one item per non-empty line.
+func listItems(value string) []string {
+	trimmed := strings.TrimSpace(value)
+	if strings.HasPrefix(trimmed, "[") {
+		var raw []json.RawMessage
+		if err := json.Unmarshal([]byte(trimmed), &raw); err == nil {
+			items := make([]string, 0, len(raw))
+			for _, r := range raw {
+				var s string
+				if err := json.Unmarshal(r, &s); err == nil {
+					items = append(items, s)
+				} else {
+					items = append(items, string(r))
+				}
+			}
+			return items
+		}
+	}
+	items := make([]string, 0)
+	for _, line := range strings.Split(value, "\n") {
+		if strings.TrimSpace(line) != "" {
+			items = append(items, line)
+		}
+	}
+	return items
+}
+
+type templateParser struct {
+	text      string
+	pos       int
+	line      int
+	rangeDeep int
+	// trimNext drops the whitespace at the start of the next text
+	trimNext  bool
+	seen      map[string]bool
+	variables []string
+	// lenient keeps invalid actions as text instead of failing
+	lenient bool
+	// unclosed holds the positions of {{if}} and {{range}} bodies already found to
+	// have no {{end}}, so lenient parsing does not read them again
+	unclosed map[int]bool
+}
+
+func (p *templateParser) errorf(format string, args ...any) error {
+	return &TemplateError{Line: p.line, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (p *templateParser) useVariable(name string) error {
+	if !templateIdentifier.MatchString(name) || templateKeywords[name] {
+		return p.errorf("invalid variable name %q (write {{\"{{\"}} for literal braces)", name)
+	}
+	if !p.seen[name] {
+		p.seen[name] = true
+		p.variables = append(p.variables, name)
+	}
+	return nil
+}
+
+// forget drops the variables first used after the first n.
+func (p *templateParser) forget(n int) {
+	for _, name := range p.variables[n:] {
+		delete(p.seen, name)
+	}
+	p.variables = p.variables[:n]
+}
+
+// appendText adds text to nodes, joining it to a text node before it.
+func appendText(nodes []templateNode, text string) []templateNode {
+	if text == "" {
+		return nodes
+	}
+	if n := len(nodes); n > 0 {
+		if prev, ok := nodes[n-1].(textNode); ok {
+			nodes[n-1] = prev + textNode(text)
+			return nodes
+		}
+	}
+	return append(nodes, textNode(text))
+}
+
+// actionEnd returns the index of the }} closing the action s starts with, skipping
+// quoted strings, or -1.
+func actionEnd(s string) int {
+	var quote byte
+	for i := 2; i < len(s); i++ {
+		switch {
+		case quote != 0 && s[i] == '\\' && quote == '"':
+			i++
+		case quote != 0 && s[i] == quote:
+			quote = 0
+		case quote == 0 && (s[i] == '"' || s[i] == '`'):
+			quote = s[i]
+		case quote == 0 && strings.HasPrefix(s[i:], "}}"):
+			return i
+		}
+	}
+	// an unterminated quote is not a string
+	return strings.Index(s, "}}")
+}
+
+// parseList parses nodes up to the end of the text or an {{else}} or {{end}},
+// which it returns.
+func (p *templateParser) parseList(depth int) ([]templateNode, string, error) {
+	nodes := make([]templateNode, 0)
+	for p.pos < len(p.text) {
+		start := strings.Index(p.text[p.pos:], "{{")
+		if start < 0 {
+			start = len(p.text) - p.pos
+		}
+		text := p.text[p.pos : p.pos+start]
+		if p.trimNext {
+			text = strings.TrimLeftFunc(text, unicode.IsSpace)
+			p.trimNext = false
+		}
+		p.line += strings.Count(p.text[p.pos:p.pos+start], "\n")
+		p.pos += start
+		if p.pos >= len(p.text) {
+			nodes = appendText(nodes, text)
+			break
+		}
+
+		closing := actionEnd(p.text[p.pos:])
+		if closing < 0 {
+			if !p.lenient {
+				return nil, "", p.errorf("unclosed action (write {{\"{{\"}} for literal braces)")
+			}
+			nodes = appendText(nodes, text+"{{")
+			p.pos += 2
+			continue
+		}
+		raw := p.text[p.pos : p.pos+closing+2]
+		action := raw[2 : len(raw)-2]
+		trimBefore := strings.HasPrefix(action, "- ") || action == "-"
+		if trimBefore {
+			action = action[1:]
+		}
+		trimAfter := false
+		if strings.HasSuffix(action, " -") {
+			trimAfter = true
+			action = action[:len(action)-1]
+		}
+
+		startPos, startLine, nVariables := p.pos, p.line, len(p.variables)
+		p.line += strings.Count(raw, "\n")
+		p.pos += len(raw)
+		p.trimNext = trimAfter
+		node, end, err := p.parseAction(action, raw, depth)
+		if err != nil {
+			if !p.lenient {
+				return nil, "", err
+			}
+			// not an action: keep its braces as text and read on after them
+			p.pos, p.line, p.trimNext = startPos+2, startLine, false
+			p.forget(nVariables)
+			nodes = appendText(nodes, text+"{{")
+			continue
+		}
+		if trimBefore {
+			text = strings.TrimRightFunc(text, unicode.IsSpace)
+		}
+		nodes = appendText(nodes, text)
+		if end != "" {
+			return nodes, end, nil
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, "", nil
+}
+
+// parseAction parses the action just read, with the whole template after it for
+// {{if}} and {{range}}. It returns the node, or which of {{else}} and {{end}} it is.
+func (p *templateParser) parseAction(action, raw string, depth int) (templateNode, string, error) {
+	fields := strings.Fields(action)
+	if len(fields) == 0 {
+		return nil, "", p.errorf("empty action (write {{\"{{\"}} for literal braces)")
+	}
+	switch fields[0] {
+	case "else", "end":
+		if len(fields) > 1 {
+			return nil, "", p.errorf("unexpected %q after {{%s}}", strings.Join(fields[1:], " "), fields[0])
+		}
+		if depth == 0 {
+			return nil, "", p.errorf("{{%s}} without {{if}} or {{range}}", fields[0])
+		}
+		return nil, fields[0], nil
+	case "if", "range":
+		if len(fields) != 2 {
+			return nil, "", p.errorf("{{%s}} takes one variable", fields[0])
+		}
+		if err := p.useVariable(fields[1]); err != nil {
+			return nil, "", err
+		}
+		openPos, openLine := p.pos, p.line
+		missingEnd := &TemplateError{Line: openLine, Msg: fmt.Sprintf("{{%s %s}} is missing {{end}}", fields[0], fields[1])}
+		if p.unclosed[openPos] {
+			return nil, "", missingEnd
+		}
+		if fields[0] == "range" {
+			p.rangeDeep++
+		}
+		body, end, err := p.parseList(depth + 1)
+		if err != nil {
+			return nil, "", err
+		}
+		if fields[0] == "range" {
+			// the else of a range runs without an item
+			p.rangeDeep--
+		}
+		var elseBody []templateNode
+		if end == "else" {
+			elseBody, end, err = p.parseList(depth + 1)
+			if err != nil {
+				return nil, "", err
+			}
+			if end == "else" {
+				return nil, "", p.errorf("{{%s %s}} has more than one {{else}}", fields[0], fields[1])
+			}
+		}
+		if end == "" {
+			p.unclosed[openPos] = true
+			return nil, "", missingEnd
+		}
+		if fields[0] == "range" {
+			return &rangeNode{name: fields[1], body: body, elseBody: elseBody}, "", nil
+		}
+		return &ifNode{name: fields[1], then: body, elseBody: elseBody}, "", nil
+	default:
+		node, err := p.parseOutput(action, raw)
+		if err != nil {
+			return nil, "", err
+		}
+		return node, "", nil
+	}
+}
+
+// parseOutput parses an action that prints a variable, like {{NAME | trim}}.
+func (p *templateParser) parseOutput(action string, raw string) (*outputNode, error) {
+	parts := splitPipeline(action)
+	node := &outputNode{name: strings.TrimSpace(parts[0]), raw: raw}
+	if strings.HasPrefix(node.name, `"`) || strings.HasPrefix(node.name, "`") {
+		literal, err := strconv.Unquote(node.name)
+		if err != nil {
+			return nil, p.errorf("invalid string %s", node.name)
+		}
+		node.name, node.literal = "", &literal
+	} else if node.name == "." {
+		if p.rangeDeep == 0 {
+			return nil, p.errorf("{{.}} outside {{range}}")
+		}
+		node.name = ""
+	} else if err := p.useVariable(node.name); err != nil {
+		return nil, err
+	}
+	for _, part := range parts[1:] {
+		name, arg, _ := strings.Cut(strings.TrimSpace(part), " ")
+		f := templateFilter{name: name}
+		switch name {
+		case "trim", "upper", "lower", "json":
+			if strings.TrimSpace(arg) != "" {
+				return nil, p.errorf("filter %s takes no argument", name)
+			}
+		case "default":
+			s, err := strconv.Unquote(strings.TrimSpace(arg))
+			if err != nil {
+				return nil, p.errorf("filter default needs a quoted string, got %q", strings.TrimSpace(arg))
+			}
+			f.arg = s
+		case "":
+			return nil, p.errorf("empty filter")
+		default:
+			return nil, p.errorf("unknown filter %q", name)
+		}
+		node.filters = append(node.filters, f)
+	}
+	return node, nil
+}
+
+// splitPipeline splits an action on the | characters outside quoted strings.
+func splitPipeline(action string) []string {
+	parts := make([]string, 0, 1)
+	var quote rune
+	escaped := false
+	start := 0
+	for i, r := range action {
+		switch {
+		case escaped:
+			escaped = false
+		case quote != 0 && r == '\\':
+			escaped = true
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote == 0 && (r == '"' || r == '`'):
+			quote = r
+		case quote == 0 && r == '|':
+			parts = append(parts, action[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, action[start:])
+}
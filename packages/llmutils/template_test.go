@@ -0,0 +1,163 @@
+package llmutils
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestRender(t *testing.T) {
+	vars := map[string]string{
+		"NAME":  "  Ada  ",
+		"LANG":  "Go",
+		"EMPTY": "",
+		"ITEMS": "apples\n\nbananas\n",
+		"JSON":  `["a", 1, "b \"c\""]`,
+		"QUOTE": `say "hi"`,
+	}
+	tests := []struct {
+		name string
+		tmpl string
+		want string
+	}{
+		{name: "plain text", tmpl: "no actions here", want: "no actions here"},
+		{name: "variable", tmpl: "Hello {{LANG}}!", want: "Hello Go!"},
+		{name: "spaces in action", tmpl: "{{ LANG }}", want: "Go"},
+		{name: "missing variable is kept", tmpl: "Hi {{MISSING}} and {{ MISSING | upper }}", want: "Hi {{MISSING}} and {{ MISSING | upper }}"},
+		{name: "empty variable renders empty", tmpl: "[{{EMPTY}}]", want: "[]"},
+
+		{name: "trim", tmpl: "[{{NAME | trim}}]", want: "[Ada]"},
+		{name: "upper and lower", tmpl: "{{NAME | trim | upper}} {{LANG | lower}}", want: "ADA go"},
+		{name: "json", tmpl: "{{QUOTE | json}}", want: `"say \"hi\""`},
+		{name: "default for missing", tmpl: `{{MISSING | default "none"}}`, want: "none"},
+		{name: "default for empty", tmpl: `{{EMPTY | default "none"}}`, want: "none"},
+		{name: "default when set", tmpl: `{{LANG | default "none"}}`, want: "Go"},
+		{name: "default with pipe", tmpl: `{{MISSING | default "a|b" | upper}}`, want: "A|B"},
+
+		{name: "if set", tmpl: "{{if LANG}}yes{{end}}", want: "yes"},
+		{name: "if empty", tmpl: "{{if EMPTY}}yes{{end}}", want: ""},
+		{name: "if missing with else", tmpl: "{{if MISSING}}yes{{else}}no{{end}}", want: "no"},
+		{name: "nested if", tmpl: "{{if LANG}}{{if EMPTY}}a{{else}}b{{end}}{{end}}", want: "b"},
+
+		{name: "range lines", tmpl: "{{range ITEMS}}<{{.}}>{{end}}", want: "<apples><bananas>"},
+		{name: "range json", tmpl: "{{range JSON}}[{{.}}]{{end}}", want: `[a][1][b "c"]`},
+		{name: "range filters item", tmpl: "{{range ITEMS}}{{. | upper}} {{end}}", want: "APPLES BANANAS "},
+		{name: "range else", tmpl: "{{range EMPTY}}x{{else}}none{{end}}", want: "none"},
+		{name: "range uses outer variables", tmpl: "{{range ITEMS}}{{LANG}}:{{.}} {{end}}", want: "Go:apples Go:bananas "},
+
+		{name: "trim before", tmpl: "a   \n{{- LANG}}", want: "aGo"},
+		{name: "trim after", tmpl: "{{LANG -}}  \n b", want: "Gob"},
+		{name: "trim around blocks", tmpl: "List:\n{{- range ITEMS}}\n- {{.}}\n{{- end}}", want: "List:\n- apples\n- bananas"},
+
+		{name: "escaped braces", tmpl: `{{"{{"}}LANG}}`, want: "{{LANG}}"},
+		{name: "raw string", tmpl: "{{`{\"a\": {\"b\": 1}}`}}", want: `{"a": {"b": 1}}`},
+		{name: "string with closing braces", tmpl: `{{"}}"}} {{LANG}}`, want: "}} Go"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := ParseTemplate(tt.tmpl)
+			if err != nil {
+				t.Fatalf("ParseTemplate(%q) failed: %v", tt.tmpl, err)
+			}
+			if got := tmpl.Render(vars); got != tt.want {
+				t.Errorf("Render(%q) = %q, want %q", tt.tmpl, got, tt.want)
+			}
+			if got := ReplacePromptVariables(tt.tmpl, vars); got != tt.want {
+				t.Errorf("ReplacePromptVariables(%q) = %q, want %q", tt.tmpl, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTemplateVariables(t *testing.T) {
+	tmpl, err := ParseTemplate(`{{B}} {{if A}}{{range C}}{{.}}{{B}}{{end}}{{end}} {{D | default "x"}} {{"{{E}}"}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := tmpl.Variables(), []string{"B", "A", "C", "D"}; !slices.Equal(got, want) {
+		t.Errorf("Variables() = %v, want %v", got, want)
+	}
+}
+
+func TestParseTemplateErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		tmpl string
+		line int
+		msg  string
+	}{
+		{name: "unclosed action", tmpl: "a\n{{ unclosed", line: 2, msg: "unclosed action"},
+		{name: "empty action", tmpl: "{{}}", line: 1, msg: "empty action"},
+		{name: "json", tmpl: `Return JSON like {{"a": 1}}`, line: 1, msg: "invalid string"},
+		{name: "invalid name", tmpl: "{{a-b}}", line: 1, msg: "invalid variable name"},
+		{name: "keyword as name", tmpl: "{{if range}}x{{end}}", line: 1, msg: "invalid variable name"},
+		{name: "unknown filter", tmpl: "{{A | shout}}", line: 1, msg: "unknown filter"},
+		{name: "empty filter", tmpl: "{{A |}}", line: 1, msg: "empty filter"},
+		{name: "filter argument", tmpl: "{{A | trim 1}}", line: 1, msg: "takes no argument"},
+		{name: "unquoted default", tmpl: "{{A | default x}}", line: 1, msg: "needs a quoted string"},
+		{name: "dot outside range", tmpl: "{{.}}", line: 1, msg: "outside {{range}}"},
+		{name: "dot in range else", tmpl: "{{range A}}x{{else}}{{.}}{{end}}", line: 1, msg: "outside {{range}}"},
+		{name: "missing end", tmpl: "\n{{if A}}\nx", line: 2, msg: "is missing {{end}}"},
+		{name: "stray end", tmpl: "x{{end}}", line: 1, msg: "without {{if}} or {{range}}"},
+		{name: "two elses", tmpl: "{{if A}}a{{else}}b{{else}}c{{end}}", line: 1, msg: "more than one {{else}}"},
+		{name: "if without variable", tmpl: "{{if}}x{{end}}", line: 1, msg: "takes one variable"},
+		{name: "end with argument", tmpl: "{{if A}}x{{end A}}", line: 1, msg: "unexpected"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseTemplate(tt.tmpl)
+			var templateErr *TemplateError
+			if !errors.As(err, &templateErr) {
+				t.Fatalf("ParseTemplate(%q) error = %v, want a TemplateError", tt.tmpl, err)
+			}
+			if templateErr.Line != tt.line || !strings.Contains(templateErr.Msg, tt.msg) {
+				t.Errorf("ParseTemplate(%q) error = %v, want line %d containing %q", tt.tmpl, err, tt.line, tt.msg)
+			}
+		})
+	}
+}
+
+func TestParseTemplateLenient(t *testing.T) {
+	vars := map[string]string{"INPUT": "x", "A": "set"}
+	tests := []struct {
+		name      string
+		tmpl      string
+		want      string
+		variables []string
+	}{
+		{name: "json example", tmpl: `Return JSON like {{"a": 1}} for {{INPUT}}`, want: `Return JSON like {{"a": 1}} for x`, variables: []string{"INPUT"}},
+		{name: "unclosed action", tmpl: "{{ unclosed {{INPUT}}", want: "{{ unclosed x", variables: []string{"INPUT"}},
+		{name: "empty action", tmpl: "{{}} {{INPUT}}", want: "{{}} x", variables: []string{"INPUT"}},
+		{name: "dot outside range", tmpl: "{{.}} {{INPUT}}", want: "{{.}} x", variables: []string{"INPUT"}},
+		{name: "handlebars", tmpl: "{{#each items}}{{this}}{{/each}}", want: "{{#each items}}{{this}}{{/each}}", variables: []string{"this"}},
+		{name: "invalid filter", tmpl: "{{INPUT | shout}} {{INPUT}}", want: "{{INPUT | shout}} x", variables: []string{"INPUT"}},
+		{name: "dash without space", tmpl: "{{-INPUT}}", want: "{{-INPUT}}", variables: []string{}},
+		{name: "trim marker kept on invalid action", tmpl: "a  {{- bad name -}}  b", want: "a  {{- bad name -}}  b", variables: []string{}},
+		{name: "stray end", tmpl: "{{INPUT}}{{end}}", want: "x{{end}}", variables: []string{"INPUT"}},
+		{name: "if without end", tmpl: "{{if A}}{{INPUT}}", want: "{{if A}}x", variables: []string{"INPUT"}},
+		{name: "nested if without end", tmpl: "{{if A}}{{if INPUT}}y{{end}}", want: "{{if A}}y", variables: []string{"INPUT"}},
+		{name: "variables only from valid actions", tmpl: "{{if B}}{{C}}", want: "{{if B}}{{C}}", variables: []string{"C"}},
+		{name: "valid template", tmpl: "{{if A}}{{INPUT | upper}}{{end}}", want: "X", variables: []string{"A", "INPUT"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl := ParseTemplateLenient(tt.tmpl)
+			if got := tmpl.Render(vars); got != tt.want {
+				t.Errorf("Render(%q) = %q, want %q", tt.tmpl, got, tt.want)
+			}
+			if got := tmpl.Variables(); !slices.Equal(got, tt.variables) {
+				t.Errorf("Variables() = %v, want %v", got, tt.variables)
+			}
+		})
+	}
+}
+
+func TestParseTemplateLenientUnclosedNesting(t *testing.T) {
+	// every {{if}} is missing its {{end}}; each body is only read once
+	tmpl := strings.Repeat("{{if A}}", 200) + "{{INPUT}}"
+	got := ParseTemplateLenient(tmpl).Render(map[string]string{"INPUT": "x"})
+	if want := strings.Repeat("{{if A}}", 200) + "x"; got != want {
+		t.Errorf("Render() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,202 @@
+// Package perturb makes small changes to input text that should not change what a
+// prompt outputs, to test how robust it is.
+package perturb
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+type Kind string
+
+const (
+	Typos       Kind = "typos"
+	Casing      Kind = "casing"
+	Whitespace  Kind = "whitespace"
+	ReorderList Kind = "reorder_list"
+	// Paraphrase needs a model, so Apply does not handle it.
+	Paraphrase Kind = "paraphrase"
+)
+
+// Apply returns a perturbed copy of text, and false if the perturbation does not
+// apply to it, e.g. reordering text without a list.
+func Apply(kind Kind, text string, rng *rand.Rand) (string, bool, error) {
+	var out string
+	switch kind {
+	case Typos:
+		out = typos(text, rng)
+	case Casing:
+		out = casing(text, rng)
+	case Whitespace:
+		out = whitespace(text, rng)
+	case ReorderList:
+		out = reorderList(text, rng)
+	default:
+		return "", false, fmt.Errorf("unsupported perturbation %q", kind)
+	}
+	return out, out != text, nil
+}
+
+// keyboardNeighbors are the keys next to each letter on a QWERTY keyboard.
+var keyboardNeighbors = map[rune]string{
+	'q': "wa", 'w': "qes", 'e': "wrd", 'r': "etf", 't': "ryg", 'y': "tuh", 'u': "yij",
+	'i': "uok", 'o': "ipl", 'p': "ol", 'a': "qsz", 's': "awdx", 'd': "sefc", 'f': "drgv",
+	'g': "fthb", 'h': "gyjn", 'j': "hukm", 'k': "jil", 'l': "kop", 'z': "asx", 'x': "zsdc",
+	'c': "xdfv", 'v': "cfgb", 'b': "vghn", 'n': "bhjm", 'm': "njk",
+}
+
+// typos introduces about one typo per 25 letters, and at least one, into words of
+// three or more letters: swapped, dropped, doubled or mistyped letters.
+func typos(text string, rng *rand.Rand) string {
+	runes := []rune(text)
+	// positions of letters inside words long enough to keep readable
+	candidates := make([]int, 0)
+	for start := 0; start < len(runes); {
+		if !unicode.IsLetter(runes[start]) {
+			start++
+			continue
+		}
+		end := start
+		for end < len(runes) && unicode.IsLetter(runes[end]) {
+			end++
+		}
+		if end-start >= 3 {
+			for i := start + 1; i < end-1; i++ {
+				candidates = append(candidates, i)
+			}
+		}
+		start = end
+	}
+	if len(candidates) == 0 {
+		return text
+	}
+
+	n := max(1, len(candidates)/25)
+	picked := make(map[int]bool, n)
+	for _, i := range rng.Perm(len(candidates))[:n] {
+		picked[candidates[i]] = true
+	}
+
+	out := make([]rune, 0, len(runes)+n)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if !picked[i] {
+			out = append(out, r)
+			continue
+		}
+		switch rng.Intn(4) {
+		case 0:
+			// swap with the next letter
+			out = append(out, runes[i+1], r)
+			i++
+		case 1:
+			// drop
+		case 2:
+			out = append(out, r, r)
+		default:
+			neighbors := keyboardNeighbors[unicode.ToLower(r)]
+			if neighbors == "" {
+				out = append(out, r, r)
+				continue
+			}
+			typo := rune(neighbors[rng.Intn(len(neighbors))])
+			if unicode.IsUpper(r) {
+				typo = unicode.ToUpper(typo)
+			}
+			out = append(out, typo)
+		}
+	}
+	return string(out)
+}
+
+// casing lowercases, uppercases or capitalizes every word of text.
+func casing(text string, rng *rand.Rand) string {
+	switch rng.Intn(3) {
+	case 0:
+		return strings.ToLower(text)
+	case 1:
+		return strings.ToUpper(text)
+	default:
+		runes := []rune(strings.ToLower(text))
+		for i, r := range runes {
+			if i == 0 || unicode.IsSpace(runes[i-1]) {
+				runes[i] = unicode.ToUpper(r)
+			}
+		}
+		return string(runes)
+	}
+}
+
+// whitespace widens some of the spaces in text and pads it with whitespace.
+func whitespace(text string, rng *rand.Rand) string {
+	var sb strings.Builder
+	if rng.Intn(2) == 0 {
+		sb.WriteString(" ")
+	}
+	for _, r := range text {
+		sb.WriteRune(r)
+		if r == ' ' && rng.Intn(4) == 0 {
+			sb.WriteString(strings.Repeat(" ", 1+rng.Intn(2)))
+		}
+	}
+	if rng.Intn(2) == 0 {
+		sb.WriteString("\n")
+	} else {
+		sb.WriteString("  ")
+	}
+	return sb.String()
+}
+
+var listItem = regexp.MustCompile(`^(\s*)([-*•]|\d+[.)])(\s+)(.*)$`)
+
+// reorderList shuffles the items of each list in text. Items are lines that start
+// with a bullet or a number, and numbered items keep their numbers in order.
+func reorderList(text string, rng *rand.Rand) string {
+	lines := strings.Split(text, "\n")
+	for start := 0; start < len(lines); {
+		end := start
+		style := listStyle(lines[start])
+		for end < len(lines) && style != "" && listStyle(lines[end]) == style {
+			end++
+		}
+		if end-start < 2 {
+			start = max(end, start+1)
+			continue
+		}
+
+		markers := make([]string, 0, end-start)
+		items := make([]string, 0, end-start)
+		for _, line := range lines[start:end] {
+			m := listItem.FindStringSubmatch(line)
+			markers = append(markers, m[1]+m[2]+m[3])
+			items = append(items, m[4])
+		}
+		original := strings.Join(items, "\n")
+		rng.Shuffle(len(items), func(i, j int) { items[i], items[j] = items[j], items[i] })
+		if strings.Join(items, "\n") == original {
+			items[0], items[1] = items[1], items[0]
+		}
+		for i := range items {
+			lines[start+i] = markers[i] + items[i]
+		}
+		start = end
+	}
+	return strings.Join(lines, "\n")
+}
+
+// listStyle identifies the list a line is an item of by its indentation and kind of
+// marker, or is empty if the line is not a list item.
+func listStyle(line string) string {
+	m := listItem.FindStringSubmatch(line)
+	if m == nil {
+		return ""
+	}
+	marker := m[2]
+	if unicode.IsDigit(rune(marker[0])) {
+		marker = "1" + marker[len(marker)-1:]
+	}
+	return m[1] + marker
+}
@@ -0,0 +1,78 @@
+// Package redact masks sensitive values, like email addresses or API keys, in text
+// before it is stored.
+package redact
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// builtins are the named patterns that can be enabled by name.
+var builtins = map[string]*regexp.Regexp{
+	"email":       regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`),
+	"phone":       regexp.MustCompile(`(?:\+\d{1,3}[ .\-]?)?\(?\b\d{3}\)?[ .\-]?\d{3}[ .\-]?\d{4}\b`),
+	"credit_card": regexp.MustCompile(`\b(?:\d[ \-]?){13,16}\b`),
+	"api_key":     regexp.MustCompile(`\b(?:sk-[A-Za-z0-9_\-]{16,}|gsk_[A-Za-z0-9]{16,}|AKIA[0-9A-Z]{16}|AIza[0-9A-Za-z_\-]{35})\b`),
+}
+
+// Builtins lists the names of the built-in patterns.
+func Builtins() []string {
+	names := make([]string, 0, len(builtins))
+	for name := range builtins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+type rule struct {
+	name    string
+	pattern *regexp.Regexp
+}
+
+// Redactor replaces every match of its patterns with a [REDACTED:<name>] marker.
+// A nil Redactor leaves text unchanged.
+type Redactor struct {
+	rules []rule
+}
+
+// New returns a Redactor for the named built-in patterns plus custom regular
+// expressions, which are redacted as "custom".
+func New(names []string, patterns []string) (*Redactor, error) {
+	r := &Redactor{}
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		pattern, ok := builtins[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown redaction %q, expected one of %s", name, strings.Join(Builtins(), ", "))
+		}
+		r.rules = append(r.rules, rule{name, pattern})
+	}
+	for _, p := range patterns {
+		if p == "" {
+			continue
+		}
+		pattern, err := regexp.Compile(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid redaction pattern %q: %w", p, err)
+		}
+		r.rules = append(r.rules, rule{"custom", pattern})
+	}
+	return r, nil
+}
+
+// Redact returns text with all matches replaced.
+func (r *Redactor) Redact(text string) string {
+	if r == nil {
+		return text
+	}
+	for _, rule := range r.rules {
+		text = rule.pattern.ReplaceAllString(text, "[REDACTED:"+rule.name+"]")
+	}
+	return text
+}
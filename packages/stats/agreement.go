@@ -0,0 +1,149 @@
+package stats
+
+import (
+	"math"
+)
+
+// Metric is a distance function between two rated values.
+type Metric func(a, b float64) float64
+
+// Nominal treats values as unordered categories: any difference counts fully.
+func Nominal(a, b float64) float64 {
+	if a == b {
+		return 0
+	}
+	return 1
+}
+
+// Interval treats values as points on a scale, penalising squared distance.
+func Interval(a, b float64) float64 {
+	return (a - b) * (a - b)
+}
+
+// CohenKappa returns the agreement between two raters who scored the same items,
+// so a[i] and b[i] must refer to the same item. With the Nominal metric this is
+// Cohen's kappa; with Interval it is the quadratic-weighted kappa.
+// It returns NaN when agreement is undefined (fewer than two items, or no variation).
+func CohenKappa(a, b []float64, metric Metric) float64 {
+	n := len(a)
+	if n != len(b) || n < 2 {
+		return math.NaN()
+	}
+
+	var observed, expected float64
+	for i := 0; i < n; i++ {
+		observed += metric(a[i], b[i])
+		for j := 0; j < n; j++ {
+			expected += metric(a[i], b[j])
+		}
+	}
+	observed /= float64(n)
+	expected /= float64(n * n)
+	if expected == 0 {
+		return math.NaN()
+	}
+	return 1 - observed/expected
+}
+
+// FleissKappa returns Fleiss' kappa for items that were each rated by the same
+// number of raters. Each row of items holds the categorical ratings for one item.
+// Rows with a different number of ratings than the first row are rejected by
+// returning NaN, since Fleiss' kappa is not defined for incomplete designs.
+func FleissKappa(items [][]float64) float64 {
+	if len(items) == 0 {
+		return math.NaN()
+	}
+	nRaters := len(items[0])
+	if nRaters < 2 {
+		return math.NaN()
+	}
+
+	categoryTotals := make(map[float64]float64)
+	var sumAgreement float64
+	for _, item := range items {
+		if len(item) != nRaters {
+			return math.NaN()
+		}
+		counts := make(map[float64]float64)
+		for _, v := range item {
+			counts[v]++
+			categoryTotals[v]++
+		}
+		var sq float64
+		for _, c := range counts {
+			sq += c * c
+		}
+		sumAgreement += (sq - float64(nRaters)) / float64(nRaters*(nRaters-1))
+	}
+
+	nItems := float64(len(items))
+	observed := sumAgreement / nItems
+	var expected float64
+	for _, c := range categoryTotals {
+		p := c / (nItems * float64(nRaters))
+		expected += p * p
+	}
+	if expected == 1 {
+		return math.NaN()
+	}
+	return (observed - expected) / (1 - expected)
+}
+
+// KrippendorffAlpha returns Krippendorff's alpha over units of analysis, where
+// each unit holds the values assigned by whichever raters scored it. Units with
+// fewer than two values are not pairable and are ignored, so missing ratings are
+// handled naturally.
+func KrippendorffAlpha(units [][]float64, metric Metric) float64 {
+	var pooled []float64
+	var observed float64
+	for _, unit := range units {
+		m := len(unit)
+		if m < 2 {
+			continue
+		}
+		var d float64
+		for i := 0; i < m; i++ {
+			for j := 0; j < m; j++ {
+				if i != j {
+					d += metric(unit[i], unit[j])
+				}
+			}
+		}
+		observed += d / float64(m-1)
+		pooled = append(pooled, unit...)
+	}
+
+	n := len(pooled)
+	if n < 2 {
+		return math.NaN()
+	}
+	observed /= float64(n)
+
+	var expected float64
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if i != j {
+				expected += metric(pooled[i], pooled[j])
+			}
+		}
+	}
+	expected /= float64(n * (n - 1))
+	if expected == 0 {
+		return math.NaN()
+	}
+	return 1 - observed/expected
+}
+
+// PercentAgreement returns the share of items where both raters gave the same value.
+func PercentAgreement(a, b []float64) float64 {
+	if len(a) != len(b) || len(a) == 0 {
+		return math.NaN()
+	}
+	var same int
+	for i := range a {
+		if a[i] == b[i] {
+			same++
+		}
+	}
+	return float64(same) / float64(len(a))
+}
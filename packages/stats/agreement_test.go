@@ -0,0 +1,225 @@
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+// repeat returns n copies of v.
+func repeat(v float64, n int) []float64 {
+	out := make([]float64, n)
+	for i := range out {
+		out[i] = v
+	}
+	return out
+}
+
+// concat joins slices in order.
+func concat(parts ...[]float64) []float64 {
+	var out []float64
+	for _, p := range parts {
+		out = append(out, p...)
+	}
+	return out
+}
+
+func assertClose(t *testing.T, got, want, tol float64) {
+	t.Helper()
+	if math.IsNaN(want) {
+		if !math.IsNaN(got) {
+			t.Errorf("got %v, want NaN", got)
+		}
+		return
+	}
+	if math.IsNaN(got) || math.Abs(got-want) > tol {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestCohenKappa(t *testing.T) {
+	tests := []struct {
+		name   string
+		a, b   []float64
+		metric Metric
+		want   float64
+	}{
+		{
+			// 20 yes/yes, 5 yes/no, 10 no/yes, 15 no/no: po = 0.7, pe = 0.5
+			name:   "textbook 2x2",
+			a:      concat(repeat(1, 20), repeat(1, 5), repeat(0, 10), repeat(0, 15)),
+			b:      concat(repeat(1, 20), repeat(0, 5), repeat(1, 10), repeat(0, 15)),
+			metric: Nominal,
+			want:   0.4,
+		},
+		{
+			name:   "perfect agreement",
+			a:      []float64{1, 0, 1, 0},
+			b:      []float64{1, 0, 1, 0},
+			metric: Nominal,
+			want:   1,
+		},
+		{
+			name:   "quadratic weights",
+			a:      []float64{1, 2, 3},
+			b:      []float64{1, 3, 2},
+			metric: Interval,
+			want:   0.5,
+		},
+		{
+			// both raters always give the same value, so expected agreement is 1
+			name:   "expected agreement is 1",
+			a:      []float64{1, 1, 1},
+			b:      []float64{1, 1, 1},
+			metric: Nominal,
+			want:   math.NaN(),
+		},
+		{
+			name:   "single item",
+			a:      []float64{1},
+			b:      []float64{0},
+			metric: Nominal,
+			want:   math.NaN(),
+		},
+		{
+			name:   "mismatched lengths",
+			a:      []float64{1, 0},
+			b:      []float64{1},
+			metric: Nominal,
+			want:   math.NaN(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertClose(t, CohenKappa(tt.a, tt.b, tt.metric), tt.want, 1e-9)
+		})
+	}
+}
+
+// fleissItems expands per-category counts into one row of ratings per item.
+func fleissItems(counts [][]int) [][]float64 {
+	items := make([][]float64, len(counts))
+	for i, row := range counts {
+		for category, n := range row {
+			items[i] = append(items[i], repeat(float64(category+1), n)...)
+		}
+	}
+	return items
+}
+
+func TestFleissKappa(t *testing.T) {
+	tests := []struct {
+		name  string
+		items [][]float64
+		want  float64
+	}{
+		{
+			// Fleiss (1971) style example: 10 items, 14 raters, 5 categories
+			name: "textbook 10x5",
+			items: fleissItems([][]int{
+				{0, 0, 0, 0, 14},
+				{0, 2, 6, 4, 2},
+				{0, 0, 3, 5, 6},
+				{0, 3, 9, 2, 0},
+				{2, 2, 8, 1, 1},
+				{7, 7, 0, 0, 0},
+				{3, 2, 6, 3, 0},
+				{2, 5, 3, 2, 2},
+				{6, 5, 2, 1, 0},
+				{0, 2, 2, 3, 7},
+			}),
+			want: 0.2099,
+		},
+		{
+			name:  "perfect agreement",
+			items: [][]float64{{1, 1, 1}, {0, 0, 0}},
+			want:  1,
+		},
+		{
+			// every rating is in one category, so expected agreement is 1
+			name:  "expected agreement is 1",
+			items: [][]float64{{1, 1, 1}, {1, 1, 1}},
+			want:  math.NaN(),
+		},
+		{
+			name:  "incomplete design",
+			items: [][]float64{{1, 1, 0}, {1, 0}},
+			want:  math.NaN(),
+		},
+		{
+			name:  "single rater",
+			items: [][]float64{{1}, {0}},
+			want:  math.NaN(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertClose(t, FleissKappa(tt.items), tt.want, 1e-4)
+		})
+	}
+}
+
+func TestKrippendorffAlpha(t *testing.T) {
+	// Krippendorff's reliability data: 4 observers, 12 units, missing values dropped
+	reliability := [][]float64{
+		{1, 1, 1},
+		{2, 2, 3, 2},
+		{3, 3, 3, 3},
+		{3, 3, 3, 3},
+		{2, 2, 2, 2},
+		{1, 2, 3, 4},
+		{4, 4, 4, 4},
+		{1, 1, 2, 1},
+		{2, 2, 2, 2},
+		{5, 5, 5},
+		{1, 1},
+		{3},
+	}
+	tests := []struct {
+		name   string
+		units  [][]float64
+		metric Metric
+		want   float64
+	}{
+		{
+			name:   "textbook nominal",
+			units:  reliability,
+			metric: Nominal,
+			want:   0.743,
+		},
+		{
+			name:   "textbook interval",
+			units:  reliability,
+			metric: Interval,
+			want:   0.849,
+		},
+		{
+			name:   "perfect agreement",
+			units:  [][]float64{{1, 1}, {0, 0, 0}, {1}},
+			metric: Nominal,
+			want:   1,
+		},
+		{
+			// every value is the same, so expected agreement is 1
+			name:   "expected agreement is 1",
+			units:  [][]float64{{1, 1}, {1, 1, 1}},
+			metric: Nominal,
+			want:   math.NaN(),
+		},
+		{
+			name:   "no pairable units",
+			units:  [][]float64{{1}, {0}},
+			metric: Nominal,
+			want:   math.NaN(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertClose(t, KrippendorffAlpha(tt.units, tt.metric), tt.want, 1e-3)
+		})
+	}
+}
+
+func TestPercentAgreement(t *testing.T) {
+	assertClose(t, PercentAgreement([]float64{1, 0, 1, 1}, []float64{1, 1, 1, 0}), 0.5, 1e-9)
+	assertClose(t, PercentAgreement(nil, nil), math.NaN(), 0)
+}
@@ -0,0 +1,148 @@
+package stats
+
+import (
+	"math"
+	"sort"
+)
+
+// exactThreshold is the sample size below which exact distributions are used
+// instead of normal approximations.
+const exactThreshold = 25
+
+// McNemar returns the two-sided p-value of McNemar's test for paired pass/fail
+// outcomes, given the discordant counts: b pairs that passed before and failed
+// after, and c pairs that failed before and passed after. Small samples use the
+// exact binomial test; larger ones use the chi-squared test with continuity correction.
+func McNemar(b, c int) float64 {
+	n := b + c
+	if n == 0 {
+		return 1
+	}
+	if n < exactThreshold {
+		k := b
+		if c < k {
+			k = c
+		}
+		var tail float64
+		for i := 0; i <= k; i++ {
+			tail += math.Exp(logChoose(n, i) - float64(n)*math.Ln2)
+		}
+		return math.Min(1, 2*tail)
+	}
+
+	// the continuity correction never makes the statistic negative
+	d := math.Max(0, math.Abs(float64(b-c))-1)
+	chi2 := d * d / float64(n)
+	return math.Erfc(math.Sqrt(chi2 / 2))
+}
+
+// WilcoxonResult is the outcome of a Wilcoxon signed-rank test.
+type WilcoxonResult struct {
+	// N is the number of non-zero differences that were ranked.
+	N int
+	// WPlus and WMinus are the rank sums of positive and negative differences.
+	WPlus  float64
+	WMinus float64
+	// PValue is two-sided.
+	PValue float64
+	// RankBiserial is the matched-pairs rank-biserial correlation, in [-1, 1].
+	// Positive values mean the differences tend to be positive. It is NaN when N is 0.
+	RankBiserial float64
+}
+
+// WilcoxonSignedRank runs the Wilcoxon signed-rank test on paired differences
+// (after - before). Zero differences are dropped. Small samples without ties use
+// the exact null distribution; otherwise a tie-corrected normal approximation
+// with continuity correction is used.
+func WilcoxonSignedRank(diffs []float64) WilcoxonResult {
+	nonZero := make([]float64, 0, len(diffs))
+	for _, d := range diffs {
+		if d != 0 {
+			nonZero = append(nonZero, d)
+		}
+	}
+	n := len(nonZero)
+	if n == 0 {
+		return WilcoxonResult{PValue: 1, RankBiserial: math.NaN()}
+	}
+
+	sort.Slice(nonZero, func(i, j int) bool {
+		return math.Abs(nonZero[i]) < math.Abs(nonZero[j])
+	})
+
+	// assign average ranks to tied absolute differences
+	var wPlus, wMinus, tieCorrection float64
+	hasTies := false
+	for i := 0; i < n; {
+		j := i
+		for j < n && math.Abs(nonZero[j]) == math.Abs(nonZero[i]) {
+			j++
+		}
+		t := float64(j - i)
+		if t > 1 {
+			hasTies = true
+			tieCorrection += t*t*t - t
+		}
+		rank := float64(i+j+1) / 2
+		for k := i; k < j; k++ {
+			if nonZero[k] > 0 {
+				wPlus += rank
+			} else {
+				wMinus += rank
+			}
+		}
+		i = j
+	}
+
+	res := WilcoxonResult{
+		N:            n,
+		WPlus:        wPlus,
+		WMinus:       wMinus,
+		RankBiserial: (wPlus - wMinus) / (wPlus + wMinus),
+	}
+
+	if n < exactThreshold && !hasTies {
+		res.PValue = wilcoxonExactP(n, math.Min(wPlus, wMinus))
+		return res
+	}
+
+	mean := float64(n*(n+1)) / 4
+	variance := float64(n*(n+1)*(2*n+1))/24 - tieCorrection/48
+	if variance <= 0 {
+		res.PValue = 1
+		return res
+	}
+	z := (math.Abs(wPlus-mean) - 0.5) / math.Sqrt(variance)
+	if z < 0 {
+		z = 0
+	}
+	res.PValue = math.Erfc(z / math.Sqrt2)
+	return res
+}
+
+// wilcoxonExactP returns the two-sided p-value of observing a rank sum of at most w
+// under the null hypothesis, for n untied ranks.
+func wilcoxonExactP(n int, w float64) float64 {
+	maxSum := n * (n + 1) / 2
+	// counts[s] is the number of sign assignments whose positive ranks sum to s
+	counts := make([]float64, maxSum+1)
+	counts[0] = 1
+	for r := 1; r <= n; r++ {
+		for s := maxSum; s >= r; s-- {
+			counts[s] += counts[s-r]
+		}
+	}
+
+	var tail float64
+	for s := 0; s <= int(math.Floor(w)) && s <= maxSum; s++ {
+		tail += counts[s]
+	}
+	return math.Min(1, 2*tail/math.Pow(2, float64(n)))
+}
+
+func logChoose(n, k int) float64 {
+	a, _ := math.Lgamma(float64(n + 1))
+	b, _ := math.Lgamma(float64(k + 1))
+	c, _ := math.Lgamma(float64(n - k + 1))
+	return a - b - c
+}
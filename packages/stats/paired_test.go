@@ -0,0 +1,100 @@
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMcNemar(t *testing.T) {
+	tests := []struct {
+		name string
+		b, c int
+		want float64
+	}{
+		{name: "no discordant pairs", b: 0, c: 0, want: 1},
+		// exact: 2 * P(X <= 1), X ~ Binomial(10, 0.5) = 2 * 11/1024
+		{name: "exact", b: 1, c: 9, want: 22.0 / 1024},
+		{name: "exact one sided", b: 5, c: 0, want: 2.0 / 32},
+		{name: "exact balanced", b: 3, c: 3, want: 1},
+		// 24 discordant pairs is the largest exact case: 2 * sum_{i<=7} C(24, i) / 2^24
+		{name: "exact below threshold", b: 7, c: 17, want: 2 * 536155.0 / 16777216},
+		// 25 discordant pairs switches to chi-squared: (|8-17| - 1)^2 / 25 = 2.56
+		{name: "chi-squared at threshold", b: 8, c: 17, want: 0.109599},
+		// (|121-59| - 1)^2 / 180 = 20.672
+		{name: "chi-squared", b: 121, c: 59, want: 5.4525e-6},
+		{name: "chi-squared balanced", b: 20, c: 20, want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := McNemar(tt.b, tt.c)
+			if math.Abs(got-tt.want) > 1e-6*math.Max(1, tt.want*100) {
+				t.Errorf("McNemar(%d, %d) = %v, want %v", tt.b, tt.c, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWilcoxonSignedRank(t *testing.T) {
+	ascending := make([]float64, 25)
+	for i := range ascending {
+		ascending[i] = float64(i + 1)
+	}
+	tests := []struct {
+		name         string
+		diffs        []float64
+		wantN        int
+		wantPlus     float64
+		wantMinus    float64
+		wantP        float64
+		wantBiserial float64
+	}{
+		{
+			// exact: only the all-positive and all-negative assignments are as extreme
+			name:  "exact all positive",
+			diffs: []float64{1, 2, 3, 4, 5}, wantN: 5,
+			wantPlus: 15, wantMinus: 0, wantP: 2.0 / 32, wantBiserial: 1,
+		},
+		{
+			// exact: rank sums 0 and 1 each occur once in 2^6 assignments
+			name:  "exact one negative",
+			diffs: []float64{-1, 2, 3, 4, 5, 6}, wantN: 6,
+			wantPlus: 20, wantMinus: 1, wantP: 4.0 / 64, wantBiserial: 19.0 / 21,
+		},
+		{
+			name:  "zero differences are dropped",
+			diffs: []float64{0, 1, 0, 2, 3, 4, 5, 0}, wantN: 5,
+			wantPlus: 15, wantMinus: 0, wantP: 2.0 / 32, wantBiserial: 1,
+		},
+		{
+			name:  "all zero",
+			diffs: []float64{0, 0, 0}, wantN: 0,
+			wantP: 1, wantBiserial: math.NaN(),
+		},
+		{
+			// ties get average ranks 1.5, 1.5, 3, 5, 5, 5 and force the normal
+			// approximation: var = 6*7*13/24 - (2^3-2 + 3^3-3)/48, z = 7/sqrt(var)
+			name:  "ties",
+			diffs: []float64{1, 1, -2, 3, 3, 3}, wantN: 6,
+			wantPlus: 18, wantMinus: 3, wantP: 0.136703, wantBiserial: 15.0 / 21,
+		},
+		{
+			// 25 pairs uses the normal approximation: z = (162.5 - 0.5) / sqrt(1381.25)
+			name:  "normal at threshold",
+			diffs: ascending, wantN: 25,
+			wantPlus: 325, wantMinus: 0, wantP: 1.3064e-5, wantBiserial: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := WilcoxonSignedRank(tt.diffs)
+			if got.N != tt.wantN || got.WPlus != tt.wantPlus || got.WMinus != tt.wantMinus {
+				t.Errorf("got N=%d W+=%v W-=%v, want N=%d W+=%v W-=%v",
+					got.N, got.WPlus, got.WMinus, tt.wantN, tt.wantPlus, tt.wantMinus)
+			}
+			if math.Abs(got.PValue-tt.wantP) > 1e-6*math.Max(1, tt.wantP*100) {
+				t.Errorf("PValue = %v, want %v", got.PValue, tt.wantP)
+			}
+			assertClose(t, got.RankBiserial, tt.wantBiserial, 1e-9)
+		})
+	}
+}
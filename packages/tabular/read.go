@@ -0,0 +1,176 @@
+package tabular
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"io"
+	"strings"
+)
+
+// Format is a file format rows can be read from or written to.
+type Format string
+
+const (
+	CSV   Format = "csv"
+	JSONL Format = "jsonl"
+	YAML  Format = "yaml"
+)
+
+// ParseFormat returns the Format with the given name, ignoring case. "yml" is
+// accepted for YAML.
+func ParseFormat(name string) (Format, error) {
+	switch strings.ToLower(name) {
+	case "csv":
+		return CSV, nil
+	case "jsonl", "ndjson":
+		return JSONL, nil
+	case "yaml", "yml":
+		return YAML, nil
+	}
+	return "", fmt.Errorf("unknown format %q", name)
+}
+
+// Row is one record read from a file. Values maps column names to their text.
+// Rows that could not be parsed carry an Err and no Values, so callers can
+// report them without failing the rest of the file.
+type Row struct {
+	// Line is the line the row starts on, starting at 1.
+	Line   int
+	Values map[string]string
+	Err    error
+}
+
+// Read parses every row of r. It only fails if the file as a whole is
+// malformed; errors in individual rows are returned in Row.Err.
+func Read(format Format, r io.Reader) ([]Row, error) {
+	switch format {
+	case CSV:
+		return readCSV(r)
+	case JSONL:
+		return readJSONL(r)
+	case YAML:
+		return readYAML(r)
+	}
+	return nil, fmt.Errorf("unknown format %q", format)
+}
+
+func readCSV(r io.Reader) ([]Row, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+	// strip a UTF-8 byte order mark, which spreadsheet exports often add
+	if len(header) > 0 {
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+
+	rows := make([]Row, 0)
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			rows = append(rows, Row{Line: parseErr.StartLine, Err: parseErr.Err})
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV: %w", err)
+		}
+		line, _ := cr.FieldPos(0)
+		if len(record) != len(header) {
+			rows = append(rows, Row{Line: line, Err: fmt.Errorf("expected %d fields, got %d", len(header), len(record))})
+			continue
+		}
+
+		values := make(map[string]string, len(header))
+		for i, col := range header {
+			values[col] = record[i]
+		}
+		rows = append(rows, Row{Line: line, Values: values})
+	}
+	return rows, nil
+}
+
+func readJSONL(r io.Reader) ([]Row, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	rows := make([]Row, 0)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+		var obj map[string]any
+		if err := json.Unmarshal(text, &obj); err != nil {
+			rows = append(rows, Row{Line: line, Err: fmt.Errorf("invalid JSON: %w", err)})
+			continue
+		}
+		values, err := stringValues(obj)
+		rows = append(rows, Row{Line: line, Values: values, Err: err})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read JSONL: %w", err)
+	}
+	return rows, nil
+}
+
+func readYAML(r io.Reader) ([]Row, error) {
+	var doc yaml.Node
+	if err := yaml.NewDecoder(r).Decode(&doc); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to parse YAML: %w", err)
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.SequenceNode {
+		return nil, fmt.Errorf("YAML document must be a list of rows")
+	}
+
+	rows := make([]Row, 0, len(doc.Content[0].Content))
+	for _, item := range doc.Content[0].Content {
+		var obj map[string]any
+		if err := item.Decode(&obj); err != nil {
+			rows = append(rows, Row{Line: item.Line, Err: fmt.Errorf("row is not a mapping: %w", err)})
+			continue
+		}
+		values, err := stringValues(obj)
+		rows = append(rows, Row{Line: item.Line, Values: values, Err: err})
+	}
+	return rows, nil
+}
+
+// stringValues flattens decoded values to text. Strings are kept as-is, null
+// becomes empty, and anything else is encoded as JSON.
+func stringValues(obj map[string]any) (map[string]string, error) {
+	values := make(map[string]string, len(obj))
+	for k, v := range obj {
+		switch v := v.(type) {
+		case nil:
+			values[k] = ""
+		case string:
+			values[k] = v
+		default:
+			b, err := json.Marshal(v)
+			if err != nil {
+				return nil, fmt.Errorf("column %q: %w", k, err)
+			}
+			values[k] = string(b)
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,64 @@
+package tabular
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestReadLines(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  Format
+		input   string
+		lines   []int
+		badLine int
+	}{
+		{
+			name:    "csv",
+			format:  CSV,
+			input:   "INPUT,OUTPUT\na,b\n\"multi\nline\",c\nonly one field\n",
+			lines:   []int{2, 3, 5},
+			badLine: 5,
+		},
+		{
+			name:    "jsonl",
+			format:  JSONL,
+			input:   "{\"INPUT\": \"a\"}\n\n{not json}\n{\"INPUT\": \"c\"}\n",
+			lines:   []int{1, 3, 4},
+			badLine: 3,
+		},
+		{
+			name:   "yaml",
+			format: YAML,
+			input: "# test cases\n" +
+				"- INPUT: a\n" +
+				"  OUTPUT: |\n" +
+				"    first\n" +
+				"    second\n" +
+				"- just a string\n" +
+				"-\n" +
+				"  INPUT: c\n",
+			lines:   []int{2, 6, 8},
+			badLine: 6,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := Read(tt.format, strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(rows) != len(tt.lines) {
+				t.Fatalf("got %d rows, want %d", len(rows), len(tt.lines))
+			}
+			for i, row := range rows {
+				if row.Line != tt.lines[i] {
+					t.Errorf("row %d: Line = %d, want %d", i, row.Line, tt.lines[i])
+				}
+				if (row.Err != nil) != (row.Line == tt.badLine) {
+					t.Errorf("row on line %d: Err = %v, want an error only on line %d", row.Line, row.Err, tt.badLine)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package tabular
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"io"
+)
+
+// Writer writes rows with a fixed set of columns. Rows are written as they come,
+// so large exports do not have to be held in memory.
+type Writer struct {
+	format  Format
+	w       io.Writer
+	columns []string
+	csv     *csv.Writer
+}
+
+// NewWriter returns a Writer for the given columns. For CSV the header is written
+// with the first row.
+func NewWriter(format Format, w io.Writer, columns []string) (*Writer, error) {
+	switch format {
+	case CSV, JSONL, YAML:
+	default:
+		return nil, fmt.Errorf("unknown format %q", format)
+	}
+	tw := &Writer{format: format, w: w, columns: columns}
+	if format == CSV {
+		tw.csv = csv.NewWriter(w)
+		if err := tw.csv.Write(columns); err != nil {
+			return nil, err
+		}
+	}
+	return tw, nil
+}
+
+// Write writes one row. Columns missing from values are written empty in CSV and
+// left out in JSONL and YAML.
+func (tw *Writer) Write(values map[string]string) error {
+	switch tw.format {
+	case CSV:
+		record := make([]string, len(tw.columns))
+		for i, col := range tw.columns {
+			record[i] = values[col]
+		}
+		return tw.csv.Write(record)
+	case JSONL:
+		return tw.writeJSON(values)
+	default:
+		return tw.writeYAML(values)
+	}
+}
+
+// Flush writes any buffered data to the underlying writer.
+func (tw *Writer) Flush() error {
+	if tw.csv != nil {
+		tw.csv.Flush()
+		return tw.csv.Error()
+	}
+	return nil
+}
+
+// writeJSON writes values as a JSON object on one line, with keys in column order.
+func (tw *Writer) writeJSON(values map[string]string) error {
+	var buf bytes.Buffer
+	// responses are often XML, which should stay readable
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	buf.WriteByte('{')
+	first := true
+	for _, col := range tw.columns {
+		v, ok := values[col]
+		if !ok {
+			continue
+		}
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+		if err := enc.Encode(col); err != nil {
+			return err
+		}
+		buf.Truncate(buf.Len() - 1)
+		buf.WriteByte(':')
+		if err := enc.Encode(v); err != nil {
+			return err
+		}
+		buf.Truncate(buf.Len() - 1)
+	}
+	buf.WriteString("}\n")
+	_, err := tw.w.Write(buf.Bytes())
+	return err
+}
+
+// writeYAML writes values as one item of a top-level YAML list, so consecutive
+// rows form a single list.
+func (tw *Writer) writeYAML(values map[string]string) error {
+	row := &yaml.Node{Kind: yaml.MappingNode}
+	for _, col := range tw.columns {
+		v, ok := values[col]
+		if !ok {
+			continue
+		}
+		row.Content = append(row.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: col},
+			&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: v},
+		)
+	}
+	out, err := yaml.Marshal([]*yaml.Node{row})
+	if err != nil {
+		return err
+	}
+	_, err = tw.w.Write(out)
+	return err
+}